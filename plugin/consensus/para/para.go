@@ -96,6 +96,9 @@ type subConfig struct {
 	MultiDownInvNumPerJob           int64                 `json:"multiDownInvNumPerJob,omitempty"`
 	MultiDownJobBuffNum             uint32                `json:"multiDownJobBuffNum,omitempty"`
 	MultiDownServerRspTime          uint32                `json:"multiDownServerRspTime,omitempty"`
+	AggCommitListen                 string                `json:"aggCommitListen,omitempty"`
+	AggCommitServer                 string                `json:"aggCommitServer,omitempty"`
+	AggCommitFallbackBlocks         int64                 `json:"aggCommitFallbackBlocks,omitempty"`
 }
 
 // New function to init paracross env
@@ -188,6 +191,17 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 		para.commitMsgClient.waitConsensStopTimes = subcfg.WaitConsensStopTimes
 	}
 
+	//聚合commit, 聚合节点监听其他授权节点的签名, 其他节点把签名发给聚合节点
+	if subcfg.AggCommitListen != "" && subcfg.AggCommitServer != "" {
+		panic("aggCommitListen and aggCommitServer should not be both set")
+	}
+	if subcfg.AggCommitListen != "" || subcfg.AggCommitServer != "" {
+		para.commitMsgClient.aggClient = newAggCommitClient(para.commitMsgClient, subcfg.AggCommitListen, subcfg.AggCommitServer)
+		if subcfg.AggCommitFallbackBlocks > 0 {
+			para.commitMsgClient.aggClient.fallbackBlocks = subcfg.AggCommitFallbackBlocks
+		}
+	}
+
 	// 设置平行链共识起始高度，在共识高度为-1也就是从未共识过的环境中允许从设置的非0起始高度开始共识
 	//note：只有在主链LoopCheckCommitTxDoneForkHeight之后才支持设置ParaConsensStartHeight
 	if subcfg.ParaConsensStartHeight > 0 {
@@ -0,0 +1,296 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package para
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+)
+
+//聚合commit
+// 1. 配置aggCommitListen的授权节点作为聚合节点，接收其他授权节点对共识status的签名
+// 2. 配置aggCommitServer的授权节点不再发送commit交易，只把签名发给聚合节点
+// 3. 聚合节点某高度收集到超过2/3授权节点的签名后，用一笔commitAgg交易提交，主链按每个签名节点投票计算
+// 4. 非聚合节点发送签名后超过aggCommitFallbackBlocks个区块共识高度仍未推进，自己发送commit交易，避免聚合节点故障时无法共识
+
+const (
+	aggCommitPostTimeout                 = 5 * time.Second
+	maxAggCommitMsgSize            int64 = 1 << 20
+	defaultAggCommitFallbackBlocks int64 = 10
+)
+
+type aggCommitItem struct {
+	status *pt.ParacrossNodeStatus
+	signs  map[string]*types.Signature
+}
+
+type aggCommitClient struct {
+	commitMsgClient *commitMsgClient
+	listen          string
+	server          string
+	httpCli         *http.Client
+	//height -> status hash -> item
+	pool  map[int64]map[string]*aggCommitItem
+	mutex sync.Mutex
+	//非聚合节点发送签名的起始高度和当时的链高度，超过fallbackBlocks个区块未共识则自己发送commit交易
+	fallbackBlocks  int64
+	postStart       int64
+	postChainHeight int64
+}
+
+func newAggCommitClient(commitCli *commitMsgClient, listen, server string) *aggCommitClient {
+	return &aggCommitClient{
+		commitMsgClient: commitCli,
+		listen:          listen,
+		server:          server,
+		httpCli:         &http.Client{Timeout: aggCommitPostTimeout},
+		pool:            make(map[int64]map[string]*aggCommitItem),
+		fallbackBlocks:  defaultAggCommitFallbackBlocks,
+		postStart:       -1,
+	}
+}
+
+func (agg *aggCommitClient) isAggregator() bool {
+	return agg.listen != ""
+}
+
+//签名的是整个status，相同blockHash的status其他字段不同也不能聚合到一起
+func calcStatusKey(status *pt.ParacrossNodeStatus) string {
+	return string(common.Sha256(types.Encode(status)))
+}
+
+//GetNodeGroupAddrs 返回的是 fmt.Sprint([]string) 格式
+func parseNodeGroupAddrs(nodes string) []string {
+	return strings.Fields(strings.Trim(nodes, "[]"))
+}
+
+func isAggCommitDone(total, signs int) bool {
+	return 3*signs > 2*total
+}
+
+func (agg *aggCommitClient) serve() {
+	srv := &http.Server{Addr: agg.listen, Handler: agg}
+	go func() {
+		<-agg.commitMsgClient.quit
+		srv.Close()
+	}()
+
+	plog.Info("para aggCommit listen", "addr", agg.listen)
+	err := srv.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		plog.Error("para aggCommit listen", "addr", agg.listen, "err", err.Error())
+	}
+	agg.commitMsgClient.paraClient.wg.Done()
+}
+
+func (agg *aggCommitClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxAggCommitMsgSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var msgs pt.ParaCommitSignMsgs
+	err = types.Decode(data, &msgs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = agg.recvSigns(msgs.Msgs)
+	if err != nil {
+		plog.Error("para aggCommit recv signs", "from", r.RemoteAddr, "err", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+
+	//收到新的签名，检查是否可以聚合发送
+	agg.commitMsgClient.sendCommitTx()
+}
+
+//只接收当前授权节点对未共识高度的签名，避免无效签名导致整个聚合交易失败
+func (agg *aggCommitClient) recvSigns(msgs []*pt.ParacrossCommitAggAction) error {
+	nodeGroup, err := agg.commitMsgClient.getNodeGroupAddrs()
+	if err != nil {
+		return err
+	}
+	nodes := make(map[string]bool)
+	for _, addr := range parseNodeGroupAddrs(nodeGroup) {
+		nodes[addr] = true
+	}
+	consensHeight := agg.commitMsgClient.getConsensusHeight()
+	for _, msg := range msgs {
+		if msg.Status == nil || msg.Status.Title != types.GetTitle() {
+			return types.ErrInvalidParam
+		}
+		if msg.Status.Height <= consensHeight {
+			continue
+		}
+		for _, sign := range msg.Signs {
+			addr, err := pt.CheckCommitSign(msg.Status, sign)
+			if err != nil {
+				return errors.Wrapf(err, "height:%d", msg.Status.Height)
+			}
+			if !nodes[addr] {
+				return errors.Wrapf(pt.ErrNodeNotForTheTitle, "addr:%s", addr)
+			}
+			agg.addSign(msg.Status, addr, sign)
+		}
+	}
+	return nil
+}
+
+func (agg *aggCommitClient) addSign(status *pt.ParacrossNodeStatus, addr string, sign *types.Signature) {
+	agg.mutex.Lock()
+	defer agg.mutex.Unlock()
+
+	items, ok := agg.pool[status.Height]
+	if !ok {
+		items = make(map[string]*aggCommitItem)
+		agg.pool[status.Height] = items
+	}
+	key := calcStatusKey(status)
+	item, ok := items[key]
+	if !ok {
+		item = &aggCommitItem{status: status, signs: make(map[string]*types.Signature)}
+		items[key] = item
+	}
+	item.signs[addr] = sign
+}
+
+//清除已经共识的高度
+func (agg *aggCommitClient) clearPool(consensHeight int64) {
+	agg.mutex.Lock()
+	defer agg.mutex.Unlock()
+
+	for height := range agg.pool {
+		if height <= consensHeight {
+			delete(agg.pool, height)
+		}
+	}
+}
+
+//按地址排序，保证相同签名集合生成的交易一致
+func (agg *aggCommitClient) getSigns(status *pt.ParacrossNodeStatus, total int) []*types.Signature {
+	agg.mutex.Lock()
+	defer agg.mutex.Unlock()
+
+	item, ok := agg.pool[status.Height][calcStatusKey(status)]
+	if !ok || !isAggCommitDone(total, len(item.signs)) {
+		return nil
+	}
+	var addrs []string
+	for addr := range item.signs {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	var signs []*types.Signature
+	for _, addr := range addrs {
+		signs = append(signs, item.signs[addr])
+	}
+	return signs
+}
+
+func (agg *aggCommitClient) postSigns(msgs *pt.ParaCommitSignMsgs) error {
+	resp, err := agg.httpCli.Post(agg.server, "application/octet-stream", bytes.NewReader(types.Encode(msgs)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("aggCommit server rsp:%s,%s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+//非聚合节点从start高度开始发送签名，链高度超过发送时fallbackBlocks个区块后仍没有共识，需要自己发送commit交易
+//共识推进后start变化，重新计算
+func (agg *aggCommitClient) needFallback(start, chainHeight int64) bool {
+	if agg.postStart != start {
+		agg.postStart = start
+		agg.postChainHeight = chainHeight
+	}
+	return chainHeight-agg.postChainHeight >= agg.fallbackBlocks
+}
+
+//非聚合节点只发送签名，不返回交易，未共识的高度每次都会重新签名发送，聚合节点按地址去重
+//聚合节点从起始高度开始，连续的签名数达到共识的status聚合为一个交易组发送
+func (agg *aggCommitClient) calcCommitAggTxs(notifications []*pt.ParacrossNodeStatus, feeRate int64) (*types.Transaction, int64, error) {
+	client := agg.commitMsgClient
+	if !agg.isAggregator() {
+		if agg.needFallback(notifications[0].Height, atomic.LoadInt64(&client.chainHeight)) {
+			plog.Info("para aggCommit fallback to commit tx", "start", notifications[0].Height, "postChainHeight", agg.postChainHeight)
+			return client.calcCommitMsgTxs(notifications, feeRate)
+		}
+		var msgs pt.ParaCommitSignMsgs
+		for _, status := range notifications {
+			msgs.Msgs = append(msgs.Msgs, &pt.ParacrossCommitAggAction{
+				Status: status,
+				Signs:  []*types.Signature{pt.SignCommitStatus(status, types.SECP256K1, client.privateKey)},
+			})
+		}
+		err := agg.postSigns(&msgs)
+		if err != nil {
+			plog.Error("para aggCommit post signs", "server", agg.server, "start", notifications[0].Height, "err", err.Error())
+			return nil, 0, err
+		}
+		plog.Debug("para aggCommit post signs", "start", notifications[0].Height, "count", len(notifications))
+		return nil, 0, nil
+	}
+
+	agg.clearPool(client.getConsensusHeight())
+	for _, status := range notifications {
+		agg.addSign(status, client.paraClient.authAccount, pt.SignCommitStatus(status, types.SECP256K1, client.privateKey))
+	}
+	nodes, err := client.getNodeGroupAddrs()
+	if err != nil {
+		return nil, 0, err
+	}
+	total := len(parseNodeGroupAddrs(nodes))
+
+	var rawTxs types.Transactions
+	for _, status := range notifications {
+		signs := agg.getSigns(status, total)
+		if len(signs) == 0 {
+			break
+		}
+		execName := pt.ParaX
+		if client.paraClient.isParaSelfConsensusForked(status.MainBlockHeight) {
+			execName = pt.GetExecName()
+		}
+		tx, err := pt.CreateRawCommitAggTx4MainChain(status, signs, execName, feeRate)
+		if err != nil {
+			plog.Error("para get commit agg tx", "block height", status.Height)
+			return nil, 0, err
+		}
+		rawTxs.Txs = append(rawTxs.Txs, tx)
+	}
+	if len(rawTxs.Txs) == 0 {
+		plog.Debug("para aggCommit signs not enough", "start", notifications[0].Height, "nodes", total)
+		return nil, 0, nil
+	}
+
+	signTx, err := client.getTxsGroup(&rawTxs)
+	if err != nil {
+		return nil, 0, err
+	}
+	return signTx, int64(len(rawTxs.Txs)), nil
+}
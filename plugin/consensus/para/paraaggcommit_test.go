@@ -0,0 +1,99 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package para
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggCommitSigns(t *testing.T) {
+	secp, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+
+	status := &pt.ParacrossNodeStatus{Title: "user.p.test.", Height: 10, BlockHash: []byte("block-hash-10")}
+	agg := newAggCommitClient(nil, "localhost:0", "")
+	var addrs []string
+	for i := 0; i < 3; i++ {
+		priv, err := secp.GenKey()
+		assert.Nil(t, err)
+		sign := pt.SignCommitStatus(status, types.SECP256K1, priv)
+		addr, err := pt.CheckCommitSign(status, sign)
+		assert.Nil(t, err)
+		assert.Equal(t, address.PubKeyToAddress(priv.PubKey().Bytes()).String(), addr)
+		addrs = append(addrs, addr)
+
+		agg.addSign(status, addr, sign)
+		//重复签名只算一次
+		agg.addSign(status, addr, sign)
+	}
+
+	//4个节点需要3个签名
+	assert.Len(t, agg.getSigns(status, 5), 0)
+	signs := agg.getSigns(status, 4)
+	assert.Len(t, signs, 3)
+	for i := 1; i < len(signs); i++ {
+		assert.True(t, address.PubKeyToAddress(signs[i-1].Pubkey).String() < address.PubKeyToAddress(signs[i].Pubkey).String())
+	}
+
+	//blockHash相同但内容不同的status不能聚合
+	other := *status
+	other.TxResult = []byte("abc")
+	assert.Len(t, agg.getSigns(&other, 4), 0)
+	_, err = pt.CheckCommitSign(&other, signs[0])
+	assert.Equal(t, types.ErrSign, err)
+
+	agg.clearPool(status.Height - 1)
+	assert.Len(t, agg.getSigns(status, 4), 3)
+	agg.clearPool(status.Height)
+	assert.Len(t, agg.getSigns(status, 4), 0)
+
+	nodes := parseNodeGroupAddrs(fmt.Sprint(addrs))
+	assert.Equal(t, addrs, nodes)
+}
+
+func TestAggCommitPostSigns(t *testing.T) {
+	var recv pt.ParaCommitSignMsgs
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		if types.Decode(data, &recv) != nil {
+			http.Error(w, "decode", http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	agg := newAggCommitClient(nil, "", srv.URL)
+	assert.False(t, agg.isAggregator())
+	msgs := &pt.ParaCommitSignMsgs{Msgs: []*pt.ParacrossCommitAggAction{
+		{Status: &pt.ParacrossNodeStatus{Height: 1}, Signs: []*types.Signature{{Ty: types.SECP256K1}}},
+	}}
+	assert.Nil(t, agg.postSigns(msgs))
+	assert.Equal(t, int64(1), recv.Msgs[0].Status.Height)
+
+	agg.server = srv.URL + "/%zz"
+	assert.NotNil(t, agg.postSigns(msgs))
+}
+
+func TestAggCommitFallback(t *testing.T) {
+	agg := newAggCommitClient(nil, "", "http://localhost:8806")
+	agg.fallbackBlocks = 3
+
+	assert.False(t, agg.needFallback(11, 20))
+	assert.False(t, agg.needFallback(11, 22))
+	assert.True(t, agg.needFallback(11, 23))
+
+	//共识推进后重新计算
+	assert.False(t, agg.needFallback(12, 23))
+	assert.True(t, agg.needFallback(12, 26))
+}
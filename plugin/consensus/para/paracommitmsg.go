@@ -50,6 +50,7 @@ type commitMsgClient struct {
 	checkTxCommitTimes   int32
 	txFeeRate            int64
 	privateKey           crypto.PrivKey
+	aggClient            *aggCommitClient
 	quit                 chan struct{}
 	mutex                sync.Mutex
 }
@@ -72,6 +73,11 @@ func (client *commitMsgClient) handler() {
 		client.sendMsgCh = make(chan *types.Transaction, 1)
 		go client.sendCommitMsg()
 
+		if client.aggClient != nil && client.aggClient.isAggregator() {
+			client.paraClient.wg.Add(1)
+			go client.aggClient.serve()
+		}
+
 		ticker := time.NewTicker(time.Second * time.Duration(minerInterval))
 		readTick = ticker.C
 		defer ticker.Stop()
@@ -337,7 +343,12 @@ func (client *commitMsgClient) getSendingTx(startHeight, endHeight int64) (*type
 		return nil, 0
 	}
 
-	signTx, count, err := client.calcCommitMsgTxs(status, atomic.LoadInt64(&client.txFeeRate))
+	var signTx *types.Transaction
+	if client.aggClient != nil {
+		signTx, count, err = client.aggClient.calcCommitAggTxs(status, atomic.LoadInt64(&client.txFeeRate))
+	} else {
+		signTx, count, err = client.calcCommitMsgTxs(status, atomic.LoadInt64(&client.txFeeRate))
+	}
 	if err != nil || signTx == nil {
 		return nil, 0
 	}
@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"strings"
+
 	"github.com/33cn/chain33/common/address"
 	log "github.com/33cn/chain33/common/log/log15"
 	drivers "github.com/33cn/chain33/system/dapp"
@@ -22,6 +24,8 @@ var (
 	driverName   = auty.AutonomyX
 	autonomyAddr = address.ExecAddress(auty.AutonomyX)
 	cfg          subConfig
+	//多重签名执行器名, 不引入multisig的包
+	multiSigX = "multisig"
 )
 
 func init() {
@@ -58,3 +62,59 @@ func GetName() string {
 func (u *Autonomy) GetDriverName() string {
 	return driverName
 }
+
+// ExecFrom 多重签名账户批准的投票交易，以多重签名账户作为投票人执行
+func (u *Autonomy) ExecFrom(from string, tx *types.Transaction, index int) (*types.Receipt, error) {
+	txs := u.GetTxs()
+	if from == "" || index < 0 || index >= len(txs) || multiSigAccAddr(txs[index]) != from {
+		return nil, types.ErrNotAllow
+	}
+	var payload auty.AutonomyAction
+	err := types.Decode(tx.Payload, &payload)
+	if err != nil {
+		return nil, err
+	}
+	action := newAction(u, tx, int32(index))
+	action.fromaddr = from
+	//提案和撤销需要冻结或者解冻coins，只支持投票
+	switch {
+	case payload.Ty == auty.AutonomyActionVotePropBoard && payload.GetVotePropBoard() != nil:
+		return action.votePropBoard(payload.GetVotePropBoard())
+	case payload.Ty == auty.AutonomyActionVotePropProject && payload.GetVotePropProject() != nil:
+		return action.votePropProject(payload.GetVotePropProject())
+	case payload.Ty == auty.AutonomyActionPubVotePropProject && payload.GetPubVotePropProject() != nil:
+		return action.pubVotePropProject(payload.GetPubVotePropProject())
+	case payload.Ty == auty.AutonomyActionVotePropRule && payload.GetVotePropRule() != nil:
+		return action.votePropRule(payload.GetVotePropRule())
+	case payload.Ty == auty.AutonomyActionVotePropChange && payload.GetVotePropChange() != nil:
+		return action.votePropChange(payload.GetVotePropChange())
+	}
+	return nil, types.ErrActionNotSupport
+}
+
+// IsFriend 多重签名账户批准的投票交易，允许修改autonomy的提案数据
+func (u *Autonomy) IsFriend(myexec, writekey []byte, othertx *types.Transaction) bool {
+	if string(types.GetRealExecName(othertx.Execer)) != multiSigX {
+		return false
+	}
+	return string(myexec) == driverName && strings.HasPrefix(string(writekey), idPrefix)
+}
+
+//多重签名交易操作的多重签名账户地址
+func multiSigAccAddr(tx *types.Transaction) string {
+	if string(types.GetRealExecName(tx.Execer)) != multiSigX {
+		return ""
+	}
+	ety := types.LoadExecutorType(multiSigX)
+	if ety == nil {
+		return ""
+	}
+	_, v, err := ety.DecodePayloadValue(tx)
+	if err != nil {
+		return ""
+	}
+	if action, ok := v.Interface().(interface{ GetMultiSigAccAddr() string }); ok {
+		return action.GetMultiSigAccAddr()
+	}
+	return ""
+}
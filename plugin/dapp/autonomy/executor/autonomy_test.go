@@ -0,0 +1,63 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecFrom(t *testing.T) {
+	multiSigAddr := "1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR"
+	au := newAutonomy().(*Autonomy)
+	_, storedb, _ := util.CreateTestDB()
+	au.SetStateDB(storedb)
+
+	confirm := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigConfirmTx,
+		Value: &mty.MultiSigAction_MultiSigConfirmTx{MultiSigConfirmTx: &mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr}},
+	}
+	mtx, err := types.CreateFormatTx(types.ExecName(mty.MultiSigX), types.Encode(confirm))
+	assert.NoError(t, err)
+	au.SetTxs([]*types.Transaction{mtx})
+
+	vote := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionVotePropBoard,
+		Value: &auty.AutonomyAction_VotePropBoard{VotePropBoard: &auty.VoteProposalBoard{ProposalID: "1111111111111111111"}},
+	}
+	tx, err := types.CreateFormatTx(types.ExecName(auty.AutonomyX), types.Encode(vote))
+	assert.NoError(t, err)
+
+	//发起人必须是多重签名交易操作的账户
+	_, err = au.ExecFrom("1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4", tx, 0)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, err = au.ExecFrom(multiSigAddr, tx, 1)
+	assert.Equal(t, types.ErrNotAllow, err)
+	//投票交易按照正常流程执行，提案不存在
+	_, err = au.ExecFrom(multiSigAddr, tx, 0)
+	assert.Error(t, err)
+	assert.NotEqual(t, types.ErrNotAllow, err)
+	assert.NotEqual(t, types.ErrActionNotSupport, err)
+
+	//提案需要冻结coins，不支持
+	prop := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionPropBoard,
+		Value: &auty.AutonomyAction_PropBoard{PropBoard: &auty.ProposalBoard{}},
+	}
+	tx, err = types.CreateFormatTx(types.ExecName(auty.AutonomyX), types.Encode(prop))
+	assert.NoError(t, err)
+	_, err = au.ExecFrom(multiSigAddr, tx, 0)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+
+	//多重签名交易只能修改提案数据
+	assert.True(t, au.IsFriend([]byte(auty.AutonomyX), propBoardID("1111111111111111111"), mtx))
+	assert.False(t, au.IsFriend([]byte(auty.AutonomyX), []byte("mavl-coins-bty-"+multiSigAddr), mtx))
+	assert.False(t, au.IsFriend([]byte(auty.AutonomyX), propBoardID("1111111111111111111"), tx))
+}
@@ -0,0 +1,283 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// TransactOpts 创建合约交易的参数，字段的含义和单位与 evm.EvmCreateTx, evm.EvmCallTx 接口一致
+type TransactOpts struct {
+	// Caller 交易发起者地址
+	Caller string
+	// Amount 调用合约时转给合约的金额
+	Amount uint64
+	// Fee 交易手续费
+	Fee    int64
+	Note   string
+	Expire string
+}
+
+// BoundContract 绑定了ABI的合约，生成的合约代码通过它创建交易和查询合约
+type BoundContract struct {
+	abi      abi.ABI
+	client   *jsonclient.JSONClient
+	paraName string
+	exec     string
+	address  string
+}
+
+// NewBoundContract 创建合约对象，exec为合约的执行器名称，如 user.evm.0x...
+func NewBoundContract(client *jsonclient.JSONClient, paraName, exec, abiData string) (*BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiData))
+	if err != nil {
+		return nil, err
+	}
+	return &BoundContract{
+		abi:      parsed,
+		client:   client,
+		paraName: paraName,
+		exec:     exec,
+		address:  address.ExecAddress(exec),
+	}, nil
+}
+
+// Address 合约地址
+func (c *BoundContract) Address() string {
+	return c.address
+}
+
+// DeployContract 创建部署合约的交易，返回未签名的交易
+func DeployContract(client *jsonclient.JSONClient, paraName string, opts *TransactOpts, abiData, bytecode string, params ...interface{}) (string, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiData))
+	if err != nil {
+		return "", err
+	}
+	args, err := convertArgs(parsed.Constructor.Inputs, params)
+	if err != nil {
+		return "", err
+	}
+	input, err := parsed.Pack("", args...)
+	if err != nil {
+		return "", err
+	}
+	code, err := common.HexToBytes(bytecode)
+	if err != nil {
+		return "", err
+	}
+	req := &evmtypes.EvmContractCreateReq{
+		Code:     common.Bytes2Hex(append(code, input...)),
+		Fee:      opts.Fee,
+		Note:     opts.Note,
+		Caller:   opts.Caller,
+		Abi:      abiData,
+		Expire:   opts.Expire,
+		ParaName: paraName,
+	}
+	var tx string
+	err = client.Call("evm.EvmCreateTx", req, &tx)
+	return tx, err
+}
+
+// Transact 创建调用合约的交易，返回未签名的交易
+func (c *BoundContract) Transact(opts *TransactOpts, method string, params ...interface{}) (string, error) {
+	m, ok := c.abi.Methods[method]
+	if !ok {
+		return "", fmt.Errorf("function %v not exists", method)
+	}
+	args, err := convertArgs(m.Inputs, params)
+	if err != nil {
+		return "", err
+	}
+	input, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return "", err
+	}
+	req := &evmtypes.EvmContractCallReq{
+		Amount:   opts.Amount,
+		Code:     common.Bytes2Hex(input),
+		Fee:      opts.Fee,
+		Note:     opts.Note,
+		Caller:   opts.Caller,
+		Exec:     c.exec,
+		Expire:   opts.Expire,
+		ParaName: c.paraName,
+	}
+	var tx string
+	err = c.client.Call("evm.EvmCallTx", req, &tx)
+	return tx, err
+}
+
+// Call 调用合约的只读方法，results为接收返回值的指针，按照返回值的顺序排列
+func (c *BoundContract) Call(caller string, results []interface{}, method string, params ...interface{}) error {
+	m, ok := c.abi.Methods[method]
+	if !ok {
+		return fmt.Errorf("function %v not exists", method)
+	}
+	//查询接口只支持字符串格式的调用参数
+	input, err := formatCall(m, params)
+	if err != nil {
+		return err
+	}
+	req := &evmtypes.EvmQueryReq{Address: c.address, Input: input, Caller: caller}
+	query := rpctypes.Query4Jrpc{
+		Execer:   types.ExecName(c.paraName + evmtypes.ExecutorName),
+		FuncName: "Query",
+		Payload:  types.MustPBToJSON(req),
+	}
+	var resp evmtypes.EvmQueryResp
+	err = c.client.Call("Chain33.Query", query, &resp)
+	if err != nil {
+		return err
+	}
+	if len(m.Outputs) == 0 {
+		return nil
+	}
+	//执行出错时RawData为空，错误信息在JsonData中
+	if resp.RawData == "" {
+		if resp.JsonData != "" {
+			return errors.New(resp.JsonData)
+		}
+		return errors.New("empty result")
+	}
+	data, err := common.HexToBytes(resp.RawData)
+	if err != nil {
+		return err
+	}
+	values, err := m.Outputs.UnpackValues(data)
+	if err != nil {
+		return err
+	}
+	return assign(results, values)
+}
+
+// UnpackLog 按照事件定义解析合约日志，results为接收参数的指针，按照事件参数的顺序排列
+func (c *BoundContract) UnpackLog(results []interface{}, event string, topics [][]byte, data []byte) error {
+	e, ok := c.abi.Events[event]
+	if !ok {
+		return fmt.Errorf("event %v not exists", event)
+	}
+	if len(topics) == 0 || common.BytesToHash(topics[0]) != e.ID() {
+		return fmt.Errorf("log is not event %v", event)
+	}
+	hashes := make([]common.Hash, len(topics))
+	for i, topic := range topics {
+		hashes[i] = common.BytesToHash(topic)
+	}
+	values, err := abi.UnpackEventValues(e, hashes, data)
+	if err != nil {
+		return err
+	}
+	return assign(results, values)
+}
+
+func assign(results []interface{}, values []interface{}) error {
+	if len(results) != len(values) {
+		return fmt.Errorf("result count error: want %d, got %d", len(values), len(results))
+	}
+	for i, value := range values {
+		dst := reflect.ValueOf(results[i])
+		if dst.Kind() != reflect.Ptr || dst.IsNil() {
+			return fmt.Errorf("result %d is not a pointer", i)
+		}
+		src := reflect.ValueOf(value)
+		if !src.Type().AssignableTo(dst.Elem().Type()) {
+			return fmt.Errorf("cannot assign %v to %v", src.Type(), dst.Elem().Type())
+		}
+		dst.Elem().Set(src)
+	}
+	return nil
+}
+
+//绑定代码中地址类型的参数使用字符串，打包之前转换为合约地址
+func convertArgs(inputs abi.Arguments, params []interface{}) ([]interface{}, error) {
+	if len(inputs) != len(params) {
+		return nil, fmt.Errorf("params count error: want %d, got %d", len(inputs), len(params))
+	}
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		args[i] = param
+		if s, ok := param.(string); ok && inputs[i].Type.T == abi.AddressTy {
+			addr := common.StringToAddress(s)
+			if addr == nil {
+				return nil, fmt.Errorf("invalid address: %v", s)
+			}
+			args[i] = addr.ToHash160()
+		}
+	}
+	return args, nil
+}
+
+//转换为查询接口使用的调用格式 foo(param1,param2)
+func formatCall(method abi.Method, params []interface{}) (string, error) {
+	if len(method.Inputs) != len(params) {
+		return "", fmt.Errorf("params count error: want %d, got %d", len(method.Inputs), len(params))
+	}
+	args := make([]string, len(params))
+	for i, param := range params {
+		arg, err := formatArg(reflect.ValueOf(param))
+		if err != nil {
+			return "", err
+		}
+		args[i] = arg
+	}
+	return fmt.Sprintf("%s(%s)", method.Name, strings.Join(args, ",")), nil
+}
+
+func formatArg(v reflect.Value) (string, error) {
+	if !v.IsValid() {
+		return "", errors.New("nil param")
+	}
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		return value.String(), nil
+	case common.Hash160Address:
+		return value.ToAddress().String(), nil
+	case common.Hash:
+		return value.Hex(), nil
+	case []byte:
+		return common.Bytes2Hex(value), nil
+	case string:
+		//字符串中不能出现调用格式中的分隔符
+		if strings.ContainsAny(value, `"[]`) {
+			return "", fmt.Errorf("string param not supported: %v", value)
+		}
+		return `"` + value + `"`, nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Array, reflect.Slice:
+		//固定长度的字节数组
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(data), v)
+			return common.Bytes2Hex(data), nil
+		}
+		items := make([]string, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item, err := formatArg(v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			items[i] = item
+		}
+		return "[" + strings.Join(items, ",") + "]", nil
+	}
+	return "", fmt.Errorf("param type not supported: %v", v.Type())
+}
@@ -0,0 +1,295 @@
+// Package bind 根据合约的ABI生成Go语言的合约绑定代码，生成的代码通过jrpc接口创建合约交易、查询合约和解析事件
+package bind
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+)
+
+type tmplData struct {
+	Package     string
+	Type        string
+	ABI         string
+	Bin         string
+	Constructor *tmplMethod
+	Calls       []*tmplMethod
+	Transacts   []*tmplMethod
+	Events      []*tmplEvent
+}
+
+type tmplMethod struct {
+	Name    string
+	GoName  string
+	Sig     string
+	Inputs  []*tmplParam
+	Outputs []*tmplParam
+}
+
+type tmplEvent struct {
+	Name   string
+	GoName string
+	Sig    string
+	Fields []*tmplParam
+}
+
+type tmplParam struct {
+	Name string
+	Type string
+}
+
+//生成代码中使用的变量名，参数不能和它们重名
+var reservedNames = map[string]bool{
+	"opts": true, "caller": true, "client": true, "paraName": true, "contract": true,
+	"err": true, "ret": true, "ret0": true, "event": true, "topics": true, "data": true,
+}
+
+// Bind 生成合约的绑定代码
+// typeName 生成的合约类型名称
+// abiData 合约的ABI定义
+// bytecode 合约的字节码，为空时不生成部署合约的函数
+// pkg 生成代码的包名
+func Bind(typeName, abiData, bytecode, pkg string) (string, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiData))
+	if err != nil {
+		return "", err
+	}
+	typeName = capitalise(typeName)
+	if !token.IsIdentifier(typeName) {
+		return "", fmt.Errorf("invalid type name: %v", typeName)
+	}
+	if !token.IsIdentifier(pkg) || token.IsKeyword(pkg) {
+		return "", fmt.Errorf("invalid package name: %v", pkg)
+	}
+	data := &tmplData{
+		Package: pkg,
+		Type:    typeName,
+		ABI:     abiData,
+		Bin:     bytecode,
+	}
+	if bytecode != "" {
+		data.Bin = "0x" + strings.TrimPrefix(bytecode, "0x")
+		data.Constructor = &tmplMethod{Inputs: bindInputs(parsed.Constructor.Inputs)}
+	}
+
+	//按名称排序，保证每次生成的代码一样
+	names := make([]string, 0, len(parsed.Methods))
+	for name := range parsed.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		method := parsed.Methods[name]
+		item := &tmplMethod{
+			Name:   method.Name,
+			GoName: capitalise(method.Name),
+			Sig:    method.Sig(),
+			Inputs: bindInputs(method.Inputs),
+		}
+		if method.Const {
+			item.Outputs = bindFields(method.Outputs, false)
+			data.Calls = append(data.Calls, item)
+		} else {
+			data.Transacts = append(data.Transacts, item)
+		}
+	}
+
+	names = names[:0]
+	for name := range parsed.Events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		event := parsed.Events[name]
+		if event.Anonymous {
+			continue
+		}
+		data.Events = append(data.Events, &tmplEvent{
+			Name:   event.Name,
+			GoName: capitalise(event.Name),
+			Sig:    eventSig(event),
+			Fields: bindFields(event.Inputs, true),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := bindTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("%v\n%s", err, buf.String())
+	}
+	return string(code), nil
+}
+
+func eventSig(event abi.Event) string {
+	types := make([]string, len(event.Inputs))
+	for i, input := range event.Inputs {
+		types[i] = input.Type.String()
+	}
+	return fmt.Sprintf("%v(%v)", event.Name, strings.Join(types, ","))
+}
+
+func bindInputs(args abi.Arguments) []*tmplParam {
+	params := make([]*tmplParam, len(args))
+	used := make(map[string]bool)
+	for i, arg := range args {
+		name := strings.TrimLeft(arg.Name, "_")
+		if name != "" {
+			name = strings.ToLower(name[:1]) + name[1:]
+		}
+		if name == "" || used[name] {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		if token.IsKeyword(name) || reservedNames[name] {
+			name += "_"
+		}
+		used[name] = true
+		params[i] = &tmplParam{Name: name, Type: goType(arg.Type, true)}
+	}
+	return params
+}
+
+//返回值和事件参数生成结构体的字段
+func bindFields(args abi.Arguments, event bool) []*tmplParam {
+	fields := make([]*tmplParam, len(args))
+	used := make(map[string]bool)
+	for i, arg := range args {
+		name := capitalise(arg.Name)
+		if name == "" || used[name] {
+			name = fmt.Sprintf("Arg%d", i)
+		}
+		used[name] = true
+		typ := goType(arg.Type, true)
+		//动态类型的indexed参数在日志中只有哈希
+		if event && arg.Indexed {
+			switch arg.Type.T {
+			case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy:
+				typ = "string"
+			}
+		}
+		fields[i] = &tmplParam{Name: name, Type: typ}
+	}
+	return fields
+}
+
+//abi类型对应的Go类型，和abi包中打包、解包使用的类型一致
+//最外层的地址类型使用字符串表示
+func goType(t abi.Type, top bool) string {
+	switch t.T {
+	case abi.AddressTy:
+		if top {
+			return "string"
+		}
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.SliceTy:
+		return "[]" + goType(*t.Elem, false)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%d]%s", t.Size, goType(*t.Elem, false))
+	}
+	return t.Type.String()
+}
+
+func capitalise(input string) string {
+	input = strings.TrimLeft(input, "_")
+	if len(input) == 0 {
+		return ""
+	}
+	return strings.ToUpper(input[:1]) + input[1:]
+}
+
+var bindTemplate = template.Must(template.New("bind").Parse(tmplSource))
+
+const tmplSource = `// Code generated by evm abi gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"math/big"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	"github.com/33cn/plugin/plugin/dapp/evm/commands/bind"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+)
+
+var (
+	_ = big.NewInt
+	_ = common.BytesToHash
+)
+
+// {{.Type}}ABI 合约的ABI定义
+const {{.Type}}ABI = {{printf "%q" .ABI}}
+{{if .Constructor}}
+// {{.Type}}Bin 合约的字节码
+const {{.Type}}Bin = {{printf "%q" .Bin}}
+
+// Deploy{{.Type}} 创建部署合约的交易，返回未签名的交易
+func Deploy{{.Type}}(client *jsonclient.JSONClient, paraName string, opts *bind.TransactOpts{{range .Constructor.Inputs}}, {{.Name}} {{.Type}}{{end}}) (string, error) {
+	return bind.DeployContract(client, paraName, opts, {{.Type}}ABI, {{.Type}}Bin{{range .Constructor.Inputs}}, {{.Name}}{{end}})
+}
+{{end}}
+// {{.Type}} 合约的绑定
+type {{.Type}} struct {
+	contract *bind.BoundContract
+}
+
+// New{{.Type}} 绑定已经部署的合约，exec为合约的执行器名称
+func New{{.Type}}(client *jsonclient.JSONClient, paraName, exec string) (*{{.Type}}, error) {
+	contract, err := bind.NewBoundContract(client, paraName, exec, {{.Type}}ABI)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.Type}}{contract: contract}, nil
+}
+{{$type := .Type}}
+{{range .Calls}}{{if gt (len .Outputs) 1}}
+// {{$type}}{{.GoName}}Result {{.Name}} 的返回值
+type {{$type}}{{.GoName}}Result struct {
+{{range .Outputs}}	{{.Name}} {{.Type}}
+{{end}}}
+{{end}}
+// {{.GoName}} 调用只读方法 {{.Sig}}
+func (_{{$type}} *{{$type}}) {{.GoName}}(caller string{{range .Inputs}}, {{.Name}} {{.Type}}{{end}}) ({{if eq (len .Outputs) 1}}{{(index .Outputs 0).Type}}, {{else if gt (len .Outputs) 1}}{{$type}}{{.GoName}}Result, {{end}}error) {
+{{- if eq (len .Outputs) 0}}
+	return _{{$type}}.contract.Call(caller, nil, {{printf "%q" .Name}}{{range .Inputs}}, {{.Name}}{{end}})
+{{- else if eq (len .Outputs) 1}}
+	var ret0 {{(index .Outputs 0).Type}}
+	err := _{{$type}}.contract.Call(caller, []interface{}{&ret0}, {{printf "%q" .Name}}{{range .Inputs}}, {{.Name}}{{end}})
+	return ret0, err
+{{- else}}
+	var ret {{$type}}{{.GoName}}Result
+	err := _{{$type}}.contract.Call(caller, []interface{}{ {{- range $i, $o := .Outputs}}{{if $i}}, {{end}}&ret.{{$o.Name}}{{end -}} }, {{printf "%q" .Name}}{{range .Inputs}}, {{.Name}}{{end}})
+	return ret, err
+{{- end}}
+}
+{{end}}
+{{range .Transacts}}
+// {{.GoName}} 创建调用 {{.Sig}} 的交易，返回未签名的交易
+func (_{{$type}} *{{$type}}) {{.GoName}}(opts *bind.TransactOpts{{range .Inputs}}, {{.Name}} {{.Type}}{{end}}) (string, error) {
+	return _{{$type}}.contract.Transact(opts, {{printf "%q" .Name}}{{range .Inputs}}, {{.Name}}{{end}})
+}
+{{end}}
+{{range .Events}}
+// {{$type}}{{.GoName}} 事件 {{.Sig}}
+type {{$type}}{{.GoName}} struct {
+{{range .Fields}}	{{.Name}} {{.Type}}
+{{end}}}
+
+// Parse{{.GoName}} 解析 {{.Name}} 事件的日志
+func (_{{$type}} *{{$type}}) Parse{{.GoName}}(topics [][]byte, data []byte) (*{{$type}}{{.GoName}}, error) {
+	event := new({{$type}}{{.GoName}})
+	err := _{{$type}}.contract.UnpackLog([]interface{}{ {{- range $i, $f := .Fields}}{{if $i}}, {{end}}&event.{{$f.Name}}{{end -}} }, {{printf "%q" .Name}}, topics, data)
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+{{end}}`
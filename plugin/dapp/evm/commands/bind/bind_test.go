@@ -0,0 +1,128 @@
+package bind
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const tokenABI = `[
+{"type":"constructor","inputs":[{"name":"_supply","type":"uint256"}]},
+{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"info","constant":true,"inputs":[],"outputs":[{"name":"name","type":"string"},{"name":"owners","type":"address[]"}]},
+{"type":"function","name":"transfer","inputs":[{"name":"_to","type":"address"},{"name":"type","type":"uint8"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false},{"name":"memo","type":"string","indexed":true}]}
+]`
+
+const testAddr = "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"
+
+func TestBind(t *testing.T) {
+	code, err := Bind("token", tokenABI, "6080", "token")
+	assert.Nil(t, err)
+	for _, item := range []string{
+		"func DeployToken(client *jsonclient.JSONClient, paraName string, opts *bind.TransactOpts, supply *big.Int) (string, error)",
+		"func (_Token *Token) BalanceOf(caller string, owner string) (*big.Int, error)",
+		"func (_Token *Token) Info(caller string) (TokenInfoResult, error)",
+		"Owners []common.Hash160Address",
+		"func (_Token *Token) Transfer(opts *bind.TransactOpts, to string, type_ uint8) (string, error)",
+		"func (_Token *Token) ParseTransfer(topics [][]byte, data []byte) (*TokenTransfer, error)",
+		`const TokenBin = "0x6080"`,
+	} {
+		assert.True(t, strings.Contains(code, item), item)
+	}
+
+	//没有字节码时不生成部署函数
+	code, err = Bind("token", tokenABI, "", "token")
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(code, "DeployToken"))
+
+	_, err = Bind("token", tokenABI, "", "type")
+	assert.NotNil(t, err)
+}
+
+//生成的代码可以编译通过
+func TestBindBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skip go build in short mode")
+	}
+	code, err := Bind("token", tokenABI, "6080", "token")
+	assert.Nil(t, err)
+
+	//放在模块内以"_"开头的目录中，go build ./... 会忽略这个目录
+	dir, err := ioutil.TempDir(".", "_bindtest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	err = ioutil.WriteFile(filepath.Join(dir, "token.go"), []byte(code), 0644)
+	assert.Nil(t, err)
+
+	out, err := exec.Command("go", "build", "./"+filepath.Base(dir)).CombinedOutput()
+	assert.Nil(t, err, string(out))
+}
+
+func TestFormatCall(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(tokenABI))
+	assert.Nil(t, err)
+	input, err := formatCall(parsed.Methods["transfer"], []interface{}{testAddr, uint8(3)})
+	assert.Nil(t, err)
+	assert.Equal(t, "transfer(\""+testAddr+"\",3)", input)
+
+	//格式化的参数可以被查询接口解析
+	_, packed, err := abi.Pack(input, tokenABI, false)
+	assert.Nil(t, err)
+	args, err := convertArgs(parsed.Methods["transfer"].Inputs, []interface{}{testAddr, uint8(3)})
+	assert.Nil(t, err)
+	expect, err := parsed.Pack("transfer", args...)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, packed)
+
+	_, err = formatCall(parsed.Methods["transfer"], []interface{}{testAddr})
+	assert.NotNil(t, err)
+	_, err = formatArg(reflect.ValueOf("a[0]"))
+	assert.NotNil(t, err)
+	arg, err := formatArg(reflect.ValueOf([]*big.Int{big.NewInt(1), big.NewInt(2)}))
+	assert.Nil(t, err)
+	assert.Equal(t, "[1,2]", arg)
+	arg, err = formatArg(reflect.ValueOf([2]byte{0xab, 0xcd}))
+	assert.Nil(t, err)
+	assert.Equal(t, "0xabcd", arg)
+}
+
+func TestUnpackLog(t *testing.T) {
+	contract, err := NewBoundContract(nil, "", "user.evm.test", tokenABI)
+	assert.Nil(t, err)
+	event := contract.abi.Events["Transfer"]
+	from := common.StringToAddress(testAddr).ToHash160()
+	memo := crypto.Keccak256Hash([]byte("memo"))
+	topics := [][]byte{event.ID().Bytes(), common.BytesToHash(from[:]).Bytes(), memo.Bytes()}
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(100))
+	assert.Nil(t, err)
+
+	var (
+		sender string
+		value  *big.Int
+		hash   string
+	)
+	err = contract.UnpackLog([]interface{}{&sender, &value, &hash}, "Transfer", topics, data)
+	assert.Nil(t, err)
+	assert.Equal(t, testAddr, sender)
+	assert.Equal(t, int64(100), value.Int64())
+	assert.Equal(t, memo.Hex(), hash)
+
+	//类型不匹配
+	var wrong int64
+	err = contract.UnpackLog([]interface{}{&sender, &wrong, &hash}, "Transfer", topics, data)
+	assert.NotNil(t, err)
+	//不是这个事件的日志
+	err = contract.UnpackLog([]interface{}{&sender, &value, &hash}, "Transfer", topics[1:], data)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,207 @@
+package compiler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// 不依赖本机的solc，直接使用编译好的合约
+// 支持以下几种格式：
+// solc --combined-json 的输出
+// solc --standard-json 的输出
+// solc --abi --bin -o dir 输出的目录，每个合约对应 Name.abi, Name.bin, Name.bin-runtime 文件，目录中的json文件按上面两种格式解析
+
+// --standard-json format
+type standardOutput struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+		Message          string `json:"message"`
+	} `json:"errors"`
+	Contracts map[string]map[string]struct {
+		Abi      interface{} `json:"abi"`
+		Metadata string      `json:"metadata"`
+		Userdoc  interface{} `json:"userdoc"`
+		Devdoc   interface{} `json:"devdoc"`
+		Evm      struct {
+			Bytecode         standardBytecode `json:"bytecode"`
+			DeployedBytecode standardBytecode `json:"deployedBytecode"`
+		} `json:"evm"`
+	} `json:"contracts"`
+}
+
+type standardBytecode struct {
+	Object    string `json:"object"`
+	SourceMap string `json:"sourceMap"`
+}
+
+// ParseJSON 解析solc输出的json，自动识别 --combined-json 和 --standard-json 两种格式
+func ParseJSON(data []byte, source string) (map[string]*Contract, error) {
+	var head struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+	//只有combined-json的输出中有编译器版本
+	if head.Version != "" {
+		return ParseCombinedJSON(data, source, head.Version, head.Version, "")
+	}
+	return ParseStandardJSON(data, source)
+}
+
+// ParseStandardJSON 解析 solc --standard-json 的输出，合约名称的格式为 文件名:合约名
+func ParseStandardJSON(data []byte, source string) (map[string]*Contract, error) {
+	var output standardOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, err
+	}
+	var msgs []string
+	for _, e := range output.Errors {
+		if e.Severity == "error" {
+			msg := e.FormattedMessage
+			if msg == "" {
+				msg = e.Message
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("solc: %s", strings.Join(msgs, "\n"))
+	}
+
+	contracts := make(map[string]*Contract)
+	for file, items := range output.Contracts {
+		for name, info := range items {
+			contracts[file+":"+name] = &Contract{
+				Code:        "0x" + info.Evm.Bytecode.Object,
+				RuntimeCode: "0x" + info.Evm.DeployedBytecode.Object,
+				Info: ContractInfo{
+					Source:        source,
+					Language:      "Solidity",
+					SrcMap:        info.Evm.Bytecode.SourceMap,
+					SrcMapRuntime: info.Evm.DeployedBytecode.SourceMap,
+					AbiDefinition: info.Abi,
+					UserDoc:       info.Userdoc,
+					DeveloperDoc:  info.Devdoc,
+					Metadata:      info.Metadata,
+				},
+			}
+		}
+	}
+	return contracts, nil
+}
+
+// LoadArtifacts 从文件或者目录中加载编译好的合约
+func LoadArtifacts(path string) (map[string]*Contract, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !stat.IsDir() {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return ParseJSON(data, "")
+	}
+
+	contracts := make(map[string]*Contract)
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		name := file.Name()
+		switch filepath.Ext(name) {
+		case ".json":
+			data, err := ioutil.ReadFile(filepath.Join(path, name))
+			if err != nil {
+				return nil, err
+			}
+			items, err := ParseJSON(data, "")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			for k, v := range items {
+				contracts[k] = v
+			}
+		case ".bin":
+			contract, err := loadBinArtifact(path, strings.TrimSuffix(name, ".bin"))
+			if err != nil {
+				return nil, err
+			}
+			contracts[strings.TrimSuffix(name, ".bin")] = contract
+		}
+	}
+	if len(contracts) == 0 {
+		return nil, fmt.Errorf("no contract found in %s", path)
+	}
+	return contracts, nil
+}
+
+//合约的 Name.abi 必须存在，Name.bin-runtime 可以不存在
+func loadBinArtifact(dir, name string) (*Contract, error) {
+	bin, err := ioutil.ReadFile(filepath.Join(dir, name+".bin"))
+	if err != nil {
+		return nil, err
+	}
+	abiData, err := ioutil.ReadFile(filepath.Join(dir, name+".abi"))
+	if err != nil {
+		return nil, err
+	}
+	var abi interface{}
+	if err := json.Unmarshal(abiData, &abi); err != nil {
+		return nil, fmt.Errorf("%s.abi: %v", name, err)
+	}
+	contract := &Contract{
+		Code: "0x" + strings.TrimPrefix(strings.TrimSpace(string(bin)), "0x"),
+		Info: ContractInfo{Language: "Solidity", AbiDefinition: abi},
+	}
+	runtime, err := ioutil.ReadFile(filepath.Join(dir, name+".bin-runtime"))
+	if err == nil {
+		contract.RuntimeCode = "0x" + strings.TrimPrefix(strings.TrimSpace(string(runtime)), "0x")
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return contract, nil
+}
+
+// SelectContract 从多个合约中选择一个，name可以是完整的名称，也可以只是合约名
+// 只有一个合约时name可以为空
+func SelectContract(contracts map[string]*Contract, name string) (string, *Contract, error) {
+	if name == "" {
+		if len(contracts) == 1 {
+			for k, v := range contracts {
+				return k, v, nil
+			}
+		}
+		return "", nil, errors.New("there are too many contracts, the contract name is required")
+	}
+	if contract, ok := contracts[name]; ok {
+		return name, contract, nil
+	}
+	var matched []string
+	for k := range contracts {
+		if strings.HasSuffix(k, ":"+name) {
+			matched = append(matched, k)
+		}
+	}
+	if len(matched) == 1 {
+		return matched[0], contracts[matched[0]], nil
+	}
+	if len(matched) == 0 {
+		return "", nil, fmt.Errorf("contract %s not found", name)
+	}
+	sort.Strings(matched)
+	return "", nil, fmt.Errorf("contract %s is ambiguous: %s", name, strings.Join(matched, ", "))
+}
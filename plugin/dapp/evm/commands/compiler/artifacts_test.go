@@ -0,0 +1,76 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testABI = `[{"constant":true,"inputs":[{"name":"a","type":"uint256"}],"name":"multiply","outputs":[{"name":"d","type":"uint256"}],"type":"function"}]`
+
+	standardJSON = `{"contracts":{"test.sol":{"test":{"abi":` + testABI + `,"metadata":"{}",
+"evm":{"bytecode":{"object":"6080","sourceMap":"1:2"},"deployedBytecode":{"object":"6060"}}}}},
+"errors":[{"severity":"warning","formattedMessage":"unused variable"}]}`
+
+	//新版本的solc输出的abi是json对象
+	combinedJSON = `{"contracts":{"test.sol:test":{"abi":` + testABI + `,"bin":"6080","bin-runtime":"6060"}},"version":"0.8.0"}`
+)
+
+func TestParseJSON(t *testing.T) {
+	for _, data := range []string{standardJSON, combinedJSON} {
+		contracts, err := ParseJSON([]byte(data), "")
+		assert.Nil(t, err)
+		name, contract, err := SelectContract(contracts, "test")
+		assert.Nil(t, err)
+		assert.Equal(t, "test.sol:test", name)
+		assert.Equal(t, "0x6080", contract.Code)
+		assert.Equal(t, "0x6060", contract.RuntimeCode)
+		assert.Equal(t, 1, len(contract.Info.AbiDefinition.([]interface{})))
+	}
+
+	_, err := ParseJSON([]byte(`{"errors":[{"severity":"error","formattedMessage":"syntax error"}]}`), "")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "syntax error")
+}
+
+func TestLoadArtifacts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifacts")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "test.abi"), []byte(testABI), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "test.bin"), []byte("6080\n"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "other.json"), []byte(standardJSON), 0644))
+
+	contracts, err := LoadArtifacts(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(contracts))
+	_, contract, err := SelectContract(contracts, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, "0x6080", contract.Code)
+	assert.Equal(t, "", contract.RuntimeCode)
+
+	//合约名称不唯一时需要指定完整的名称
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "other.json"), []byte(combinedJSON), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "more.json"), []byte(`{"contracts":{"a.sol:test":{"abi":[],"bin":"00"}},"version":"0.8.0"}`), 0644))
+	assert.Nil(t, os.Remove(filepath.Join(dir, "test.bin")))
+	contracts, err = LoadArtifacts(dir)
+	assert.Nil(t, err)
+	_, _, err = SelectContract(contracts, "test")
+	assert.NotNil(t, err)
+	_, _, err = SelectContract(contracts, "")
+	assert.NotNil(t, err)
+	name, _, err := SelectContract(contracts, "a.sol:test")
+	assert.Nil(t, err)
+	assert.Equal(t, "a.sol:test", name)
+
+	contracts, err = LoadArtifacts(filepath.Join(dir, "more.json"))
+	assert.Nil(t, err)
+	_, contract, err = SelectContract(contracts, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "0x00", contract.Code)
+}
@@ -66,9 +66,11 @@ type Solidity struct {
 // --combined-output format
 type solcOutput struct {
 	Contracts map[string]struct {
-		BinRuntime                                  string `json:"bin-runtime"`
-		SrcMapRuntime                               string `json:"srcmap-runtime"`
-		Bin, SrcMap, Abi, Devdoc, Userdoc, Metadata string
+		BinRuntime            string `json:"bin-runtime"`
+		SrcMapRuntime         string `json:"srcmap-runtime"`
+		Bin, SrcMap, Metadata string
+		// 旧版本的solc输出的是json字符串，新版本直接输出json对象
+		Abi, Devdoc, Userdoc json.RawMessage
 	}
 	Version string
 }
@@ -177,15 +179,15 @@ func ParseCombinedJSON(combinedJSON []byte, source string, languageVersion strin
 	for name, info := range output.Contracts {
 		// Parse the individual compilation results.
 		var abi interface{}
-		if err := json.Unmarshal([]byte(info.Abi), &abi); err != nil {
+		if err := unmarshalEmbedded(info.Abi, &abi); err != nil {
 			return nil, fmt.Errorf("solc: error reading abi definition (%v)", err)
 		}
 		var userdoc interface{}
-		if err := json.Unmarshal([]byte(info.Userdoc), &userdoc); err != nil {
+		if err := unmarshalEmbedded(info.Userdoc, &userdoc); err != nil {
 			return nil, fmt.Errorf("solc: error reading user doc: %v", err)
 		}
 		var devdoc interface{}
-		if err := json.Unmarshal([]byte(info.Devdoc), &devdoc); err != nil {
+		if err := unmarshalEmbedded(info.Devdoc, &devdoc); err != nil {
 			return nil, fmt.Errorf("solc: error reading dev doc: %v", err)
 		}
 		contracts[name] = &Contract{
@@ -220,3 +222,15 @@ func slurpFiles(files []string) (string, error) {
 	}
 	return concat.String(), nil
 }
+
+//兼容以json字符串嵌入和直接嵌入的两种格式，没有输出的字段忽略
+func unmarshalEmbedded(data json.RawMessage, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var embedded string
+	if err := json.Unmarshal(data, &embedded); err == nil {
+		data = json.RawMessage(embedded)
+	}
+	return json.Unmarshal(data, v)
+}
@@ -5,12 +5,17 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/33cn/plugin/plugin/dapp/evm/commands/bind"
 	"github.com/33cn/plugin/plugin/dapp/evm/commands/compiler"
 
 	"strings"
@@ -43,6 +48,7 @@ func EvmCmd() *cobra.Command {
 	cmd.AddCommand(
 		createContractCmd(),
 		callContractCmd(),
+		multiCallCmd(),
 		abiCmd(),
 		estimateContractCmd(),
 		checkContractAddrCmd(),
@@ -51,6 +57,8 @@ func EvmCmd() *cobra.Command {
 		evmWithdrawCmd(),
 		getEvmBalanceCmd(),
 		evmToolsCmd(),
+		getLogsCmd(),
+		storageCmd(),
 	)
 
 	return cmd
@@ -208,9 +216,41 @@ func addCreateContractFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("alias", "s", "", "human readable contract alias name")
 	cmd.Flags().StringP("abi", "b", "", "bind the abi data")
 
+	addCompiledContractFlags(cmd)
+}
+
+//编译合约的参数，不需要本机安装solc时可以直接使用编译好的合约
+func addCompiledContractFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("sol", "", "", "sol file path")
 	cmd.Flags().StringP("solc", "", "solc", "solc compiler")
+	cmd.Flags().StringP("artifacts", "", "", "solc json output file (--combined-json or --standard-json) or directory of compiled contracts (solc --abi --bin -o)")
+	cmd.Flags().StringP("contract", "", "", "contract name, required if there are more than one contracts")
+}
 
+//从sol文件或者编译好的合约中加载合约
+func loadCompiledContract(cmd *cobra.Command) (string, *compiler.Contract, error) {
+	sol, _ := cmd.Flags().GetString("sol")
+	solc, _ := cmd.Flags().GetString("solc")
+	artifacts, _ := cmd.Flags().GetString("artifacts")
+	name, _ := cmd.Flags().GetString("contract")
+
+	var contracts map[string]*compiler.Contract
+	var err error
+	if !strings.EqualFold(artifacts, "") {
+		contracts, err = compiler.LoadArtifacts(artifacts)
+		if err != nil {
+			return "", nil, fmt.Errorf("load compiled contract error: %v", err)
+		}
+	} else {
+		if _, err := os.Stat(sol); os.IsNotExist(err) {
+			return "", nil, errors.New("sol file is not exist")
+		}
+		contracts, err = compiler.CompileSolidity(solc, sol)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to build Solidity contract: %v", err)
+		}
+	}
+	return compiler.SelectContract(contracts, name)
 }
 
 func createContract(cmd *cobra.Command, args []string) {
@@ -224,41 +264,34 @@ func createContract(cmd *cobra.Command, args []string) {
 	paraName, _ := cmd.Flags().GetString("paraName")
 	abi, _ := cmd.Flags().GetString("abi")
 	sol, _ := cmd.Flags().GetString("sol")
-	solc, _ := cmd.Flags().GetString("solc")
+	artifacts, _ := cmd.Flags().GetString("artifacts")
 
 	feeInt64 := uint64(fee*1e4) * 1e4
 
-	if !strings.EqualFold(sol, "") && !strings.EqualFold(code, "") && !strings.EqualFold(abi, "") {
-		fmt.Fprintln(os.Stderr, "--sol, --code and --abi shouldn't be used at the same time.")
+	compiled := !strings.EqualFold(sol, "") || !strings.EqualFold(artifacts, "")
+	if compiled && (!strings.EqualFold(code, "") || !strings.EqualFold(abi, "")) {
+		fmt.Fprintln(os.Stderr, "--sol or --artifacts shouldn't be used with --input and --abi at the same time.")
+		return
+	}
+	if !strings.EqualFold(sol, "") && !strings.EqualFold(artifacts, "") {
+		fmt.Fprintln(os.Stderr, "--sol and --artifacts shouldn't be used at the same time.")
 		return
 	}
 
 	var action evmtypes.EVMContractAction
-	if !strings.EqualFold(sol, "") {
-		if _, err := os.Stat(sol); os.IsNotExist(err) {
-			fmt.Fprintln(os.Stderr, "Sol file is not exist.")
-			return
-		}
-		contracts, err := compiler.CompileSolidity(solc, sol)
+	if compiled {
+		_, contract, err := loadCompiledContract(cmd)
 		if err != nil {
-			fmt.Fprintln(os.Stderr, "Failed to build Solidity contract", err)
+			fmt.Fprintln(os.Stderr, err)
 			return
 		}
-
-		if len(contracts) > 1 {
-			fmt.Fprintln(os.Stderr, "There are too many contracts in the sol file.")
+		abi, _ := json.Marshal(contract.Info.AbiDefinition) // Flatten the compiler parse
+		bCode, err := common.FromHex(contract.Code)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "parse evm code error", err)
 			return
 		}
-
-		for _, contract := range contracts {
-			abi, _ := json.Marshal(contract.Info.AbiDefinition) // Flatten the compiler parse
-			bCode, err := common.FromHex(contract.Code)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, "parse evm code error", err)
-				return
-			}
-			action = evmtypes.EVMContractAction{Amount: 0, Code: bCode, GasLimit: 0, GasPrice: 0, Note: note, Alias: alias, Abi: string(abi)}
-		}
+		action = evmtypes.EVMContractAction{Amount: 0, Code: bCode, GasLimit: 0, GasPrice: 0, Note: note, Alias: alias, Abi: string(abi)}
 	} else {
 		bCode, err := common.FromHex(code)
 		if err != nil {
@@ -429,6 +462,104 @@ func addCallContractFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("abi", "b", "", "call with abi")
 }
 
+// 在一个交易中批量调用合约
+func multiCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multicall",
+		Short: "Call several EVM contracts in one transaction, or query them read-only",
+		Run:   multiCall,
+	}
+	addMultiCallFlags(cmd)
+	return cmd
+}
+
+func addMultiCallFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("file", "l", "", `json file of calls, like [{"contract":"user.evm.xxx","abi":"transfer(addr,1)","amount":0.1},{"contract":"1xxx","code":"0xa9059cbb..."}]`)
+	cmd.MarkFlagRequired("file")
+
+	cmd.Flags().StringP("caller", "c", "", "the caller address, required when send transaction")
+
+	cmd.Flags().BoolP("query", "q", false, "query the calls read-only, do not send transaction")
+
+	cmd.Flags().StringP("expire", "p", "120s", "transaction expire time (optional)")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info (optional)")
+
+	cmd.Flags().Float64P("fee", "f", 0, "contract gas fee (optional)")
+}
+
+// 批量调用文件中的单个调用，金额单位和call命令相同
+type multiCallItem struct {
+	Contract string  `json:"contract"`
+	Abi      string  `json:"abi"`
+	Code     string  `json:"code"`
+	Amount   float64 `json:"amount"`
+}
+
+func readMultiCalls(file string) ([]*evmtypes.EVMContractCall, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var items []multiCallItem
+	err = json.Unmarshal(data, &items)
+	if err != nil {
+		return nil, err
+	}
+	var calls []*evmtypes.EVMContractCall
+	for _, item := range items {
+		code, err := common.FromHex(item.Code)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, &evmtypes.EVMContractCall{Contract: item.Contract, Amount: uint64(item.Amount*1e4) * 1e4, Code: code, Abi: item.Abi})
+	}
+	return calls, nil
+}
+
+func multiCall(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	caller, _ := cmd.Flags().GetString("caller")
+	query, _ := cmd.Flags().GetBool("query")
+	expire, _ := cmd.Flags().GetString("expire")
+	note, _ := cmd.Flags().GetString("note")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	calls, err := readMultiCalls(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "read calls error", err)
+		return
+	}
+
+	if query {
+		var req = evmtypes.EvmQueryReq{Caller: caller, Calls: calls}
+		var resp evmtypes.EvmQueryResp
+		printQuery(rpcLaddr, "Query", &req, &resp)
+		return
+	}
+	if len(caller) == 0 {
+		fmt.Fprintln(os.Stderr, "caller is required")
+		return
+	}
+
+	feeInt64 := uint64(fee*1e4) * 1e4
+	execName := types.ExecName(evmtypes.ExecutorName)
+	action := evmtypes.EVMContractAction{Note: note, Calls: calls}
+	data, err := createEvmTx(&action, execName, caller, address.ExecAddress(execName), expire, rpcLaddr, feeInt64)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "multicall contract error", err)
+		return
+	}
+
+	params := rpctypes.RawParm{
+		Data: data,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SendTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func addCommonFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("input", "i", "", "input contract binary code")
 
@@ -453,6 +584,7 @@ func abiCmd() *cobra.Command {
 	cmd.AddCommand(
 		getAbiCmd(),
 		callAbiCmd(),
+		genAbiCmd(),
 	)
 	return cmd
 }
@@ -521,6 +653,82 @@ func callAbi(cmd *cobra.Command, args []string) {
 	}
 }
 
+// 生成合约的Go语言绑定代码
+func genAbiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gen",
+		Short: "generate go binding of evm contract",
+		Run:   genAbi,
+	}
+
+	cmd.Flags().StringP("abi", "b", "", "abi file path, deploy function is not generated if only abi is given")
+	addCompiledContractFlags(cmd)
+	cmd.Flags().StringP("pkg", "", "main", "package name of the generated code")
+	cmd.Flags().StringP("type", "t", "", "type name of the generated contract, default is the contract name")
+	cmd.Flags().StringP("out", "o", "", "output file path, default is stdout")
+
+	return cmd
+}
+
+func genAbi(cmd *cobra.Command, args []string) {
+	abiFile, _ := cmd.Flags().GetString("abi")
+	sol, _ := cmd.Flags().GetString("sol")
+	artifacts, _ := cmd.Flags().GetString("artifacts")
+	pkg, _ := cmd.Flags().GetString("pkg")
+	typeName, _ := cmd.Flags().GetString("type")
+	out, _ := cmd.Flags().GetString("out")
+
+	var abiData, bytecode string
+	if !strings.EqualFold(abiFile, "") {
+		if !strings.EqualFold(sol, "") || !strings.EqualFold(artifacts, "") {
+			fmt.Fprintln(os.Stderr, "--abi shouldn't be used with --sol or --artifacts at the same time.")
+			return
+		}
+		data, err := ioutil.ReadFile(abiFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		abiData = string(data)
+		if typeName == "" {
+			typeName = strings.TrimSuffix(filepath.Base(abiFile), filepath.Ext(abiFile))
+		}
+	} else {
+		name, contract, err := loadCompiledContract(cmd)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		data, _ := json.Marshal(contract.Info.AbiDefinition)
+		abiData, bytecode = string(data), contract.Code
+		if typeName == "" {
+			typeName = name
+		}
+	}
+	//合约名称可能带有文件名
+	if idx := strings.LastIndex(typeName, ":"); idx >= 0 {
+		typeName = typeName[idx+1:]
+	}
+	if typeName == "" {
+		fmt.Fprintln(os.Stderr, "--type is required")
+		return
+	}
+
+	code, err := bind.Bind(typeName, abiData, bytecode, pkg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "generate binding error:", err)
+		return
+	}
+	if strings.EqualFold(out, "") {
+		fmt.Fprint(os.Stdout, code)
+		return
+	}
+	err = ioutil.WriteFile(out, []byte(code), 0644)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
 func estimateContract(cmd *cobra.Command, args []string) {
 	code, _ := cmd.Flags().GetString("input")
 	name, _ := cmd.Flags().GetString("exec")
@@ -616,6 +824,134 @@ func checkContractAddr(cmd *cobra.Command, args []string) {
 	}
 }
 
+// 查询合约事件日志
+func getLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Query evm contract event logs",
+		Run:   getLogs,
+	}
+	addGetLogsFlags(cmd)
+	return cmd
+}
+
+func addGetLogsFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64P("from", "s", 0, "start block height")
+	cmd.Flags().Int64P("to", "e", 0, "end block height, 0 means no limit")
+	cmd.Flags().StringP("address", "a", "", "evm contract addresses, separated by ','")
+	cmd.Flags().StringArrayP("topic", "t", nil, "topics of each position in order, alternatives separated by '|', empty means any")
+	cmd.Flags().Int32P("count", "c", 20, "max count of logs")
+	cmd.Flags().StringP("primary", "p", "", "primary key returned by last query, used for paging")
+}
+
+func getLogs(cmd *cobra.Command, args []string) {
+	from, _ := cmd.Flags().GetInt64("from")
+	to, _ := cmd.Flags().GetInt64("to")
+	addrs, _ := cmd.Flags().GetString("address")
+	topics, _ := cmd.Flags().GetStringArray("topic")
+	count, _ := cmd.Flags().GetInt32("count")
+	primary, _ := cmd.Flags().GetString("primary")
+
+	req := evmtypes.EvmGetLogsReq{FromBlock: from, ToBlock: to, Count: count, PrimaryKey: primary}
+	if len(addrs) > 0 {
+		req.Addresses = strings.Split(addrs, ",")
+	}
+	for _, topic := range topics {
+		item := &evmtypes.EvmLogTopics{}
+		if len(topic) > 0 {
+			item.Topics = strings.Split(topic, "|")
+		}
+		req.Topics = append(req.Topics, item)
+	}
+
+	var resp evmtypes.EvmGetLogsResp
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	printQuery(rpcLaddr, "GetLogs", &req, &resp)
+}
+
+// 查询合约状态数据
+func storageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Query evm contract storage",
+	}
+	cmd.AddCommand(
+		getStorageAtCmd(),
+		dumpStorageCmd(),
+		getAccountStateCmd())
+
+	return cmd
+}
+
+func getStorageAtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get the value of a storage slot",
+		Run:   getStorageAt,
+	}
+	cmd.Flags().StringP("address", "a", "", "evm contract address")
+	cmd.MarkFlagRequired("address")
+	cmd.Flags().StringP("slot", "s", "", "storage slot in hex")
+	cmd.MarkFlagRequired("slot")
+	return cmd
+}
+
+func getStorageAt(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("address")
+	slot, _ := cmd.Flags().GetString("slot")
+	var req = evmtypes.EvmGetStorageAtReq{Addr: addr, Slot: slot}
+	var resp evmtypes.EvmStorageItem
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	printQuery(rpcLaddr, "GetStorageAt", &req, &resp)
+}
+
+func dumpStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "List storage slots of a contract, from the largest slot",
+		Run:   dumpStorage,
+	}
+	cmd.Flags().StringP("address", "a", "", "evm contract address")
+	cmd.MarkFlagRequired("address")
+	cmd.Flags().Int32P("count", "c", 20, "max count of slots")
+	cmd.Flags().StringP("primary", "p", "", "primary key returned by last query, used for paging")
+	return cmd
+}
+
+func dumpStorage(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("address")
+	count, _ := cmd.Flags().GetInt32("count")
+	primary, _ := cmd.Flags().GetString("primary")
+	var req = evmtypes.EvmDumpStorageReq{Addr: addr, Count: count, PrimaryKey: primary}
+	var resp evmtypes.EvmDumpStorageResp
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	printQuery(rpcLaddr, "DumpStorage", &req, &resp)
+}
+
+func getAccountStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account",
+		Short: "Get contract account data in state tree and values of storage slots, without merkle proof",
+		Run:   getAccountState,
+	}
+	cmd.Flags().StringP("address", "a", "", "evm contract address")
+	cmd.MarkFlagRequired("address")
+	cmd.Flags().StringP("slots", "s", "", "storage slots in hex, separated by ','")
+	return cmd
+}
+
+func getAccountState(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("address")
+	slots, _ := cmd.Flags().GetString("slots")
+	var req = evmtypes.EvmGetAccountStateReq{Addr: addr}
+	if len(slots) > 0 {
+		req.Slots = strings.Split(slots, ",")
+	}
+	var resp evmtypes.EvmGetAccountStateResp
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	printQuery(rpcLaddr, "GetAccountState", &req, &resp)
+}
+
 // 查询或设置EVM调试开关
 func evmDebugCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -625,7 +961,8 @@ func evmDebugCmd() *cobra.Command {
 	cmd.AddCommand(
 		evmDebugQueryCmd(),
 		evmDebugSetCmd(),
-		evmDebugClearCmd())
+		evmDebugClearCmd(),
+		evmDebugTraceCmd())
 
 	return cmd
 }
@@ -663,6 +1000,48 @@ func evmDebugSet(cmd *cobra.Command, args []string) {
 func evmDebugClear(cmd *cobra.Command, args []string) {
 	evmDebugRPC(cmd, -1)
 }
+
+// 重新执行历史交易并输出跟踪信息
+func evmDebugTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Trace the execution of an evm transaction",
+		Run:   evmDebugTrace,
+	}
+	addEvmDebugTraceFlags(cmd)
+	return cmd
+}
+
+func addEvmDebugTraceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+
+	cmd.Flags().StringP("tracer", "t", "structLog", "trace type: structLog (every opcode) or callTracer (call tree)")
+}
+
+func evmDebugTrace(cmd *cobra.Command, args []string) {
+	hash, _ := cmd.Flags().GetString("hash")
+	tracer, _ := cmd.Flags().GetString("tracer")
+	var req = evmtypes.EvmTraceTxReq{TxHash: hash, Tracer: tracer}
+	var resp evmtypes.EvmTraceTxResp
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	query := sendQuery(rpcLaddr, "TraceTx", &req, &resp)
+	if !query {
+		fmt.Fprintln(os.Stderr, "error")
+		return
+	}
+	if len(resp.Error) > 0 {
+		fmt.Fprintln(os.Stderr, "execute error:", resp.Error)
+	}
+	var buf bytes.Buffer
+	err := json.Indent(&buf, []byte(resp.JsonData), "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(buf.String())
+}
+
 func evmDebugRPC(cmd *cobra.Command, flag int32) {
 	var debugReq = evmtypes.EvmDebugReq{Optype: flag}
 	var debugResp evmtypes.EvmDebugResp
@@ -793,6 +1172,19 @@ func sendQuery(rpcAddr, funcName string, request types.Message, result proto.Mes
 	return true
 }
 
+// 查询并按JSON格式输出结果
+func printQuery(rpcAddr, funcName string, request types.Message, result proto.Message) {
+	if !sendQuery(rpcAddr, funcName, request, result) {
+		return
+	}
+	data, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
 // 这里实现 EIP55中提及的以太坊地址表示方式（增加Checksum）
 func checksumAddr(address []byte) string {
 	unchecksummed := hex.EncodeToString(address[:])
@@ -94,12 +94,14 @@ func (abi ABI) Unpack(v interface{}, name string, output []byte) (err error) {
 // UnmarshalJSON implements json.Unmarshaler interface
 func (abi *ABI) UnmarshalJSON(data []byte) error {
 	var fields []struct {
-		Type      string
-		Name      string
-		Constant  bool
-		Anonymous bool
-		Inputs    []Argument
-		Outputs   []Argument
+		Type     string
+		Name     string
+		Constant bool
+		// 新版本编译器使用stateMutability代替constant
+		StateMutability string
+		Anonymous       bool
+		Inputs          []Argument
+		Outputs         []Argument
 	}
 
 	if err := json.Unmarshal(data, &fields); err != nil {
@@ -118,7 +120,7 @@ func (abi *ABI) UnmarshalJSON(data []byte) error {
 		case "function", "":
 			abi.Methods[field.Name] = Method{
 				Name:    field.Name,
-				Const:   field.Constant,
+				Const:   field.Constant || field.StateMutability == "view" || field.StateMutability == "pure",
 				Inputs:  field.Inputs,
 				Outputs: field.Outputs,
 			}
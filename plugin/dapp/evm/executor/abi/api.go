@@ -112,6 +112,91 @@ func Unpack(data []byte, methodName, abiData string) (output string, err error)
 	return string(jsondata), err
 }
 
+// UnpackEvent 将合约事件日志按照ABI中的事件定义序列化为json
+// topics 第一个元素为事件签名哈希，其余为indexed参数
+// data 日志中的非indexed参数
+func UnpackEvent(topics []common.Hash, data []byte, abiData string) (eventName, output string, err error) {
+	if len(topics) == 0 {
+		return eventName, output, errors.New("anonymous event not supported")
+	}
+	abi, err := JSON(strings.NewReader(abiData))
+	if err != nil {
+		return eventName, output, err
+	}
+
+	var event *Event
+	for _, item := range abi.Events {
+		if !item.Anonymous && item.ID() == topics[0] {
+			event = &item
+			break
+		}
+	}
+	if event == nil {
+		return eventName, output, fmt.Errorf("event %v not exists", topics[0].Hex())
+	}
+
+	values, err := UnpackEventValues(*event, topics, data)
+	if err != nil {
+		return eventName, output, err
+	}
+
+	outputs := []*Param{}
+	for i, arg := range event.Inputs {
+		outputs = append(outputs, &Param{Name: arg.Name, Type: arg.Type.String(), Value: values[i]})
+	}
+
+	jsondata, err := json.Marshal(outputs)
+	if err != nil {
+		return eventName, output, err
+	}
+	return event.Name, string(jsondata), err
+}
+
+// UnpackEventValues 按照事件参数的定义顺序返回日志中的参数取值
+// topics 第一个元素为事件签名哈希，其余为indexed参数
+func UnpackEventValues(event Event, topics []common.Hash, data []byte) ([]interface{}, error) {
+	values, err := event.Inputs.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]interface{}, 0, len(event.Inputs))
+	topicIdx, valueIdx := 1, 0
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			if topicIdx >= len(topics) {
+				return nil, fmt.Errorf("topics not match event %v", event.Name)
+			}
+			value, err := unpackTopic(arg.Type, topics[topicIdx])
+			if err != nil {
+				return nil, err
+			}
+			outputs = append(outputs, value)
+			topicIdx++
+		} else {
+			outputs = append(outputs, values[valueIdx])
+			valueIdx++
+		}
+	}
+	return outputs, nil
+}
+
+// 动态类型的indexed参数在topic中保存的是数据哈希，无法还原，直接返回哈希
+func unpackTopic(typ Type, topic common.Hash) (interface{}, error) {
+	switch typ.T {
+	case StringTy, BytesTy, SliceTy, ArrayTy:
+		return topic.Hex(), nil
+	}
+	value, err := toGoType(0, typ, topic.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if addr, ok := value.(common.Hash160Address); ok {
+		return addr.ToAddress().String(), nil
+	}
+	return value, nil
+}
+
 // Param 返回值参数结构定义
 type Param struct {
 	// Name 参数名称
@@ -0,0 +1,274 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"sort"
+
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 按区块高度排列的全部事件日志
+	eventLogPrefix = "LODB-evm-log:"
+	// 按合约地址索引的事件日志
+	eventLogAddrPrefix = "LODB-evm-log-addr:"
+	// 按主题位置和主题索引的事件日志
+	eventLogTopicPrefix = "LODB-evm-log-topic:"
+
+	// 单次查询默认和最多返回的日志条数
+	defaultLogCount = 20
+	maxLogCount     = 100
+)
+
+// 日志主键，按区块高度、交易序号、日志序号排序
+func calcEventLogPrimaryKey(height, txIndex, logIndex int64) string {
+	return fmt.Sprintf("%012d:%06d:%06d", height, txIndex, logIndex)
+}
+
+func calcEventLogAddrPrefix(addr string) string {
+	return fmt.Sprintf("%s%s:", eventLogAddrPrefix, addr)
+}
+
+func calcEventLogTopicPrefix(pos int, topic string) string {
+	return fmt.Sprintf("%s%d:%s:", eventLogTopicPrefix, pos, topic)
+}
+
+// 把合约执行时生成的日志转换为交易回执中的日志
+func buildEventLogs(contractLogs []*model.ContractLog) (logs []*types.ReceiptLog) {
+	for _, item := range contractLogs {
+		eventLog := &evmtypes.EVMContractEventLog{Address: item.Address.String(), Data: item.Data}
+		for _, topic := range item.Topics {
+			eventLog.Topics = append(eventLog.Topics, topic.Bytes())
+		}
+		logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogEVMEventLog, Log: types.Encode(eventLog)})
+	}
+	return logs
+}
+
+// 交易回执中的事件日志在localdb中的索引，add为false时返回回滚需要删除的数据
+func (evm *EVMExecutor) getEventLogKVs(tx *types.Transaction, receipt *types.ReceiptData, index int, add bool) (kvs []*types.KeyValue, err error) {
+	var logIndex int64
+	for _, logItem := range receipt.Logs {
+		if logItem.Ty != evmtypes.TyLogEVMEventLog {
+			continue
+		}
+		var eventLog evmtypes.EVMContractEventLog
+		err = types.Decode(logItem.Log, &eventLog)
+		if err != nil {
+			return nil, err
+		}
+		item := &evmtypes.EvmLogItem{
+			Height:   evm.GetHeight(),
+			TxIndex:  int64(index),
+			TxHash:   common.Bytes2Hex(tx.Hash()),
+			LogIndex: logIndex,
+			Address:  eventLog.Address,
+			Data:     common.Bytes2Hex(eventLog.Data),
+		}
+		for _, topic := range eventLog.Topics {
+			item.Topics = append(item.Topics, common.BytesToHash(topic).Hex())
+		}
+		logIndex++
+
+		var value []byte
+		if add {
+			value = types.Encode(item)
+		}
+		primaryKey := calcEventLogPrimaryKey(item.Height, item.TxIndex, item.LogIndex)
+		kvs = append(kvs, &types.KeyValue{Key: []byte(eventLogPrefix + primaryKey), Value: value})
+		kvs = append(kvs, &types.KeyValue{Key: []byte(calcEventLogAddrPrefix(item.Address) + primaryKey), Value: value})
+		for pos, topic := range item.Topics {
+			kvs = append(kvs, &types.KeyValue{Key: []byte(calcEventLogTopicPrefix(pos, topic) + primaryKey), Value: value})
+		}
+	}
+	return kvs, nil
+}
+
+// 日志是否满足查询条件，地址列表和每个位置上的主题列表中任意一个匹配即可
+func matchEventLog(item *evmtypes.EvmLogItem, addrs map[string]bool, topics []map[string]bool) bool {
+	if len(addrs) > 0 && !addrs[item.Address] {
+		return false
+	}
+	for pos, set := range topics {
+		if len(set) == 0 {
+			continue
+		}
+		if pos >= len(item.Topics) || !set[item.Topics[pos]] {
+			return false
+		}
+	}
+	return true
+}
+
+// 从prefix下按主键顺序列出after之后的日志，不包含after本身
+func listEventLogs(db dbm.KVDB, prefix, after string, count int32) ([]*evmtypes.EvmLogItem, error) {
+	// List指定key时从key的下一个元素开始，这里先找到不大于after的最后一个key
+	var start []byte
+	floor, err := db.List([]byte(prefix), []byte(prefix+after), 1, dbm.ListSeek)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if len(floor) == 2 {
+		start = floor[0]
+	}
+	values, err := db.List([]byte(prefix), start, count, dbm.ListASC)
+	if err == types.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*evmtypes.EvmLogItem
+	for _, value := range values {
+		var item evmtypes.EvmLogItem
+		err = types.Decode(value, &item)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+	return items, nil
+}
+
+// 在一个索引前缀下查找满足条件的日志，最多返回count条
+func (evm *EVMExecutor) scanEventLogs(prefix, after string, toBlock int64, count int32, addrs map[string]bool, topics []map[string]bool) ([]*evmtypes.EvmLogItem, error) {
+	var result []*evmtypes.EvmLogItem
+	for int32(len(result)) < count {
+		items, err := listEventLogs(evm.GetLocalDB(), prefix, after, count)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if toBlock > 0 && item.Height > toBlock {
+				return result, nil
+			}
+			if matchEventLog(item, addrs, topics) {
+				result = append(result, item)
+			}
+		}
+		if int32(len(items)) < count {
+			break
+		}
+		last := items[len(items)-1]
+		after = calcEventLogPrimaryKey(last.Height, last.TxIndex, last.LogIndex)
+	}
+	return result, nil
+}
+
+func (evm *EVMExecutor) getLogs(in *evmtypes.EvmGetLogsReq) (*evmtypes.EvmGetLogsResp, error) {
+	if in.FromBlock < 0 || in.ToBlock < 0 || (in.ToBlock > 0 && in.ToBlock < in.FromBlock) {
+		return nil, types.ErrInvalidParam
+	}
+	count := in.Count
+	if count <= 0 {
+		count = defaultLogCount
+	}
+	if count > maxLogCount {
+		count = maxLogCount
+	}
+
+	addrs := make(map[string]bool)
+	for _, addr := range in.Addresses {
+		addrs[addr] = true
+	}
+	topics := make([]map[string]bool, len(in.Topics))
+	for pos, item := range in.Topics {
+		topics[pos] = make(map[string]bool)
+		for _, topic := range item.Topics {
+			data, err := common.HexToBytes(topic)
+			if err != nil {
+				return nil, err
+			}
+			topics[pos][common.BytesToHash(data).Hex()] = true
+		}
+	}
+
+	// 优先使用地址索引，其次使用第一个有条件的主题位置的索引
+	var prefixes []string
+	if len(addrs) > 0 {
+		for addr := range addrs {
+			prefixes = append(prefixes, calcEventLogAddrPrefix(addr))
+		}
+	} else {
+		for pos, set := range topics {
+			if len(set) == 0 {
+				continue
+			}
+			for topic := range set {
+				prefixes = append(prefixes, calcEventLogTopicPrefix(pos, topic))
+			}
+			break
+		}
+	}
+	if len(prefixes) == 0 {
+		prefixes = append(prefixes, eventLogPrefix)
+	}
+
+	// 只有高度的key比该高度的所有日志主键都小
+	after := fmt.Sprintf("%012d", in.FromBlock)
+	if len(in.PrimaryKey) > 0 {
+		after = in.PrimaryKey
+	}
+
+	var logs []*evmtypes.EvmLogItem
+	for _, prefix := range prefixes {
+		items, err := evm.scanEventLogs(prefix, after, in.ToBlock, count, addrs, topics)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, items...)
+	}
+	// 多个索引的结果合并之后重新按主键排序
+	sort.Slice(logs, func(i, j int) bool {
+		return calcEventLogPrimaryKey(logs[i].Height, logs[i].TxIndex, logs[i].LogIndex) < calcEventLogPrimaryKey(logs[j].Height, logs[j].TxIndex, logs[j].LogIndex)
+	})
+	resp := &evmtypes.EvmGetLogsResp{}
+	if int32(len(logs)) >= count {
+		logs = logs[:count]
+		last := logs[count-1]
+		resp.PrimaryKey = calcEventLogPrimaryKey(last.Height, last.TxIndex, last.LogIndex)
+	}
+
+	// 合约绑定了ABI时，按照事件定义解析日志
+	abis := make(map[string]string)
+	for _, item := range logs {
+		abiData, ok := abis[item.Address]
+		if !ok {
+			abiData = evm.mStateDB.GetAbi(item.Address)
+			abis[item.Address] = abiData
+		}
+		if len(abiData) > 0 {
+			decodeEventLog(item, abiData)
+		}
+	}
+	resp.Logs = logs
+	return resp, nil
+}
+
+func decodeEventLog(item *evmtypes.EvmLogItem, abiData string) {
+	var topics []common.Hash
+	for _, topic := range item.Topics {
+		data, _ := common.HexToBytes(topic)
+		topics = append(topics, common.BytesToHash(data))
+	}
+	data, _ := common.HexToBytes(item.Data)
+	eventName, jsonData, err := abi.UnpackEvent(topics, data, abiData)
+	if err != nil {
+		// 解析失败不影响查询结果，只打印错误信息
+		log.Debug("unpack evm event log error", "address", item.Address, "error", err)
+		return
+	}
+	item.EventName = eventName
+	item.JsonData = jsonData
+}
@@ -21,8 +21,13 @@ import (
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 )
 
+type subConfig struct {
+	ChainID int64 `json:"chainID"`
+}
+
 var (
 	evmDebug = false
+	cfg      subConfig
 
 	// EvmAddress 本合约地址
 	EvmAddress = address.ExecAddress(types.ExecName(evmtypes.ExecutorName))
@@ -37,6 +42,9 @@ func init() {
 
 // Init 初始化本合约对象
 func Init(name string, sub []byte) {
+	if sub != nil {
+		types.MustDecode(sub, &cfg)
+	}
 	driverName = name
 	drivers.Register(driverName, newEVMDriver, types.GetDappFork(driverName, evmtypes.EVMEnable))
 	EvmAddress = address.ExecAddress(types.ExecName(name))
@@ -171,5 +179,6 @@ func (evm *EVMExecutor) NewEVMContext(msg *common.Message) runtime.Context {
 		Difficulty:  new(big.Int).SetUint64(evm.GetDifficulty()),
 		GasLimit:    msg.GasLimit(),
 		GasPrice:    msg.GasPrice(),
+		ChainID:     new(big.Int).SetInt64(cfg.ChainID),
 	}
 }
@@ -22,8 +22,16 @@ import (
 // Exec 本合约执行逻辑
 func (evm *EVMExecutor) Exec(tx *types.Transaction, index int) (*types.Receipt, error) {
 	evm.CheckInit()
+	var action evmtypes.EVMContractAction
+	err := types.Decode(tx.Payload, &action)
+	if err != nil {
+		return nil, err
+	}
+	if isMultiCall(&action, evm.GetHeight()) {
+		return evm.execMultiCall(tx, &action, index)
+	}
 	// 先转换消息
-	msg, err := evm.GetMessage(tx)
+	msg, err := evm.getMessage(tx, &action)
 	if err != nil {
 		return nil, err
 	}
@@ -145,8 +153,18 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 		}
 	}
 
+	if types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEventLog) {
+		// 合约生成的事件日志写入交易回执，ExecLocal中据此建立索引
+		logs = append(logs, buildEventLogs(evm.mStateDB.GetContractLogs())...)
+	}
+
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kvSet, Logs: logs}
+	evm.finishReceipt(txHash, receipt, contractReceipt)
+	return receipt, nil
+}
 
+// 合约执行成功，返回回执之前的收尾处理
+func (evm *EVMExecutor) finishReceipt(txHash []byte, receipt *types.Receipt, contracts ...*evmtypes.ReceiptEVMContract) {
 	// 返回之前，把本次交易在区块中生成的合约日志集中打印出来
 	if evm.mStateDB != nil {
 		evm.mStateDB.WritePreimages(evm.GetHeight())
@@ -155,9 +173,9 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 	// 替换导致分叉的执行数据信息
 	state.ProcessFork(evm.GetHeight(), txHash, receipt)
 
-	evm.collectEvmTxLog(txHash, contractReceipt, receipt)
-
-	return receipt, nil
+	for _, contract := range contracts {
+		evm.collectEvmTxLog(txHash, contract, receipt)
+	}
 }
 
 // CheckInit 检查是否初始化数据库
@@ -174,6 +192,11 @@ func (evm *EVMExecutor) GetMessage(tx *types.Transaction) (msg *common.Message,
 	if err != nil {
 		return msg, err
 	}
+	return evm.getMessage(tx, &action)
+}
+
+// 使用已经解码的交易数据转换消息
+func (evm *EVMExecutor) getMessage(tx *types.Transaction, action *evmtypes.EVMContractAction) (msg *common.Message, err error) {
 	// 此处暂时不考虑消息发送签名的处理，chain33在mempool中对签名做了检查
 	from := getCaller(tx)
 	to := getReceiver(tx)
@@ -34,5 +34,11 @@ func (evm *EVMExecutor) ExecDelLocal(tx *types.Transaction, receipt *types.Recei
 		}
 	}
 
+	kvs, err := evm.getEventLogKVs(tx, receipt, index, false)
+	if err != nil {
+		return set, err
+	}
+	set.KV = append(set.KV, kvs...)
+
 	return set, err
 }
@@ -43,5 +43,12 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 		}
 	}
 
+	// 合约事件日志按合约地址和主题建立索引
+	kvs, err := evm.getEventLogKVs(tx, receipt, index, true)
+	if err != nil {
+		return set, err
+	}
+	set.KV = append(set.KV, kvs...)
+
 	return set, err
 }
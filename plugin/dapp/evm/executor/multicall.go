@@ -0,0 +1,179 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math/big"
+	"strings"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 分叉之后，携带批量调用列表的交易按批量调用执行
+func isMultiCall(action *evmtypes.EVMContractAction, height int64) bool {
+	return len(action.Calls) > 0 && types.IsDappFork(height, "evm", evmtypes.ForkEVMMultiCall)
+}
+
+// 批量调用的交易需要发给evm执行器，并且不能携带单个调用的数据
+func checkMultiCall(tx *types.Transaction, action *evmtypes.EVMContractAction) error {
+	if tx.To != EvmAddress || action.Amount > 0 || len(action.Code) > 0 || len(action.Abi) > 0 {
+		return types.ErrInvalidParam
+	}
+	for _, call := range action.Calls {
+		if len(call.Contract) == 0 || (len(call.Code) > 0 && len(call.Abi) > 0) {
+			return types.ErrInvalidParam
+		}
+	}
+	return nil
+}
+
+// 执行批量调用交易
+func (evm *EVMExecutor) execMultiCall(tx *types.Transaction, action *evmtypes.EVMContractAction, index int) (*types.Receipt, error) {
+	err := checkMultiCall(tx, action)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := evm.getMessage(tx, action)
+	if err != nil {
+		return nil, err
+	}
+	receipt, _, err := evm.innerMultiCall(msg, action.Calls, tx.Hash(), index, tx.Fee, false)
+	return receipt, err
+}
+
+// 合约地址或者合约名称转换为合约地址
+func getCallAddress(contract string) (common.Address, error) {
+	if strings.HasPrefix(contract, types.ExecName(evmtypes.EvmPrefix)) {
+		return common.ExecAddress(contract), nil
+	}
+	addr := common.StringToAddress(contract)
+	if addr == nil {
+		return common.Address{}, model.ErrAddrNotExists
+	}
+	return *addr, nil
+}
+
+// 在同一个EVM上下文中按顺序执行多个合约调用，共用一个Gas限制
+// 任意一个调用失败时回滚全部调用的数据变更，每个调用生成各自的合约回执
+// readOnly 是否只读查询，仅批量查询时为true
+func (evm *EVMExecutor) innerMultiCall(msg *common.Message, calls []*evmtypes.EVMContractCall, txHash []byte, index int, txFee int64, readOnly bool) (*types.Receipt, []*evmtypes.ReceiptEVMContract, error) {
+	context := evm.NewEVMContext(msg)
+	env := runtime.NewEVM(context, evm.mStateDB, *evm.vmCfg)
+	evm.mStateDB.Prepare(common.BytesToHash(txHash), index)
+	snapshot := evm.mStateDB.Snapshot()
+
+	var (
+		contracts []*evmtypes.ReceiptEVMContract
+		addrs     []common.Address
+	)
+	leftOverGas := msg.GasLimit()
+	for i, call := range calls {
+		addr, err := getCallAddress(call.Contract)
+		if err != nil {
+			evm.mStateDB.RevertToSnapshot(snapshot)
+			return nil, nil, err
+		}
+		inData := call.Code
+		methodName := ""
+		abiData := ""
+		if len(call.Abi) > 0 {
+			abiData = evm.mStateDB.GetAbi(addr.String())
+			methodName, inData, err = abi.Pack(call.Abi, abiData, readOnly)
+			if err != nil {
+				evm.mStateDB.RevertToSnapshot(snapshot)
+				return nil, nil, err
+			}
+		}
+
+		gas := leftOverGas
+		var ret []byte
+		ret, _, leftOverGas, err = env.Call(runtime.AccountRef(msg.From()), addr, inData, gas, call.Amount)
+		if err != nil {
+			log.Error("evm multicall exec error", "index", i, "contract", addr.String(), "error", err)
+			evm.mStateDB.RevertToSnapshot(snapshot)
+			return nil, nil, err
+		}
+
+		contract := &evmtypes.ReceiptEVMContract{Caller: msg.From().String(), ContractAddr: addr.String(), UsedGas: gas - leftOverGas, Ret: ret}
+		if len(methodName) > 0 {
+			jsonRet, err := abi.Unpack(ret, methodName, abiData)
+			if err != nil {
+				// 这里出错不影响整体执行，只打印错误信息
+				log.Error("unpack evm return error", "error", err)
+			}
+			contract.JsonRet = jsonRet
+		}
+		contracts = append(contracts, contract)
+		addrs = append(addrs, addr)
+	}
+
+	usedGas := msg.GasLimit() - leftOverGas
+	usedFee, overflow := common.SafeMul(usedGas, uint64(msg.GasPrice()))
+	if overflow || usedFee > uint64(txFee) {
+		evm.mStateDB.RevertToSnapshot(snapshot)
+		return nil, nil, model.ErrOutOfGas
+	}
+	evm.mStateDB.PrintLogs()
+
+	kvSet, logs := evm.mStateDB.GetChangedData(snapshot)
+	for _, contract := range contracts {
+		logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogCallContract, Log: types.Encode(contract)})
+	}
+	// 同一个合约多次调用时，只需要生成一次合约数据变更日志
+	seen := make(map[string]bool)
+	for _, addr := range addrs {
+		if seen[addr.String()] {
+			continue
+		}
+		seen[addr.String()] = true
+		logs = append(logs, evm.mStateDB.GetReceiptLogs(addr.String())...)
+		if types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMKVHash) {
+			hashKV := evm.calcKVHash(addr, logs)
+			if hashKV != nil {
+				kvSet = append(kvSet, hashKV)
+			}
+		}
+	}
+	if types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEventLog) {
+		logs = append(logs, buildEventLogs(evm.mStateDB.GetContractLogs())...)
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk, KV: kvSet, Logs: logs}
+	// 只读查询不生成交易，不需要收尾处理
+	if !readOnly {
+		evm.finishReceipt(txHash, receipt, contracts...)
+	}
+	return receipt, contracts, nil
+}
+
+// 批量只读调用，返回每个调用的结果
+func (evm *EVMExecutor) queryMultiCall(caller common.Address, in *evmtypes.EvmQueryReq, ret *evmtypes.EvmQueryResp) {
+	msg := common.NewMessage(caller, common.StringToAddress(EvmAddress), 0, 0, evmtypes.MaxGasLimit, 1, nil, "estimateGas", "")
+	txHash := common.BigToHash(big.NewInt(evmtypes.MaxGasLimit)).Bytes()
+	_, contracts, err := evm.innerMultiCall(msg, in.Calls, txHash, 1, evmtypes.MaxGasLimit, true)
+	if err != nil {
+		ret.JsonData = err.Error()
+		return
+	}
+	for i, contract := range contracts {
+		call := in.Calls[i]
+		input := call.Abi
+		if len(input) == 0 {
+			input = common.Bytes2Hex(call.Code)
+		}
+		ret.Calls = append(ret.Calls, &evmtypes.EvmQueryResp{
+			Address:  contract.ContractAddr,
+			Input:    input,
+			Caller:   ret.Caller,
+			RawData:  common.Bytes2Hex(contract.Ret),
+			JsonData: contract.JsonRet,
+		})
+	}
+}
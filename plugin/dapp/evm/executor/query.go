@@ -136,12 +136,6 @@ func (evm *EVMExecutor) Query_Query(in *evmtypes.EvmQueryReq) (types.Message, er
 		caller common.Address
 	)
 
-	to := common.StringToAddress(in.Address)
-	if to == nil {
-		ret.JsonData = fmt.Sprintf("invalid address:%v", in.Address)
-		return ret, nil
-	}
-
 	// 如果未指定调用地址，则直接使用一个虚拟的地址发起调用
 	if len(in.Caller) > 0 {
 		callAddr := common.StringToAddress(in.Caller)
@@ -152,6 +146,17 @@ func (evm *EVMExecutor) Query_Query(in *evmtypes.EvmQueryReq) (types.Message, er
 		caller = common.ExecAddress(types.ExecName(evmtypes.ExecutorName))
 	}
 
+	if len(in.Calls) > 0 && types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMMultiCall) {
+		evm.queryMultiCall(caller, in, ret)
+		return ret, nil
+	}
+
+	to := common.StringToAddress(in.Address)
+	if to == nil {
+		ret.JsonData = fmt.Sprintf("invalid address:%v", in.Address)
+		return ret, nil
+	}
+
 	msg := common.NewMessage(caller, common.StringToAddress(in.Address), 0, 0, evmtypes.MaxGasLimit, 1, nil, "estimateGas", in.Input)
 	txHash := common.BigToHash(big.NewInt(evmtypes.MaxGasLimit)).Bytes()
 
@@ -184,3 +189,32 @@ func (evm *EVMExecutor) Query_QueryABI(in *evmtypes.EvmQueryAbiReq) (types.Messa
 
 	return &evmtypes.EvmQueryAbiResp{Address: in.GetAddress(), Abi: abiData}, nil
 }
+
+// Query_GetLogs 按区块高度范围、合约地址和主题查询合约事件日志，支持翻页
+func (evm *EVMExecutor) Query_GetLogs(in *evmtypes.EvmGetLogsReq) (types.Message, error) {
+	evm.CheckInit()
+	return evm.getLogs(in)
+}
+
+// Query_TraceTx 在父区块的状态上依次重新执行区块中的交易，返回指令级或调用层级的跟踪信息，不修改原有执行器的状态数据
+func (evm *EVMExecutor) Query_TraceTx(in *evmtypes.EvmTraceTxReq) (types.Message, error) {
+	return evm.traceTx(in)
+}
+
+// Query_GetStorageAt 查询合约在指定存储位置上的状态数据
+func (evm *EVMExecutor) Query_GetStorageAt(in *evmtypes.EvmGetStorageAtReq) (types.Message, error) {
+	evm.CheckInit()
+	return evm.getStorage(in)
+}
+
+// Query_DumpStorage 按存储位置分页列出合约的全部状态数据
+func (evm *EVMExecutor) Query_DumpStorage(in *evmtypes.EvmDumpStorageReq) (types.Message, error) {
+	evm.CheckInit()
+	return evm.dumpStorage(in)
+}
+
+// Query_GetAccountState 查询合约账户在状态树中的原始数据和指定位置的状态数据
+func (evm *EVMExecutor) Query_GetAccountState(in *evmtypes.EvmGetAccountStateReq) (types.Message, error) {
+	evm.CheckInit()
+	return evm.getAccountState(in)
+}
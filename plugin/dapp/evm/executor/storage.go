@@ -0,0 +1,238 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 单次查询默认和最多返回的存储条数
+	defaultStorageCount = 20
+	maxStorageCount     = 100
+)
+
+// 最大的存储位置，倒序遍历时从这里开始
+var maxStorageSlot = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// 支持合约地址和合约名称两种格式
+func (evm *EVMExecutor) getContractAccount(addrStr string) (*state.ContractAccount, error) {
+	var addr common.Address
+	if strings.HasPrefix(addrStr, types.ExecName(evmtypes.EvmPrefix)) {
+		addr = common.ExecAddress(addrStr)
+	} else {
+		nAddr := common.StringToAddress(addrStr)
+		if nAddr == nil {
+			return nil, model.ErrAddrNotExists
+		}
+		addr = *nAddr
+	}
+	acc := evm.mStateDB.GetAccount(addr.String())
+	if acc == nil {
+		return nil, model.ErrAddrNotExists
+	}
+	return acc, nil
+}
+
+// 存储位置按十六进制数字解析，可以省略高位的0
+func parseStorageSlot(slot string) (common.Hash, error) {
+	if strings.HasPrefix(slot, "0x") || strings.HasPrefix(slot, "0X") {
+		slot = slot[2:]
+	}
+	value, ok := new(big.Int).SetString(slot, 16)
+	if !ok || value.Sign() < 0 || value.BitLen() > 256 {
+		return common.Hash{}, types.ErrInvalidParam
+	}
+	return common.BigToHash(value), nil
+}
+
+// 读取存储位置上的数据
+// 分叉之后还没有被调用过的合约，状态数据仍然保存在合约状态对象中，需要先从中查找
+func (evm *EVMExecutor) getStorageAt(acc *state.ContractAccount, slot common.Hash) common.Hash {
+	if value, ok := acc.State.GetStorage()[slot.Hex()]; ok {
+		return common.BytesToHash(value)
+	}
+	return evm.mStateDB.GetState(acc.Addr, slot)
+}
+
+func (evm *EVMExecutor) getStorage(in *evmtypes.EvmGetStorageAtReq) (*evmtypes.EvmStorageItem, error) {
+	acc, err := evm.getContractAccount(in.Addr)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := parseStorageSlot(in.Slot)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.EvmStorageItem{Slot: slot.Hex(), Value: evm.getStorageAt(acc, slot).Hex()}, nil
+}
+
+// 按存储位置从大到小列出合约的状态数据，值为0的位置不返回
+func (evm *EVMExecutor) dumpStorage(in *evmtypes.EvmDumpStorageReq) (*evmtypes.EvmDumpStorageResp, error) {
+	acc, err := evm.getContractAccount(in.Addr)
+	if err != nil {
+		return nil, err
+	}
+	count := int(in.Count)
+	if count <= 0 {
+		count = defaultStorageCount
+	}
+	if count > maxStorageCount {
+		count = maxStorageCount
+	}
+	// 从上一页最后一个位置的前一个位置开始
+	start := maxStorageSlot
+	if len(in.PrimaryKey) > 0 {
+		last, err := parseStorageSlot(in.PrimaryKey)
+		if err != nil {
+			return nil, err
+		}
+		if last.Big().Sign() == 0 {
+			return &evmtypes.EvmDumpStorageResp{}, nil
+		}
+		start = new(big.Int).Sub(last.Big(), big.NewInt(1))
+	}
+
+	var items []*evmtypes.EvmStorageItem
+	if len(acc.State.GetStorage()) > 0 {
+		items = dumpStorageMap(acc.State.GetStorage(), start, count)
+	} else {
+		items, err = dumpStorageLocal(evm.GetLocalDB(), acc.Addr, start, count)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp := &evmtypes.EvmDumpStorageResp{Items: items}
+	if len(items) == count {
+		resp.PrimaryKey = items[count-1].Slot
+	}
+	return resp, nil
+}
+
+// 分叉之前的合约，状态数据全部保存在合约状态对象中
+func dumpStorageMap(storage map[string][]byte, start *big.Int, count int) []*evmtypes.EvmStorageItem {
+	var items []*evmtypes.EvmStorageItem
+	for key, value := range storage {
+		slot := common.BytesToHash(common.FromHex(key))
+		if slot.Big().Cmp(start) > 0 || common.BytesToHash(value).Big().Sign() == 0 {
+			continue
+		}
+		items = append(items, &evmtypes.EvmStorageItem{Slot: slot.Hex(), Value: common.BytesToHash(value).Hex()})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Slot > items[j].Slot })
+	if len(items) > count {
+		items = items[:count]
+	}
+	return items
+}
+
+// 分叉之后，状态数据按位置分散保存在localdb中
+// localdb的列表接口只有Seek时返回key，所以逐个查找不大于当前位置的最大位置
+func dumpStorageLocal(db dbm.KVDB, addr string, start *big.Int, count int) ([]*evmtypes.EvmStorageItem, error) {
+	prefix := state.GetStateItemPrefix(addr)
+	var items []*evmtypes.EvmStorageItem
+	for len(items) < count && start.Sign() >= 0 {
+		floor, err := db.List([]byte(prefix), []byte(prefix+common.BigToHash(start).Hex()), 1, dbm.ListSeek)
+		if err == types.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(floor) != 2 {
+			break
+		}
+		slot, err := parseStorageSlot(strings.TrimPrefix(string(floor[0]), prefix))
+		if err != nil {
+			return nil, err
+		}
+		value := common.BytesToHash(floor[1])
+		if value.Big().Sign() != 0 {
+			items = append(items, &evmtypes.EvmStorageItem{Slot: slot.Hex(), Value: value.Hex()})
+		}
+		start = new(big.Int).Sub(slot.Big(), big.NewInt(1))
+	}
+	return items, nil
+}
+
+// 返回最新区块状态哈希下合约账户在状态树中的原始数据，以及指定位置的状态数据
+// 不带默克尔证明：执行器只能通过StoreGet读取状态数据，store模块没有提供获取mavl证明的接口，
+// 且kvmvccmavl在ForkKvmvccmavl之后只保存kvmvcc数据，已经没有mavl树可以生成证明
+// 分叉之后分散存储的状态数据保存在localdb中，不在状态树中
+func (evm *EVMExecutor) getAccountState(in *evmtypes.EvmGetAccountStateReq) (*evmtypes.EvmGetAccountStateResp, error) {
+	acc, err := evm.getContractAccount(in.Addr)
+	if err != nil {
+		return nil, err
+	}
+	var slots []common.Hash
+	for _, item := range in.Slots {
+		slot, err := parseStorageSlot(item)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, slot)
+	}
+
+	header, err := evm.GetAPI().GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	keys := [][]byte{acc.GetDataKey(), acc.GetStateKey()}
+	reply, err := evm.GetAPI().StoreGet(&types.StoreGet{StateHash: header.StateHash, Keys: keys})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Values) != len(keys) || len(reply.Values[0]) == 0 {
+		return nil, model.ErrAddrNotExists
+	}
+
+	resp := &evmtypes.EvmGetAccountStateResp{Addr: acc.Addr, Height: header.Height, StateHash: common.Bytes2Hex(header.StateHash)}
+	var (
+		data     evmtypes.EVMContractData
+		contract evmtypes.EVMContractState
+	)
+	err = types.Decode(reply.Values[0], &data)
+	if err != nil {
+		return nil, err
+	}
+	err = types.Decode(reply.Values[1], &contract)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range keys {
+		resp.AccountKVs = append(resp.AccountKVs, &types.KeyValue{Key: key, Value: reply.Values[i]})
+	}
+	resp.Data = &evmtypes.EVMContractDataCmd{
+		Creator:  data.Creator,
+		Name:     data.Name,
+		Alias:    data.Alias,
+		Addr:     data.Addr,
+		Code:     common.Bytes2Hex(data.Code),
+		CodeHash: common.Bytes2Hex(data.CodeHash),
+	}
+	resp.State = &evmtypes.EVMContractStateCmd{
+		Nonce:       contract.Nonce,
+		Suicided:    contract.Suicided,
+		StorageHash: common.Bytes2Hex(contract.StorageHash),
+		Storage:     make(map[string]string),
+	}
+	for key, value := range contract.Storage {
+		resp.State.Storage[key] = common.Bytes2Hex(value)
+	}
+
+	for _, slot := range slots {
+		resp.Storage = append(resp.Storage, &evmtypes.EvmStorageItem{Slot: slot.Hex(), Value: evm.getStorageAt(acc, slot).Hex()})
+	}
+	return resp, nil
+}
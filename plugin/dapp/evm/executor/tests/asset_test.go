@@ -0,0 +1,142 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// 合约把调用参数原样转发给资产合约，调用失败时回滚
+var assetProxyCode = []byte{
+	0x36, 0x60, 0x00, 0x60, 0x00, 0x37,
+	0x60, 0x20, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, 0x61, 0x01, 0x00, 0x5a, 0xf1,
+	0x60, 0x1c, 0x57, 0x60, 0x00, 0x60, 0x00, 0xfd,
+	0x5b, 0x60, 0x20, 0x60, 0x00, 0xf3,
+}
+
+// 合约通过代理合约操作token资产
+func TestAssetPrecompile(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMPrecompile)
+
+	e := newEVMTestEnv(t, height)
+	defer e.close()
+	user := address.PubKeyToAddress(e.privKey.PubKey().Bytes()).String()
+
+	proxy := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(assetProxyCode)}, 0)
+	// 调用代理合约成功后回滚
+	revertCode := append([]byte{0x36, 0x60, 0x00, 0x60, 0x00, 0x37, 0x60, 0x20, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, 0x73}, common.StringToAddress(proxy.ContractAddr).Bytes()...)
+	revertCode = append(revertCode, 0x5a, 0xf1, 0x60, 0x00, 0x60, 0x00, 0xfd)
+	reverter := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(revertCode)}, 1)
+	// 恶意合约，把调用参数转发给代理合约，调用失败时回滚
+	forwardCode := append([]byte{0x36, 0x60, 0x00, 0x60, 0x00, 0x37, 0x60, 0x20, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, 0x73}, common.StringToAddress(proxy.ContractAddr).Bytes()...)
+	forwardCode = append(forwardCode, 0x5a, 0xf1, 0x60, 0x2e, 0x57, 0x60, 0x00, 0x60, 0x00, 0xfd, 0x5b, 0x60, 0x20, 0x60, 0x00, 0xf3)
+	forwarder := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(forwardCode)}, 8)
+
+	// 用户向代理合约存入1000个token
+	accDB, err := account.NewAccountDB("token", "TEST", e.stateDB)
+	assert.Nil(t, err)
+	accDB.SaveAccount(&types.Account{Addr: proxy.ContractAddr, Balance: 1000})
+	accDB.SaveExecAccount(proxy.ContractAddr, &types.Account{Addr: user, Balance: 1000})
+
+	assetABI, err := abi.JSON(strings.NewReader(runtime.AssetContractABI))
+	assert.Nil(t, err)
+	call := func(to *evmtypes.ReceiptEVMContract, index int, method string, args ...interface{}) (*evmtypes.ReceiptEVMContract, error) {
+		input, err := assetABI.Pack(method, args...)
+		assert.Nil(t, err)
+		_, receipt, err := e.run(to.ContractName, to.ContractAddr, &evmtypes.EVMContractAction{Code: input}, index)
+		if err != nil {
+			return nil, err
+		}
+		contracts := e.contracts(receipt)
+		return contracts[len(contracts)-1], nil
+	}
+	balance := func(owner string) int64 {
+		ret, err := call(proxy, 9, "balanceOf", "token", "TEST", common.StringToAddress(owner).ToHash160())
+		assert.Nil(t, err)
+		return new(big.Int).SetBytes(ret.Ret).Int64()
+	}
+
+	_, err = call(proxy, 2, "deposit", "token", "TEST", big.NewInt(600))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(400), accDB.LoadExecAccount(user, proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(600), balance(proxy.ContractAddr))
+	assert.Equal(t, int64(400), balance(user))
+
+	// 合约向用户转账，用户可以从合约中取出
+	_, err = call(proxy, 3, "transfer", "token", "TEST", common.StringToAddress(user).ToHash160(), big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(500), accDB.LoadExecAccount(proxy.ContractAddr, proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(500), accDB.LoadExecAccount(user, proxy.ContractAddr).Balance)
+
+	// 合约资产转入trade执行器
+	_, err = call(proxy, 4, "transferToExec", "token", "TEST", "trade", big.NewInt(200))
+	assert.Nil(t, err)
+	tradeAddr := address.ExecAddress("trade")
+	assert.Equal(t, int64(300), accDB.LoadExecAccount(proxy.ContractAddr, proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(200), accDB.LoadExecAccount(proxy.ContractAddr, tradeAddr).Balance)
+	assert.Equal(t, int64(800), accDB.LoadAccount(proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(200), accDB.LoadAccount(tradeAddr).Balance)
+
+	// 余额不足
+	_, err = call(proxy, 5, "transfer", "token", "TEST", common.StringToAddress(user).ToHash160(), big.NewInt(1000))
+	assert.Equal(t, model.ErrExecutionReverted, err)
+	assert.Equal(t, int64(300), accDB.LoadExecAccount(proxy.ContractAddr, proxy.ContractAddr).Balance)
+
+	// 外层合约回滚时，内部调用中的资产操作也要回滚
+	_, err = call(reverter, 6, "deposit", "token", "TEST", big.NewInt(100))
+	assert.Equal(t, model.ErrExecutionReverted, err)
+	assert.Equal(t, int64(300), accDB.LoadExecAccount(proxy.ContractAddr, proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(500), accDB.LoadExecAccount(user, proxy.ContractAddr).Balance)
+
+	// 用户调用其它合约时，被调用合约不能把用户存在代理合约中的资产转走，deposit只扣除代理合约的直接调用者
+	_, err = call(forwarder, 7, "deposit", "token", "TEST", big.NewInt(100))
+	assert.Equal(t, model.ErrExecutionReverted, err)
+	assert.Equal(t, int64(300), accDB.LoadExecAccount(proxy.ContractAddr, proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(500), accDB.LoadExecAccount(user, proxy.ContractAddr).Balance)
+
+	// 转发合约自己存入的资产可以通过转发合约存入
+	accDB.SaveExecAccount(proxy.ContractAddr, &types.Account{Addr: forwarder.ContractAddr, Balance: 100})
+	_, err = call(forwarder, 10, "deposit", "token", "TEST", big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(400), accDB.LoadExecAccount(proxy.ContractAddr, proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(0), accDB.LoadExecAccount(forwarder.ContractAddr, proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(500), accDB.LoadExecAccount(user, proxy.ContractAddr).Balance)
+
+	// 用户授权代理合约后，合约通过transferFrom在额度内取出用户的token，授权转账通过token的执行器驱动完成
+	if _, err := drivers.LoadDriver(tokenty.TokenX, -1); err != nil {
+		tokenexec.Init(tokenty.TokenX, nil)
+	}
+	accDB.SaveAccount(&types.Account{Addr: user, Balance: 1000})
+	approve := &tokenty.TokenAllowance{Symbol: "TEST", Owner: user, Spender: proxy.ContractAddr, Amount: 300}
+	e.stateDB.Set([]byte("mavl-token-allowance-TEST-"+user+"-"+proxy.ContractAddr), types.Encode(approve))
+	_, err = call(proxy, 11, "transferFrom", "token", "TEST", common.StringToAddress(user).ToHash160(), big.NewInt(400))
+	assert.Equal(t, model.ErrExecutionReverted, err)
+	_, err = call(proxy, 12, "transferFrom", "token", "TEST", common.StringToAddress(user).ToHash160(), big.NewInt(300))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(700), accDB.LoadAccount(user).Balance)
+	assert.Equal(t, int64(1100), accDB.LoadAccount(proxy.ContractAddr).Balance)
+	assert.Equal(t, int64(700), balance(proxy.ContractAddr))
+	assert.Equal(t, int64(0), tokenexec.GetAllowance(e.stateDB, "TEST", user, proxy.ContractAddr))
+
+	// 只有token支持授权
+	_, err = call(proxy, 13, "transferFrom", "coins", "bty", common.StringToAddress(user).ToHash160(), big.NewInt(1))
+	assert.Equal(t, model.ErrExecutionReverted, err)
+}
@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const transferEventABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"id","type":"uint256"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+// 部署一个每次调用都生成 Transfer(id, 5) 事件的合约，查询事件日志
+func TestEventLogs(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEventLog)
+
+	// 合约代码：mstore(0, 5); log2(0, 0x20, sig, 1)
+	sig := crypto.Keccak256([]byte("Transfer(uint256,uint256)"))
+	runtimeCode := append(append([]byte{0x60, 0x05, 0x60, 0x00, 0x52, 0x60, 0x01, 0x7f}, sig...), 0x60, 0x20, 0x60, 0x00, 0xa2, 0x00)
+
+	e := newEVMTestEnv(t, height)
+	defer e.close()
+
+	contract := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(runtimeCode), Abi: transferEventABI}, 0)
+	assert.NotEmpty(t, contract.ContractAddr)
+
+	// 调用三次，每次生成一条事件日志
+	call := &evmtypes.EVMContractAction{}
+	for i := 0; i < 3; i++ {
+		_, receipt, err := e.run(contract.ContractName, contract.ContractAddr, call, i+1)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(evmtypes.TyLogEVMEventLog), receipt.Logs[len(receipt.Logs)-1].Ty)
+	}
+
+	topic0 := common.BytesToHash(sig).Hex()
+	query := func(req *evmtypes.EvmGetLogsReq) *evmtypes.EvmGetLogsResp {
+		msg, err := e.inst.Query("GetLogs", types.Encode(req))
+		assert.Nil(t, err)
+		return msg.(*evmtypes.EvmGetLogsResp)
+	}
+
+	// 按地址查询，翻页
+	resp := query(&evmtypes.EvmGetLogsReq{Addresses: []string{contract.ContractAddr}, Count: 2})
+	assert.Equal(t, 2, len(resp.Logs))
+	assert.NotEmpty(t, resp.PrimaryKey)
+	assert.Equal(t, int64(1), resp.Logs[0].TxIndex)
+	assert.Equal(t, topic0, resp.Logs[0].Topics[0])
+	assert.Equal(t, "Transfer", resp.Logs[0].EventName)
+	assert.Equal(t, `[{"name":"id","type":"uint256","value":1},{"name":"value","type":"uint256","value":5}]`, resp.Logs[0].JsonData)
+	resp = query(&evmtypes.EvmGetLogsReq{Addresses: []string{contract.ContractAddr}, Count: 2, PrimaryKey: resp.PrimaryKey})
+	assert.Equal(t, 1, len(resp.Logs))
+	assert.Equal(t, int64(3), resp.Logs[0].TxIndex)
+	assert.Empty(t, resp.PrimaryKey)
+
+	// 按主题查询，第一个位置不限制
+	topic1 := hex.EncodeToString(common.BytesToHash([]byte{1}).Bytes())
+	resp = query(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmLogTopics{{}, {Topics: []string{topic1}}}})
+	assert.Equal(t, 3, len(resp.Logs))
+	resp = query(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmLogTopics{{Topics: []string{topic1}}}})
+	assert.Equal(t, 0, len(resp.Logs))
+
+	// 高度范围
+	resp = query(&evmtypes.EvmGetLogsReq{FromBlock: height + 1})
+	assert.Equal(t, 0, len(resp.Logs))
+	resp = query(&evmtypes.EvmGetLogsReq{FromBlock: height, ToBlock: height})
+	assert.Equal(t, 3, len(resp.Logs))
+	_, err := e.inst.Query("GetLogs", types.Encode(&evmtypes.EvmGetLogsReq{FromBlock: height, ToBlock: height - 1}))
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"path/filepath"
 	"testing"
@@ -22,9 +23,13 @@ import (
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestVM(t *testing.T) {
+	//istanbulTest 中的用例需要在 ForkEVMIstanbul 之后执行
+	types.SetTitleOnlyForTest("chain33")
 
 	basePath := "testdata/"
 
@@ -201,3 +206,41 @@ func buildMsg(c VMCase) *common.Message {
 	gasPrice := c.exec.gasPrice
 	return common.NewMessage(*addr1, addr2, int64(1), uint64(c.exec.value), gasLimit, uint32(gasPrice), code, "", "")
 }
+
+// 合约通过CREATE2创建新合约时转入endowment，返回新合约的余额
+func TestCreate2Endowment(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMIstanbul)
+
+	e := newEVMTestEnv(t, height)
+	defer e.close()
+
+	create2 := func(value byte) []byte {
+		return []byte{0x60, 0x2a, 0x60, 0x00, 0x60, 0x00, 0x60, value, 0xf5, 0x31, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	}
+	factory := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(create2(5))}, 0)
+	poor := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(create2(100))}, 1)
+
+	// 合约持有的金额保存在合约地址自身的执行账户下
+	accDB := account.NewCoinsAccount()
+	accDB.SetDB(e.stateDB)
+	for _, addr := range []string{factory.ContractAddr, poor.ContractAddr} {
+		accDB.SaveAccount(&types.Account{Addr: addr, Balance: 10})
+		accDB.SaveExecAccount(addr, &types.Account{Addr: addr, Balance: 10})
+	}
+
+	_, receipt, err := e.run(factory.ContractName, factory.ContractAddr, &evmtypes.EVMContractAction{}, 2)
+	assert.Nil(t, err)
+	contracts := e.contracts(receipt)
+	assert.Equal(t, int64(5), new(big.Int).SetBytes(contracts[len(contracts)-1].Ret).Int64())
+	created := common.NewContractAddress2(*common.StringToAddress(factory.ContractAddr), common.BigToHash(big.NewInt(0x2a)), nil).String()
+	assert.Equal(t, int64(5), accDB.LoadExecAccount(created, created).Balance)
+	assert.Equal(t, int64(5), accDB.LoadExecAccount(factory.ContractAddr, factory.ContractAddr).Balance)
+
+	// 余额不足时创建失败，不扣除金额
+	_, receipt, err = e.run(poor.ContractName, poor.ContractAddr, &evmtypes.EVMContractAction{}, 3)
+	assert.Nil(t, err)
+	contracts = e.contracts(receipt)
+	assert.Equal(t, int64(0), new(big.Int).SetBytes(contracts[len(contracts)-1].Ret).Int64())
+	assert.Equal(t, int64(10), accDB.LoadExecAccount(poor.ContractAddr, poor.ContractAddr).Balance)
+}
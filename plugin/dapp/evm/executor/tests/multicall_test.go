@@ -0,0 +1,100 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const counterABI = `[{"constant":false,"inputs":[{"name":"x","type":"uint256"}],"name":"add","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"constant":true,"inputs":[{"name":"x","type":"uint256"}],"name":"peek","outputs":[{"name":"","type":"uint256"}],"type":"function"}]`
+
+// 计数合约：忽略方法签名，把参数x累加到存储位置0并返回结果，x为0时回滚
+var counterCode = []byte{
+	0x60, 0x04, 0x35, 0x80, 0x15, 0x60, 0x18, 0x57,
+	0x60, 0x00, 0x54, 0x01, 0x80, 0x60, 0x00, 0x55,
+	0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3,
+	0x5b, 0x60, 0x00, 0x60, 0x00, 0xfd,
+}
+
+// 在一个交易中按顺序调用两个合约，失败时全部回滚
+func TestMultiCall(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMMultiCall)
+
+	e := newEVMTestEnv(t, height)
+	defer e.close()
+
+	evmAddr := address.ExecAddress(evmtypes.ExecutorName)
+	counterA := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(counterCode), Abi: counterABI}, 0)
+	counterB := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(counterCode)}, 1)
+
+	// 十六进制调用数据：4字节方法签名加32字节参数
+	rawCall := func(x int64) []byte {
+		return append(make([]byte, 4), common.BigToHash(big.NewInt(x)).Bytes()...)
+	}
+	value := func(x int64) []byte { return common.BigToHash(big.NewInt(x)).Bytes() }
+	storage := func(contract *evmtypes.ReceiptEVMContract) string {
+		msg, err := e.inst.Query("GetStorageAt", types.Encode(&evmtypes.EvmGetStorageAtReq{Addr: contract.ContractAddr, Slot: "0x0"}))
+		assert.Nil(t, err)
+		return msg.(*evmtypes.EvmStorageItem).Value
+	}
+
+	// 按ABI和十六进制数据混合调用，同一个合约调用两次
+	calls := []*evmtypes.EVMContractCall{
+		{Contract: counterA.ContractName, Abi: "add(2)"},
+		{Contract: counterB.ContractAddr, Code: rawCall(3)},
+		{Contract: counterA.ContractAddr, Abi: "add(5)"},
+	}
+	_, receipt, err := e.run(evmtypes.ExecutorName, evmAddr, &evmtypes.EVMContractAction{Calls: calls}, 2)
+	assert.Nil(t, err)
+	contracts := e.contracts(receipt)
+	assert.Equal(t, 3, len(contracts))
+	assert.Equal(t, value(2), contracts[0].Ret)
+	assert.Equal(t, value(3), contracts[1].Ret)
+	assert.Equal(t, value(7), contracts[2].Ret)
+	assert.Equal(t, counterA.ContractAddr, contracts[2].ContractAddr)
+	assert.NotEmpty(t, contracts[0].JsonRet)
+	assert.Empty(t, contracts[1].JsonRet)
+	assert.True(t, contracts[0].UsedGas > 0)
+	assert.Equal(t, common.BigToHash(big.NewInt(7)).Hex(), storage(counterA))
+	assert.Equal(t, common.BigToHash(big.NewInt(3)).Hex(), storage(counterB))
+
+	// 后面的调用失败时，前面调用的数据变更也要回滚
+	calls = []*evmtypes.EVMContractCall{
+		{Contract: counterA.ContractAddr, Abi: "add(1)"},
+		{Contract: counterB.ContractAddr, Code: rawCall(0)},
+	}
+	_, _, err = e.run(evmtypes.ExecutorName, evmAddr, &evmtypes.EVMContractAction{Calls: calls}, 3)
+	assert.Equal(t, model.ErrExecutionReverted, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(7)).Hex(), storage(counterA))
+
+	// 批量调用交易必须发给evm执行器
+	_, _, err = e.run(evmtypes.ExecutorName, counterA.ContractAddr, &evmtypes.EVMContractAction{Calls: calls[:1]}, 4)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	// 只读批量调用，只能调用常量方法
+	msg, err := e.inst.Query("Query", types.Encode(&evmtypes.EvmQueryReq{Calls: []*evmtypes.EVMContractCall{
+		{Contract: counterA.ContractAddr, Abi: "peek(1)"},
+		{Contract: counterB.ContractAddr, Code: rawCall(10)},
+	}}))
+	assert.Nil(t, err)
+	resp := msg.(*evmtypes.EvmQueryResp)
+	assert.Equal(t, 2, len(resp.Calls))
+	assert.Equal(t, common.Bytes2Hex(value(8)), resp.Calls[0].RawData)
+	assert.Equal(t, "peek(1)", resp.Calls[0].Input)
+	assert.Equal(t, common.Bytes2Hex(value(13)), resp.Calls[1].RawData)
+	msg, err = e.inst.Query("Query", types.Encode(&evmtypes.EvmQueryReq{Calls: []*evmtypes.EVMContractCall{{Contract: counterA.ContractAddr, Abi: "add(1)"}}}))
+	assert.Nil(t, err)
+	assert.Empty(t, msg.(*evmtypes.EvmQueryResp).Calls)
+	assert.NotEmpty(t, msg.(*evmtypes.EvmQueryResp).JsonData)
+}
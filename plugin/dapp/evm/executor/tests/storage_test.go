@@ -0,0 +1,97 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 部署时写入多个存储位置的合约，查询合约的状态数据
+func TestStorageQuery(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	height := int64(3800000)
+
+	// 构造函数：sstore(1, 0x11); sstore(2, 0x22); sstore(0x100, 0x33); sstore(3, 0)，运行时代码为STOP
+	initCode := []byte{
+		0x60, 0x11, 0x60, 0x01, 0x55,
+		0x60, 0x22, 0x60, 0x02, 0x55,
+		0x60, 0x33, 0x61, 0x01, 0x00, 0x55,
+		0x60, 0x00, 0x60, 0x03, 0x55,
+	}
+	offset := byte(len(initCode) + 12)
+	deployCode := append(initCode, 0x60, 0x01, 0x60, offset, 0x60, 0x00, 0x39, 0x60, 0x01, 0x60, 0x00, 0xf3, 0x00)
+
+	e := newEVMTestEnv(t, height)
+	defer e.close()
+
+	contract := e.deploy(&evmtypes.EVMContractAction{Code: deployCode}, 0)
+
+	// 查询单个存储位置，合约名称和地址都可以
+	slot := func(n int64) string { return common.BigToHash(big.NewInt(n)).Hex() }
+	msg, err := e.inst.Query("GetStorageAt", types.Encode(&evmtypes.EvmGetStorageAtReq{Addr: contract.ContractName, Slot: "0x02"}))
+	assert.Nil(t, err)
+	assert.Equal(t, slot(0x22), msg.(*evmtypes.EvmStorageItem).Value)
+	msg, err = e.inst.Query("GetStorageAt", types.Encode(&evmtypes.EvmGetStorageAtReq{Addr: contract.ContractAddr, Slot: "0x05"}))
+	assert.Nil(t, err)
+	assert.Equal(t, slot(0), msg.(*evmtypes.EvmStorageItem).Value)
+	_, err = e.inst.Query("GetStorageAt", types.Encode(&evmtypes.EvmGetStorageAtReq{Addr: contract.ContractAddr, Slot: "xyz"}))
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	// 分页列出全部存储位置，值为0的位置不返回
+	dump := func(primary string) *evmtypes.EvmDumpStorageResp {
+		msg, err := e.inst.Query("DumpStorage", types.Encode(&evmtypes.EvmDumpStorageReq{Addr: contract.ContractAddr, Count: 2, PrimaryKey: primary}))
+		assert.Nil(t, err)
+		return msg.(*evmtypes.EvmDumpStorageResp)
+	}
+	page := dump("")
+	assert.Equal(t, 2, len(page.Items))
+	assert.Equal(t, slot(0x100), page.Items[0].Slot)
+	assert.Equal(t, slot(0x33), page.Items[0].Value)
+	assert.Equal(t, slot(2), page.Items[1].Slot)
+	assert.Equal(t, slot(2), page.PrimaryKey)
+	page = dump(page.PrimaryKey)
+	assert.Equal(t, 1, len(page.Items))
+	assert.Equal(t, slot(1), page.Items[0].Slot)
+	assert.Equal(t, slot(0x11), page.Items[0].Value)
+	assert.Empty(t, page.PrimaryKey)
+
+	// 账户在状态树中的数据
+	api := &apimock.QueueProtocolAPI{}
+	api.On("GetLastHeader").Return(&types.Header{Height: height, StateHash: []byte("current")}, nil)
+	api.On("StoreGet", mock.Anything).Return(func(req *types.StoreGet) *types.StoreReplyValue {
+		reply := &types.StoreReplyValue{}
+		for _, key := range req.Keys {
+			value, _ := e.stateDB.Get(key)
+			reply.Values = append(reply.Values, value)
+		}
+		return reply
+	}, nil)
+	e.inst.SetAPI(api)
+	msg, err = e.inst.Query("GetAccountState", types.Encode(&evmtypes.EvmGetAccountStateReq{Addr: contract.ContractAddr, Slots: []string{"0x01", "0x100"}}))
+	assert.Nil(t, err)
+	accState := msg.(*evmtypes.EvmGetAccountStateResp)
+	assert.Equal(t, common.Bytes2Hex([]byte("current")), accState.StateHash)
+	assert.Equal(t, 2, len(accState.AccountKVs))
+	for _, kv := range accState.AccountKVs {
+		value, err := e.stateDB.Get(kv.Key)
+		assert.Nil(t, err)
+		assert.Equal(t, value, kv.Value)
+	}
+	assert.Equal(t, contract.ContractAddr, accState.Data.Addr)
+	assert.Equal(t, "0x00", accState.Data.Code)
+	assert.Equal(t, []*evmtypes.EvmStorageItem{{Slot: slot(1), Value: slot(0x11)}, {Slot: slot(0x100), Value: slot(0x33)}}, accState.Storage)
+
+	_, err = e.inst.Query("DumpStorage", types.Encode(&evmtypes.EvmDumpStorageReq{Addr: address.ExecAddress("trade")}))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,163 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 合约A调用合约B，合约B执行REVERT，跟踪这次调用
+func TestTraceTx(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	// ForkEVMState之前的高度，合约状态数据保存在StateDB中
+	height := types.GetDappFork("evm", evmtypes.ForkEVMState) - 1
+
+	e := newEVMTestEnv(t, height)
+	defer e.close()
+
+	contractB := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode([]byte{0x60, 0x00, 0x60, 0x00, 0xfd})}, 0)
+	// 合约A代码：call(gas, B, 0, 0, 0, 0, 0); pop; stop
+	codeA := append([]byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73}, common.StringToAddress(contractB.ContractAddr).Bytes()...)
+	codeA = append(codeA, 0x5a, 0xf1, 0x50, 0x00)
+	contractA := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(codeA)}, 1)
+
+	// 执行后的状态数据作为父区块的状态
+	parentState := e.snapshot()
+	tx, _, err := e.run(contractA.ContractName, contractA.ContractAddr, &evmtypes.EVMContractAction{}, 0)
+	assert.Nil(t, err)
+
+	setTraceBalance(parentState, tx)
+
+	api := &apimock.QueueProtocolAPI{}
+	api.On("QueryTx", &types.ReqHash{Hash: tx.Hash()}).Return(&types.TransactionDetail{Tx: tx, Height: height, Index: 0}, nil)
+	api.On("GetBlocks", &types.ReqBlocks{Start: height - 1, End: height, IsDetail: true}).Return(&types.BlockDetails{Items: []*types.BlockDetail{
+		{Block: &types.Block{Height: height - 1, StateHash: []byte("parent")}},
+		{Block: &types.Block{Height: height, StateHash: []byte("current"), Txs: []*types.Transaction{tx}}, Receipts: []*types.ReceiptData{{Ty: types.ExecOk}}},
+	}}, nil)
+	api.On("StoreGet", mock.Anything).Return(func(req *types.StoreGet) *types.StoreReplyValue {
+		assert.Equal(t, []byte("parent"), req.StateHash)
+		return &types.StoreReplyValue{Values: [][]byte{parentState[string(req.Keys[0])]}}
+	}, nil)
+	e.inst.SetAPI(api)
+
+	trace := func(tracer string) *evmtypes.EvmTraceTxResp {
+		msg, err := e.inst.Query("TraceTx", types.Encode(&evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(tx.Hash()), Tracer: tracer}))
+		assert.Nil(t, err)
+		return msg.(*evmtypes.EvmTraceTxResp)
+	}
+
+	// 调用层级
+	resp := trace("callTracer")
+	assert.Empty(t, resp.Error)
+	var root runtime.CallFrame
+	assert.Nil(t, json.Unmarshal([]byte(resp.JsonData), &root))
+	assert.Equal(t, "CALL", root.Type)
+	assert.Equal(t, contractA.ContractAddr, root.To)
+	assert.Empty(t, root.Error)
+	assert.Equal(t, 1, len(root.Calls))
+	assert.Equal(t, "CALL", root.Calls[0].Type)
+	assert.Equal(t, contractA.ContractAddr, root.Calls[0].From)
+	assert.Equal(t, contractB.ContractAddr, root.Calls[0].To)
+	assert.True(t, root.Calls[0].Reverted)
+	assert.True(t, root.Calls[0].GasUsed > 0)
+	assert.True(t, root.GasUsed > root.Calls[0].GasUsed)
+
+	// 指令级跟踪，默认方式
+	resp = trace("")
+	assert.Equal(t, "structLog", resp.Tracer)
+	var result runtime.StructLogResult
+	assert.Nil(t, json.Unmarshal([]byte(resp.JsonData), &result))
+	assert.False(t, result.Failed)
+	assert.Equal(t, root.GasUsed, result.Gas)
+	assert.Equal(t, "PUSH1", result.StructLogs[0].Op)
+	assert.Equal(t, 1, result.StructLogs[0].Depth)
+	last := result.StructLogs[len(result.StructLogs)-1]
+	assert.Equal(t, "STOP", last.Op)
+	var inner []string
+	for _, item := range result.StructLogs {
+		if item.Depth == 2 {
+			inner = append(inner, item.Op)
+		}
+	}
+	assert.Equal(t, []string{"PUSH1", "PUSH1", "REVERT"}, inner)
+
+	_, err = e.inst.Query("TraceTx", types.Encode(&evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(tx.Hash()), Tracer: "unknown"}))
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+}
+
+// 区块中的第二笔交易，跟踪时需要先重新执行第一笔交易
+func TestTraceTxReplay(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	if _, err := drivers.LoadDriver(evmtypes.ExecutorName, 0); err != nil {
+		evm.Init(evmtypes.ExecutorName, nil)
+	}
+	fork := types.GetDappFork("evm", evmtypes.ForkEVMState)
+	// ForkEVMState之前合约状态保存在StateDB中，之后保存在localdb中
+	testTraceReplay(t, fork-1)
+	testTraceReplay(t, fork+1)
+}
+
+func testTraceReplay(t *testing.T, height int64) {
+	e := newEVMTestEnv(t, height-1)
+	defer e.close()
+
+	// 计数合约：slot0 = slot0 + 1
+	counter := []byte{0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x60, 0x00, 0x55, 0x00}
+	contract := e.deploy(&evmtypes.EVMContractAction{Code: wrapDeployCode(counter)}, 0)
+	parentState := e.snapshot()
+
+	// 同一区块中调用两次，之后的区块再调用一次
+	e.inst.SetEnv(height, 0, 1)
+	tx0, receipt0, err := e.run(contract.ContractName, contract.ContractAddr, &evmtypes.EVMContractAction{}, 0)
+	assert.Nil(t, err)
+	tx1, receipt1, err := e.run(contract.ContractName, contract.ContractAddr, &evmtypes.EVMContractAction{}, 1)
+	assert.Nil(t, err)
+	e.inst.SetEnv(height+1, 0, 1)
+	_, receipt2, err := e.run(contract.ContractName, contract.ContractAddr, &evmtypes.EVMContractAction{}, 0)
+	assert.Nil(t, err)
+	setTraceBalance(parentState, tx0)
+
+	api := &apimock.QueueProtocolAPI{}
+	block := &types.BlockDetail{
+		Block:    &types.Block{Height: height, StateHash: []byte("current"), Txs: []*types.Transaction{tx0, tx1}},
+		Receipts: []*types.ReceiptData{{Ty: receipt0.Ty, Logs: receipt0.Logs}, {Ty: receipt1.Ty, Logs: receipt1.Logs}},
+	}
+	api.On("GetBlocks", &types.ReqBlocks{Start: height - 1, End: height, IsDetail: true}).Return(&types.BlockDetails{Items: []*types.BlockDetail{
+		{Block: &types.Block{Height: height - 1, StateHash: []byte("parent")}}, block,
+	}}, nil)
+	api.On("GetLastHeader").Return(&types.Header{Height: height + 1}, nil)
+	api.On("GetBlocks", &types.ReqBlocks{Start: height, End: height + 1, IsDetail: true}).Return(&types.BlockDetails{Items: []*types.BlockDetail{
+		block, {Block: &types.Block{Height: height + 1}, Receipts: []*types.ReceiptData{{Ty: receipt2.Ty, Logs: receipt2.Logs}}},
+	}}, nil)
+	api.On("StoreGet", mock.Anything).Return(func(req *types.StoreGet) *types.StoreReplyValue {
+		return &types.StoreReplyValue{Values: [][]byte{parentState[string(req.Keys[0])]}}
+	}, nil)
+	e.inst.SetAPI(api)
+
+	// SLOAD之后栈顶是交易执行前的计数
+	loaded := func(tx *types.Transaction, index int64) string {
+		api.On("QueryTx", &types.ReqHash{Hash: tx.Hash()}).Return(&types.TransactionDetail{Tx: tx, Height: height, Index: index}, nil)
+		msg, err := e.inst.Query("TraceTx", types.Encode(&evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(tx.Hash())}))
+		assert.Nil(t, err)
+		var result runtime.StructLogResult
+		assert.Nil(t, json.Unmarshal([]byte(msg.(*evmtypes.EvmTraceTxResp).JsonData), &result))
+		assert.Equal(t, "SLOAD", result.StructLogs[1].Op)
+		return result.StructLogs[2].Stack[0]
+	}
+	assert.Equal(t, "0", loaded(tx0, 0))
+	assert.Equal(t, "1", loaded(tx1, 1))
+}
@@ -8,18 +8,21 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"testing"
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
 	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	crypto2 "github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func getBin(data string) (ret []byte) {
@@ -275,3 +278,99 @@ func createContract(mdb *db.GoMemDB, tx types.Transaction, maxCodeSize int) (ret
 
 	return ret, addr, leftGas, statedb, err
 }
+
+// 生成部署代码：把运行时代码复制到内存并返回
+func wrapDeployCode(runtimeCode []byte) []byte {
+	size := byte(len(runtimeCode))
+	return append([]byte{0x60, size, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, size, 0x60, 0x00, 0xf3}, runtimeCode...)
+}
+
+// evmTestEnv 直接调用evm执行器的测试环境，交易执行后写入StateDB并执行ExecLocal
+type evmTestEnv struct {
+	t       *testing.T
+	inst    *evm.EVMExecutor
+	stateDB *db.GoMemDB
+	localDB db.KVDB
+	privKey crypto.PrivKey
+	// 记录执行后的状态数据，用来构造父区块的状态
+	state map[string][]byte
+	close func()
+}
+
+func newEVMTestEnv(t *testing.T, height int64) *evmTestEnv {
+	stateDB, _ := db.NewGoMemDB("state", "state", 100)
+	dir, ldb, localDB := util.CreateTestDB()
+
+	inst := evm.NewEVMExecutor()
+	inst.SetEnv(height, 0, 1)
+	inst.SetStateDB(stateDB)
+	inst.SetLocalDB(localDB)
+	return &evmTestEnv{
+		t:       t,
+		inst:    inst,
+		stateDB: stateDB,
+		localDB: localDB,
+		privKey: getPrivKey(),
+		state:   make(map[string][]byte),
+		close:   func() { util.CloseTestDB(dir, ldb) },
+	}
+}
+
+// run 签名并执行交易，和区块执行时一样把合约状态变更写入localdb
+func (e *evmTestEnv) run(execer string, to string, action *evmtypes.EVMContractAction, index int) (*types.Transaction, *types.Receipt, error) {
+	tx := &types.Transaction{Execer: []byte(execer), Payload: types.Encode(action), Fee: 1000000, To: to, Nonce: int64(index)}
+	tx.Sign(types.SECP256K1, e.privKey)
+	receipt, err := e.inst.Exec(tx, index)
+	if err != nil {
+		return tx, nil, err
+	}
+	for _, kv := range receipt.KV {
+		e.stateDB.Set(kv.Key, kv.Value)
+		e.state[string(kv.Key)] = kv.Value
+	}
+	set, err := e.inst.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, index)
+	assert.Nil(e.t, err)
+	for _, kv := range set.KV {
+		e.localDB.Set(kv.Key, kv.Value)
+	}
+	return tx, receipt, nil
+}
+
+// deploy 部署合约，返回合约的调用结果
+func (e *evmTestEnv) deploy(action *evmtypes.EVMContractAction, index int) *evmtypes.ReceiptEVMContract {
+	_, receipt, err := e.run(evmtypes.ExecutorName, address.ExecAddress(evmtypes.ExecutorName), action, index)
+	assert.Nil(e.t, err)
+	contracts := e.contracts(receipt)
+	assert.Equal(e.t, 1, len(contracts))
+	return contracts[0]
+}
+
+// contracts 从回执中取出合约调用结果
+func (e *evmTestEnv) contracts(receipt *types.Receipt) []*evmtypes.ReceiptEVMContract {
+	var contracts []*evmtypes.ReceiptEVMContract
+	for _, item := range receipt.Logs {
+		if item.Ty == evmtypes.TyLogCallContract {
+			var contract evmtypes.ReceiptEVMContract
+			assert.Nil(e.t, types.Decode(item.Log, &contract))
+			contracts = append(contracts, &contract)
+		}
+	}
+	return contracts
+}
+
+// snapshot 复制当前的状态数据，作为父区块的状态
+func (e *evmTestEnv) snapshot() map[string][]byte {
+	state := make(map[string][]byte)
+	for k, v := range e.state {
+		state[k] = v
+	}
+	return state
+}
+
+// 父区块状态中给交易发起人设置手续费余额
+func setTraceBalance(state map[string][]byte, tx *types.Transaction) {
+	accDB := account.NewCoinsAccount()
+	for _, kv := range accDB.GetKVSet(&types.Account{Addr: tx.From(), Balance: 100 * types.Coin}) {
+		state[string(kv.Key)] = kv.Value
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 按指令跟踪
+	traceStructLog = "structLog"
+	// 按调用层级跟踪
+	traceCallTracer = "callTracer"
+	// 回滚合约状态时每次获取的区块数
+	traceBlockCount = 128
+)
+
+// 重新执行历史交易时写入的数据只保存在内存中，支持按交易提交或回滚
+type traceCache struct {
+	cache   map[string][]byte
+	txcache map[string][]byte
+	intx    bool
+}
+
+func (db *traceCache) get(key []byte) ([]byte, bool) {
+	skey := string(key)
+	if db.intx {
+		if value, ok := db.txcache[skey]; ok {
+			return value, true
+		}
+	}
+	value, ok := db.cache[skey]
+	return value, ok
+}
+
+func (db *traceCache) Set(key []byte, value []byte) error {
+	if db.intx {
+		db.txcache[string(key)] = value
+	} else {
+		db.cache[string(key)] = value
+	}
+	return nil
+}
+
+func (db *traceCache) Begin() {
+	db.intx = true
+	db.txcache = make(map[string][]byte)
+}
+
+func (db *traceCache) Commit() error {
+	for key, value := range db.txcache {
+		db.cache[key] = value
+	}
+	db.intx = false
+	db.txcache = nil
+	return nil
+}
+
+func (db *traceCache) Rollback() {
+	db.intx = false
+	db.txcache = nil
+}
+
+// 重新执行历史交易时使用的状态数据库，从指定状态哈希下读取数据
+type traceStateDB struct {
+	traceCache
+	api       client.QueueProtocolAPI
+	stateHash []byte
+}
+
+func newTraceStateDB(api client.QueueProtocolAPI, stateHash []byte) *traceStateDB {
+	return &traceStateDB{traceCache: traceCache{cache: make(map[string][]byte)}, api: api, stateHash: stateHash}
+}
+
+func (db *traceStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := db.get(key); ok {
+		return value, nil
+	}
+	reply, err := db.api.StoreGet(&types.StoreGet{StateHash: db.stateHash, Keys: [][]byte{key}})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Values) == 0 || reply.Values[0] == nil {
+		return nil, types.ErrNotFound
+	}
+	db.cache[string(key)] = reply.Values[0]
+	return reply.Values[0], nil
+}
+
+// 重新执行历史交易时使用的本地数据库
+// ForkEVMState之后合约状态数据保存在localdb中，回滚之后的状态变更，得到父区块时的合约状态
+type traceLocalDB struct {
+	traceCache
+	dbm.KVDB
+}
+
+func newTraceLocalDB(db dbm.KVDB) *traceLocalDB {
+	return &traceLocalDB{traceCache: traceCache{cache: make(map[string][]byte)}, KVDB: db}
+}
+
+func (db *traceLocalDB) Get(key []byte) ([]byte, error) {
+	if value, ok := db.get(key); ok {
+		if len(value) == 0 {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return db.KVDB.Get(key)
+}
+
+func (db *traceLocalDB) Set(key []byte, value []byte) error {
+	return db.traceCache.Set(key, value)
+}
+
+func (db *traceLocalDB) Begin() {
+	db.traceCache.Begin()
+}
+
+func (db *traceLocalDB) Commit() error {
+	return db.traceCache.Commit()
+}
+
+func (db *traceLocalDB) Rollback() {
+	db.traceCache.Rollback()
+}
+
+// 从最新区块倒序回滚到指定高度（包含）的合约状态变更
+func rollbackEVMState(api client.QueueProtocolAPI, db *traceLocalDB, height int64) error {
+	header, err := api.GetLastHeader()
+	if err != nil {
+		return err
+	}
+	for end := header.Height; end >= height; end -= traceBlockCount {
+		start := end - traceBlockCount + 1
+		if start < height {
+			start = height
+		}
+		blocks, err := api.GetBlocks(&types.ReqBlocks{Start: start, End: end, IsDetail: true})
+		if err != nil {
+			return err
+		}
+		for i := len(blocks.Items) - 1; i >= 0; i-- {
+			receipts := blocks.Items[i].Receipts
+			for j := len(receipts) - 1; j >= 0; j-- {
+				if receipts[j].Ty != types.ExecOk {
+					continue
+				}
+				for k := len(receipts[j].Logs) - 1; k >= 0; k-- {
+					item := receipts[j].Logs[k]
+					if item.Ty != evmtypes.TyLogEVMStateChangeItem {
+						continue
+					}
+					var change evmtypes.EVMStateChangeItem
+					if err := types.Decode(item.Log, &change); err != nil {
+						return err
+					}
+					// 和ExecLocal中一样，转换老的log的key
+					key := []byte(change.Key)
+					if bytes.HasPrefix(key, []byte("mavl-")) {
+						key = append([]byte("LODB-"), key[len("mavl-"):]...)
+					}
+					db.cache[string(key)] = change.PreValue
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// 和区块执行时一样设置执行器的环境
+func setTraceEnv(driver drivers.Driver, api client.QueueProtocolAPI, block *types.Block, stateDB dbm.KV, localDB dbm.KVDB) {
+	driver.SetAPI(api)
+	driver.SetEnv(block.Height, block.BlockTime, uint64(block.Difficulty))
+	driver.SetBlockInfo(block.ParentHash, block.MainHash, block.MainHeight)
+	driver.SetTxs(block.Txs)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+}
+
+// 扣除交易手续费，交易组只在第一笔交易扣除
+func traceFee(driver drivers.Driver, stateDB dbm.KV, txs []*types.Transaction, index int) error {
+	tx := txs[index]
+	if types.IsPara() || types.GInt("MinFee") <= 0 || driver.IsFree() {
+		return nil
+	}
+	if tx.GroupCount >= 2 && index > 0 && bytes.Equal(txs[index-1].Header, tx.Header) {
+		return nil
+	}
+	accDB := account.NewCoinsAccount()
+	accDB.SetDB(stateDB)
+	acc := accDB.LoadAccount(tx.From())
+	if acc.GetBalance()-tx.Fee < 0 {
+		return types.ErrNoBalance
+	}
+	acc.Balance -= tx.Fee
+	accDB.SaveKVSet(accDB.GetKVSet(acc))
+	return nil
+}
+
+// 按照区块中的执行结果重新执行之前的交易，执行失败的交易只扣除手续费
+func replayTx(api client.QueueProtocolAPI, block *types.Block, index int, receiptTy int32, stateDB *traceStateDB, localDB *traceLocalDB) error {
+	tx := block.Txs[index]
+	driver := drivers.LoadDriverAllow(tx, index, block.Height)
+	setTraceEnv(driver, api, block, stateDB, localDB)
+	if err := traceFee(driver, stateDB, block.Txs, index); err != nil {
+		return err
+	}
+	if receiptTy != types.ExecOk {
+		return nil
+	}
+	stateDB.Begin()
+	localDB.Begin()
+	receipt, err := driver.Exec(tx, index)
+	if err != nil {
+		stateDB.Rollback()
+		localDB.Rollback()
+		return err
+	}
+	if receipt != nil {
+		for _, kv := range receipt.KV {
+			stateDB.Set(kv.Key, kv.Value)
+		}
+	}
+	stateDB.Commit()
+	return localDB.Commit()
+}
+
+// 在交易所在区块的父区块状态上，依次重新执行区块中此交易之前的交易，再执行此交易并返回跟踪信息
+// ForkEVMState之后合约状态数据保存在localdb中，先回滚此区块及之后区块的状态变更
+func (evm *EVMExecutor) traceTx(in *evmtypes.EvmTraceTxReq) (*evmtypes.EvmTraceTxResp, error) {
+	name := in.Tracer
+	if len(name) == 0 {
+		name = traceStructLog
+	}
+	var (
+		tracer runtime.Tracer
+		result func() interface{}
+	)
+	switch name {
+	case traceStructLog:
+		logger := runtime.NewStructLogger()
+		tracer, result = logger, func() interface{} { return logger.Result() }
+	case traceCallTracer:
+		callTracer := runtime.NewCallTracer()
+		tracer, result = callTracer, func() interface{} { return callTracer.Result() }
+	default:
+		return nil, types.ErrInvalidParam
+	}
+
+	hash, err := common.HexToBytes(in.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	api := evm.GetAPI()
+	detail, err := api.QueryTx(&types.ReqHash{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	tx := detail.Tx
+	exec := types.GetParaExec(tx.Execer)
+	if !bytes.Equal(exec, evmtypes.ExecerEvm) && !bytes.HasPrefix(exec, evmtypes.UserPrefix) {
+		return nil, types.ErrExecNameNotMatch
+	}
+	if detail.Height <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	blocks, err := api.GetBlocks(&types.ReqBlocks{Start: detail.Height - 1, End: detail.Height, IsDetail: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.Items) != 2 {
+		return nil, types.ErrBlockNotFound
+	}
+	parent, block := blocks.Items[0].Block, blocks.Items[1].Block
+	index := int(detail.Index)
+	receipts := blocks.Items[1].Receipts
+	if index >= len(block.Txs) || index >= len(receipts) || !bytes.Equal(block.Txs[index].Hash(), hash) {
+		return nil, types.ErrTxNotExist
+	}
+
+	stateDB := newTraceStateDB(api, parent.StateHash)
+	localDB := newTraceLocalDB(evm.GetLocalDB())
+	if types.IsDappFork(detail.Height, "evm", evmtypes.ForkEVMState) {
+		if err := rollbackEVMState(api, localDB, detail.Height); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i < index; i++ {
+		if err := replayTx(api, block, i, receipts[i].Ty, stateDB, localDB); err != nil {
+			log.Error("trace evm tx replay error", "txHash", common.Bytes2Hex(block.Txs[i].Hash()), "error", err)
+			return nil, model.ErrTraceReplay
+		}
+	}
+
+	// 使用新的执行器对象执行，不影响当前执行器的状态
+	executor := NewEVMExecutor()
+	setTraceEnv(executor, api, block, stateDB, localDB)
+	executor.vmCfg.Debug = true
+	executor.vmCfg.Tracer = tracer
+	executor.CheckInit()
+	if err := traceFee(executor, stateDB, block.Txs, index); err != nil {
+		return nil, err
+	}
+
+	resp := &evmtypes.EvmTraceTxResp{TxHash: common.Bytes2Hex(hash), Tracer: name}
+	_, err = executor.Exec(tx, index)
+	if err != nil {
+		// 执行失败的交易同样返回跟踪信息
+		log.Debug("trace evm tx error", "txHash", resp.TxHash, "error", err)
+		resp.Error = err.Error()
+	}
+
+	data, err := json.Marshal(result())
+	if err != nil {
+		return nil, err
+	}
+	resp.JsonData = string(data)
+	return resp, nil
+}
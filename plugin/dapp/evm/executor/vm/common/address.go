@@ -96,6 +96,21 @@ func NewAddress(txHash []byte) Address {
 	return Address{addr: execAddr}
 }
 
+// NewContractAddress2 CREATE2指令生成EVM合约地址
+// 按照以太坊规则计算 keccak256(0xff ++ creator ++ salt ++ keccak256(code)),
+// 再按照NewAddress的方式转换为Chain33格式的执行器地址，所以相同的参数总是得到相同的地址
+func NewContractAddress2(creator Address, salt Hash, code []byte) Address {
+	codeHash := sha3.NewLegacyKeccak256()
+	codeHash.Write(code)
+
+	sha := sha3.NewLegacyKeccak256()
+	sha.Write([]byte{0xff})
+	sha.Write(creator.Bytes())
+	sha.Write(salt.Bytes())
+	sha.Write(codeHash.Sum(nil))
+	return NewAddress(sha.Sum(nil))
+}
+
 // ExecAddress 返回合约地址
 func ExecAddress(execName string) Address {
 	execAddr := address.GetExecAddress(execName)
@@ -147,6 +162,15 @@ func BigToAddress(b *big.Int) Address {
 	return Address{addr: a}
 }
 
+// Uint256ToAddress 大数字转换为地址，取低20个字节，不足时在高位补0
+func Uint256ToAddress(b *big.Int) Address {
+	bs := b.Bytes()
+	if len(bs) > 20 {
+		bs = bs[len(bs)-20:]
+	}
+	return BytesToAddress(bs)
+}
+
 // EmptyAddress 返回空地址
 func EmptyAddress() Address { return BytesToAddress([]byte{0}) }
 
@@ -24,3 +24,13 @@ func TestAddressBytes(t *testing.T) {
 	addr := BytesToAddress([]byte{1})
 	assert.Equal(t, addr.String(), "11111111111111111111BZbvjr")
 }
+
+func TestNewContractAddress2(t *testing.T) {
+	creator := StringToAddress("1FwuqJsLH1c4LRBGYPHvKtspEYmS8ag2kf")
+	salt := BytesToHash([]byte{42})
+	addr := NewContractAddress2(*creator, salt, []byte{0})
+	assert.Equal(t, "1KLzFzQ3QNgjNVy8YLZLABLzXKvxhypd3W", addr.String())
+	assert.Equal(t, addr.String(), NewContractAddress2(*creator, salt, []byte{0}).String())
+	assert.NotEqual(t, addr.String(), NewContractAddress2(*creator, BytesToHash([]byte{43}), []byte{0}).String())
+	assert.NotEqual(t, addr.String(), NewContractAddress2(*creator, salt, []byte{1}).String())
+}
@@ -5,10 +5,12 @@
 package gas
 
 import (
+	"github.com/33cn/chain33/types"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/mm"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 )
 
 // 本文件中定义各种操作中需要花费的Gas逻辑
@@ -25,6 +27,8 @@ type Table struct {
 	ExtcodeSize uint64
 	// ExtcodeCopy 代码复制价格
 	ExtcodeCopy uint64
+	// ExtcodeHash 代码哈希计价
+	ExtcodeHash uint64
 	// Balance 账户计价
 	Balance uint64
 	// SLoad 加载数据计价
@@ -42,6 +46,7 @@ var (
 	TableHomestead = Table{
 		ExtcodeSize: 20,
 		ExtcodeCopy: 20,
+		ExtcodeHash: 400,
 		Balance:     20,
 		SLoad:       50,
 		Calls:       40,
@@ -326,6 +331,29 @@ func Create(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack
 	return gas, nil
 }
 
+// Create2 开辟内存计费，额外按字收取计算合约代码哈希的费用
+func Create2(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	var overflow bool
+	gas, err := memoryGasCost(mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	if gas, overflow = common.SafeAdd(gas, params.CreateGas); overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+	wordGas, overflow := common.BigUint64(stack.Back(2))
+	if overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+	if wordGas, overflow = common.SafeMul(common.ToWordSize(wordGas), params.Sha3WordGas); overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+	if gas, overflow = common.SafeAdd(gas, wordGas); overflow {
+		return 0, model.ErrGasUintOverflow
+	}
+	return gas, nil
+}
+
 // Balance 获取余额计费
 func Balance(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
 	return gt.Balance, nil
@@ -336,6 +364,11 @@ func ExtCodeSize(gt Table, evm *params.EVMParam, contractGas *params.GasParam, s
 	return gt.ExtcodeSize, nil
 }
 
+// ExtCodeHash 获取代码哈希计费
+func ExtCodeHash(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	return gt.ExtcodeHash, nil
+}
+
 // SLoad 加载存储计费
 func SLoad(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
 	return gt.SLoad, nil
@@ -362,6 +395,9 @@ func Call(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *m
 		transfersValue = stack.Back(2).Sign() != 0
 		address        = common.BigToAddress(stack.Back(1))
 	)
+	if types.IsDappFork(evm.BlockNumber.Int64(), "evm", evmtypes.ForkEVMAddress) {
+		address = common.Uint256ToAddress(stack.Back(1))
+	}
 	if !evm.StateDB.Exist(address.String()) {
 		gas += params.CallNewAccountGas
 	}
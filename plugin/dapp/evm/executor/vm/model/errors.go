@@ -41,4 +41,14 @@ var (
 
 	// ErrNoCoinsAccount no coins account in executor!
 	ErrNoCoinsAccount = errors.New("no coins account in executor")
+
+	// ErrAssetCaller asset precompiled contract must be called by contract directly
+	ErrAssetCaller = errors.New("asset precompiled contract must be called by contract directly")
+	// ErrAssetAmount asset amount out of range
+	ErrAssetAmount = errors.New("asset amount out of range")
+	// ErrAssetExec asset of this executor can not be modified by evm contract
+	ErrAssetExec = errors.New("asset of this executor can not be modified by evm contract")
+
+	// ErrTraceReplay replay preceding tx in block failed when tracing tx
+	ErrTraceReplay = errors.New("replay preceding tx in block failed")
 )
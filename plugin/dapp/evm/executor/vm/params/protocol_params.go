@@ -76,4 +76,8 @@ const (
 	Bn256PairingBaseGas uint64 = 100000
 	// Bn256PairingPerPointGas  bn256Pairing 按point计费（总计费等于两者相加）
 	Bn256PairingPerPointGas uint64 = 80000
+	// AssetBalanceGas 资产合约查询余额计费
+	AssetBalanceGas uint64 = 400
+	// AssetTransferGas 资产合约转账计费
+	AssetTransferGas uint64 = 9000
 )
@@ -28,6 +28,14 @@ type PrecompiledContract interface {
 	Run(input []byte) ([]byte, error)
 }
 
+// StatefulPrecompiledContract 需要读写状态数据的预编译合约，执行时调用RunStateful而不是Run
+type StatefulPrecompiledContract interface {
+	PrecompiledContract
+
+	// 执行合约逻辑，可以访问EVM上下文和调用信息
+	RunStateful(evm *EVM, contract *Contract, input []byte) ([]byte, error)
+}
+
 // PrecompiledContractsByzantium chain33平台支持君士坦丁堡版本支持的所有预编译合约指令，并从此版本开始同步支持EVM黄皮书中的新增指令；
 // 保存拜占庭版本支持的所有预编译合约（包括之前版本的合约）；
 // 后面如果有硬分叉，需要在此处考虑分叉逻辑，根据区块高度分别处理；
@@ -46,10 +54,28 @@ var PrecompiledContractsByzantium = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{8}): &bn256Pairing{},
 }
 
+// PrecompiledContractsAsset 从ForkEVMPrecompile开始支持的资产操作预编译合约
+var PrecompiledContractsAsset = map[common.Hash160Address]PrecompiledContract{
+	AssetContractAddress.ToHash160(): &assetContract{},
+}
+
+// 拜占庭版本的合约表以地址对象为key，地址对象中包含指针，实际上无法查找到合约，
+// 从ForkEVMAddress开始使用Hash160地址作为key查找
+var precompiledContractsByzantiumHash160 = make(map[common.Hash160Address]PrecompiledContract)
+
+func init() {
+	for addr, p := range PrecompiledContractsByzantium {
+		precompiledContractsByzantiumHash160[addr.ToHash160()] = p
+	}
+}
+
 // RunPrecompiledContract 调用预编译的合约逻辑并返回结果
-func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contract) (ret []byte, err error) {
+func RunPrecompiledContract(evm *EVM, p PrecompiledContract, input []byte, contract *Contract) (ret []byte, err error) {
 	gas := p.RequiredGas(input)
 	if contract.UseGas(gas) {
+		if sp, ok := p.(StatefulPrecompiledContract); ok {
+			return sp.RunStateful(evm, contract, input)
+		}
 		return p.Run(input)
 	}
 	return nil, model.ErrOutOfGas
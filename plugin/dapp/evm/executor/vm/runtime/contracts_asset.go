@@ -0,0 +1,129 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
+)
+
+// AssetContractAddress 资产操作预编译合约地址 0x0000000000000000000000000000000000000100
+var AssetContractAddress = common.BytesToAddress([]byte{1, 0})
+
+// AssetContractABI 资产操作预编译合约的接口定义，合约按照ABI编码调用
+// exec和symbol指定资产所在的执行器和币种，比如 coins/bty、token/YCC、paracross/coins.bty
+// 修改资产只支持token和paracross，这两个执行器允许evm交易修改其账户数据，coins通过合约调用的value转账
+// balanceOf 查询owner在调用合约中的余额，owner为合约自身地址时即为合约持有的资产
+// transfer 把合约持有的资产转给to，to可以在资产执行器中从本合约取出
+// transferToExec 把合约持有的资产转入toExec执行器，转入后仍然属于本合约
+// deposit 把调用合约的直接调用者(msg.sender)存入本合约的资产转为合约持有，不使用交易发起人，避免被其它合约代为转走
+// transferFrom 在owner授权给本合约的额度内取出owner的资产转为合约持有，目前只支持token
+const AssetContractABI = `[
+{"type":"function","name":"balanceOf","constant":true,"inputs":[{"name":"exec","type":"string"},{"name":"symbol","type":"string"},{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"transfer","inputs":[{"name":"exec","type":"string"},{"name":"symbol","type":"string"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"transferToExec","inputs":[{"name":"exec","type":"string"},{"name":"symbol","type":"string"},{"name":"toExec","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"deposit","inputs":[{"name":"exec","type":"string"},{"name":"symbol","type":"string"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"transferFrom","inputs":[{"name":"exec","type":"string"},{"name":"symbol","type":"string"},{"name":"owner","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var assetABI abi.ABI
+
+func init() {
+	var err error
+	assetABI, err = abi.JSON(strings.NewReader(AssetContractABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// 允许evm合约修改资产的执行器，需要资产执行器的IsFriend允许evm交易写入账户的key
+var assetWritableExecs = map[string]bool{"token": true, "paracross": true}
+
+// 预编译合约 资产操作，通过account.DB读写任意执行器和币种的资产
+type assetContract struct{}
+
+// RequiredGas 查询余额和转账分别计费，无法识别的调用按查询计费
+func (c *assetContract) RequiredGas(input []byte) uint64 {
+	method, err := assetABI.MethodByID(input)
+	if err != nil || method.Const {
+		return params.AssetBalanceGas
+	}
+	return params.AssetTransferGas
+}
+
+// Run 资产操作需要访问状态数据，不能直接执行
+func (c *assetContract) Run(input []byte) ([]byte, error) {
+	return nil, model.ErrAssetCaller
+}
+
+// RunStateful 执行资产操作，调用者必须是合约，资产操作的主体即为调用合约
+func (c *assetContract) RunStateful(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
+	// DELEGATECALL和CALLCODE时合约对象的地址是调用者自身，这种情况不允许操作资产
+	if contract.Address().String() != contract.CodeAddr.String() {
+		return nil, model.ErrAssetCaller
+	}
+	caller := contract.CallerAddress.String()
+	if !evm.StateDB.Exist(caller) {
+		return nil, model.ErrAssetCaller
+	}
+
+	method, err := assetABI.MethodByID(input)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.UnpackValues(input[4:])
+	if err != nil {
+		return nil, err
+	}
+	exec, symbol := args[0].(string), args[1].(string)
+
+	if method.Name == "balanceOf" {
+		// 地址类型参数解析后为字符串格式的地址
+		owner := args[2].(string)
+		balance, err := evm.StateDB.GetAssetBalance(exec, symbol, owner, caller)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(big.NewInt(balance))
+	}
+
+	// 以下操作会修改状态数据
+	if evm.Interpreter.readOnly {
+		return nil, model.ErrWriteProtection
+	}
+	if !assetWritableExecs[exec] {
+		return nil, model.ErrAssetExec
+	}
+	value := args[len(args)-1].(*big.Int)
+	if !value.IsInt64() || value.Sign() <= 0 {
+		return nil, model.ErrAssetAmount
+	}
+	amount := value.Int64()
+
+	switch method.Name {
+	case "transfer":
+		err = evm.StateDB.TransferAsset(exec, symbol, caller, args[2].(string), caller, amount)
+	case "transferToExec":
+		err = evm.StateDB.TransferAssetToExec(exec, symbol, caller, args[2].(string), amount)
+	case "deposit":
+		// contract.caller为调用合约的上下文，其CallerAddress即调用合约的msg.sender
+		parent, ok := contract.caller.(*Contract)
+		if !ok {
+			return nil, model.ErrAssetCaller
+		}
+		err = evm.StateDB.TransferAsset(exec, symbol, parent.CallerAddress.String(), caller, caller, amount)
+	case "transferFrom":
+		err = evm.StateDB.TransferAssetFrom(exec, symbol, args[2].(string), caller, amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
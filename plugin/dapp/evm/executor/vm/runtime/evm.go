@@ -35,9 +35,8 @@ type (
 func run(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
 	if contract.CodeAddr != nil {
 		// 预编译合约以拜占庭分支为初始版本，后继如有分叉，需要在此处理
-		precompiles := PrecompiledContractsByzantium
-		if p := precompiles[*contract.CodeAddr]; p != nil {
-			return RunPrecompiledContract(p, input, contract)
+		if p := evm.precompile(*contract.CodeAddr); p != nil {
+			return RunPrecompiledContract(evm, p, input, contract)
 		}
 	}
 	// 在此处打印下自定义合约的错误信息
@@ -76,6 +75,8 @@ type Context struct {
 	Time *big.Int
 	// Difficulty 指令，当前区块难度
 	Difficulty *big.Int
+	// ChainID CHAINID 指令，当前链的标识
+	ChainID *big.Int
 }
 
 // EVM 结构对象及其提供的操作方法，用于进行满足以太坊EVM黄皮书规范定义的智能合约代码的创建和执行
@@ -176,9 +177,8 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	}
 
 	if !evm.StateDB.Exist(addr.String()) {
-		precompiles := PrecompiledContractsByzantium
 		// 合约地址在自定义合约和预编译合约中都不存在时，可能为外部账户
-		if precompiles[addr] == nil {
+		if evm.precompile(addr) == nil {
 			// 只有一种情况会走到这里来，就是合约账户向外部账户转账的情况
 			if len(input) > 0 || value == 0 {
 				// 其它情况要求地址必须存在，所以需要报错
@@ -221,6 +221,8 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 			evm.VMConfig.Tracer.CaptureEnd(ret, gas-contract.Gas, types.Since(start), err)
 		}()
 	}
+	evm.captureEnter(CALL, caller.Address(), addr, input, gas, value)
+	defer func() { evm.captureExit(ret, gas-contract.Gas, err) }()
 
 	// 从ForkV20EVMState开始，状态数据存储发生变更，需要做数据迁移
 	if types.IsDappFork(evm.BlockNumber.Int64(), "evm", evmtypes.ForkEVMState) {
@@ -263,6 +265,9 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 	// 正常从合约地址加载合约代码
 	contract.SetCallCode(&addr, evm.StateDB.GetCodeHash(addr.String()), evm.StateDB.GetCode(addr.String()))
 
+	evm.captureEnter(CALLCODE, caller.Address(), addr, input, gas, value)
+	defer func() { evm.captureExit(ret, gas-contract.Gas, err) }()
+
 	ret, err = run(evm, contract, input)
 	if err != nil {
 		evm.StateDB.RevertToSnapshot(snapshot)
@@ -297,6 +302,9 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 	contract := NewContract(caller, to, 0, gas).AsDelegate()
 	contract.SetCallCode(&addr, evm.StateDB.GetCodeHash(addr.String()), evm.StateDB.GetCode(addr.String()))
 
+	evm.captureEnter(DELEGATECALL, caller.Address(), addr, input, gas, 0)
+	defer func() { evm.captureExit(ret, gas-contract.Gas, err) }()
+
 	// 其它逻辑同StaticCall
 	ret, err = run(evm, contract, input)
 	if err != nil {
@@ -338,6 +346,9 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 	contract := NewContract(caller, to, 0, gas)
 	contract.SetCallCode(&addr, evm.StateDB.GetCodeHash(addr.String()), evm.StateDB.GetCode(addr.String()))
 
+	evm.captureEnter(STATICCALL, caller.Address(), addr, input, gas, 0)
+	defer func() { evm.captureExit(ret, gas-contract.Gas, err) }()
+
 	// 执行合约指令时如果出错，需要进行回滚，并且扣除剩余的Gas
 	ret, err = run(evm, contract, input)
 	if err != nil {
@@ -358,22 +369,37 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 // 目前chain33为了保证账户安全，不允许合约中涉及到外部账户的转账操作，
 // 所以，本步骤不接收转账金额参数
 func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []byte, gas uint64, execName, alias, abi string) (ret []byte, snapshot int, leftOverGas uint64, err error) {
-	pass, err := evm.preCheck(caller, contractAddr, 0)
+	return evm.create(caller, contractAddr, code, gas, 0, execName, alias, abi, CREATE)
+}
+
+// 创建合约的通用逻辑，value为创建时转入新合约的金额，typ为发起创建的指令，仅用于调试跟踪
+func (evm *EVM) create(caller ContractRef, contractAddr common.Address, code []byte, gas uint64, value uint64, execName, alias, abi string, typ OpCode) (ret []byte, snapshot int, leftOverGas uint64, err error) {
+	pass, err := evm.preCheck(caller, contractAddr, value)
 	if !pass {
 		return nil, -1, gas, err
 	}
 
 	// 创建新的合约对象，包含双方地址以及合约代码，可用Gas信息
-	contract := NewContract(caller, AccountRef(contractAddr), 0, gas)
+	contract := NewContract(caller, AccountRef(contractAddr), value, gas)
 	contract.SetCallCode(&contractAddr, common.ToHash(code), code)
 
 	// 创建一个新的账户对象（合约账户）
 	snapshot = evm.StateDB.Snapshot()
 	evm.StateDB.CreateAccount(contractAddr.String(), contract.CallerAddress.String(), execName, alias)
 
+	// 向新合约地址转账，合约之间不能通过Transfer转账
+	if value > 0 {
+		err = evm.StateDB.TransferToContract(caller.Address().String(), contractAddr.String(), int64(value))
+		if err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, snapshot, gas, err
+		}
+	}
+
 	if evm.VMConfig.Debug && evm.depth == 0 {
-		evm.VMConfig.Tracer.CaptureStart(caller.Address(), contractAddr, true, code, gas, 0)
+		evm.VMConfig.Tracer.CaptureStart(caller.Address(), contractAddr, true, code, gas, value)
 	}
+	evm.captureEnter(typ, caller.Address(), contractAddr, code, gas, value)
 	start := types.Now()
 
 	// 通过预编译指令和解释器执行合约
@@ -416,6 +442,54 @@ func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []b
 	if evm.VMConfig.Debug && evm.depth == 0 {
 		evm.VMConfig.Tracer.CaptureEnd(ret, gas-contract.Gas, types.Since(start), err)
 	}
+	evm.captureExit(ret, gas-contract.Gas, err)
 
 	return ret, snapshot, contract.Gas, err
 }
+
+// Create2 使用调用者地址、salt和合约代码计算出确定的合约地址，然后创建合约
+// 合约地址已经存在时返回地址冲突错误，并扣除全部Gas
+func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, value uint64, salt *big.Int, execName, alias, abi string) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
+	contractAddr = common.NewContractAddress2(caller.Address(), common.BigToHash(salt), code)
+	if evm.StateDB.Exist(contractAddr.String()) {
+		return nil, contractAddr, 0, model.ErrContractAddressCollision
+	}
+	ret, _, leftOverGas, err = evm.create(caller, contractAddr, code, gas, value, execName, alias, abi, CREATE2)
+	return ret, contractAddr, leftOverGas, err
+}
+
+// 调试模式下记录合约内部调用的开始，最外层调用由CaptureStart记录
+func (evm *EVM) captureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureEnter(typ, from, to, input, gas, value)
+	}
+}
+
+// 调试模式下记录合约内部调用的结束
+func (evm *EVM) captureExit(output []byte, gasUsed uint64, err error) {
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureExit(output, gasUsed, err)
+	}
+}
+
+// 查找地址对应的预编译合约，找不到时返回nil
+func (evm *EVM) precompile(addr common.Address) PrecompiledContract {
+	height := evm.BlockNumber.Int64()
+	if types.IsDappFork(height, "evm", evmtypes.ForkEVMPrecompile) {
+		if p := PrecompiledContractsAsset[addr.ToHash160()]; p != nil {
+			return p
+		}
+	}
+	if types.IsDappFork(height, "evm", evmtypes.ForkEVMAddress) {
+		return precompiledContractsByzantiumHash160[addr.ToHash160()]
+	}
+	return PrecompiledContractsByzantium[addr]
+}
+
+// 栈中数据转换为地址，分叉之前高位字节为0的地址会转换错误
+func (evm *EVM) bigToAddress(b *big.Int) common.Address {
+	if types.IsDappFork(evm.BlockNumber.Int64(), "evm", evmtypes.ForkEVMAddress) {
+		return common.Uint256ToAddress(b)
+	}
+	return common.BigToAddress(b)
+}
@@ -416,7 +416,7 @@ func opAddress(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stac
 // 获取指定地址的账户余额
 func opBalance(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	slot := stack.Peek()
-	slot.SetUint64(evm.StateDB.GetBalance(common.BigToAddress(slot).String()))
+	slot.SetUint64(evm.StateDB.GetBalance(evm.bigToAddress(slot).String()))
 	return nil, nil
 }
 
@@ -497,13 +497,28 @@ func opReturnDataCopy(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memor
 func opExtCodeSize(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	a := stack.Pop()
 
-	addr := common.BigToAddress(a)
+	addr := evm.bigToAddress(a)
 	a.SetInt64(int64(evm.StateDB.GetCodeSize(addr.String())))
 	stack.Push(a)
 
 	return nil, nil
 }
 
+// 获取指定地址上合约代码的哈希，地址上没有合约时返回0
+func opExtCodeHash(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	slot := stack.Peek()
+	addr := evm.bigToAddress(slot).String()
+	//不存在的账户返回0, 存在但是没有代码的账户返回空代码的哈希
+	if !evm.StateDB.Exist(addr) && evm.StateDB.Empty(addr) {
+		slot.SetUint64(0)
+	} else if evm.StateDB.GetCodeSize(addr) == 0 {
+		slot.SetBytes(crypto.Keccak256(nil))
+	} else {
+		slot.SetBytes(evm.StateDB.GetCodeHash(addr).Bytes())
+	}
+	return nil, nil
+}
+
 // 获取合约代码大小
 func opCodeSize(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	l := evm.Interpreter.IntPool.Get().SetInt64(int64(len(contract.Code)))
@@ -529,7 +544,7 @@ func opCodeCopy(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, sta
 // 蒋指定合约中指定位置的数据复制到内存中
 func opExtCodeCopy(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	var (
-		addr       = common.BigToAddress(stack.Pop())
+		addr       = evm.bigToAddress(stack.Pop())
 		memOffset  = stack.Pop()
 		codeOffset = stack.Pop()
 		length     = stack.Pop()
@@ -598,6 +613,23 @@ func opGasLimit(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, sta
 	return nil, nil
 }
 
+// 获取当前链的标识
+func opChainID(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	chainID := evm.Interpreter.IntPool.GetZero()
+	if evm.ChainID != nil {
+		chainID.Set(evm.ChainID)
+	}
+	stack.Push(chainID)
+	return nil, nil
+}
+
+// 获取当前合约的余额
+func opSelfBalance(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	balance := evm.Interpreter.IntPool.Get().SetUint64(evm.StateDB.GetBalance(contract.Address().String()))
+	stack.Push(balance)
+	return nil, nil
+}
+
 // 弹出栈顶数据
 func opPop(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	evm.Interpreter.IntPool.Put(stack.Pop())
@@ -742,6 +774,42 @@ func opCreate(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack
 	return nil, nil
 }
 
+// 根据salt创建确定地址的合约
+func opCreate2(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
+	var (
+		endowment    = stack.Pop()
+		offset, size = stack.Pop(), stack.Pop()
+		salt         = stack.Pop()
+		inPut        = memory.Get(offset.Int64(), size.Int64())
+		gas          = contract.Gas
+	)
+
+	contract.UseGas(gas)
+
+	// 合约地址由调用者地址、salt和合约代码决定，endowment转入新合约
+	endowment = common.U256(endowment)
+	res, addr, returnGas, suberr := evm.Create2(contract, inPut, gas, endowment.Uint64(), salt, "innerContract", "", "")
+
+	// 出错时压栈0，否则压栈创建出来的合约对象的地址
+	if suberr != nil && suberr != model.ErrCodeStoreOutOfGas {
+		log15.Error("evm contract opCreate2 instruction error", suberr)
+		stack.Push(evm.Interpreter.IntPool.GetZero())
+	} else {
+		stack.Push(addr.Big())
+	}
+
+	// 剩余的Gas再返还给合约对象
+	contract.Gas += returnGas
+
+	// 其它参数写入整数池
+	evm.Interpreter.IntPool.Put(endowment, offset, size, salt)
+
+	if suberr == model.ErrExecutionReverted {
+		return res, nil
+	}
+	return nil, nil
+}
+
 // 合约调用操作
 func opCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	// 弹出可用的gas，并放入整数池
@@ -751,7 +819,7 @@ func opCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *
 
 	// 从栈中一次弹出其它参数
 	addr, value, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.bigToAddress(addr)
 	value = common.U256(value)
 
 	// 从内存中读取调用合约时需要的输入参数，
@@ -798,7 +866,7 @@ func opCallCode(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, sta
 	evm.Interpreter.IntPool.Put(stack.Pop())
 	gas := evm.CallGasTemp
 	addr, value, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.bigToAddress(addr)
 	value = common.U256(value)
 	args := memory.Get(inOffset.Int64(), inSize.Int64())
 
@@ -830,7 +898,7 @@ func opDelegateCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory,
 	evm.Interpreter.IntPool.Put(stack.Pop())
 	gas := evm.CallGasTemp
 	addr, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.bigToAddress(addr)
 	args := memory.Get(inOffset.Int64(), inSize.Int64())
 
 	ret, returnGas, err := evm.DelegateCall(contract, toAddr, args, gas)
@@ -857,7 +925,7 @@ func opStaticCall(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, s
 	evm.Interpreter.IntPool.Put(stack.Pop())
 	gas := evm.CallGasTemp
 	addr, inOffset, inSize, retOffset, retSize := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
-	toAddr := common.BigToAddress(addr)
+	toAddr := evm.bigToAddress(addr)
 	args := memory.Get(inOffset.Int64(), inSize.Int64())
 
 	ret, returnGas, err := evm.StaticCall(contract, toAddr, args, gas)
@@ -906,7 +974,7 @@ func opStop(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *
 func opSuicide(pc *uint64, evm *EVM, contract *Contract, memory *mm.Memory, stack *mm.Stack) ([]byte, error) {
 	balance := evm.StateDB.GetBalance(contract.Address().String())
 	// 合约自毁后，将剩余金额返还给创建者
-	evm.StateDB.AddBalance(common.BigToAddress(stack.Pop()).String(), (*contract.CodeAddr).String(), balance)
+	evm.StateDB.AddBalance(evm.bigToAddress(stack.Pop()).String(), (*contract.CodeAddr).String(), balance)
 
 	evm.StateDB.Suicide(contract.Address().String())
 	return nil, nil
@@ -45,10 +45,45 @@ type Operation struct {
 
 var (
 	// ConstantinopleInstructionSet 对应EVM不同版本的指令集，从上往下，从旧版本到新版本，
-	// 新版本包含旧版本的指令集（目前直接使用康士坦丁堡指令集）
+	// 新版本包含旧版本的指令集（ForkEVMIstanbul之前使用康士坦丁堡指令集）
 	ConstantinopleInstructionSet = NewConstantinopleInstructionSet()
+	// IstanbulInstructionSet ForkEVMIstanbul之后使用的指令集
+	IstanbulInstructionSet = NewIstanbulInstructionSet()
 )
 
+// NewIstanbulInstructionSet 伊斯坦布尔 版本支持的指令集（包含康士坦丁堡的CREATE2,EXTCODEHASH）
+func NewIstanbulInstructionSet() [256]Operation {
+	instructionSet := NewConstantinopleInstructionSet()
+	instructionSet[CREATE2] = Operation{
+		Execute:       opCreate2,
+		GasCost:       gas.Create2,
+		ValidateStack: mm.MakeStackFunc(4, 1),
+		MemorySize:    mm.MemoryCreate,
+		Valid:         true,
+		Writes:        true,
+		Returns:       true,
+	}
+	instructionSet[EXTCODEHASH] = Operation{
+		Execute:       opExtCodeHash,
+		GasCost:       gas.ExtCodeHash,
+		ValidateStack: mm.MakeStackFunc(1, 1),
+		Valid:         true,
+	}
+	instructionSet[CHAINID] = Operation{
+		Execute:       opChainID,
+		GasCost:       gas.ConstGasFunc(gas.GasQuickStep),
+		ValidateStack: mm.MakeStackFunc(0, 1),
+		Valid:         true,
+	}
+	instructionSet[SELFBALANCE] = Operation{
+		Execute:       opSelfBalance,
+		GasCost:       gas.ConstGasFunc(gas.GasFastStep),
+		ValidateStack: mm.MakeStackFunc(0, 1),
+		Valid:         true,
+	}
+	return instructionSet
+}
+
 // NewConstantinopleInstructionSet 康士坦丁堡 版本支持的指令集
 func NewConstantinopleInstructionSet() [256]Operation {
 	instructionSet := NewByzantiumInstructionSet()
@@ -27,6 +27,10 @@ type Tracer interface {
 	CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error
 	// CaptureEnd 结束记录
 	CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error
+	// CaptureEnter 进入合约内部调用或创建
+	CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64)
+	// CaptureExit 合约内部调用或创建结束
+	CaptureExit(output []byte, gasUsed uint64, err error)
 }
 
 // JSONLogger 使用json格式打印日志
@@ -104,6 +108,14 @@ func (logger *JSONLogger) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost
 	return nil
 }
 
+// CaptureEnter 目前实现为空
+func (logger *JSONLogger) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+}
+
+// CaptureExit 目前实现为空
+func (logger *JSONLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
 // CaptureEnd 结束记录
 func (logger *JSONLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
 	type endLog struct {
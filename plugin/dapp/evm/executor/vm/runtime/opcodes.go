@@ -81,14 +81,17 @@ func initMap() {
 		EXTCODECOPY:    "EXTCODECOPY",
 		RETURNDATASIZE: "RETURNDATASIZE",
 		RETURNDATACOPY: "RETURNDATACOPY",
+		EXTCODEHASH:    "EXTCODEHASH",
 
 		// 0x40 range - block operations
-		BLOCKHASH:  "BLOCKHASH",
-		COINBASE:   "COINBASE",
-		TIMESTAMP:  "TIMESTAMP",
-		NUMBER:     "NUMBER",
-		DIFFICULTY: "DIFFICULTY",
-		GASLIMIT:   "GASLIMIT",
+		BLOCKHASH:   "BLOCKHASH",
+		COINBASE:    "COINBASE",
+		TIMESTAMP:   "TIMESTAMP",
+		NUMBER:      "NUMBER",
+		DIFFICULTY:  "DIFFICULTY",
+		GASLIMIT:    "GASLIMIT",
+		CHAINID:     "CHAINID",
+		SELFBALANCE: "SELFBALANCE",
 
 		// 0x50 range - 'storage' and execution
 		POP: "POP",
@@ -185,6 +188,7 @@ func initMap() {
 		RETURN:       "RETURN",
 		CALLCODE:     "CALLCODE",
 		DELEGATECALL: "DELEGATECALL",
+		CREATE2:      "CREATE2",
 		STATICCALL:   "STATICCALL",
 		REVERT:       "REVERT",
 		SELFDESTRUCT: "SELFDESTRUCT",
@@ -297,6 +301,8 @@ const (
 	RETURNDATASIZE
 	// RETURNDATACOPY op
 	RETURNDATACOPY
+	// EXTCODEHASH op
+	EXTCODEHASH
 )
 
 const (
@@ -312,6 +318,10 @@ const (
 	DIFFICULTY
 	// GASLIMIT op
 	GASLIMIT
+	// CHAINID op
+	CHAINID
+	// SELFBALANCE op
+	SELFBALANCE
 )
 
 const (
@@ -496,6 +506,8 @@ const (
 	RETURN
 	// DELEGATECALL op
 	DELEGATECALL
+	// CREATE2 op
+	CREATE2
 	// STATICCALL  op
 	STATICCALL = 0xfa
 
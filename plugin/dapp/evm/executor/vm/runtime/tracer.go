@@ -0,0 +1,200 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/mm"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+)
+
+// StructLogResult 按指令记录的跟踪结果
+type StructLogResult struct {
+	// Gas 消耗的Gas
+	Gas uint64 `json:"gas"`
+	// Failed 是否执行失败
+	Failed bool `json:"failed"`
+	// ReturnValue 返回数据
+	ReturnValue string `json:"returnValue"`
+	// Error 错误信息
+	Error string `json:"error,omitempty"`
+	// StructLogs 每条指令执行前的状态
+	StructLogs []StructLog `json:"structLogs"`
+}
+
+// StructLogger 在内存中记录每条指令的执行状态
+type StructLogger struct {
+	result StructLogResult
+}
+
+// NewStructLogger 创建指令跟踪记录器
+func NewStructLogger() *StructLogger {
+	return &StructLogger{result: StructLogResult{StructLogs: []StructLog{}}}
+}
+
+// CaptureStart 开始记录
+func (logger *StructLogger) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	return nil
+}
+
+// CaptureState 记录当前指令的执行状态，内存和栈数据在这里复制
+func (logger *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	log := StructLog{
+		Pc:         pc,
+		Op:         op.String(),
+		Gas:        gas,
+		GasCost:    cost,
+		MemorySize: memory.Len(),
+		Depth:      depth,
+		Err:        err,
+	}
+	log.Memory = formatMemory(memory.Data())
+	log.Stack = formatStack(stack.Data())
+	logger.result.StructLogs = append(logger.result.StructLogs, log)
+	return nil
+}
+
+// CaptureFault 目前实现为空
+func (logger *StructLogger) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnter 目前实现为空
+func (logger *StructLogger) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+}
+
+// CaptureExit 目前实现为空
+func (logger *StructLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
+// CaptureEnd 记录执行结果
+func (logger *StructLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	logger.result.Gas = gasUsed
+	logger.result.ReturnValue = common.Bytes2Hex(output)
+	if err != nil {
+		logger.result.Failed = true
+		logger.result.Error = err.Error()
+	}
+	return nil
+}
+
+// Result 返回跟踪结果
+func (logger *StructLogger) Result() *StructLogResult {
+	return &logger.result
+}
+
+// CallFrame 一次合约调用或创建的跟踪信息
+type CallFrame struct {
+	// Type 调用类型，CALL、CREATE等
+	Type string `json:"type"`
+	// From 调用者地址
+	From string `json:"from"`
+	// To 被调用的合约地址
+	To string `json:"to"`
+	// Value 转账金额
+	Value uint64 `json:"value"`
+	// Gas 可用的Gas
+	Gas uint64 `json:"gas"`
+	// GasUsed 本次调用消耗的Gas，包含内部调用
+	GasUsed uint64 `json:"gasUsed"`
+	// Input 调用参数或合约部署代码
+	Input string `json:"input"`
+	// Output 返回数据
+	Output string `json:"output,omitempty"`
+	// Error 错误信息
+	Error string `json:"error,omitempty"`
+	// Reverted 是否因REVERT指令回滚
+	Reverted bool `json:"reverted,omitempty"`
+	// Calls 内部调用
+	Calls []*CallFrame `json:"calls,omitempty"`
+}
+
+func (frame *CallFrame) finish(output []byte, gasUsed uint64, err error) {
+	frame.Output = common.Bytes2Hex(output)
+	frame.GasUsed = gasUsed
+	if err != nil {
+		frame.Error = err.Error()
+		frame.Reverted = err == model.ErrExecutionReverted
+	}
+}
+
+// CallTracer 按调用层级记录合约调用和创建
+type CallTracer struct {
+	root  *CallFrame
+	stack []*CallFrame
+}
+
+// NewCallTracer 创建调用跟踪记录器
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// CaptureStart 记录最外层调用
+func (tracer *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	typ := CALL
+	if create {
+		typ = CREATE
+	}
+	tracer.root = newCallFrame(typ, from, to, input, gas, value)
+	tracer.stack = []*CallFrame{tracer.root}
+	return nil
+}
+
+// CaptureState 目前实现为空
+func (tracer *CallTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureFault 目前实现为空
+func (tracer *CallTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnter 记录内部调用，挂到当前调用下面
+func (tracer *CallTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+	if len(tracer.stack) == 0 {
+		return
+	}
+	frame := newCallFrame(typ, from, to, input, gas, value)
+	parent := tracer.stack[len(tracer.stack)-1]
+	parent.Calls = append(parent.Calls, frame)
+	tracer.stack = append(tracer.stack, frame)
+}
+
+// CaptureExit 内部调用结束
+func (tracer *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	// 栈底是最外层调用，由CaptureEnd处理
+	if len(tracer.stack) <= 1 {
+		return
+	}
+	tracer.stack[len(tracer.stack)-1].finish(output, gasUsed, err)
+	tracer.stack = tracer.stack[:len(tracer.stack)-1]
+}
+
+// CaptureEnd 最外层调用结束
+func (tracer *CallTracer) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	if tracer.root != nil {
+		tracer.root.finish(output, gasUsed, err)
+	}
+	return nil
+}
+
+// Result 返回调用树，没有执行任何调用时返回nil
+func (tracer *CallTracer) Result() *CallFrame {
+	return tracer.root
+}
+
+func newCallFrame(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) *CallFrame {
+	return &CallFrame{
+		Type:  typ.String(),
+		From:  from.String(),
+		To:    to.String(),
+		Value: value,
+		Gas:   gas,
+		Input: common.Bytes2Hex(input),
+	}
+}
@@ -291,7 +291,12 @@ func (ca *ContractAccount) GetStateKey() []byte {
 
 // 这份数据是存在LocalDB中的
 func getStateItemKey(addr, key string) string {
-	return fmt.Sprintf("LODB-"+evmtypes.ExecutorName+"-state:%v:%v", addr, key)
+	return GetStateItemPrefix(addr) + key
+}
+
+// GetStateItemPrefix 合约分散存储的状态数据在LocalDB中的key前缀
+func GetStateItemPrefix(addr string) string {
+	return fmt.Sprintf("LODB-"+evmtypes.ExecutorName+"-state:%v:", addr)
 }
 
 // Suicide 自杀
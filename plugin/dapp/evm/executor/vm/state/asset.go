@@ -0,0 +1,213 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+// 支持授权转账的资产执行器，通过加载执行器驱动调用，不依赖具体的执行器
+type assetTransferFrom interface {
+	drivers.Driver
+	TransferFrom(symbol, owner, spender, to string, amount int64) (*types.Receipt, error)
+}
+
+// 资产操作时使用的状态数据库封装
+// 记录每个key在当前快照版本中第一次写入之前的数据，用于回滚
+type assetKV struct {
+	db.KV
+	prev []*types.KeyValue
+	seen map[string]bool
+}
+
+func (mdb *MemoryStateDB) newAssetKV() *assetKV {
+	kv := &assetKV{KV: mdb.StateDB, seen: make(map[string]bool)}
+	// 快照回滚时按变更顺序依次执行，所以同一版本中只有第一次变更需要记录原始数据
+	if mdb.currentVer != nil {
+		for _, entry := range mdb.currentVer.entries {
+			if change, ok := entry.(assetChange); ok {
+				for _, item := range change.prev {
+					kv.seen[string(item.Key)] = true
+				}
+			}
+		}
+	}
+	return kv
+}
+
+func (kv *assetKV) Set(key []byte, value []byte) error {
+	if !kv.seen[string(key)] {
+		kv.seen[string(key)] = true
+		prev, err := kv.KV.Get(key)
+		if err != nil {
+			prev = nil
+		}
+		kv.prev = append(kv.prev, &types.KeyValue{Key: append([]byte{}, key...), Value: prev})
+	}
+	return kv.KV.Set(key, value)
+}
+
+// 操作失败时恢复已经写入的数据
+func (kv *assetKV) restore() {
+	for _, item := range kv.prev {
+		kv.KV.Set(item.Key, item.Value)
+	}
+}
+
+// 操作成功后记录变更，快照回滚时恢复原始数据
+func (mdb *MemoryStateDB) addAssetChange(kv *assetKV, receipt *types.Receipt) {
+	mdb.addChange(assetChange{
+		baseChange: baseChange{},
+		prev:       kv.prev,
+		data:       receipt.KV,
+		logs:       receipt.Logs,
+	})
+}
+
+// GetAssetBalance 获取指定执行器和币种下，地址在execAddr执行账户中的余额
+func (mdb *MemoryStateDB) GetAssetBalance(exec, symbol, addr, execAddr string) (int64, error) {
+	accDB, err := account.NewAccountDB(exec, symbol, mdb.StateDB)
+	if err != nil {
+		return 0, err
+	}
+	return accDB.LoadExecAccount(addr, execAddr).Balance, nil
+}
+
+// TransferAsset 在execAddr执行账户下，把指定执行器和币种的资产从from转给to
+func (mdb *MemoryStateDB) TransferAsset(exec, symbol, from, to, execAddr string, amount int64) error {
+	kv := mdb.newAssetKV()
+	accDB, err := account.NewAccountDB(exec, symbol, kv)
+	if err != nil {
+		return err
+	}
+	receipt, err := accDB.ExecTransfer(from, to, execAddr, amount)
+	if err != nil {
+		kv.restore()
+		return err
+	}
+	mdb.addAssetChange(kv, receipt)
+	return nil
+}
+
+// 合约资产保存在合约地址自身的执行账户下，直接修改余额
+func changeContractBalance(accDB *account.DB, addr string, amount int64) (*types.Receipt, error) {
+	acc := accDB.LoadExecAccount(addr, addr)
+	if acc.Balance+amount < 0 {
+		return nil, types.ErrNoBalance
+	}
+	prev := *acc
+	acc.Balance += amount
+	accDB.SaveExecAccount(addr, acc)
+	ty := int32(types.TyLogExecDeposit)
+	if amount < 0 {
+		ty = types.TyLogExecWithdraw
+	}
+	log := &types.ReceiptExecAccountTransfer{ExecAddr: addr, Prev: &prev, Current: acc}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   accDB.GetExecKVSet(addr, acc),
+		Logs: []*types.ReceiptLog{{Ty: ty, Log: types.Encode(log)}},
+	}, nil
+}
+
+// TransferAssetToExec 把合约自身持有的资产转入toExec执行器，转入后仍然属于本合约
+func (mdb *MemoryStateDB) TransferAssetToExec(exec, symbol, addr, toExec string, amount int64) error {
+	if !types.CheckAmount(amount) {
+		return types.ErrAmount
+	}
+	kv := mdb.newAssetKV()
+	accDB, err := account.NewAccountDB(exec, symbol, kv)
+	if err != nil {
+		return err
+	}
+
+	// 先从合约地址自身的执行账户中取出
+	receipt, err := changeContractBalance(accDB, addr, -amount)
+	if err != nil {
+		return err
+	}
+
+	// 再转入目标执行器中合约地址对应的账户
+	deposit, err := accDB.TransferToExec(addr, address.ExecAddress(types.ExecName(toExec)), amount)
+	if err != nil {
+		kv.restore()
+		return err
+	}
+	receipt.KV = append(receipt.KV, deposit.KV...)
+	receipt.Logs = append(receipt.Logs, deposit.Logs...)
+	mdb.addAssetChange(kv, receipt)
+	return nil
+}
+
+// TransferToContract 创建合约时把调用合约持有的coins转入新合约
+// 合约之间不能通过Transfer转账，从调用合约的执行账户取出后存入新合约的执行账户
+func (mdb *MemoryStateDB) TransferToContract(sender, recipient string, amount int64) error {
+	if !types.CheckAmount(amount) {
+		return types.ErrAmount
+	}
+	kv := mdb.newAssetKV()
+	accDB := account.NewCoinsAccount()
+	accDB.SetDB(kv)
+
+	receipt, err := changeContractBalance(accDB, sender, -amount)
+	if err != nil {
+		return err
+	}
+	transfer, err := accDB.Transfer(sender, recipient, amount)
+	if err != nil {
+		kv.restore()
+		return err
+	}
+	deposit, err := changeContractBalance(accDB, recipient, amount)
+	if err != nil {
+		kv.restore()
+		return err
+	}
+	for _, r := range []*types.Receipt{transfer, deposit} {
+		receipt.KV = append(receipt.KV, r.KV...)
+		receipt.Logs = append(receipt.Logs, r.Logs...)
+	}
+	mdb.addAssetChange(kv, receipt)
+	return nil
+}
+
+// TransferAssetFrom spender合约在owner授权的额度内取出owner的资产，转为合约自身持有，资产执行器需要支持授权转账
+func (mdb *MemoryStateDB) TransferAssetFrom(exec, symbol, owner, spender string, amount int64) error {
+	driver, err := drivers.LoadDriver(exec, mdb.GetBlockHeight())
+	if err != nil {
+		return err
+	}
+	asset, ok := driver.(assetTransferFrom)
+	if !ok {
+		return types.ErrNotSupport
+	}
+	kv := mdb.newAssetKV()
+	asset.SetStateDB(kv)
+	asset.SetEnv(mdb.GetBlockHeight(), 0, 0)
+	// 先从owner转到合约地址，再记入合约自身的执行账户
+	receipt, err := asset.TransferFrom(symbol, owner, spender, spender, amount)
+	if err != nil {
+		kv.restore()
+		return err
+	}
+	accDB, err := account.NewAccountDB(exec, symbol, kv)
+	if err != nil {
+		kv.restore()
+		return err
+	}
+	acc := accDB.LoadExecAccount(spender, spender)
+	prev := *acc
+	acc.Balance += amount
+	accDB.SaveExecAccount(spender, acc)
+	deposit := &types.ReceiptExecAccountTransfer{ExecAddr: spender, Prev: &prev, Current: acc}
+	receipt.KV = append(receipt.KV, accDB.GetExecKVSet(spender, acc)...)
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: types.TyLogExecDeposit, Log: types.Encode(deposit)})
+	mdb.addAssetChange(kv, receipt)
+	return nil
+}
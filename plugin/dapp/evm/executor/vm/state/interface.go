@@ -79,6 +79,17 @@ type EVMStateDB interface {
 	// Transfer 转账交易
 	Transfer(sender, recipient string, amount uint64) bool
 
+	// GetAssetBalance 获取指定执行器和币种下，地址在执行账户中的余额
+	GetAssetBalance(exec, symbol, addr, execAddr string) (int64, error)
+	// TransferAsset 在执行账户下转移指定执行器和币种的资产
+	TransferAsset(exec, symbol, from, to, execAddr string, amount int64) error
+	// TransferAssetToExec 把合约持有的资产转入其它执行器
+	TransferAssetToExec(exec, symbol, addr, toExec string, amount int64) error
+	// TransferToContract 创建合约时把调用合约持有的coins转入新合约
+	TransferToContract(sender, recipient string, amount int64) error
+	// TransferAssetFrom 合约在owner授权的额度内把owner的资产转为合约持有
+	TransferAssetFrom(exec, symbol, owner, spender string, amount int64) error
+
 	// GetBlockHeight 返回当前区块高度
 	GetBlockHeight() int64
 }
@@ -145,6 +145,15 @@ type (
 		logs   []*types.ReceiptLog
 	}
 
+	// 资产预编译合约操作事件
+	// prev中记录的是本版本中第一次写入之前的数据，回滚时直接恢复
+	assetChange struct {
+		baseChange
+		prev []*types.KeyValue
+		data []*types.KeyValue
+		logs []*types.ReceiptLog
+	}
+
 	// 合约生成日志事件
 	addLogChange struct {
 		baseChange
@@ -304,6 +313,19 @@ func (ch addPreimageChange) revert(mdb *MemoryStateDB) {
 	delete(mdb.preimages, ch.hash)
 }
 
+func (ch assetChange) revert(mdb *MemoryStateDB) {
+	for _, item := range ch.prev {
+		mdb.StateDB.Set(item.Key, item.Value)
+	}
+}
+
+func (ch assetChange) getData(mdb *MemoryStateDB) []*types.KeyValue {
+	return ch.data
+}
+func (ch assetChange) getLog(mdb *MemoryStateDB) []*types.ReceiptLog {
+	return ch.logs
+}
+
 func (ch transferChange) getData(mdb *MemoryStateDB) []*types.KeyValue {
 	return ch.data
 }
@@ -713,6 +713,11 @@ func (mdb *MemoryStateDB) PrintLogs() {
 	}
 }
 
+// GetContractLogs 获取当前交易中合约生成的日志
+func (mdb *MemoryStateDB) GetContractLogs() []*model.ContractLog {
+	return mdb.logs[mdb.txHash]
+}
+
 // WritePreimages 打印本区块内生成的preimages日志
 func (mdb *MemoryStateDB) WritePreimages(number int64) {
 	for k, v := range mdb.preimages {
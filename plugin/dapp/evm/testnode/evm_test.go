@@ -0,0 +1,180 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testnode
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/crypto"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	_ "github.com/33cn/plugin/plugin"
+)
+
+// 合约把调用参数原样转发给资产合约，调用失败时回滚
+var assetProxyCode = []byte{
+	0x36, 0x60, 0x00, 0x60, 0x00, 0x37,
+	0x60, 0x20, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, 0x61, 0x01, 0x00, 0x5a, 0xf1,
+	0x60, 0x1c, 0x57, 0x60, 0x00, 0x60, 0x00, 0xfd,
+	0x5b, 0x60, 0x20, 0x60, 0x00, 0xf3,
+}
+
+// 部署代码把运行代码复制到内存后返回
+func wrapDeployCode(runtimeCode []byte) []byte {
+	size := byte(len(runtimeCode))
+	return append([]byte{0x60, size, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, size, 0x60, 0x00, 0xf3}, runtimeCode...)
+}
+
+// 构造交易，合约调用需要按照gas设置手续费
+func createTx(t *testing.T, execer string, action types.Message, fee int64) *types.Transaction {
+	tx, err := types.CreateFormatTx(types.ExecName(execer), types.Encode(action))
+	require.Nil(t, err)
+	if fee > 0 {
+		tx.Fee = fee
+	}
+	return tx
+}
+
+// 发送交易并等待打包，返回交易的执行结果
+func sendTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, tx *types.Transaction) *rpctypes.TransactionDetail {
+	tx.Sign(types.SECP256K1, priv)
+	reply, err := mock33.GetAPI().SendTx(tx)
+	require.Nil(t, err)
+	detail, err := mock33.WaitTx(reply.GetMsg())
+	require.Nil(t, err)
+	return detail
+}
+
+// 部署合约，返回合约的执行器名和地址
+func deployContract(t *testing.T, mock33 *testnode.Chain33Mock, code []byte) *evmtypes.ReceiptEVMContract {
+	action := &evmtypes.EVMContractAction{Code: wrapDeployCode(code)}
+	detail := sendTx(t, mock33, mock33.GetHotKey(), createTx(t, evmtypes.ExecutorName, action, types.Coin))
+	require.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	var contract evmtypes.ReceiptEVMContract
+	for _, item := range detail.Receipt.Logs {
+		if item.Ty == evmtypes.TyLogCallContract {
+			require.Nil(t, types.Decode(common.FromHex(item.RawLog), &contract))
+		}
+	}
+	return &contract
+}
+
+// 合约通过资产预编译合约操作token，交易按区块执行时需要通过执行器的写权限检查
+func TestEVMAssetPrecompile(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	require.Nil(t, mock33.SendHot())
+	hot := mock33.GetHotAddress()
+
+	for _, key := range []string{"token-blacklist", "token-finisher"} {
+		value := hot
+		if key == "token-blacklist" {
+			value = "BTY"
+		}
+		tx := util.CreateManageTx(mock33.GetHotKey(), key, "add", value)
+		reply, err := mock33.GetAPI().SendTx(tx)
+		require.Nil(t, err)
+		_, err = mock33.WaitTx(reply.GetMsg())
+		require.Nil(t, err)
+	}
+
+	//发行token
+	precreate := &tokenty.TokenAction{
+		Ty: tokenty.TokenActionPreCreate,
+		Value: &tokenty.TokenAction_TokenPreCreate{TokenPreCreate: &tokenty.TokenPreCreate{
+			Name: "Test", Symbol: "TEST", Total: 10000 * types.Coin, Owner: hot,
+		}},
+	}
+	detail := sendTx(t, mock33, mock33.GetHotKey(), createTx(t, tokenty.TokenX, precreate, 0))
+	require.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	finish := &tokenty.TokenAction{
+		Ty: tokenty.TokenActionFinishCreate,
+		Value: &tokenty.TokenAction_TokenFinishCreate{TokenFinishCreate: &tokenty.TokenFinishCreate{
+			Symbol: "TEST", Owner: hot,
+		}},
+	}
+	detail = sendTx(t, mock33, mock33.GetHotKey(), createTx(t, tokenty.TokenX, finish, 0))
+	require.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	proxy := deployContract(t, mock33, assetProxyCode)
+	// 转发合约把调用参数转发给代理合约，代理合约中的资产操作由嵌套调用发起
+	forwardCode := append([]byte{0x36, 0x60, 0x00, 0x60, 0x00, 0x37, 0x60, 0x20, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, 0x73}, common.StringToAddress(proxy.ContractAddr).Bytes()...)
+	forwardCode = append(forwardCode, 0x5a, 0xf1, 0x60, 0x2e, 0x57, 0x60, 0x00, 0x60, 0x00, 0xfd, 0x5b, 0x60, 0x20, 0x60, 0x00, 0xf3)
+	forwarder := deployContract(t, mock33, forwardCode)
+
+	//token转入代理合约
+	transfer := &tokenty.TokenAction{
+		Ty: tokenty.TokenActionTransferToExec,
+		Value: &tokenty.TokenAction_TransferToExec{TransferToExec: &types.AssetsTransferToExec{
+			Cointoken: "TEST", Amount: 1000, To: proxy.ContractAddr, ExecName: proxy.ContractName,
+		}},
+	}
+	tx := createTx(t, tokenty.TokenX, transfer, 0)
+	tx.To = proxy.ContractAddr
+	detail = sendTx(t, mock33, mock33.GetHotKey(), tx)
+	require.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	assetABI, err := abi.JSON(strings.NewReader(runtime.AssetContractABI))
+	require.Nil(t, err)
+	call := func(to *evmtypes.ReceiptEVMContract, method string, args ...interface{}) int32 {
+		input, err := assetABI.Pack(method, args...)
+		require.Nil(t, err)
+		action := &evmtypes.EVMContractAction{Code: input}
+		return sendTx(t, mock33, mock33.GetHotKey(), createTx(t, to.ContractName, action, types.Coin)).Receipt.Ty
+	}
+	accDB, err := account.NewAccountDB(tokenty.TokenX, "TEST", nil)
+	require.Nil(t, err)
+	balance := func(addr, execer string) int64 {
+		accs, err := accDB.GetBalance(mock33.GetAPI(), &types.ReqBalance{Addresses: []string{addr}, Execer: execer})
+		require.Nil(t, err)
+		return accs[0].Balance
+	}
+
+	//存入的token转为合约持有
+	assert.Equal(t, int32(types.ExecOk), call(proxy, "deposit", "token", "TEST", big.NewInt(600)))
+	assert.Equal(t, int64(400), balance(hot, proxy.ContractName))
+	assert.Equal(t, int64(600), balance(proxy.ContractAddr, proxy.ContractName))
+
+	//合约持有的token转入trade执行器，需要修改token的普通账户
+	assert.Equal(t, int32(types.ExecOk), call(proxy, "transferToExec", "token", "TEST", "trade", big.NewInt(200)))
+	assert.Equal(t, int64(200), balance(proxy.ContractAddr, "trade"))
+	assert.Equal(t, int64(400), balance(proxy.ContractAddr, proxy.ContractName))
+
+	//嵌套调用时交易的执行器是转发合约，代理合约的资产账户需要由token执行器允许写入
+	assert.Equal(t, int32(types.ExecOk), call(forwarder, "transfer", "token", "TEST", common.StringToAddress(hot).ToHash160(), big.NewInt(100)))
+	assert.Equal(t, int64(500), balance(hot, proxy.ContractName))
+	assert.Equal(t, int64(300), balance(proxy.ContractAddr, proxy.ContractName))
+
+	//授权代理合约后，合约在额度内取出token，需要修改授权额度
+	approve := &tokenty.TokenAction{
+		Ty: tokenty.TokenActionApprove,
+		Value: &tokenty.TokenAction_TokenApprove{TokenApprove: &tokenty.TokenApprove{
+			Symbol: "TEST", Spender: proxy.ContractAddr, Amount: 300,
+		}},
+	}
+	detail = sendTx(t, mock33, mock33.GetHotKey(), createTx(t, tokenty.TokenX, approve, 0))
+	require.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	assert.Equal(t, int32(types.ExecOk), call(proxy, "transferFrom", "token", "TEST", common.StringToAddress(hot).ToHash160(), big.NewInt(300)))
+	assert.Equal(t, int64(600), balance(proxy.ContractAddr, proxy.ContractName))
+	assert.Equal(t, int32(types.ExecPack), call(proxy, "transferFrom", "token", "TEST", common.StringToAddress(hot).ToHash160(), big.NewInt(1)))
+
+	//coins不允许通过资产合约修改
+	assert.Equal(t, int32(types.ExecPack), call(proxy, "transfer", "coins", "bty", common.StringToAddress(hot).ToHash160(), big.NewInt(1)))
+}
@@ -40,6 +40,16 @@ func init() {
 	types.RegisterDappFork(ExecutorName, ForkEVMABI, 1250000)
 	// EEVM合约用户金额冻结
 	types.RegisterDappFork(ExecutorName, ForkEVMFrozen, 1300000)
+	// EVM合约支持CREATE2等新增指令
+	types.RegisterDappFork(ExecutorName, ForkEVMIstanbul, 3800000)
+	// EVM合约事件日志写入交易回执
+	types.RegisterDappFork(ExecutorName, ForkEVMEventLog, 3800000)
+	// EVM栈中数据转换地址修正
+	types.RegisterDappFork(ExecutorName, ForkEVMAddress, 3800000)
+	// EVM支持资产操作的预编译合约
+	types.RegisterDappFork(ExecutorName, ForkEVMPrecompile, 3800000)
+	// EVM支持批量调用合约
+	types.RegisterDappFork(ExecutorName, ForkEVMMultiCall, 3800000)
 }
 
 // EvmType EVM类型定义
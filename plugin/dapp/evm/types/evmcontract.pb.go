@@ -5,9 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-
+	types "github.com/33cn/chain33/types"
 	proto "github.com/golang/protobuf/proto"
-
 	math "math"
 )
 
@@ -20,7 +19,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // 合约对象信息
 type EVMContractObject struct {
@@ -36,16 +35,17 @@ func (m *EVMContractObject) Reset()         { *m = EVMContractObject{} }
 func (m *EVMContractObject) String() string { return proto.CompactTextString(m) }
 func (*EVMContractObject) ProtoMessage()    {}
 func (*EVMContractObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{0}
+	return fileDescriptor_74353de561acd7c6, []int{0}
 }
+
 func (m *EVMContractObject) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EVMContractObject.Unmarshal(m, b)
 }
 func (m *EVMContractObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EVMContractObject.Marshal(b, m, deterministic)
 }
-func (dst *EVMContractObject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMContractObject.Merge(dst, src)
+func (m *EVMContractObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractObject.Merge(m, src)
 }
 func (m *EVMContractObject) XXX_Size() int {
 	return xxx_messageInfo_EVMContractObject.Size(m)
@@ -96,16 +96,17 @@ func (m *EVMContractData) Reset()         { *m = EVMContractData{} }
 func (m *EVMContractData) String() string { return proto.CompactTextString(m) }
 func (*EVMContractData) ProtoMessage()    {}
 func (*EVMContractData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{1}
+	return fileDescriptor_74353de561acd7c6, []int{1}
 }
+
 func (m *EVMContractData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EVMContractData.Unmarshal(m, b)
 }
 func (m *EVMContractData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EVMContractData.Marshal(b, m, deterministic)
 }
-func (dst *EVMContractData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMContractData.Merge(dst, src)
+func (m *EVMContractData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractData.Merge(m, src)
 }
 func (m *EVMContractData) XXX_Size() int {
 	return xxx_messageInfo_EVMContractData.Size(m)
@@ -180,16 +181,17 @@ func (m *EVMContractState) Reset()         { *m = EVMContractState{} }
 func (m *EVMContractState) String() string { return proto.CompactTextString(m) }
 func (*EVMContractState) ProtoMessage()    {}
 func (*EVMContractState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{2}
+	return fileDescriptor_74353de561acd7c6, []int{2}
 }
+
 func (m *EVMContractState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EVMContractState.Unmarshal(m, b)
 }
 func (m *EVMContractState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EVMContractState.Marshal(b, m, deterministic)
 }
-func (dst *EVMContractState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMContractState.Merge(dst, src)
+func (m *EVMContractState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractState.Merge(m, src)
 }
 func (m *EVMContractState) XXX_Size() int {
 	return xxx_messageInfo_EVMContractState.Size(m)
@@ -243,26 +245,29 @@ type EVMContractAction struct {
 	// 交易备注
 	Note string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
 	// 创建或调用合约时携带的ABI数据 ForkEVMABI
-	Abi                  string   `protobuf:"bytes,7,opt,name=abi,proto3" json:"abi,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Abi string `protobuf:"bytes,7,opt,name=abi,proto3" json:"abi,omitempty"`
+	// 批量调用合约，按顺序执行，任意一个调用失败时全部回滚 ForkEVMMultiCall
+	Calls                []*EVMContractCall `protobuf:"bytes,8,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *EVMContractAction) Reset()         { *m = EVMContractAction{} }
 func (m *EVMContractAction) String() string { return proto.CompactTextString(m) }
 func (*EVMContractAction) ProtoMessage()    {}
 func (*EVMContractAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{3}
+	return fileDescriptor_74353de561acd7c6, []int{3}
 }
+
 func (m *EVMContractAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EVMContractAction.Unmarshal(m, b)
 }
 func (m *EVMContractAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EVMContractAction.Marshal(b, m, deterministic)
 }
-func (dst *EVMContractAction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMContractAction.Merge(dst, src)
+func (m *EVMContractAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractAction.Merge(m, src)
 }
 func (m *EVMContractAction) XXX_Size() int {
 	return xxx_messageInfo_EVMContractAction.Size(m)
@@ -322,6 +327,81 @@ func (m *EVMContractAction) GetAbi() string {
 	return ""
 }
 
+func (m *EVMContractAction) GetCalls() []*EVMContractCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// 批量调用中的单个合约调用
+type EVMContractCall struct {
+	// 合约地址或者合约名称
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// 转账金额
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 十六进制编码的调用数据，和abi二选一
+	Code []byte `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// ABI格式的调用，比如 transfer(addr, 100)
+	Abi                  string   `protobuf:"bytes,4,opt,name=abi,proto3" json:"abi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMContractCall) Reset()         { *m = EVMContractCall{} }
+func (m *EVMContractCall) String() string { return proto.CompactTextString(m) }
+func (*EVMContractCall) ProtoMessage()    {}
+func (*EVMContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{4}
+}
+
+func (m *EVMContractCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMContractCall.Unmarshal(m, b)
+}
+func (m *EVMContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMContractCall.Marshal(b, m, deterministic)
+}
+func (m *EVMContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractCall.Merge(m, src)
+}
+func (m *EVMContractCall) XXX_Size() int {
+	return xxx_messageInfo_EVMContractCall.Size(m)
+}
+func (m *EVMContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMContractCall proto.InternalMessageInfo
+
+func (m *EVMContractCall) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EVMContractCall) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *EVMContractCall) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *EVMContractCall) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
 // 合约创建/调用日志
 type ReceiptEVMContract struct {
 	Caller       string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
@@ -341,16 +421,17 @@ func (m *ReceiptEVMContract) Reset()         { *m = ReceiptEVMContract{} }
 func (m *ReceiptEVMContract) String() string { return proto.CompactTextString(m) }
 func (*ReceiptEVMContract) ProtoMessage()    {}
 func (*ReceiptEVMContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{4}
+	return fileDescriptor_74353de561acd7c6, []int{5}
 }
+
 func (m *ReceiptEVMContract) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptEVMContract.Unmarshal(m, b)
 }
 func (m *ReceiptEVMContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptEVMContract.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptEVMContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptEVMContract.Merge(dst, src)
+func (m *ReceiptEVMContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptEVMContract.Merge(m, src)
 }
 func (m *ReceiptEVMContract) XXX_Size() int {
 	return xxx_messageInfo_ReceiptEVMContract.Size(m)
@@ -417,16 +498,17 @@ func (m *EVMStateChangeItem) Reset()         { *m = EVMStateChangeItem{} }
 func (m *EVMStateChangeItem) String() string { return proto.CompactTextString(m) }
 func (*EVMStateChangeItem) ProtoMessage()    {}
 func (*EVMStateChangeItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{5}
+	return fileDescriptor_74353de561acd7c6, []int{6}
 }
+
 func (m *EVMStateChangeItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EVMStateChangeItem.Unmarshal(m, b)
 }
 func (m *EVMStateChangeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EVMStateChangeItem.Marshal(b, m, deterministic)
 }
-func (dst *EVMStateChangeItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMStateChangeItem.Merge(dst, src)
+func (m *EVMStateChangeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMStateChangeItem.Merge(m, src)
 }
 func (m *EVMStateChangeItem) XXX_Size() int {
 	return xxx_messageInfo_EVMStateChangeItem.Size(m)
@@ -458,6 +540,62 @@ func (m *EVMStateChangeItem) GetCurrentValue() []byte {
 	return nil
 }
 
+// 合约事件日志 ForkEVMEventLog
+type EVMContractEventLog struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics               [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMContractEventLog) Reset()         { *m = EVMContractEventLog{} }
+func (m *EVMContractEventLog) String() string { return proto.CompactTextString(m) }
+func (*EVMContractEventLog) ProtoMessage()    {}
+func (*EVMContractEventLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{7}
+}
+
+func (m *EVMContractEventLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMContractEventLog.Unmarshal(m, b)
+}
+func (m *EVMContractEventLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMContractEventLog.Marshal(b, m, deterministic)
+}
+func (m *EVMContractEventLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractEventLog.Merge(m, src)
+}
+func (m *EVMContractEventLog) XXX_Size() int {
+	return xxx_messageInfo_EVMContractEventLog.Size(m)
+}
+func (m *EVMContractEventLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMContractEventLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMContractEventLog proto.InternalMessageInfo
+
+func (m *EVMContractEventLog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EVMContractEventLog) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EVMContractEventLog) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // 存放合约固定数据
 type EVMContractDataCmd struct {
 	Creator              string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -475,16 +613,17 @@ func (m *EVMContractDataCmd) Reset()         { *m = EVMContractDataCmd{} }
 func (m *EVMContractDataCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractDataCmd) ProtoMessage()    {}
 func (*EVMContractDataCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{6}
+	return fileDescriptor_74353de561acd7c6, []int{8}
 }
+
 func (m *EVMContractDataCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EVMContractDataCmd.Unmarshal(m, b)
 }
 func (m *EVMContractDataCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EVMContractDataCmd.Marshal(b, m, deterministic)
 }
-func (dst *EVMContractDataCmd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMContractDataCmd.Merge(dst, src)
+func (m *EVMContractDataCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractDataCmd.Merge(m, src)
 }
 func (m *EVMContractDataCmd) XXX_Size() int {
 	return xxx_messageInfo_EVMContractDataCmd.Size(m)
@@ -552,16 +691,17 @@ func (m *EVMContractStateCmd) Reset()         { *m = EVMContractStateCmd{} }
 func (m *EVMContractStateCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractStateCmd) ProtoMessage()    {}
 func (*EVMContractStateCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{7}
+	return fileDescriptor_74353de561acd7c6, []int{9}
 }
+
 func (m *EVMContractStateCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EVMContractStateCmd.Unmarshal(m, b)
 }
 func (m *EVMContractStateCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EVMContractStateCmd.Marshal(b, m, deterministic)
 }
-func (dst *EVMContractStateCmd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EVMContractStateCmd.Merge(dst, src)
+func (m *EVMContractStateCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractStateCmd.Merge(m, src)
 }
 func (m *EVMContractStateCmd) XXX_Size() int {
 	return xxx_messageInfo_EVMContractStateCmd.Size(m)
@@ -618,16 +758,17 @@ func (m *ReceiptEVMContractCmd) Reset()         { *m = ReceiptEVMContractCmd{} }
 func (m *ReceiptEVMContractCmd) String() string { return proto.CompactTextString(m) }
 func (*ReceiptEVMContractCmd) ProtoMessage()    {}
 func (*ReceiptEVMContractCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{8}
+	return fileDescriptor_74353de561acd7c6, []int{10}
 }
+
 func (m *ReceiptEVMContractCmd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptEVMContractCmd.Unmarshal(m, b)
 }
 func (m *ReceiptEVMContractCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptEVMContractCmd.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptEVMContractCmd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptEVMContractCmd.Merge(dst, src)
+func (m *ReceiptEVMContractCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptEVMContractCmd.Merge(m, src)
 }
 func (m *ReceiptEVMContractCmd) XXX_Size() int {
 	return xxx_messageInfo_ReceiptEVMContractCmd.Size(m)
@@ -684,16 +825,17 @@ func (m *CheckEVMAddrReq) Reset()         { *m = CheckEVMAddrReq{} }
 func (m *CheckEVMAddrReq) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrReq) ProtoMessage()    {}
 func (*CheckEVMAddrReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{9}
+	return fileDescriptor_74353de561acd7c6, []int{11}
 }
+
 func (m *CheckEVMAddrReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckEVMAddrReq.Unmarshal(m, b)
 }
 func (m *CheckEVMAddrReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CheckEVMAddrReq.Marshal(b, m, deterministic)
 }
-func (dst *CheckEVMAddrReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckEVMAddrReq.Merge(dst, src)
+func (m *CheckEVMAddrReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckEVMAddrReq.Merge(m, src)
 }
 func (m *CheckEVMAddrReq) XXX_Size() int {
 	return xxx_messageInfo_CheckEVMAddrReq.Size(m)
@@ -725,16 +867,17 @@ func (m *CheckEVMAddrResp) Reset()         { *m = CheckEVMAddrResp{} }
 func (m *CheckEVMAddrResp) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrResp) ProtoMessage()    {}
 func (*CheckEVMAddrResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{10}
+	return fileDescriptor_74353de561acd7c6, []int{12}
 }
+
 func (m *CheckEVMAddrResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckEVMAddrResp.Unmarshal(m, b)
 }
 func (m *CheckEVMAddrResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CheckEVMAddrResp.Marshal(b, m, deterministic)
 }
-func (dst *CheckEVMAddrResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckEVMAddrResp.Merge(dst, src)
+func (m *CheckEVMAddrResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckEVMAddrResp.Merge(m, src)
 }
 func (m *CheckEVMAddrResp) XXX_Size() int {
 	return xxx_messageInfo_CheckEVMAddrResp.Size(m)
@@ -788,16 +931,17 @@ func (m *EstimateEVMGasReq) Reset()         { *m = EstimateEVMGasReq{} }
 func (m *EstimateEVMGasReq) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasReq) ProtoMessage()    {}
 func (*EstimateEVMGasReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{11}
+	return fileDescriptor_74353de561acd7c6, []int{13}
 }
+
 func (m *EstimateEVMGasReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateEVMGasReq.Unmarshal(m, b)
 }
 func (m *EstimateEVMGasReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EstimateEVMGasReq.Marshal(b, m, deterministic)
 }
-func (dst *EstimateEVMGasReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateEVMGasReq.Merge(dst, src)
+func (m *EstimateEVMGasReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateEVMGasReq.Merge(m, src)
 }
 func (m *EstimateEVMGasReq) XXX_Size() int {
 	return xxx_messageInfo_EstimateEVMGasReq.Size(m)
@@ -854,16 +998,17 @@ func (m *EstimateEVMGasResp) Reset()         { *m = EstimateEVMGasResp{} }
 func (m *EstimateEVMGasResp) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasResp) ProtoMessage()    {}
 func (*EstimateEVMGasResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{12}
+	return fileDescriptor_74353de561acd7c6, []int{14}
 }
+
 func (m *EstimateEVMGasResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateEVMGasResp.Unmarshal(m, b)
 }
 func (m *EstimateEVMGasResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EstimateEVMGasResp.Marshal(b, m, deterministic)
 }
-func (dst *EstimateEVMGasResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateEVMGasResp.Merge(dst, src)
+func (m *EstimateEVMGasResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateEVMGasResp.Merge(m, src)
 }
 func (m *EstimateEVMGasResp) XXX_Size() int {
 	return xxx_messageInfo_EstimateEVMGasResp.Size(m)
@@ -893,16 +1038,17 @@ func (m *EvmDebugReq) Reset()         { *m = EvmDebugReq{} }
 func (m *EvmDebugReq) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugReq) ProtoMessage()    {}
 func (*EvmDebugReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{13}
+	return fileDescriptor_74353de561acd7c6, []int{15}
 }
+
 func (m *EvmDebugReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmDebugReq.Unmarshal(m, b)
 }
 func (m *EvmDebugReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmDebugReq.Marshal(b, m, deterministic)
 }
-func (dst *EvmDebugReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmDebugReq.Merge(dst, src)
+func (m *EvmDebugReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmDebugReq.Merge(m, src)
 }
 func (m *EvmDebugReq) XXX_Size() int {
 	return xxx_messageInfo_EvmDebugReq.Size(m)
@@ -931,16 +1077,17 @@ func (m *EvmDebugResp) Reset()         { *m = EvmDebugResp{} }
 func (m *EvmDebugResp) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugResp) ProtoMessage()    {}
 func (*EvmDebugResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{14}
+	return fileDescriptor_74353de561acd7c6, []int{16}
 }
+
 func (m *EvmDebugResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmDebugResp.Unmarshal(m, b)
 }
 func (m *EvmDebugResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmDebugResp.Marshal(b, m, deterministic)
 }
-func (dst *EvmDebugResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmDebugResp.Merge(dst, src)
+func (m *EvmDebugResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmDebugResp.Merge(m, src)
 }
 func (m *EvmDebugResp) XXX_Size() int {
 	return xxx_messageInfo_EvmDebugResp.Size(m)
@@ -958,6 +1105,118 @@ func (m *EvmDebugResp) GetDebugStatus() string {
 	return ""
 }
 
+type EvmTraceTxReq struct {
+	TxHash string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// structLog 按指令跟踪（默认），callTracer 按调用层级跟踪
+	Tracer               string   `protobuf:"bytes,2,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmTraceTxReq) Reset()         { *m = EvmTraceTxReq{} }
+func (m *EvmTraceTxReq) String() string { return proto.CompactTextString(m) }
+func (*EvmTraceTxReq) ProtoMessage()    {}
+func (*EvmTraceTxReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{17}
+}
+
+func (m *EvmTraceTxReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTraceTxReq.Unmarshal(m, b)
+}
+func (m *EvmTraceTxReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTraceTxReq.Marshal(b, m, deterministic)
+}
+func (m *EvmTraceTxReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTraceTxReq.Merge(m, src)
+}
+func (m *EvmTraceTxReq) XXX_Size() int {
+	return xxx_messageInfo_EvmTraceTxReq.Size(m)
+}
+func (m *EvmTraceTxReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTraceTxReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTraceTxReq proto.InternalMessageInfo
+
+func (m *EvmTraceTxReq) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmTraceTxReq) GetTracer() string {
+	if m != nil {
+		return m.Tracer
+	}
+	return ""
+}
+
+type EvmTraceTxResp struct {
+	TxHash   string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Tracer   string `protobuf:"bytes,2,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	JsonData string `protobuf:"bytes,3,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+	// 交易重新执行失败时的错误信息
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmTraceTxResp) Reset()         { *m = EvmTraceTxResp{} }
+func (m *EvmTraceTxResp) String() string { return proto.CompactTextString(m) }
+func (*EvmTraceTxResp) ProtoMessage()    {}
+func (*EvmTraceTxResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{18}
+}
+
+func (m *EvmTraceTxResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTraceTxResp.Unmarshal(m, b)
+}
+func (m *EvmTraceTxResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTraceTxResp.Marshal(b, m, deterministic)
+}
+func (m *EvmTraceTxResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTraceTxResp.Merge(m, src)
+}
+func (m *EvmTraceTxResp) XXX_Size() int {
+	return xxx_messageInfo_EvmTraceTxResp.Size(m)
+}
+func (m *EvmTraceTxResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTraceTxResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTraceTxResp proto.InternalMessageInfo
+
+func (m *EvmTraceTxResp) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmTraceTxResp) GetTracer() string {
+	if m != nil {
+		return m.Tracer
+	}
+	return ""
+}
+
+func (m *EvmTraceTxResp) GetJsonData() string {
+	if m != nil {
+		return m.JsonData
+	}
+	return ""
+}
+
+func (m *EvmTraceTxResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type EvmQueryAbiReq struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -969,16 +1228,17 @@ func (m *EvmQueryAbiReq) Reset()         { *m = EvmQueryAbiReq{} }
 func (m *EvmQueryAbiReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiReq) ProtoMessage()    {}
 func (*EvmQueryAbiReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{15}
+	return fileDescriptor_74353de561acd7c6, []int{19}
 }
+
 func (m *EvmQueryAbiReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmQueryAbiReq.Unmarshal(m, b)
 }
 func (m *EvmQueryAbiReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmQueryAbiReq.Marshal(b, m, deterministic)
 }
-func (dst *EvmQueryAbiReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmQueryAbiReq.Merge(dst, src)
+func (m *EvmQueryAbiReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmQueryAbiReq.Merge(m, src)
 }
 func (m *EvmQueryAbiReq) XXX_Size() int {
 	return xxx_messageInfo_EvmQueryAbiReq.Size(m)
@@ -1008,16 +1268,17 @@ func (m *EvmQueryAbiResp) Reset()         { *m = EvmQueryAbiResp{} }
 func (m *EvmQueryAbiResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiResp) ProtoMessage()    {}
 func (*EvmQueryAbiResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{16}
+	return fileDescriptor_74353de561acd7c6, []int{20}
 }
+
 func (m *EvmQueryAbiResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmQueryAbiResp.Unmarshal(m, b)
 }
 func (m *EvmQueryAbiResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmQueryAbiResp.Marshal(b, m, deterministic)
 }
-func (dst *EvmQueryAbiResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmQueryAbiResp.Merge(dst, src)
+func (m *EvmQueryAbiResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmQueryAbiResp.Merge(m, src)
 }
 func (m *EvmQueryAbiResp) XXX_Size() int {
 	return xxx_messageInfo_EvmQueryAbiResp.Size(m)
@@ -1043,28 +1304,31 @@ func (m *EvmQueryAbiResp) GetAbi() string {
 }
 
 type EvmQueryReq struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Input                string   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
-	Caller               string   `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Input   string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Caller  string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	// 批量只读调用，不为空时忽略address和input
+	Calls                []*EVMContractCall `protobuf:"bytes,4,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *EvmQueryReq) Reset()         { *m = EvmQueryReq{} }
 func (m *EvmQueryReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryReq) ProtoMessage()    {}
 func (*EvmQueryReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{17}
+	return fileDescriptor_74353de561acd7c6, []int{21}
 }
+
 func (m *EvmQueryReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmQueryReq.Unmarshal(m, b)
 }
 func (m *EvmQueryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmQueryReq.Marshal(b, m, deterministic)
 }
-func (dst *EvmQueryReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmQueryReq.Merge(dst, src)
+func (m *EvmQueryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmQueryReq.Merge(m, src)
 }
 func (m *EvmQueryReq) XXX_Size() int {
 	return xxx_messageInfo_EvmQueryReq.Size(m)
@@ -1096,31 +1360,41 @@ func (m *EvmQueryReq) GetCaller() string {
 	return ""
 }
 
+func (m *EvmQueryReq) GetCalls() []*EVMContractCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
 type EvmQueryResp struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Input                string   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
-	Caller               string   `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
-	RawData              string   `protobuf:"bytes,4,opt,name=rawData,proto3" json:"rawData,omitempty"`
-	JsonData             string   `protobuf:"bytes,5,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Input    string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Caller   string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	RawData  string `protobuf:"bytes,4,opt,name=rawData,proto3" json:"rawData,omitempty"`
+	JsonData string `protobuf:"bytes,5,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+	// 批量只读调用时每个调用的结果
+	Calls                []*EvmQueryResp `protobuf:"bytes,6,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *EvmQueryResp) Reset()         { *m = EvmQueryResp{} }
 func (m *EvmQueryResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryResp) ProtoMessage()    {}
 func (*EvmQueryResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{18}
+	return fileDescriptor_74353de561acd7c6, []int{22}
 }
+
 func (m *EvmQueryResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmQueryResp.Unmarshal(m, b)
 }
 func (m *EvmQueryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmQueryResp.Marshal(b, m, deterministic)
 }
-func (dst *EvmQueryResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmQueryResp.Merge(dst, src)
+func (m *EvmQueryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmQueryResp.Merge(m, src)
 }
 func (m *EvmQueryResp) XXX_Size() int {
 	return xxx_messageInfo_EvmQueryResp.Size(m)
@@ -1166,6 +1440,13 @@ func (m *EvmQueryResp) GetJsonData() string {
 	return ""
 }
 
+func (m *EvmQueryResp) GetCalls() []*EvmQueryResp {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
 type EvmContractCreateReq struct {
 	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	Fee                  int64    `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
@@ -1184,16 +1465,17 @@ func (m *EvmContractCreateReq) Reset()         { *m = EvmContractCreateReq{} }
 func (m *EvmContractCreateReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractCreateReq) ProtoMessage()    {}
 func (*EvmContractCreateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{19}
+	return fileDescriptor_74353de561acd7c6, []int{23}
 }
+
 func (m *EvmContractCreateReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmContractCreateReq.Unmarshal(m, b)
 }
 func (m *EvmContractCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmContractCreateReq.Marshal(b, m, deterministic)
 }
-func (dst *EvmContractCreateReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmContractCreateReq.Merge(dst, src)
+func (m *EvmContractCreateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmContractCreateReq.Merge(m, src)
 }
 func (m *EvmContractCreateReq) XXX_Size() int {
 	return xxx_messageInfo_EvmContractCreateReq.Size(m)
@@ -1279,16 +1561,17 @@ func (m *EvmContractCallReq) Reset()         { *m = EvmContractCallReq{} }
 func (m *EvmContractCallReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractCallReq) ProtoMessage()    {}
 func (*EvmContractCallReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{20}
+	return fileDescriptor_74353de561acd7c6, []int{24}
 }
+
 func (m *EvmContractCallReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmContractCallReq.Unmarshal(m, b)
 }
 func (m *EvmContractCallReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmContractCallReq.Marshal(b, m, deterministic)
 }
-func (dst *EvmContractCallReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmContractCallReq.Merge(dst, src)
+func (m *EvmContractCallReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmContractCallReq.Merge(m, src)
 }
 func (m *EvmContractCallReq) XXX_Size() int {
 	return xxx_messageInfo_EvmContractCallReq.Size(m)
@@ -1378,16 +1661,17 @@ func (m *EvmContractTransferReq) Reset()         { *m = EvmContractTransferReq{}
 func (m *EvmContractTransferReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractTransferReq) ProtoMessage()    {}
 func (*EvmContractTransferReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_evmcontract_555b7353802de0b0, []int{21}
+	return fileDescriptor_74353de561acd7c6, []int{25}
 }
+
 func (m *EvmContractTransferReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EvmContractTransferReq.Unmarshal(m, b)
 }
 func (m *EvmContractTransferReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EvmContractTransferReq.Marshal(b, m, deterministic)
 }
-func (dst *EvmContractTransferReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EvmContractTransferReq.Merge(dst, src)
+func (m *EvmContractTransferReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmContractTransferReq.Merge(m, src)
 }
 func (m *EvmContractTransferReq) XXX_Size() int {
 	return xxx_messageInfo_EvmContractTransferReq.Size(m)
@@ -1440,14 +1724,623 @@ func (m *EvmContractTransferReq) GetParaName() string {
 	return ""
 }
 
+// 同一位置上的主题，任意一个匹配即可
+type EvmLogTopics struct {
+	Topics               []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmLogTopics) Reset()         { *m = EvmLogTopics{} }
+func (m *EvmLogTopics) String() string { return proto.CompactTextString(m) }
+func (*EvmLogTopics) ProtoMessage()    {}
+func (*EvmLogTopics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{26}
+}
+
+func (m *EvmLogTopics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmLogTopics.Unmarshal(m, b)
+}
+func (m *EvmLogTopics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmLogTopics.Marshal(b, m, deterministic)
+}
+func (m *EvmLogTopics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmLogTopics.Merge(m, src)
+}
+func (m *EvmLogTopics) XXX_Size() int {
+	return xxx_messageInfo_EvmLogTopics.Size(m)
+}
+func (m *EvmLogTopics) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmLogTopics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmLogTopics proto.InternalMessageInfo
+
+func (m *EvmLogTopics) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type EvmGetLogsReq struct {
+	FromBlock int64 `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	// 为0时不限制结束高度
+	ToBlock   int64    `protobuf:"varint,2,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// 按位置匹配主题，位置上为空表示不限制
+	Topics []*EvmLogTopics `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	Count  int32           `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 翻页时填上一页返回的primaryKey
+	PrimaryKey           string   `protobuf:"bytes,6,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetLogsReq) Reset()         { *m = EvmGetLogsReq{} }
+func (m *EvmGetLogsReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetLogsReq) ProtoMessage()    {}
+func (*EvmGetLogsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{27}
+}
+
+func (m *EvmGetLogsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetLogsReq.Unmarshal(m, b)
+}
+func (m *EvmGetLogsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetLogsReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetLogsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetLogsReq.Merge(m, src)
+}
+func (m *EvmGetLogsReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetLogsReq.Size(m)
+}
+func (m *EvmGetLogsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetLogsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetLogsReq proto.InternalMessageInfo
+
+func (m *EvmGetLogsReq) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *EvmGetLogsReq) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *EvmGetLogsReq) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *EvmGetLogsReq) GetTopics() []*EvmLogTopics {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EvmGetLogsReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *EvmGetLogsReq) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type EvmLogItem struct {
+	Height   int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex  int64    `protobuf:"varint,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	TxHash   string   `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	LogIndex int64    `protobuf:"varint,4,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	Address  string   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Topics   []string `protobuf:"bytes,6,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data     string   `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// 合约绑定了ABI时按事件定义解析
+	EventName            string   `protobuf:"bytes,8,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	JsonData             string   `protobuf:"bytes,9,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmLogItem) Reset()         { *m = EvmLogItem{} }
+func (m *EvmLogItem) String() string { return proto.CompactTextString(m) }
+func (*EvmLogItem) ProtoMessage()    {}
+func (*EvmLogItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{28}
+}
+
+func (m *EvmLogItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmLogItem.Unmarshal(m, b)
+}
+func (m *EvmLogItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmLogItem.Marshal(b, m, deterministic)
+}
+func (m *EvmLogItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmLogItem.Merge(m, src)
+}
+func (m *EvmLogItem) XXX_Size() int {
+	return xxx_messageInfo_EvmLogItem.Size(m)
+}
+func (m *EvmLogItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmLogItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmLogItem proto.InternalMessageInfo
+
+func (m *EvmLogItem) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetLogIndex() int64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EvmLogItem) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetJsonData() string {
+	if m != nil {
+		return m.JsonData
+	}
+	return ""
+}
+
+type EvmGetLogsResp struct {
+	Logs                 []*EvmLogItem `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	PrimaryKey           string        `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EvmGetLogsResp) Reset()         { *m = EvmGetLogsResp{} }
+func (m *EvmGetLogsResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetLogsResp) ProtoMessage()    {}
+func (*EvmGetLogsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{29}
+}
+
+func (m *EvmGetLogsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetLogsResp.Unmarshal(m, b)
+}
+func (m *EvmGetLogsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetLogsResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetLogsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetLogsResp.Merge(m, src)
+}
+func (m *EvmGetLogsResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetLogsResp.Size(m)
+}
+func (m *EvmGetLogsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetLogsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetLogsResp proto.InternalMessageInfo
+
+func (m *EvmGetLogsResp) GetLogs() []*EvmLogItem {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *EvmGetLogsResp) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type EvmGetStorageAtReq struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 32字节的存储位置，十六进制格式
+	Slot                 string   `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetStorageAtReq) Reset()         { *m = EvmGetStorageAtReq{} }
+func (m *EvmGetStorageAtReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetStorageAtReq) ProtoMessage()    {}
+func (*EvmGetStorageAtReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{30}
+}
+
+func (m *EvmGetStorageAtReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetStorageAtReq.Unmarshal(m, b)
+}
+func (m *EvmGetStorageAtReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetStorageAtReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetStorageAtReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetStorageAtReq.Merge(m, src)
+}
+func (m *EvmGetStorageAtReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetStorageAtReq.Size(m)
+}
+func (m *EvmGetStorageAtReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetStorageAtReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetStorageAtReq proto.InternalMessageInfo
+
+func (m *EvmGetStorageAtReq) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EvmGetStorageAtReq) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+type EvmStorageItem struct {
+	Slot                 string   `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmStorageItem) Reset()         { *m = EvmStorageItem{} }
+func (m *EvmStorageItem) String() string { return proto.CompactTextString(m) }
+func (*EvmStorageItem) ProtoMessage()    {}
+func (*EvmStorageItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{31}
+}
+
+func (m *EvmStorageItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmStorageItem.Unmarshal(m, b)
+}
+func (m *EvmStorageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmStorageItem.Marshal(b, m, deterministic)
+}
+func (m *EvmStorageItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmStorageItem.Merge(m, src)
+}
+func (m *EvmStorageItem) XXX_Size() int {
+	return xxx_messageInfo_EvmStorageItem.Size(m)
+}
+func (m *EvmStorageItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmStorageItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmStorageItem proto.InternalMessageInfo
+
+func (m *EvmStorageItem) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+func (m *EvmStorageItem) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type EvmDumpStorageReq struct {
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 翻页时填上一页返回的primaryKey
+	PrimaryKey           string   `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmDumpStorageReq) Reset()         { *m = EvmDumpStorageReq{} }
+func (m *EvmDumpStorageReq) String() string { return proto.CompactTextString(m) }
+func (*EvmDumpStorageReq) ProtoMessage()    {}
+func (*EvmDumpStorageReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{32}
+}
+
+func (m *EvmDumpStorageReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmDumpStorageReq.Unmarshal(m, b)
+}
+func (m *EvmDumpStorageReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmDumpStorageReq.Marshal(b, m, deterministic)
+}
+func (m *EvmDumpStorageReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmDumpStorageReq.Merge(m, src)
+}
+func (m *EvmDumpStorageReq) XXX_Size() int {
+	return xxx_messageInfo_EvmDumpStorageReq.Size(m)
+}
+func (m *EvmDumpStorageReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmDumpStorageReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmDumpStorageReq proto.InternalMessageInfo
+
+func (m *EvmDumpStorageReq) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EvmDumpStorageReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *EvmDumpStorageReq) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type EvmDumpStorageResp struct {
+	Items                []*EvmStorageItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	PrimaryKey           string            `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EvmDumpStorageResp) Reset()         { *m = EvmDumpStorageResp{} }
+func (m *EvmDumpStorageResp) String() string { return proto.CompactTextString(m) }
+func (*EvmDumpStorageResp) ProtoMessage()    {}
+func (*EvmDumpStorageResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{33}
+}
+
+func (m *EvmDumpStorageResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmDumpStorageResp.Unmarshal(m, b)
+}
+func (m *EvmDumpStorageResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmDumpStorageResp.Marshal(b, m, deterministic)
+}
+func (m *EvmDumpStorageResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmDumpStorageResp.Merge(m, src)
+}
+func (m *EvmDumpStorageResp) XXX_Size() int {
+	return xxx_messageInfo_EvmDumpStorageResp.Size(m)
+}
+func (m *EvmDumpStorageResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmDumpStorageResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmDumpStorageResp proto.InternalMessageInfo
+
+func (m *EvmDumpStorageResp) GetItems() []*EvmStorageItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *EvmDumpStorageResp) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type EvmGetAccountStateReq struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Slots                []string `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetAccountStateReq) Reset()         { *m = EvmGetAccountStateReq{} }
+func (m *EvmGetAccountStateReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetAccountStateReq) ProtoMessage()    {}
+func (*EvmGetAccountStateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{34}
+}
+
+func (m *EvmGetAccountStateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetAccountStateReq.Unmarshal(m, b)
+}
+func (m *EvmGetAccountStateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetAccountStateReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetAccountStateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetAccountStateReq.Merge(m, src)
+}
+func (m *EvmGetAccountStateReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetAccountStateReq.Size(m)
+}
+func (m *EvmGetAccountStateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetAccountStateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetAccountStateReq proto.InternalMessageInfo
+
+func (m *EvmGetAccountStateReq) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EvmGetAccountStateReq) GetSlots() []string {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+type EvmGetAccountStateResp struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 读取账户数据时所在区块的高度和状态哈希
+	Height    int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	StateHash string `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	// 合约账户在状态树中的原始数据，不带默克尔证明
+	AccountKVs           []*types.KeyValue    `protobuf:"bytes,4,rep,name=accountKVs,proto3" json:"accountKVs,omitempty"`
+	Data                 *EVMContractDataCmd  `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	State                *EVMContractStateCmd `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Storage              []*EvmStorageItem    `protobuf:"bytes,7,rep,name=storage,proto3" json:"storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EvmGetAccountStateResp) Reset()         { *m = EvmGetAccountStateResp{} }
+func (m *EvmGetAccountStateResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetAccountStateResp) ProtoMessage()    {}
+func (*EvmGetAccountStateResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{35}
+}
+
+func (m *EvmGetAccountStateResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetAccountStateResp.Unmarshal(m, b)
+}
+func (m *EvmGetAccountStateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetAccountStateResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetAccountStateResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetAccountStateResp.Merge(m, src)
+}
+func (m *EvmGetAccountStateResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetAccountStateResp.Size(m)
+}
+func (m *EvmGetAccountStateResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetAccountStateResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetAccountStateResp proto.InternalMessageInfo
+
+func (m *EvmGetAccountStateResp) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EvmGetAccountStateResp) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EvmGetAccountStateResp) GetStateHash() string {
+	if m != nil {
+		return m.StateHash
+	}
+	return ""
+}
+
+func (m *EvmGetAccountStateResp) GetAccountKVs() []*types.KeyValue {
+	if m != nil {
+		return m.AccountKVs
+	}
+	return nil
+}
+
+func (m *EvmGetAccountStateResp) GetData() *EVMContractDataCmd {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EvmGetAccountStateResp) GetState() *EVMContractStateCmd {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *EvmGetAccountStateResp) GetStorage() []*EvmStorageItem {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EVMContractObject)(nil), "types.EVMContractObject")
 	proto.RegisterType((*EVMContractData)(nil), "types.EVMContractData")
 	proto.RegisterType((*EVMContractState)(nil), "types.EVMContractState")
 	proto.RegisterMapType((map[string][]byte)(nil), "types.EVMContractState.StorageEntry")
 	proto.RegisterType((*EVMContractAction)(nil), "types.EVMContractAction")
+	proto.RegisterType((*EVMContractCall)(nil), "types.EVMContractCall")
 	proto.RegisterType((*ReceiptEVMContract)(nil), "types.ReceiptEVMContract")
 	proto.RegisterType((*EVMStateChangeItem)(nil), "types.EVMStateChangeItem")
+	proto.RegisterType((*EVMContractEventLog)(nil), "types.EVMContractEventLog")
 	proto.RegisterType((*EVMContractDataCmd)(nil), "types.EVMContractDataCmd")
 	proto.RegisterType((*EVMContractStateCmd)(nil), "types.EVMContractStateCmd")
 	proto.RegisterMapType((map[string]string)(nil), "types.EVMContractStateCmd.StorageEntry")
@@ -1458,6 +2351,8 @@ func init() {
 	proto.RegisterType((*EstimateEVMGasResp)(nil), "types.EstimateEVMGasResp")
 	proto.RegisterType((*EvmDebugReq)(nil), "types.EvmDebugReq")
 	proto.RegisterType((*EvmDebugResp)(nil), "types.EvmDebugResp")
+	proto.RegisterType((*EvmTraceTxReq)(nil), "types.EvmTraceTxReq")
+	proto.RegisterType((*EvmTraceTxResp)(nil), "types.EvmTraceTxResp")
 	proto.RegisterType((*EvmQueryAbiReq)(nil), "types.EvmQueryAbiReq")
 	proto.RegisterType((*EvmQueryAbiResp)(nil), "types.EvmQueryAbiResp")
 	proto.RegisterType((*EvmQueryReq)(nil), "types.EvmQueryReq")
@@ -1465,73 +2360,118 @@ func init() {
 	proto.RegisterType((*EvmContractCreateReq)(nil), "types.EvmContractCreateReq")
 	proto.RegisterType((*EvmContractCallReq)(nil), "types.EvmContractCallReq")
 	proto.RegisterType((*EvmContractTransferReq)(nil), "types.EvmContractTransferReq")
-}
-
-func init() { proto.RegisterFile("evmcontract.proto", fileDescriptor_evmcontract_555b7353802de0b0) }
-
-var fileDescriptor_evmcontract_555b7353802de0b0 = []byte{
-	// 1004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x96, 0x63, 0x27, 0x1b, 0xbf, 0x0d, 0xed, 0xae, 0x29, 0x4b, 0xb4, 0x42, 0x28, 0xb2, 0x28,
-	0xac, 0x2a, 0xb1, 0x42, 0xe5, 0x82, 0x2a, 0x81, 0xb4, 0x4a, 0xa3, 0x82, 0xc4, 0xf2, 0x63, 0x0a,
-	0xe1, 0x3c, 0xb1, 0x67, 0xb3, 0x6e, 0xe3, 0x1f, 0xcc, 0x4c, 0xd2, 0xe6, 0xca, 0x99, 0x23, 0x47,
-	0x6e, 0x1c, 0xb9, 0x20, 0x71, 0xe3, 0xcc, 0x1f, 0xc1, 0x99, 0x13, 0x7f, 0x06, 0x7a, 0xcf, 0x63,
-	0x7b, 0xec, 0x4d, 0x2a, 0x90, 0x2a, 0xc4, 0x29, 0xf3, 0xcd, 0x3c, 0xcf, 0x7c, 0xdf, 0xbc, 0xef,
-	0xbd, 0x09, 0x1c, 0x8b, 0x4d, 0x1a, 0xe5, 0x99, 0x96, 0x3c, 0xd2, 0xe7, 0x85, 0xcc, 0x75, 0x1e,
-	0xf4, 0xf5, 0xb6, 0x10, 0x2a, 0xfc, 0xce, 0x81, 0xe3, 0xd9, 0xfc, 0x72, 0x6a, 0x16, 0x3f, 0x5f,
-	0x3c, 0x11, 0x91, 0x0e, 0x02, 0xf0, 0x78, 0x1c, 0xcb, 0xb1, 0x33, 0x71, 0xce, 0x7c, 0x46, 0xe3,
-	0xe0, 0x1e, 0x78, 0x31, 0xd7, 0x7c, 0xdc, 0x9b, 0x38, 0x67, 0x87, 0xf7, 0x4f, 0xce, 0xe9, 0xfb,
-	0x73, 0xeb, 0xdb, 0x87, 0x5c, 0x73, 0x46, 0x31, 0xc1, 0xbb, 0xd0, 0x57, 0x9a, 0x6b, 0x31, 0x76,
-	0x29, 0xf8, 0xf5, 0x9b, 0xc1, 0x8f, 0x71, 0x99, 0x95, 0x51, 0xe1, 0xcf, 0x0e, 0xdc, 0xee, 0x6c,
-	0x14, 0x8c, 0xe1, 0x20, 0x92, 0x82, 0xeb, 0xbc, 0x62, 0x51, 0x41, 0x24, 0x97, 0xf1, 0x54, 0x10,
-	0x11, 0x9f, 0xd1, 0x38, 0xb8, 0x03, 0x7d, 0xbe, 0x4a, 0xb8, 0xa2, 0x03, 0x7d, 0x56, 0x82, 0x5a,
-	0x86, 0x67, 0xc9, 0x08, 0xc0, 0x8b, 0xf2, 0x58, 0x8c, 0xfb, 0x13, 0xe7, 0x6c, 0xc4, 0x68, 0x1c,
-	0x9c, 0xc2, 0x10, 0x7f, 0x3f, 0xe6, 0xea, 0x7a, 0x3c, 0xa0, 0xf9, 0x1a, 0x07, 0x47, 0xe0, 0xf2,
-	0x45, 0x32, 0x3e, 0xa0, 0x2d, 0x70, 0x18, 0xfe, 0xe9, 0xc0, 0x51, 0x57, 0x09, 0x12, 0xc8, 0xf2,
-	0x2c, 0x12, 0x44, 0xd6, 0x63, 0x25, 0xc0, 0x8d, 0xd5, 0x3a, 0x89, 0x92, 0x58, 0xc4, 0x44, 0x77,
-	0xc8, 0x6a, 0x1c, 0x4c, 0xe0, 0x50, 0xe9, 0x5c, 0xf2, 0x65, 0x79, 0xae, 0x4b, 0xe7, 0xda, 0x53,
-	0xc1, 0x47, 0x70, 0x60, 0xe0, 0xd8, 0x9b, 0xb8, 0x67, 0x87, 0xf7, 0xdf, 0xda, 0x73, 0x8f, 0xe7,
-	0x8f, 0xcb, 0xb0, 0x59, 0xa6, 0xe5, 0x96, 0x55, 0x1f, 0x9d, 0x3e, 0x80, 0x91, 0xbd, 0x80, 0x52,
-	0x9e, 0x8a, 0xad, 0xb9, 0x4e, 0x1c, 0x22, 0xeb, 0x0d, 0x5f, 0xad, 0xcb, 0xbb, 0x1c, 0xb1, 0x12,
-	0x3c, 0xe8, 0x7d, 0xe0, 0x84, 0xbf, 0xb6, 0x7d, 0x71, 0x11, 0xe9, 0x24, 0xcf, 0x82, 0x13, 0x18,
-	0xf0, 0x34, 0x5f, 0x67, 0xda, 0xc8, 0x34, 0x08, 0x75, 0x2e, 0xb9, 0xfa, 0x34, 0x49, 0x13, 0x4d,
-	0x5b, 0x79, 0xac, 0xc6, 0x66, 0xed, 0x0b, 0x99, 0x44, 0xa5, 0x1d, 0x5e, 0x61, 0x35, 0xae, 0x93,
-	0xe1, 0x59, 0xc9, 0xa8, 0x53, 0xd9, 0xef, 0xa4, 0x32, 0xcb, 0xb5, 0xa0, 0xf4, 0x60, 0xd2, 0x73,
-	0x2d, 0x76, 0xa4, 0xe6, 0x37, 0x07, 0x02, 0x26, 0x22, 0x91, 0x14, 0xda, 0x22, 0x8f, 0xb4, 0x23,
-	0xbe, 0x5a, 0x89, 0xca, 0x4a, 0x06, 0x05, 0x21, 0x8c, 0xaa, 0xaa, 0xf8, 0xac, 0x71, 0x54, 0x6b,
-	0xce, 0x8e, 0xb9, 0x40, 0x2f, 0xb9, 0xed, 0x18, 0x9c, 0x43, 0xaf, 0xae, 0x95, 0x88, 0x1f, 0x71,
-	0x45, 0x4a, 0x3c, 0x56, 0x41, 0xa4, 0x28, 0x85, 0x36, 0x66, 0xc3, 0x21, 0xc6, 0x3e, 0x51, 0x79,
-	0xc6, 0x84, 0x36, 0x5a, 0x2a, 0x18, 0x5e, 0x41, 0x30, 0x9b, 0x5f, 0x52, 0x42, 0xa7, 0xd7, 0x3c,
-	0x5b, 0x8a, 0x4f, 0xb4, 0x48, 0x77, 0x24, 0xed, 0x14, 0x86, 0x85, 0x14, 0x73, 0x2b, 0x6f, 0x35,
-	0x26, 0xb6, 0x6b, 0x29, 0x45, 0xa6, 0xcb, 0xf5, 0xd2, 0x55, 0xad, 0xb9, 0xf0, 0x47, 0x87, 0x0e,
-	0xb2, 0xab, 0x6d, 0x9a, 0xc6, 0xff, 0x49, 0xc1, 0xf9, 0x7b, 0x0a, 0xce, 0x6f, 0x0a, 0x2e, 0xfc,
-	0xcb, 0x81, 0x57, 0xbb, 0x06, 0x47, 0x7e, 0x2f, 0xa5, 0xc2, 0xfc, 0x76, 0x85, 0x5d, 0x74, 0x2b,
-	0xec, 0x9d, 0x3d, 0x15, 0x36, 0x4d, 0xe3, 0x97, 0x53, 0x64, 0xbe, 0x5d, 0x64, 0x3f, 0x39, 0xf0,
-	0xda, 0x4d, 0xbb, 0xa2, 0xd8, 0xff, 0x85, 0x63, 0x7d, 0x72, 0x6c, 0x78, 0x17, 0x6e, 0x4f, 0xaf,
-	0x45, 0xf4, 0x74, 0x36, 0xbf, 0xc4, 0x6f, 0x99, 0xf8, 0x76, 0xd7, 0xfb, 0x10, 0xfe, 0xe0, 0xc0,
-	0x51, 0x3b, 0x4e, 0x15, 0x65, 0xa2, 0xcb, 0x73, 0x29, 0x78, 0xc8, 0x6a, 0x7c, 0x83, 0x67, 0x6f,
-	0x07, 0xcf, 0xae, 0x5e, 0x77, 0x87, 0xde, 0x37, 0xc0, 0x27, 0xf7, 0x51, 0x40, 0xe9, 0xbc, 0x66,
-	0x22, 0xdc, 0xc2, 0xf1, 0x4c, 0xe9, 0x24, 0xe5, 0x5a, 0xcc, 0xe6, 0x97, 0x8f, 0xb8, 0x42, 0xfe,
-	0xb7, 0xa0, 0xa7, 0x73, 0xc3, 0xbe, 0xa7, 0xf3, 0xda, 0xa3, 0x3d, 0xab, 0x0f, 0x35, 0x29, 0x70,
-	0x5b, 0x29, 0x68, 0x7a, 0xa0, 0xd7, 0xea, 0x81, 0xa6, 0x1b, 0xf5, 0x9b, 0x6e, 0xf4, 0x36, 0x04,
-	0xdd, 0xa3, 0x55, 0x81, 0x71, 0x4b, 0xae, 0x8c, 0x8b, 0x71, 0x18, 0xde, 0x85, 0xc3, 0xd9, 0x26,
-	0x7d, 0x28, 0x16, 0xeb, 0x25, 0x92, 0x3b, 0x81, 0x41, 0x5e, 0xa0, 0x0d, 0x29, 0xa6, 0xcf, 0x0c,
-	0x0a, 0xdf, 0x83, 0x51, 0x13, 0xa6, 0x0a, 0xb4, 0x77, 0x8c, 0x00, 0x0d, 0xba, 0x56, 0x46, 0x8d,
-	0x3d, 0x15, 0xde, 0x83, 0x5b, 0xb3, 0x4d, 0xfa, 0xe5, 0x5a, 0xc8, 0xed, 0xc5, 0x22, 0xc1, 0xbd,
-	0xc7, 0x70, 0x80, 0xc9, 0x12, 0xaa, 0x8a, 0xaf, 0x60, 0xf8, 0x21, 0xdc, 0x6e, 0xc5, 0xaa, 0x62,
-	0x7f, 0x70, 0xa5, 0xb5, 0xd7, 0x68, 0xfd, 0x9a, 0x34, 0xd0, 0xe7, 0x2f, 0x3c, 0x07, 0xab, 0x21,
-	0xc9, 0x8a, 0xb5, 0xae, 0xaa, 0x81, 0xc0, 0xbe, 0xcb, 0x0e, 0xbf, 0x77, 0x48, 0xb4, 0xd9, 0xf7,
-	0x85, 0x9c, 0xfe, 0xd5, 0xc6, 0xb8, 0x8f, 0xe4, 0xcf, 0xb0, 0xf7, 0x19, 0xcb, 0x54, 0x10, 0x2d,
-	0x8b, 0x1d, 0x99, 0x96, 0xca, 0x64, 0xd6, 0x38, 0xfc, 0xdd, 0x81, 0x3b, 0xb3, 0x4d, 0x5a, 0x57,
-	0x2a, 0x36, 0x48, 0x61, 0x0a, 0x82, 0x0c, 0xe4, 0x58, 0x4d, 0xee, 0x08, 0xdc, 0x2b, 0x51, 0x7a,
-	0xca, 0x65, 0x38, 0xac, 0x1f, 0x31, 0xd7, 0x7a, 0xc4, 0xea, 0x46, 0xea, 0xd9, 0x8d, 0xb4, 0xa1,
-	0xdd, 0x6f, 0xd1, 0x36, 0x17, 0x3f, 0xa8, 0x2f, 0x1e, 0x23, 0xc5, 0xf3, 0x22, 0x91, 0xc2, 0xbc,
-	0x83, 0x06, 0xd1, 0x2b, 0xc1, 0x25, 0xa7, 0xa2, 0x18, 0x96, 0x32, 0x2a, 0x1c, 0xfe, 0x81, 0x2f,
-	0x80, 0x25, 0x83, 0xaf, 0x56, 0xc6, 0x78, 0x3b, 0x5f, 0x77, 0xbb, 0x3a, 0x3a, 0xe2, 0xdc, 0x9b,
-	0xe2, 0x3c, 0x4b, 0xdc, 0x3f, 0x97, 0x11, 0x80, 0x27, 0x9e, 0x8b, 0xc8, 0x88, 0xa0, 0xb1, 0x25,
-	0x6d, 0xb8, 0x57, 0x9a, 0xdf, 0x91, 0xf6, 0x8b, 0x03, 0x27, 0x96, 0xb4, 0xaf, 0x24, 0xcf, 0xd4,
-	0x95, 0x90, 0x46, 0xde, 0xce, 0x9e, 0xda, 0xc8, 0x46, 0x81, 0x3d, 0x5b, 0x36, 0x51, 0x72, 0x77,
-	0x52, 0xf2, 0x5a, 0x94, 0xde, 0x04, 0x48, 0xd4, 0x37, 0x89, 0xbe, 0x8e, 0x25, 0x7f, 0x46, 0x62,
-	0x87, 0xcc, 0x9a, 0x69, 0x51, 0x1e, 0xb4, 0x29, 0x2f, 0x06, 0xf4, 0x87, 0xfc, 0xfd, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0x17, 0xf5, 0x15, 0xa5, 0x0b, 0x00, 0x00,
+	proto.RegisterType((*EvmLogTopics)(nil), "types.EvmLogTopics")
+	proto.RegisterType((*EvmGetLogsReq)(nil), "types.EvmGetLogsReq")
+	proto.RegisterType((*EvmLogItem)(nil), "types.EvmLogItem")
+	proto.RegisterType((*EvmGetLogsResp)(nil), "types.EvmGetLogsResp")
+	proto.RegisterType((*EvmGetStorageAtReq)(nil), "types.EvmGetStorageAtReq")
+	proto.RegisterType((*EvmStorageItem)(nil), "types.EvmStorageItem")
+	proto.RegisterType((*EvmDumpStorageReq)(nil), "types.EvmDumpStorageReq")
+	proto.RegisterType((*EvmDumpStorageResp)(nil), "types.EvmDumpStorageResp")
+	proto.RegisterType((*EvmGetAccountStateReq)(nil), "types.EvmGetAccountStateReq")
+	proto.RegisterType((*EvmGetAccountStateResp)(nil), "types.EvmGetAccountStateResp")
+}
+
+func init() { proto.RegisterFile("evmcontract.proto", fileDescriptor_74353de561acd7c6) }
+
+var fileDescriptor_74353de561acd7c6 = []byte{
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x96, 0xd7, 0xf6, 0x66, 0xfd, 0x66, 0xdb, 0x24, 0x6e, 0x1b, 0x96, 0xa8, 0x42, 0x2b, 0x8b,
+	0x96, 0xd0, 0xd2, 0xb4, 0x2a, 0x17, 0x54, 0xf1, 0xa1, 0x90, 0xae, 0x42, 0xd5, 0x86, 0x0f, 0x37,
+	0x4a, 0x0f, 0x88, 0xc3, 0xc4, 0x9e, 0x6c, 0xdc, 0xac, 0x3f, 0xf0, 0xcc, 0xa6, 0xd9, 0x2b, 0xfc,
+	0x05, 0x8e, 0xdc, 0x10, 0x27, 0x2e, 0xdc, 0x90, 0x38, 0x73, 0xe0, 0x27, 0x70, 0x85, 0x13, 0x67,
+	0x7e, 0x01, 0x7a, 0xe7, 0xc3, 0x1e, 0x3b, 0xbb, 0xa5, 0x48, 0x15, 0xe2, 0xb4, 0xf3, 0x8e, 0x5f,
+	0xcf, 0x3c, 0xcf, 0xfb, 0xf1, 0xcc, 0xac, 0x61, 0x8d, 0x9e, 0xa6, 0x51, 0x9e, 0xf1, 0x92, 0x44,
+	0x7c, 0xab, 0x28, 0x73, 0x9e, 0xfb, 0x2e, 0x9f, 0x15, 0x94, 0x6d, 0xf4, 0xa3, 0x3c, 0x4d, 0xf3,
+	0x4c, 0x4e, 0x06, 0x5f, 0x59, 0xb0, 0x36, 0x3a, 0xd8, 0xdb, 0x51, 0xae, 0x9f, 0x1c, 0x3e, 0xa5,
+	0x11, 0xf7, 0x7d, 0x70, 0x48, 0x1c, 0x97, 0x03, 0x6b, 0x68, 0x6d, 0x7a, 0xa1, 0x18, 0xfb, 0x37,
+	0xc0, 0x89, 0x09, 0x27, 0x83, 0xce, 0xd0, 0xda, 0x5c, 0xbe, 0xbb, 0xbe, 0x25, 0x56, 0xdb, 0x32,
+	0xde, 0xbd, 0x4f, 0x38, 0x09, 0x85, 0x8f, 0x7f, 0x0b, 0x5c, 0xc6, 0x09, 0xa7, 0x03, 0x5b, 0x38,
+	0xbf, 0x72, 0xde, 0xf9, 0x31, 0x3e, 0x0e, 0xa5, 0x57, 0xf0, 0x83, 0x05, 0x2b, 0xad, 0x85, 0xfc,
+	0x01, 0x2c, 0x45, 0x25, 0x25, 0x3c, 0xd7, 0x28, 0xb4, 0x89, 0xe0, 0x32, 0x92, 0x52, 0x01, 0xc4,
+	0x0b, 0xc5, 0xd8, 0xbf, 0x0c, 0x2e, 0x99, 0x24, 0x84, 0x89, 0x0d, 0xbd, 0x50, 0x1a, 0x15, 0x0d,
+	0xc7, 0xa0, 0xe1, 0x83, 0x13, 0xe5, 0x31, 0x1d, 0xb8, 0x43, 0x6b, 0xb3, 0x1f, 0x8a, 0xb1, 0xbf,
+	0x01, 0x3d, 0xfc, 0xfd, 0x88, 0xb0, 0xe3, 0x41, 0x57, 0xcc, 0x57, 0xb6, 0xbf, 0x0a, 0x36, 0x39,
+	0x4c, 0x06, 0x4b, 0x62, 0x09, 0x1c, 0x06, 0x7f, 0x58, 0xb0, 0xda, 0x66, 0x82, 0x00, 0xb2, 0x3c,
+	0x8b, 0xa8, 0x00, 0xeb, 0x84, 0xd2, 0xc0, 0x85, 0xd9, 0x34, 0x89, 0x92, 0x98, 0xc6, 0x02, 0x6e,
+	0x2f, 0xac, 0x6c, 0x7f, 0x08, 0xcb, 0x8c, 0xe7, 0x25, 0x19, 0xcb, 0x7d, 0x6d, 0xb1, 0xaf, 0x39,
+	0xe5, 0xbf, 0x0f, 0x4b, 0xca, 0x1c, 0x38, 0x43, 0x7b, 0x73, 0xf9, 0xee, 0xeb, 0x0b, 0xe2, 0xb8,
+	0xf5, 0x58, 0xba, 0x8d, 0x32, 0x5e, 0xce, 0x42, 0xfd, 0xd2, 0xc6, 0x3d, 0xe8, 0x9b, 0x0f, 0x90,
+	0xca, 0x09, 0x9d, 0xa9, 0x70, 0xe2, 0x10, 0x51, 0x9f, 0x92, 0xc9, 0x54, 0xc6, 0xb2, 0x1f, 0x4a,
+	0xe3, 0x5e, 0xe7, 0x1d, 0x2b, 0xf8, 0xbd, 0x59, 0x17, 0xdb, 0x11, 0x4f, 0xf2, 0xcc, 0x5f, 0x87,
+	0x2e, 0x49, 0xf3, 0x69, 0xc6, 0x15, 0x4d, 0x65, 0x21, 0xcf, 0x31, 0x61, 0x8f, 0x92, 0x34, 0xe1,
+	0x62, 0x29, 0x27, 0xac, 0x6c, 0xf5, 0xec, 0xd3, 0x32, 0x89, 0x64, 0x39, 0x5c, 0x08, 0x2b, 0xbb,
+	0x4a, 0x86, 0x63, 0x24, 0xa3, 0x4a, 0xa5, 0xdb, 0x4a, 0x65, 0x96, 0x73, 0x3a, 0xe8, 0xaa, 0xa4,
+	0xe7, 0x9c, 0x9e, 0x4f, 0x8d, 0xff, 0x16, 0xb8, 0x11, 0x99, 0x4c, 0xd8, 0xa0, 0x37, 0xb4, 0xe7,
+	0x17, 0xe9, 0x0e, 0x99, 0x4c, 0x42, 0xe9, 0x14, 0x9c, 0xc0, 0x4a, 0xeb, 0x89, 0xac, 0x04, 0x69,
+	0xab, 0x38, 0x55, 0xb6, 0x41, 0xbe, 0xd3, 0x20, 0xaf, 0x49, 0xd8, 0x06, 0x09, 0x05, 0xcd, 0xa9,
+	0xab, 0xe6, 0x67, 0x0b, 0xfc, 0x90, 0x46, 0x34, 0x29, 0xb8, 0xb1, 0x29, 0x2e, 0x8a, 0x60, 0xa8,
+	0xae, 0x72, 0x65, 0xf9, 0x01, 0xf4, 0xf5, 0xc6, 0x1f, 0xd7, 0xc5, 0xde, 0x98, 0x33, 0x7d, 0xb6,
+	0xb1, 0xcc, 0xed, 0xa6, 0x0f, 0xce, 0x61, 0x1b, 0x4d, 0x19, 0x8d, 0x77, 0x09, 0x13, 0x60, 0x9c,
+	0x50, 0x9b, 0x08, 0xb1, 0xa4, 0x5c, 0xf5, 0x01, 0x0e, 0xd1, 0xf7, 0x29, 0xcb, 0xb3, 0x90, 0x72,
+	0x15, 0x66, 0x6d, 0x06, 0x47, 0xe0, 0x8f, 0x0e, 0xf6, 0x44, 0xad, 0xed, 0x1c, 0x93, 0x6c, 0x4c,
+	0x1f, 0x70, 0x9a, 0xce, 0xa9, 0xa7, 0x0d, 0xe8, 0x15, 0x25, 0x3d, 0x30, 0x4a, 0xaa, 0xb2, 0x05,
+	0xda, 0x69, 0x59, 0xd2, 0x8c, 0xcb, 0xe7, 0x32, 0x5c, 0x8d, 0xb9, 0xe0, 0x73, 0xb8, 0x64, 0x04,
+	0x67, 0x74, 0x4a, 0x33, 0xfe, 0x28, 0x1f, 0x23, 0x30, 0xec, 0x5d, 0xca, 0x98, 0xd6, 0x02, 0x65,
+	0x62, 0xf8, 0x78, 0x5e, 0x24, 0x11, 0x1b, 0x74, 0x86, 0xf6, 0x66, 0x3f, 0x54, 0x16, 0xe6, 0x44,
+	0x88, 0x95, 0xca, 0x09, 0x8e, 0x83, 0x6f, 0x2d, 0xf0, 0x8d, 0xd5, 0x51, 0x65, 0x76, 0xd2, 0xf8,
+	0x3f, 0x11, 0x1a, 0x6f, 0x81, 0xd0, 0x78, 0xb5, 0xd0, 0x04, 0x7f, 0x5a, 0x0d, 0xf2, 0x32, 0xd8,
+	0x69, 0xfc, 0x72, 0x94, 0xc5, 0x6b, 0x2a, 0xcb, 0x76, 0x5b, 0x59, 0xde, 0x58, 0xa0, 0x2c, 0x3b,
+	0x69, 0xfc, 0x72, 0xc4, 0xc5, 0x33, 0xc5, 0xe5, 0x3b, 0x0b, 0xae, 0x9c, 0xef, 0x05, 0x24, 0xfb,
+	0xbf, 0x68, 0x07, 0x4f, 0xb4, 0x43, 0x70, 0x0d, 0x56, 0x76, 0x8e, 0x69, 0x74, 0x32, 0x3a, 0xd8,
+	0xc3, 0x77, 0x43, 0xfa, 0xe5, 0xbc, 0x73, 0x31, 0xf8, 0xc6, 0x82, 0xd5, 0xa6, 0x1f, 0x2b, 0xce,
+	0xe9, 0x48, 0xcf, 0xd0, 0x91, 0x36, 0xce, 0xce, 0x1c, 0x9c, 0x6d, 0xbe, 0xf6, 0x1c, 0xbe, 0x57,
+	0xc1, 0x13, 0xd5, 0x27, 0x1c, 0x64, 0xe5, 0xd5, 0x13, 0xc1, 0x0c, 0xd6, 0x46, 0x8c, 0x27, 0x29,
+	0xe1, 0x74, 0x74, 0xb0, 0xb7, 0x4b, 0x18, 0xe2, 0xbf, 0x08, 0x1d, 0x9e, 0x2b, 0xf4, 0x1d, 0x9e,
+	0x57, 0x35, 0xda, 0x31, 0xa4, 0xab, 0x4e, 0x81, 0xdd, 0x48, 0x41, 0x2d, 0x7f, 0x4e, 0x43, 0xfe,
+	0x94, 0xd4, 0xb9, 0xb5, 0xd4, 0x5d, 0x07, 0xbf, 0xbd, 0x35, 0x2b, 0xd0, 0x6f, 0x4c, 0x98, 0xaa,
+	0x62, 0x1c, 0x06, 0xd7, 0x60, 0x79, 0x74, 0x9a, 0xde, 0xa7, 0x87, 0xd3, 0x31, 0x82, 0x5b, 0x87,
+	0x6e, 0x5e, 0x60, 0x19, 0x0a, 0x1f, 0x37, 0x54, 0x56, 0x70, 0x07, 0xfa, 0xb5, 0x1b, 0x2b, 0xb0,
+	0xbc, 0x63, 0x34, 0xb0, 0x40, 0xa7, 0x5a, 0x11, 0xcc, 0xa9, 0xe0, 0x03, 0xb8, 0x30, 0x3a, 0x4d,
+	0xf7, 0x4b, 0x12, 0xd1, 0xfd, 0x33, 0xb5, 0x34, 0x3f, 0x13, 0xcd, 0xa0, 0xca, 0x4a, 0x5a, 0x62,
+	0x1e, 0xbd, 0x74, 0x12, 0x94, 0x15, 0x94, 0x70, 0xd1, 0x5c, 0x80, 0x15, 0xff, 0x76, 0x05, 0x2c,
+	0x00, 0x14, 0xcf, 0xfb, 0x5a, 0x84, 0xbc, 0xb0, 0xb2, 0xb1, 0x31, 0x68, 0x59, 0xe6, 0x5a, 0x2e,
+	0xa4, 0x11, 0xdc, 0x10, 0x7b, 0x7e, 0x36, 0xa5, 0xe5, 0x6c, 0xfb, 0x30, 0x41, 0xd4, 0x0b, 0x65,
+	0x2f, 0x78, 0x0f, 0x56, 0x1a, 0xbe, 0xac, 0x58, 0xec, 0xac, 0x13, 0xd4, 0xa9, 0x13, 0xf4, 0xb5,
+	0x05, 0xcb, 0xfa, 0xfd, 0xe7, 0x6e, 0x84, 0x50, 0x93, 0xac, 0x98, 0x72, 0xdd, 0xc3, 0xc2, 0x58,
+	0x58, 0x22, 0xd5, 0xf1, 0xeb, 0xbc, 0xc8, 0xf1, 0xfb, 0x93, 0x05, 0xfd, 0x1a, 0x05, 0x2b, 0x5e,
+	0x1a, 0x8c, 0x01, 0x2c, 0x95, 0xe4, 0x99, 0x08, 0xbd, 0x8c, 0xb0, 0x36, 0x1b, 0x59, 0x71, 0x5b,
+	0x59, 0x79, 0x53, 0x83, 0xef, 0x0a, 0xf0, 0x97, 0x34, 0x78, 0x03, 0xa1, 0x46, 0xfe, 0x8b, 0x05,
+	0x97, 0x47, 0xa7, 0x69, 0x45, 0x0a, 0xcf, 0x0b, 0xaa, 0xf4, 0x41, 0xf4, 0x93, 0x65, 0x68, 0xfe,
+	0x2a, 0xd8, 0x47, 0x54, 0xb6, 0x98, 0x1d, 0xe2, 0xb0, 0xba, 0xcb, 0xd8, 0xc6, 0x5d, 0xa6, 0x3a,
+	0x57, 0x1c, 0xf3, 0x5c, 0xa9, 0x19, 0xba, 0x0d, 0x86, 0x2a, 0xa5, 0xdd, 0xfa, 0xe6, 0xb3, 0x0e,
+	0x5d, 0x7a, 0x56, 0x24, 0x25, 0x55, 0xd7, 0x21, 0x65, 0x89, 0x13, 0x99, 0x94, 0x44, 0x68, 0x44,
+	0x4f, 0x32, 0xd6, 0x76, 0xf0, 0x1b, 0x1e, 0x88, 0xa7, 0x69, 0x23, 0x37, 0xb2, 0x59, 0xe6, 0x5e,
+	0xf2, 0x4c, 0xb1, 0x68, 0x91, 0xb3, 0xcf, 0x93, 0x73, 0x0c, 0x72, 0x2f, 0x4e, 0xc3, 0x07, 0x87,
+	0x9e, 0xd1, 0x48, 0x91, 0x10, 0x63, 0x83, 0x5a, 0x6f, 0x21, 0x35, 0xaf, 0x45, 0xed, 0x47, 0x0b,
+	0xd6, 0x0d, 0x6a, 0xfb, 0x25, 0xc9, 0xd8, 0x11, 0x2d, 0x15, 0xbd, 0xb9, 0x47, 0x4c, 0xf3, 0x7a,
+	0xd7, 0x31, 0x69, 0x0b, 0x48, 0xf6, 0x5c, 0x48, 0x4e, 0x03, 0xd2, 0x6b, 0x00, 0x09, 0x7b, 0x92,
+	0xf0, 0xe3, 0xb8, 0x24, 0xcf, 0x04, 0xd9, 0x5e, 0x68, 0xcc, 0x34, 0x20, 0x77, 0x5b, 0x90, 0xaf,
+	0x8b, 0x6e, 0x78, 0x94, 0x8f, 0xf7, 0xe5, 0x15, 0xa6, 0xbe, 0xda, 0x58, 0x43, 0x1b, 0xf7, 0x90,
+	0x56, 0xf0, 0xab, 0x25, 0xd4, 0x6d, 0x97, 0xe2, 0xd5, 0x48, 0xa8, 0xfa, 0x55, 0xf0, 0x8e, 0xca,
+	0x3c, 0xfd, 0x70, 0x92, 0x47, 0x27, 0x82, 0x94, 0x1d, 0xd6, 0x13, 0xd8, 0x0d, 0x3c, 0x97, 0xcf,
+	0x64, 0x0d, 0x6a, 0x13, 0xdf, 0x53, 0x0d, 0x46, 0xf1, 0x3e, 0x63, 0x8b, 0x03, 0x44, 0x4f, 0xf8,
+	0x37, 0xab, 0xfd, 0x9d, 0x76, 0x43, 0x54, 0x20, 0xab, 0xfb, 0xd6, 0x65, 0x70, 0x23, 0x11, 0x3b,
+	0x57, 0x48, 0xb7, 0x34, 0x30, 0x1c, 0x45, 0x99, 0xa4, 0xa4, 0x9c, 0x3d, 0xa4, 0x33, 0x45, 0xd8,
+	0x98, 0x09, 0xfe, 0xb2, 0x00, 0xe4, 0x72, 0xe2, 0x3e, 0xb9, 0x0e, 0xdd, 0x63, 0x9a, 0x8c, 0x8f,
+	0xb9, 0x22, 0xa1, 0x2c, 0xc1, 0xe0, 0xec, 0x41, 0x16, 0xd3, 0xb3, 0x8a, 0x81, 0x34, 0x0d, 0x55,
+	0xb6, 0x1b, 0xaa, 0xbc, 0x01, 0xbd, 0x49, 0x3e, 0x96, 0xaf, 0x38, 0xe2, 0x95, 0xca, 0x36, 0x55,
+	0xc6, 0x5d, 0x74, 0x99, 0xec, 0x9a, 0x11, 0xaf, 0x2e, 0x93, 0xaa, 0x28, 0x71, 0x8c, 0xb1, 0xa3,
+	0x78, 0x3d, 0x35, 0x1a, 0xab, 0x9e, 0x68, 0xe8, 0x8c, 0xd7, 0xd4, 0x99, 0xe0, 0x09, 0x5c, 0x34,
+	0xd3, 0xc7, 0x0a, 0xff, 0x1a, 0x38, 0x93, 0x7c, 0x2c, 0xf3, 0xbc, 0x7c, 0x77, 0xad, 0x11, 0x67,
+	0x0c, 0x4c, 0x28, 0x1e, 0xb7, 0xa2, 0xd9, 0x39, 0x17, 0xcd, 0x77, 0x45, 0x37, 0xef, 0x52, 0xae,
+	0xee, 0x65, 0xdb, 0x7c, 0xc1, 0x95, 0x05, 0xe7, 0xd8, 0x24, 0xd7, 0x6a, 0x2a, 0xc6, 0xc1, 0x3d,
+	0x01, 0x4b, 0xbd, 0x2a, 0xd2, 0xa1, 0xbd, 0xac, 0xda, 0x6b, 0xfe, 0x9d, 0x2e, 0xf8, 0x02, 0xd6,
+	0xf0, 0x84, 0x9e, 0xa6, 0x85, 0x7a, 0x7f, 0xd1, 0xc6, 0x55, 0x99, 0x74, 0x16, 0x97, 0x89, 0x7d,
+	0x8e, 0x18, 0x01, 0xbf, 0xbd, 0x3c, 0x2b, 0xfc, 0x9b, 0xe0, 0x26, 0x9c, 0xa6, 0x3a, 0x6c, 0x57,
+	0xea, 0xb0, 0x19, 0x24, 0x42, 0xe9, 0xf3, 0x8f, 0xb1, 0xdb, 0x86, 0x2b, 0x32, 0x76, 0xdb, 0x91,
+	0xc0, 0x24, 0x3f, 0x4f, 0x2c, 0x66, 0x81, 0xc1, 0x90, 0xff, 0x39, 0xbc, 0x50, 0x1a, 0xc1, 0xf7,
+	0x1d, 0x58, 0x9f, 0xb7, 0x06, 0x2b, 0xe6, 0x2e, 0x52, 0x17, 0x7b, 0xa7, 0x51, 0xec, 0x57, 0xc1,
+	0x13, 0x1f, 0x45, 0x8c, 0xaa, 0xae, 0x27, 0xfc, 0xdb, 0x00, 0x44, 0xae, 0xfe, 0xf0, 0x40, 0x37,
+	0xe6, 0x8a, 0x62, 0xfe, 0x90, 0xce, 0xc4, 0xbf, 0xa8, 0xd0, 0x70, 0xf1, 0x6f, 0xa9, 0xda, 0x75,
+	0xc5, 0x87, 0x98, 0x57, 0xe7, 0x7f, 0xb5, 0xd9, 0x49, 0x63, 0x55, 0xd6, 0x77, 0xf4, 0x87, 0x9b,
+	0xae, 0xf0, 0xdf, 0x58, 0xfc, 0xb7, 0x40, 0x7d, 0xbb, 0xf1, 0x6f, 0xd7, 0x7f, 0x25, 0x96, 0x9e,
+	0x97, 0x08, 0xed, 0x75, 0xd8, 0x15, 0x1f, 0x9e, 0xde, 0xfe, 0x7b, 0x00, 0x39, 0xc2, 0x85, 0x36,
+	0xa2, 0x12, 0x00, 0x00,
 }
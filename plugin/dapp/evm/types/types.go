@@ -24,6 +24,8 @@ const (
 	TyLogCallContract = 603
 	// TyLogEVMStateChangeItem  合约状态数据变更项日志
 	TyLogEVMStateChangeItem = 604
+	// TyLogEVMEventLog 合约事件日志
+	TyLogEVMEventLog = 605
 
 	// MaxGasLimit  最大Gas消耗上限
 	MaxGasLimit = 10000000
@@ -40,6 +42,16 @@ const (
 	ForkEVMABI = "ForkEVMABI"
 	// ForkEVMFrozen EVM合约用户金额冻结
 	ForkEVMFrozen = "ForkEVMFrozen"
+	// ForkEVMIstanbul EVM支持CREATE2,EXTCODEHASH,CHAINID,SELFBALANCE指令
+	ForkEVMIstanbul = "ForkEVMIstanbul"
+	// ForkEVMEventLog EVM合约事件日志写入交易回执，并在localdb中建立索引
+	ForkEVMEventLog = "ForkEVMEventLog"
+	// ForkEVMAddress EVM预编译合约按地址正确查找，修正栈中数据转换地址时高位未补0的问题
+	ForkEVMAddress = "ForkEVMAddress"
+	// ForkEVMPrecompile EVM支持token等资产操作的预编译合约，依赖ForkEVMAddress
+	ForkEVMPrecompile = "ForkEVMPrecompile"
+	// ForkEVMMultiCall EVM支持在一个交易中按顺序批量调用多个合约
+	ForkEVMMultiCall = "ForkEVMMultiCall"
 )
 
 var (
@@ -58,5 +70,6 @@ var (
 		TyLogContractData:       {Ty: reflect.TypeOf(EVMContractData{}), Name: "LogContractData"},
 		TyLogContractState:      {Ty: reflect.TypeOf(EVMContractState{}), Name: "LogContractState"},
 		TyLogEVMStateChangeItem: {Ty: reflect.TypeOf(EVMStateChangeItem{}), Name: "LogEVMStateChangeItem"},
+		TyLogEVMEventLog:        {Ty: reflect.TypeOf(EVMContractEventLog{}), Name: "LogEVMEventLog"},
 	}
 )
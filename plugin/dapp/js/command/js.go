@@ -30,6 +30,9 @@ func JavaScriptCmd() *cobra.Command {
 		JavaScriptCreateCmd(),
 		JavaScriptCallCmd(),
 		JavaScriptQueryCmd(),
+		JavaScriptUpgradeCmd(),
+		JavaScriptHistoryCmd(),
+		JavaScriptEventsCmd(),
 	)
 	return cmd
 }
@@ -137,6 +140,7 @@ func queryJavaScriptFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("funcname", "f", "", "java script contract funcname")
 	cmd.MarkFlagRequired("funcname")
 	cmd.Flags().StringP("args", "a", "", "json str of args")
+	cmd.Flags().BoolP("estimate", "e", false, "estimate steps and fee of exec funcname")
 }
 
 func queryJavaScript(cmd *cobra.Command, args []string) {
@@ -144,12 +148,14 @@ func queryJavaScript(cmd *cobra.Command, args []string) {
 	name, _ := cmd.Flags().GetString("name")
 	funcname, _ := cmd.Flags().GetString("funcname")
 	input, _ := cmd.Flags().GetString("args")
+	estimate, _ := cmd.Flags().GetBool("estimate")
 	var params rpctypes.Query4Jrpc
 	var rep interface{}
 	req := &jsproto.Call{
 		Name:     name,
 		Funcname: funcname,
 		Args:     input,
+		Estimate: estimate,
 	}
 
 	params.Execer = jsty.JsX
@@ -159,3 +165,133 @@ func queryJavaScript(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, rep)
 	ctx.Run()
 }
+
+// JavaScriptUpgradeCmd :
+func JavaScriptUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "upgrade java script contract",
+		Run:   upgradeJavaScriptContract,
+	}
+	upgradeJavaScriptContractFlags(cmd)
+	return cmd
+}
+
+func upgradeJavaScriptContractFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("code", "c", "", "path of new js file,it must always be in utf-8.")
+	cmd.MarkFlagRequired("code")
+
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("args", "a", "", "json str of migrate args")
+}
+
+func upgradeJavaScriptContract(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	patch, _ := cmd.Flags().GetString("code")
+	name, _ := cmd.Flags().GetString("name")
+	input, _ := cmd.Flags().GetString("args")
+
+	codestr, err := ioutil.ReadFile(patch)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	upgrade := &jsproto.Upgrade{
+		Code: string(codestr),
+		Name: name,
+		Args: input,
+	}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     jsty.JsX,
+		ActionName: "Upgrade",
+		Payload:    types.MustPBToJSON(upgrade),
+	}
+
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// JavaScriptHistoryCmd :
+func JavaScriptHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "list upgrade history of java script contract",
+		Run:   historyJavaScript,
+	}
+	historyJavaScriptFlags(cmd)
+	return cmd
+}
+
+func historyJavaScriptFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("name", "n", "", "java script contract name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().Int32P("count", "c", 20, "count of records")
+	cmd.Flags().StringP("primary", "p", "", "primary key of last page")
+}
+
+func historyJavaScript(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	count, _ := cmd.Flags().GetInt32("count")
+	primary, _ := cmd.Flags().GetString("primary")
+	var params rpctypes.Query4Jrpc
+	req := &jsproto.JsUpgradeHistoryReq{
+		Name:       name,
+		Count:      count,
+		PrimaryKey: primary,
+	}
+
+	params.Execer = jsty.JsX
+	params.FuncName = "GetUpgradeHistory"
+	params.Payload = types.MustPBToJSON(req)
+	var rep jsproto.JsUpgradeHistory
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &rep)
+	ctx.Run()
+}
+
+// JavaScriptEventsCmd :
+func JavaScriptEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "list events of java script contract",
+		Run:   eventsJavaScript,
+	}
+	eventsJavaScriptFlags(cmd)
+	return cmd
+}
+
+func eventsJavaScriptFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("contract", "n", "", "java script contract name")
+	cmd.MarkFlagRequired("contract")
+	cmd.Flags().StringP("event", "e", "", "event name")
+	cmd.Flags().Int32P("count", "c", 20, "count of events")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc, 1: asc")
+	cmd.Flags().StringP("primary", "p", "", "primary key of last page")
+}
+
+func eventsJavaScript(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	contract, _ := cmd.Flags().GetString("contract")
+	event, _ := cmd.Flags().GetString("event")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	primary, _ := cmd.Flags().GetString("primary")
+	var params rpctypes.Query4Jrpc
+	req := &jsproto.JsEventReq{
+		Contract:   contract,
+		Name:       event,
+		Count:      count,
+		Direction:  direction,
+		PrimaryKey: primary,
+	}
+
+	params.Execer = jsty.JsX
+	params.FuncName = "GetEvents"
+	params.Payload = types.MustPBToJSON(req)
+	var rep jsproto.JsEventList
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &rep)
+	ctx.Run()
+}
@@ -0,0 +1,215 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
+	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
+	"github.com/robertkrimen/otto"
+)
+
+/*
+//合约之间的调用和事件
+call_contract(name, funcname, args) {value: result} 调用其他合约的exec函数，被调用合约中context.from为调用者合约的地址，context.origin为交易签名者
+query_contract(name, funcname, args) {value: result} 调用其他合约的query函数
+emit_event(name, fields) 产生一个事件
+*/
+func (u *js) registerCallFunc(vm *otto.Otto, tx *types.Transaction, index int) {
+	u.callContractFunc(vm, tx, index)
+	u.queryContractFunc(vm, tx, index)
+	u.emitEventFunc(vm)
+}
+
+//最外层的调用开始时清空合约调用的状态
+func (u *js) resetCall(prefix string, tx *types.Transaction) {
+	u.meter = u.newStepMeter(prefix, tx)
+	u.callMode = prefix
+	u.callKVs = nil
+	u.callLogs = nil
+	u.callUndo = nil
+}
+
+//只有交易执行时可以修改状态
+func (u *js) isWritable() bool {
+	return u.callMode == "exec" || u.callMode == "init" || u.callMode == "migrate"
+}
+
+//交易执行中调用其他合约的query函数时，只能读取状态数据，本地数据不参与共识
+func (u *js) isLocalReadable() bool {
+	return u.callMode != "statequery"
+}
+
+func getCallArgs(call otto.FunctionCall) (name, funcname, args string, err error) {
+	name, err = call.Argument(0).ToString()
+	if err != nil {
+		return "", "", "", err
+	}
+	funcname, err = call.Argument(1).ToString()
+	if err != nil {
+		return "", "", "", err
+	}
+	args, err = call.Argument(2).ToString()
+	if err != nil {
+		return "", "", "", err
+	}
+	return name, funcname, args, nil
+}
+
+func (u *js) callContractFunc(vm *otto.Otto, tx *types.Transaction, index int) {
+	vm.Set("call_contract", func(call otto.FunctionCall) otto.Value {
+		name, funcname, args, err := getCallArgs(call)
+		if err != nil {
+			return errReturn(vm, err)
+		}
+		if !u.isWritable() {
+			return errReturn(vm, ptypes.ErrJsReadOnly)
+		}
+		result, err := u.callContract(name, funcname, args, tx, index)
+		if err != nil {
+			return errReturn(vm, err)
+		}
+		return okReturn(vm, result)
+	})
+}
+
+func (u *js) queryContractFunc(vm *otto.Otto, tx *types.Transaction, index int) {
+	vm.Set("query_contract", func(call otto.FunctionCall) otto.Value {
+		name, funcname, args, err := getCallArgs(call)
+		if err != nil {
+			return errReturn(vm, err)
+		}
+		result, err := u.queryContract(name, funcname, args, tx, index)
+		if err != nil {
+			return errReturn(vm, err)
+		}
+		return okReturn(vm, result)
+	})
+}
+
+func (u *js) emitEventFunc(vm *otto.Otto) {
+	vm.Set("emit_event", func(call otto.FunctionCall) otto.Value {
+		name, err := call.Argument(0).ToString()
+		if err != nil {
+			return errReturn(vm, err)
+		}
+		data, err := call.Argument(1).ToString()
+		if err != nil {
+			return errReturn(vm, err)
+		}
+		if name == "" {
+			return errReturn(vm, types.ErrInvalidParam)
+		}
+		if !u.isWritable() {
+			return errReturn(vm, ptypes.ErrJsReadOnly)
+		}
+		event := &jsproto.JsEvent{Contract: u.callStack[len(u.callStack)-1], Name: name, Data: data}
+		u.callLogs = append(u.callLogs, &types.ReceiptLog{Ty: ptypes.TyLogJsEvent, Log: types.Encode(event)})
+		return okReturn(vm, "")
+	})
+}
+
+func (u *js) checkCallDepth(name string) error {
+	if len(u.callStack) >= ptypes.JsMaxCallDepth {
+		return ptypes.ErrJsCallDepth
+	}
+	_, err := u.GetStateDB().Get(calcCodeKey(name))
+	if err == types.ErrNotFound {
+		return ptypes.ErrJsCodeNotFound
+	}
+	return err
+}
+
+//调用其他合约的exec函数
+//被调用合约的数据马上写入状态数据库，后面的调用可以读到最新的数据，调用失败时恢复原来的数据
+//数据和日志在最外层的交易执行完成时合并到交易的回执中
+func (u *js) callContract(name, funcname, args string, tx *types.Transaction, index int) (string, error) {
+	err := u.checkCallDepth(name)
+	if err != nil {
+		return "", err
+	}
+	nkvs, nlogs, nundo := len(u.callKVs), len(u.callLogs), len(u.callUndo)
+	result, err := u.innerCallContract(name, funcname, args, tx, index)
+	if err != nil {
+		u.revertCall(nkvs, nlogs, nundo)
+		return "", err
+	}
+	return result, nil
+}
+
+func (u *js) innerCallContract(name, funcname, args string, tx *types.Transaction, index int) (string, error) {
+	jsvalue, err := u.callVM("exec", &jsproto.Call{Name: name, Funcname: funcname, Args: args}, tx, index, nil)
+	if err != nil {
+		return "", err
+	}
+	prefix, _ := calcAllPrefix(name)
+	kvs, logs, err := parseJsReturn(prefix, jsvalue)
+	if err != nil {
+		return "", err
+	}
+	for _, kv := range kvs {
+		prev, err := u.GetStateDB().Get(kv.Key)
+		if err != nil && err != types.ErrNotFound {
+			return "", err
+		}
+		u.callUndo = append(u.callUndo, &types.KeyValue{Key: kv.Key, Value: prev})
+		err = u.GetStateDB().Set(kv.Key, kv.Value)
+		if err != nil {
+			return "", err
+		}
+	}
+	u.callKVs = append(u.callKVs, kvs...)
+	u.callLogs = append(u.callLogs, logs...)
+	return getResult(jsvalue)
+}
+
+//调用失败时恢复状态数据，丢弃被调用合约产生的数据和日志
+func (u *js) revertCall(nkvs, nlogs, nundo int) {
+	for i := len(u.callUndo) - 1; i >= nundo; i-- {
+		u.GetStateDB().Set(u.callUndo[i].Key, u.callUndo[i].Value)
+	}
+	u.callKVs = u.callKVs[:nkvs]
+	u.callLogs = u.callLogs[:nlogs]
+	u.callUndo = u.callUndo[:nundo]
+}
+
+//调用其他合约的query函数，不修改任何数据
+func (u *js) queryContract(name, funcname, args string, tx *types.Transaction, index int) (string, error) {
+	err := u.checkCallDepth(name)
+	if err != nil {
+		return "", err
+	}
+	//query函数中调用的其他合约也不能修改数据
+	mode := u.callMode
+	if u.isWritable() {
+		u.callMode = "statequery"
+	} else if u.isLocalReadable() {
+		u.callMode = "query"
+	}
+	jsvalue, err := u.callVM("query", &jsproto.Call{Name: name, Funcname: funcname, Args: args}, tx, index, nil)
+	u.callMode = mode
+	if err != nil {
+		return "", err
+	}
+	return getString(jsvalue, "result")
+}
+
+//exec函数可以通过 kvc.receipt(result) 返回调用结果
+func getResult(jsvalue *otto.Object) (string, error) {
+	v, err := jsvalue.Get("result")
+	if err != nil {
+		return "", err
+	}
+	if !v.IsDefined() {
+		return "", nil
+	}
+	return v.ToString()
+}
+
+//合并合约调用产生的数据和日志，被调用合约的数据在前
+func (u *js) callReceipt(kvc *dapp.KVCreator, kvs []*types.KeyValue, logs []*types.ReceiptLog) *types.Receipt {
+	kvc.AddListNoPrefix(u.callKVs)
+	kvc.AddListNoPrefix(kvs)
+	logs = append(logs, u.callLogs...)
+	logs = append(logs, u.stepsLog()...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvc.KVList(), Logs: logs}
+}
@@ -0,0 +1,195 @@
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
+	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
+	"github.com/stretchr/testify/assert"
+)
+
+var calleeCode = `
+function Init(context) {
+    this.kvc = new kvcreator("init")
+    return this.kvc.receipt()
+}
+
+Exec.prototype.add = function(args) {
+    var n = this.kvc.get("n") || 0
+    n += args.n
+    if (n > 100) {
+        throw new Error("too large")
+    }
+    this.kvc.add("n", n)
+    emit("Added", {n: n, caller: this.context.caller})
+    return this.kvc.receipt({n: n})
+}
+
+Exec.prototype.whoami = function(args) {
+    this.kvc.add("from", this.context.from)
+    this.kvc.add("origin", this.context.origin)
+    return this.kvc.receipt()
+}
+
+Exec.prototype.recurse = function(args) {
+    callContract("callee", "recurse", {})
+    return this.kvc.receipt()
+}
+
+Query.prototype.double = function(args) {
+    return "" + args.x * 2
+}
+
+Query.prototype.local = function(args) {
+    var v = getlocaldb("n")
+    if (v.err) {
+        throw new Error(v.err)
+    }
+    return v.value
+}
+`
+
+var callerCode = `
+function Init(context) {
+    this.kvc = new kvcreator("init")
+    return this.kvc.receipt()
+}
+
+Exec.prototype.deposit = function(args) {
+    callContract("callee", "add", {n: args.n})
+    var ret = callContract("callee", "add", {n: args.n})
+    this.kvc.add("last", ret.n)
+    emit("Deposit", {n: ret.n})
+    return this.kvc.receipt()
+}
+
+Exec.prototype.tryadd = function(args) {
+    try {
+        callContract("callee", "add", {n: args.n})
+    } catch (e) {
+        this.kvc.add("failed", "true")
+    }
+    return this.kvc.receipt()
+}
+
+Exec.prototype.whoami = function(args) {
+    callContract("callee", "whoami", {})
+    return this.kvc.receipt()
+}
+
+Exec.prototype.double = function(args) {
+    this.kvc.add("double", queryContract("callee", "double", {x: 21}))
+    return this.kvc.receipt()
+}
+
+Exec.prototype.readlocal = function(args) {
+    this.kvc.add("local", queryContract("callee", "local", {}))
+    return this.kvc.receipt()
+}
+
+ExecLocal.prototype.deposit = function(args) {
+    return this.kvc.receipt()
+}
+
+ExecLocal.prototype.tryadd = function(args) {
+    callContract("callee", "add", {n: 1})
+    return this.kvc.receipt()
+}
+`
+
+func TestCallContract(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	e := initExec(ldb, kvdb, jscode, t)
+	e.SetEnv(types.GetDappFork(ptypes.JsX, ptypes.ForkJsCallContract), 0, 1)
+	for name, code := range map[string]string{"callee": calleeCode, "caller": callerCode} {
+		c, tx := createCodeTx(name, code)
+		tx.Fee = 100000
+		_, err := e.Exec_Create(c, tx, 0)
+		assert.Nil(t, err)
+	}
+	call := func(f, args string) (*jsproto.Call, *types.Transaction, *types.Receipt, error) {
+		c, tx := callCodeTx("caller", f, args)
+		tx.Fee = 1000000
+		receipt, err := e.Exec_Call(c, tx, 1)
+		return c, tx, receipt, err
+	}
+	value := func(name, key string) string {
+		prefix, _ := calcAllPrefix(name)
+		v, err := kvdb.Get(append(prefix, []byte(key)...))
+		assert.Nil(t, err)
+		return string(v)
+	}
+	events := func(contract, name string) []*jsproto.JsEventRecord {
+		msg, err := e.Query_GetEvents(&jsproto.JsEventReq{Contract: contract, Name: name})
+		assert.Nil(t, err)
+		return msg.(*jsproto.JsEventList).Events
+	}
+
+	//被调用合约的数据和事件合并到交易的回执中，第二次调用可以读到第一次调用的数据
+	depositCall, depositTx, deposit, err := call("deposit", `{"n":5}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "10", value("callee", "n"))
+	assert.Equal(t, "10", value("caller", "last"))
+	var eventLogs int
+	for _, item := range deposit.Logs {
+		if item.Ty == ptypes.TyLogJsEvent {
+			eventLogs++
+		}
+	}
+	assert.Equal(t, 3, eventLogs)
+	kvset, err := e.ExecLocal_Call(depositCall, depositTx, &types.ReceiptData{Ty: deposit.Ty, Logs: deposit.Logs}, 1)
+	assert.Nil(t, err)
+	util.SaveKVList(ldb, kvset.KV)
+	added := events("callee", "Added")
+	assert.Equal(t, 2, len(added))
+	assert.Equal(t, `{"caller":"caller","n":10}`, added[0].Data)
+	assert.Equal(t, 1, len(events("caller", "")))
+
+	//被调用合约失败时恢复数据，调用者可以捕获错误
+	_, _, receipt, err := call("tryadd", `{"n":1000}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "10", value("callee", "n"))
+	assert.Equal(t, "true", value("caller", "failed"))
+	for _, item := range receipt.Logs {
+		assert.NotEqual(t, int32(ptypes.TyLogJsEvent), item.Ty)
+	}
+
+	//只读调用
+	_, _, _, err = call("double", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "42", value("caller", "double"))
+
+	//交易执行中调用的query函数不能读取本地数据
+	_, _, _, err = call("readlocal", "")
+	assert.True(t, strings.Contains(err.Error(), ptypes.ErrJsLocalDB.Error()))
+
+	//被调用合约看到的from是调用者合约的地址，交易签名者在origin中
+	_, whoamiTx, _, err := call("whoami", "")
+	assert.Nil(t, err)
+	assert.Equal(t, address.ExecAddress(types.ExecName("user.jsvm.caller")), value("callee", "from"))
+	assert.Equal(t, whoamiTx.From(), value("callee", "origin"))
+	assert.NotEqual(t, whoamiTx.From(), value("callee", "from"))
+
+	//调用深度限制
+	c, tx := callCodeTx("callee", "recurse", "")
+	tx.Fee = 1000000
+	_, err = e.Exec_Call(c, tx, 1)
+	assert.True(t, strings.Contains(err.Error(), ptypes.ErrJsCallDepth.Error()))
+
+	//本地执行时不能修改状态
+	c, tx = callCodeTx("caller", "tryadd", "")
+	_, err = e.ExecLocal_Call(c, tx, &types.ReceiptData{}, 1)
+	assert.True(t, strings.Contains(err.Error(), ptypes.ErrJsReadOnly.Error()))
+
+	//回滚时删除事件
+	kvset, err = e.ExecDelLocal_Call(depositCall, depositTx, &types.ReceiptData{Ty: deposit.Ty, Logs: deposit.Logs}, 1)
+	assert.Nil(t, err)
+	util.SaveKVList(ldb, kvset.KV)
+	assert.Equal(t, 0, len(events("callee", "Added")))
+}
@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
@@ -41,6 +42,12 @@ func (c *js) Exec_Create(payload *jsproto.Create, tx *types.Transaction, index i
 		return nil, ptypes.ErrDupName
 	}
 	kvc.AddNoPrefix(calcCodeKey(payload.Name), []byte(payload.Code))
+	//分叉之后记录合约的创建者，用于合约升级时的权限检查
+	if types.IsDappFork(c.GetHeight(), ptypes.JsX, ptypes.ForkJsUpgrade) {
+		info := &jsproto.JsContractInfo{Name: payload.Name, Creator: tx.From(), Version: 1}
+		kvc.AddNoPrefix(calcInfoKey(payload.Name), types.Encode(info))
+		kvc.AddNoPrefix(calcCodeVersionKey(payload.Name, info.Version), []byte(payload.Code))
+	}
 	jsvalue, err := c.callVM("init", &jsproto.Call{Name: payload.Name}, tx, index, nil)
 	if err != nil {
 		return nil, err
@@ -49,9 +56,7 @@ func (c *js) Exec_Create(payload *jsproto.Create, tx *types.Transaction, index i
 	if err != nil {
 		return nil, err
 	}
-	kvc.AddListNoPrefix(kvs)
-	r := &types.Receipt{Ty: types.ExecOk, KV: kvc.KVList(), Logs: logs}
-	return r, nil
+	return c.callReceipt(kvc, kvs, logs), nil
 }
 
 func (c *js) Exec_Call(payload *jsproto.Call, tx *types.Transaction, index int) (*types.Receipt, error) {
@@ -69,7 +74,76 @@ func (c *js) Exec_Call(payload *jsproto.Call, tx *types.Transaction, index int)
 	if err != nil {
 		return nil, err
 	}
-	kvc.AddListNoPrefix(kvs)
-	r := &types.Receipt{Ty: types.ExecOk, KV: kvc.KVList(), Logs: logs}
-	return r, nil
+	return c.callReceipt(kvc, kvs, logs), nil
+}
+
+func (c *js) Exec_Upgrade(payload *jsproto.Upgrade, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(c.GetHeight(), ptypes.JsX, ptypes.ForkJsUpgrade) {
+		return nil, types.ErrActionNotSupport
+	}
+	if !c.checkTxExec(string(tx.Execer), ptypes.JsX) {
+		return nil, types.ErrExecNameNotMatch
+	}
+	execer := c.userExecName(payload.Name, false)
+	c.prefix = types.CalcStatePrefix([]byte(execer))
+	kvc := dapp.NewKVCreator(c.GetStateDB(), c.prefix, nil)
+	oldcode, err := kvc.GetNoPrefix(calcCodeKey(payload.Name))
+	if err == types.ErrNotFound {
+		return nil, ptypes.ErrJsCodeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.getContractInfo(kvc, payload.Name)
+	if err != nil {
+		return nil, err
+	}
+	//合约创建者或者管理员可以升级合约
+	if info.Creator != tx.From() && checkPriv(tx.From(), ptypes.JsUpgrader, c.GetStateDB()) != nil {
+		return nil, ptypes.ErrJsUpgrader
+	}
+	//分叉之前创建的合约没有保存版本代码，升级时补上
+	if info.Version == 0 {
+		info.Version = 1
+		kvc.AddNoPrefix(calcCodeVersionKey(payload.Name, info.Version), oldcode)
+	}
+	info.Version++
+	kvc.AddNoPrefix(calcInfoKey(payload.Name), types.Encode(info))
+	kvc.AddNoPrefix(calcCodeKey(payload.Name), []byte(payload.Code))
+	kvc.AddNoPrefix(calcCodeVersionKey(payload.Name, info.Version), []byte(payload.Code))
+
+	//用新的代码执行数据迁移，合约没有定义Migrate函数时不做任何处理
+	jsvalue, err := c.callVM("migrate", &jsproto.Call{Name: payload.Name, Args: payload.Args}, tx, index, nil)
+	if err != nil {
+		return nil, err
+	}
+	kvs, logs, err := parseJsReturn(c.prefix, jsvalue)
+	if err != nil {
+		return nil, err
+	}
+	log := &jsproto.JsUpgradeLog{
+		Name:     payload.Name,
+		Version:  info.Version,
+		Operator: tx.From(),
+		CodeHash: common.ToHex(common.Sha256([]byte(payload.Code))),
+	}
+	logs = append(logs, &types.ReceiptLog{Ty: ptypes.TyLogJsUpgrade, Log: types.Encode(log)})
+	return c.callReceipt(kvc, kvs, logs), nil
+}
+
+//读取合约信息，分叉之前创建的合约没有记录，版本号为0
+func (c *js) getContractInfo(kvc *dapp.KVCreator, name string) (*jsproto.JsContractInfo, error) {
+	data, err := kvc.GetNoPrefix(calcInfoKey(name))
+	if err == types.ErrNotFound {
+		return &jsproto.JsContractInfo{Name: name}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var info jsproto.JsContractInfo
+	err = types.Decode(data, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
 }
@@ -2,11 +2,16 @@ package executor
 
 import (
 	"github.com/33cn/chain33/types"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
 	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
 )
 
 func (c *js) ExecDelLocal_Create(payload *jsproto.Create, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	return &types.LocalDBSet{}, nil
+	kvs, err := c.eventKVs(tx, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
 }
 
 func (c *js) ExecDelLocal_Call(payload *jsproto.Call, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
@@ -17,6 +22,31 @@ func (c *js) ExecDelLocal_Call(payload *jsproto.Call, tx *types.Transaction, rec
 	if err != nil {
 		return nil, err
 	}
-	r.KV = kvs
+	events, err := c.eventKVs(tx, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	r.KV = append(kvs, events...)
+	return r, nil
+}
+
+func (c *js) ExecDelLocal_Upgrade(payload *jsproto.Upgrade, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	r := &types.LocalDBSet{}
+	for _, item := range receiptData.Logs {
+		if item.Ty != ptypes.TyLogJsUpgrade {
+			continue
+		}
+		var log jsproto.JsUpgradeLog
+		err := types.Decode(item.Log, &log)
+		if err != nil {
+			return nil, err
+		}
+		r.KV = append(r.KV, &types.KeyValue{Key: calcUpgradeKey(log.Name, log.Version)})
+	}
+	events, err := c.eventKVs(tx, receiptData, index, true)
+	if err != nil {
+		return nil, err
+	}
+	r.KV = append(r.KV, events...)
 	return r, nil
 }
@@ -1,12 +1,18 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
 	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
 )
 
 func (c *js) ExecLocal_Create(payload *jsproto.Create, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	return &types.LocalDBSet{}, nil
+	kvs, err := c.eventKVs(tx, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
 }
 
 func (c *js) ExecLocal_Call(payload *jsproto.Call, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
@@ -22,5 +28,71 @@ func (c *js) ExecLocal_Call(payload *jsproto.Call, tx *types.Transaction, receip
 	}
 	r := &types.LocalDBSet{}
 	r.KV = c.AddRollbackKV(tx, []byte(execer), kvs)
+	events, err := c.eventKVs(tx, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	r.KV = append(r.KV, events...)
 	return r, nil
 }
+
+func (c *js) ExecLocal_Upgrade(payload *jsproto.Upgrade, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	r := &types.LocalDBSet{}
+	for _, item := range receiptData.Logs {
+		if item.Ty != ptypes.TyLogJsUpgrade {
+			continue
+		}
+		var log jsproto.JsUpgradeLog
+		err := types.Decode(item.Log, &log)
+		if err != nil {
+			return nil, err
+		}
+		record := &jsproto.JsUpgradeRecord{
+			Name:      log.Name,
+			Version:   log.Version,
+			Operator:  log.Operator,
+			CodeHash:  log.CodeHash,
+			TxHash:    common.ToHex(tx.Hash()),
+			Height:    c.GetHeight(),
+			BlockTime: c.GetBlockTime(),
+		}
+		r.KV = append(r.KV, &types.KeyValue{Key: calcUpgradeKey(log.Name, log.Version), Value: types.Encode(record)})
+	}
+	events, err := c.eventKVs(tx, receiptData, index, false)
+	if err != nil {
+		return nil, err
+	}
+	r.KV = append(r.KV, events...)
+	return r, nil
+}
+
+//按合约和事件名称保存合约产生的事件，回滚时删除
+func (c *js) eventKVs(tx *types.Transaction, receiptData *types.ReceiptData, index int, del bool) ([]*types.KeyValue, error) {
+	var kvs []*types.KeyValue
+	for i, item := range receiptData.Logs {
+		if item.Ty != ptypes.TyLogJsEvent {
+			continue
+		}
+		var event jsproto.JsEvent
+		err := types.Decode(item.Log, &event)
+		if err != nil {
+			return nil, err
+		}
+		key := calcEventKey(event.Contract, event.Name, c.GetHeight(), int64(index), i)
+		if del {
+			kvs = append(kvs, &types.KeyValue{Key: key})
+			continue
+		}
+		record := &jsproto.JsEventRecord{
+			Contract: event.Contract,
+			Name:     event.Name,
+			Data:     event.Data,
+			TxHash:   common.ToHex(tx.Hash()),
+			Height:   c.GetHeight(),
+			Index:    int64(index),
+			Seq:      int32(i),
+		}
+		kvs = append(kvs, &types.KeyValue{Key: key, Value: types.Encode(record)})
+	}
+	return kvs, nil
+}
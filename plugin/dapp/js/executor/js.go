@@ -7,6 +7,7 @@ import (
 	"sync/atomic"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
@@ -53,6 +54,14 @@ type js struct {
 	prefix            []byte
 	globalTableHandle sync.Map
 	globalHanldeID    int64
+	usedSteps         int64
+	meter             *stepMeter
+	//合约之间调用的状态，见call.go
+	callStack []string
+	callMode  string
+	callKVs   []*types.KeyValue
+	callLogs  []*types.ReceiptLog
+	callUndo  []*types.KeyValue
 }
 
 func newjs() drivers.Driver {
@@ -89,6 +98,11 @@ func (u *js) IsFriend(myexec, writekey []byte, othertx *types.Transaction) bool
 			return true
 		}
 	}
+	//合约之间调用时，调用者合约的交易可以修改被调用合约的状态数据
+	contractPrefix := []byte("user." + ptypes.JsX + ".")
+	if bytes.HasPrefix(exec, contractPrefix) && bytes.HasPrefix(types.GetParaExec(myexec), contractPrefix) {
+		return bytes.HasPrefix(writekey, []byte("mavl-"+string(myexec)+"-"))
+	}
 	return false
 }
 
@@ -107,19 +121,32 @@ func (u *js) callVM(prefix string, payload *jsproto.Call, tx *types.Transaction,
 	if err != nil {
 		return nil, err
 	}
+	//最外层的调用创建步数计数，合约之间的调用共用这个计数
+	if len(u.callStack) == 0 {
+		u.resetCall(prefix, tx)
+		defer func() {
+			if u.meter != nil {
+				u.usedSteps = u.meter.steps
+			}
+		}()
+	}
+	u.callStack = append(u.callStack, payload.Name)
+	defer func() {
+		u.callStack = u.callStack[:len(u.callStack)-1]
+	}()
 	vm, err := u.createVM(payload.Name, tx, index)
 	if err != nil {
 		return nil, err
 	}
 	vm.Set("loglist", loglist)
-	if prefix == "init" {
-		vm.Set("f", "init")
+	if prefix == "init" || prefix == "migrate" {
+		vm.Set("f", prefix)
 	} else {
 		vm.Set("f", prefix+"_"+payload.Funcname)
 	}
 	vm.Set("args", payload.Args)
 	callfunc := "callcode(context, f, args, loglist)"
-	jsvalue, err := vm.Run(callfunc)
+	jsvalue, err := runWithMeter(vm, callfunc, u.meter)
 	//除非你知道怎么做，不要返回这样的操作，这会引起整个区块执行失败，从而引起严重的安全问题。
 	//要保证不能人工的创造这样的条件，也就是调用接口的输入，不能用户可以任意修改的。
 	if u.GetExecutorAPI().IsErr() {
@@ -177,6 +204,15 @@ func (u *js) getContext(tx *types.Transaction, index int64) *blockContext {
 	if tx != nil {
 		copy(hash[:], tx.Hash())
 	}
+	//被其他合约调用时，from为调用者合约的地址，origin始终是交易的签名者
+	//避免被调用合约把调用者合约的操作当成用户本人的操作
+	var caller string
+	origin := tx.From()
+	from := origin
+	if len(u.callStack) > 1 {
+		caller = u.callStack[len(u.callStack)-2]
+		from = address.ExecAddress(u.userExecName(caller, true))
+	}
 	return &blockContext{
 		Height:     u.GetHeight(),
 		Name:       u.GetName(),
@@ -186,7 +222,9 @@ func (u *js) getContext(tx *types.Transaction, index int64) *blockContext {
 		Difficulty: u.GetDifficulty(),
 		TxHash:     common.ToHex(hash[:]),
 		Index:      index,
-		From:       tx.From(),
+		From:       from,
+		Caller:     caller,
+		Origin:     origin,
 	}
 }
 
@@ -226,6 +264,9 @@ func (u *js) localdbFunc(vm *otto.Otto, name string) {
 		if !hasprefix {
 			key = string(prefix) + key
 		}
+		if !u.isLocalReadable() {
+			return errReturn(vm, ptypes.ErrJsLocalDB)
+		}
 		v, err := u.getlocaldb(key)
 		if err != nil {
 			return errReturn(vm, err)
@@ -276,6 +317,9 @@ func (u *js) listdbFunc(vm *otto.Otto, name string) {
 		if err != nil {
 			return errReturn(vm, err)
 		}
+		if !u.isLocalReadable() {
+			return errReturn(vm, ptypes.ErrJsLocalDB)
+		}
 		v, err := u.listdb(string(plocal)+prefix, key, int32(count), int32(direction))
 		if err != nil {
 			return errReturn(vm, err)
@@ -289,18 +333,24 @@ func (u *js) createVM(name string, tx *types.Transaction, index int) (*otto.Otto
 	if err != nil {
 		return nil, err
 	}
+	code, err := u.GetStateDB().Get(calcCodeKey(name))
+	if err != nil {
+		return nil, err
+	}
+	//按合约名称和代码哈希缓存，合约升级或者回滚之后代码变化，不会用到旧代码的虚拟机
+	cachekey := name + "-" + string(common.Sha256(code))
 	var vm *otto.Otto
-	if vmitem, ok := codecache.Get(name); ok {
+	if vmitem, ok := codecache.Get(cachekey); ok {
 		vm = vmitem.(*otto.Otto).Copy()
 	} else {
-		code, err := u.GetStateDB().Get(calcCodeKey(name))
-		if err != nil {
-			return nil, err
-		}
 		//cache 合约代码部分，不会cache 具体执行
+		//是否命中cache每个节点不一样，所以加载代码的步数不计入交易，只检查固定的上限
 		cachevm := basevm.Copy()
-		cachevm.Run(code)
-		codecache.Add(name, cachevm)
+		_, err = runWithMeter(cachevm, code, u.newStepMeter("load", nil))
+		if err == ptypes.ErrJsOutOfGas {
+			return nil, err
+		}
+		codecache.Add(cachekey, cachevm)
 		vm = cachevm.Copy()
 	}
 	vm.Set("context", string(data))
@@ -311,6 +361,9 @@ func (u *js) createVM(name string, tx *types.Transaction, index int) (*otto.Otto
 	u.randnumFunc(vm, name)
 	u.registerAccountFunc(vm)
 	u.registerTableFunc(vm, name)
+	if types.IsDappFork(u.GetHeight(), ptypes.JsX, ptypes.ForkJsCallContract) {
+		u.registerCallFunc(vm, tx, index)
+	}
 	return vm, nil
 }
 
@@ -23,6 +23,8 @@ type blockContext struct {
 	TxHash     string `json:"txhash"`
 	Index      int64  `json:"index"`
 	From       string `json:"from"`
+	Caller     string `json:"caller,omitempty"`
+	Origin     string `json:"origin"`
 }
 
 func parseJsReturn(prefix []byte, jsvalue *otto.Object) (kvlist []*types.KeyValue, logs []*types.ReceiptLog, err error) {
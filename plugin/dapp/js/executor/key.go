@@ -1,6 +1,8 @@
 package executor
 
 import (
+	"fmt"
+
 	"github.com/33cn/chain33/types"
 	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
 )
@@ -15,3 +17,34 @@ func calcAllPrefix(name string) ([]byte, []byte) {
 func calcCodeKey(name string) []byte {
 	return append([]byte("mavl-"+ptypes.JsX+"-code-"), []byte(name)...)
 }
+
+//合约的创建者和当前版本
+func calcInfoKey(name string) []byte {
+	return append([]byte("mavl-"+ptypes.JsX+"-info-"), []byte(name)...)
+}
+
+//每个版本的合约代码
+func calcCodeVersionKey(name string, version int64) []byte {
+	return []byte(fmt.Sprintf("mavl-%s-version-%s-%012d", ptypes.JsX, name, version))
+}
+
+//合约升级记录，按版本排序
+func calcUpgradePrefix(name string) []byte {
+	return []byte("LODB-" + ptypes.JsX + "-upgrade-" + name + "-")
+}
+
+func calcUpgradeKey(name string, version int64) []byte {
+	return append(calcUpgradePrefix(name), []byte(fmt.Sprintf("%012d", version))...)
+}
+
+//合约事件，按合约和事件名称分类，按交易在链上的位置排序
+func calcEventPrefix(contract, name string) []byte {
+	if name == "" {
+		return []byte("LODB-" + ptypes.JsX + "-event-" + contract + "-")
+	}
+	return []byte("LODB-" + ptypes.JsX + "-event-" + contract + "-" + name + "-")
+}
+
+func calcEventKey(contract, name string, height, index int64, seq int) []byte {
+	return append(calcEventPrefix(contract, name), []byte(fmt.Sprintf("%012d-%06d-%04d", height, index, seq))...)
+}
@@ -0,0 +1,71 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
+	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
+	"github.com/robertkrimen/otto"
+)
+
+//执行步数超过限制时中断虚拟机
+type outOfGas struct{}
+
+//stepMeter 记录执行步数，合约之间调用时共用一个计数
+type stepMeter struct {
+	limit int64
+	steps int64
+}
+
+//runWithMeter 限制执行步数运行代码，meter为空时不做限制
+//虚拟机在计算每个语句和表达式之前，都会从Interrupt中非阻塞的取出一个函数执行，
+//这个函数计数之后马上放回channel，所以每个语句和表达式都计为一步，在所有节点上都是一样的
+func runWithMeter(vm *otto.Otto, src interface{}, meter *stepMeter) (value otto.Value, err error) {
+	if meter == nil {
+		return vm.Run(src)
+	}
+	vm.Interrupt = make(chan func(), 1)
+	defer func() {
+		vm.Interrupt = nil
+		if r := recover(); r != nil {
+			if _, ok := r.(outOfGas); !ok {
+				panic(r)
+			}
+			err = ptypes.ErrJsOutOfGas
+		}
+	}()
+	var step func()
+	step = func() {
+		meter.steps++
+		vm.Interrupt <- step
+		if meter.steps > meter.limit {
+			panic(outOfGas{})
+		}
+	}
+	vm.Interrupt <- step
+	return vm.Run(src)
+}
+
+//分叉之后限制合约的执行步数
+//交易执行时按照手续费计算，本地执行、查询和加载合约代码使用固定的上限
+func (u *js) newStepMeter(prefix string, tx *types.Transaction) *stepMeter {
+	if !types.IsDappFork(u.GetHeight(), ptypes.JsX, ptypes.ForkJsMetering) {
+		return nil
+	}
+	if tx == nil || prefix == "execlocal" || prefix == "query" {
+		return &stepMeter{limit: ptypes.JsMaxSteps}
+	}
+	limit := tx.Fee / ptypes.JsStepFee
+	if limit > ptypes.JsMaxSteps {
+		limit = ptypes.JsMaxSteps
+	}
+	return &stepMeter{limit: limit}
+}
+
+//交易执行使用的步数
+func (u *js) stepsLog() []*types.ReceiptLog {
+	if u.usedSteps == 0 {
+		return nil
+	}
+	log := &jsproto.JsLog{Steps: u.usedSteps}
+	return []*types.ReceiptLog{{Ty: ptypes.TyLogJsSteps, Log: types.Encode(log)}}
+}
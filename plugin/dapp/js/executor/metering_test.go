@@ -0,0 +1,88 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
+	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
+	"github.com/stretchr/testify/assert"
+)
+
+var meteringCode = `
+function Init(context) {
+    this.kvc = new kvcreator("init")
+    return this.kvc.receipt()
+}
+
+Exec.prototype.count = function(args) {
+    var n = 0
+    for (var i = 0; i < args.n; i++) {
+        n++
+    }
+    this.kvc.add("n", n)
+    return this.kvc.receipt()
+}
+
+Exec.prototype.loop = function(args) {
+    try {
+        while (true) {}
+    } catch (e) {
+        return this.kvc.receipt()
+    }
+}
+`
+
+func TestMetering(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	e := initExec(ldb, kvdb, jscode, t)
+	e.SetEnv(types.GetDappFork(ptypes.JsX, ptypes.ForkJsMetering), 0, 1)
+	create, tx := createCodeTx("metering", meteringCode)
+	tx.Fee = 100000
+	_, err := e.Exec_Create(create, tx, 0)
+	assert.Nil(t, err)
+
+	call := func(f, args string, fee int64) (int64, error) {
+		c, tx := callCodeTx("metering", f, args)
+		tx.Fee = fee
+		receipt, err := e.Exec_Call(c, tx, 0)
+		if err != nil {
+			return 0, err
+		}
+		last := receipt.Logs[len(receipt.Logs)-1]
+		assert.Equal(t, int32(ptypes.TyLogJsSteps), last.Ty)
+		var log jsproto.JsLog
+		assert.Nil(t, types.Decode(last.Log, &log))
+		return log.Steps, nil
+	}
+
+	//相同的调用在每次执行时使用的步数都一样
+	steps10, err := call("count", `{"n":10}`, 100000)
+	assert.Nil(t, err)
+	assert.True(t, steps10 > 0)
+	steps, err := call("count", `{"n":10}`, 100000)
+	assert.Nil(t, err)
+	assert.Equal(t, steps10, steps)
+	steps20, err := call("count", `{"n":20}`, 100000)
+	assert.Nil(t, err)
+	assert.True(t, steps20 > steps10)
+
+	//手续费不足以支付执行步数
+	_, err = call("count", `{"n":10}`, steps10-1)
+	assert.Equal(t, ptypes.ErrJsOutOfGas, err)
+	_, err = call("count", `{"n":10}`, steps10)
+	assert.Nil(t, err)
+
+	//死循环在超出步数时中断，合约中的try catch不能捕获
+	_, err = call("loop", "", 100000)
+	assert.Equal(t, ptypes.ErrJsOutOfGas, err)
+
+	//估算模式返回执行使用的步数和手续费
+	msg, err := e.Query_Query(&jsproto.Call{Name: "metering", Funcname: "count", Args: `{"n":10}`, Estimate: true})
+	assert.Nil(t, err)
+	assert.Equal(t, steps10, msg.(*jsproto.QueryResult).Steps)
+	assert.Equal(t, steps10*ptypes.JsStepFee, msg.(*jsproto.QueryResult).Fee)
+}
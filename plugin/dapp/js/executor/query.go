@@ -1,13 +1,19 @@
 package executor
 
 import (
+	"bytes"
 	"fmt"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
 	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
 )
 
 func (c *js) Query_Query(payload *jsproto.Call) (types.Message, error) {
+	if payload.Estimate {
+		return c.estimate(payload)
+	}
 	execer := c.userExecName(payload.Name, true)
 	c.prefix = types.CalcLocalPrefix([]byte(execer))
 	jsvalue, err := c.callVM("query", payload, nil, 0, nil)
@@ -20,5 +26,108 @@ func (c *js) Query_Query(payload *jsproto.Call) (types.Message, error) {
 		fmt.Println("result", err)
 		return nil, err
 	}
-	return &jsproto.QueryResult{Data: str}, nil
+	return &jsproto.QueryResult{Data: str, Steps: c.usedSteps}, nil
+}
+
+//估算模式，在最新的状态上执行exec函数，返回使用的步数和需要的手续费
+func (c *js) estimate(payload *jsproto.Call) (types.Message, error) {
+	execer := c.userExecName(payload.Name, false)
+	c.prefix = types.CalcStatePrefix([]byte(execer))
+	_, err := c.callVM("exec", payload, nil, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &jsproto.QueryResult{Steps: c.usedSteps, Fee: c.usedSteps * ptypes.JsStepFee}, nil
+}
+
+const (
+	//列表查询单次默认和最多返回的条数
+	defaultListCount = 20
+	maxListCount     = 100
+)
+
+//Query_GetUpgradeHistory 按版本从小到大列出合约的升级记录
+func (c *js) Query_GetUpgradeHistory(payload *jsproto.JsUpgradeHistoryReq) (types.Message, error) {
+	if payload.Name == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := payload.Count
+	if count <= 0 {
+		count = defaultListCount
+	}
+	if count > maxListCount {
+		count = maxListCount
+	}
+	prefix := calcUpgradePrefix(payload.Name)
+	var key []byte
+	if payload.PrimaryKey != "" {
+		if !bytes.HasPrefix([]byte(payload.PrimaryKey), prefix) {
+			return nil, types.ErrInvalidParam
+		}
+		key = []byte(payload.PrimaryKey)
+	}
+	values, err := c.GetLocalDB().List(prefix, key, count, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	resp := &jsproto.JsUpgradeHistory{}
+	for i, value := range values {
+		var record jsproto.JsUpgradeRecord
+		err = types.Decode(value, &record)
+		if err != nil {
+			return nil, err
+		}
+		if i == int(count)-1 {
+			resp.PrimaryKey = string(calcUpgradeKey(record.Name, record.Version))
+		}
+		//名称是其他合约名称的前缀时，会列出其他合约的记录
+		if record.Name != payload.Name {
+			continue
+		}
+		resp.Records = append(resp.Records, &record)
+	}
+	return resp, nil
+}
+
+//Query_GetEvents 按合约和事件名称查询合约产生的事件，事件名称为空时查询合约的全部事件
+func (c *js) Query_GetEvents(payload *jsproto.JsEventReq) (types.Message, error) {
+	if payload.Contract == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := payload.Count
+	if count <= 0 {
+		count = defaultListCount
+	}
+	if count > maxListCount {
+		count = maxListCount
+	}
+	prefix := calcEventPrefix(payload.Contract, payload.Name)
+	var key []byte
+	if payload.PrimaryKey != "" {
+		if !bytes.HasPrefix([]byte(payload.PrimaryKey), prefix) {
+			return nil, types.ErrInvalidParam
+		}
+		key = []byte(payload.PrimaryKey)
+	}
+	values, err := c.GetLocalDB().List(prefix, key, count, payload.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	resp := &jsproto.JsEventList{}
+	for i, value := range values {
+		var record jsproto.JsEventRecord
+		err = types.Decode(value, &record)
+		if err != nil {
+			return nil, err
+		}
+		if i == int(count)-1 {
+			resp.PrimaryKey = string(calcEventKey(record.Contract, record.Name, record.Height, record.Index, int(record.Seq)))
+		}
+		//名称是其他合约或者事件名称的前缀时，会列出其他的事件
+		if record.Contract != payload.Contract || (payload.Name != "" && record.Name != payload.Name) {
+			continue
+		}
+		resp.Events = append(resp.Events, &record)
+	}
+	return resp, nil
 }
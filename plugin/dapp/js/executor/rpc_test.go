@@ -294,6 +294,74 @@ func TestJsGame(t *testing.T) {
 	t.Log(queryresult.Data)
 }
 
+//合约之间调用，被调用合约的数据由调用者合约的交易写入，区块执行时需要通过写权限检查
+func TestJsVMCallContract(t *testing.T) {
+	mocker := testnode.New("--free--", nil)
+	defer mocker.Close()
+	mocker.Listen()
+	err := mocker.SendHot()
+	assert.Nil(t, err)
+	configCreator(mocker, t)
+
+	send := func(execer, action string, payload types.Message) *rpctypes.TransactionDetail {
+		req := &rpctypes.CreateTxIn{
+			Execer:     execer,
+			ActionName: action,
+			Payload:    types.MustPBToJSON(payload),
+		}
+		var txhex string
+		err := mocker.GetJSONC().Call("Chain33.CreateTransaction", req, &txhex)
+		assert.Nil(t, err)
+		hash, err := mocker.SendAndSign(mocker.GetHotKey(), txhex)
+		assert.Nil(t, err)
+		txinfo, err := mocker.WaitTx(hash)
+		assert.Nil(t, err)
+		return txinfo
+	}
+	code := map[string]string{
+		"nodecallee": `
+function Init(context) {
+    this.kvc = new kvcreator("init")
+    return this.kvc.receipt()
+}
+
+Exec.prototype.add = function(args) {
+    var n = this.kvc.get("n") || 0
+    this.kvc.add("n", n + args.n)
+    return this.kvc.receipt({n: n + args.n})
+}
+`,
+		"nodecaller": `
+function Init(context) {
+    this.kvc = new kvcreator("init")
+    return this.kvc.receipt()
+}
+
+Exec.prototype.deposit = function(args) {
+    var ret = callContract("nodecallee", "add", {n: args.n})
+    this.kvc.add("last", ret.n)
+    return this.kvc.receipt()
+}
+`,
+	}
+	for _, name := range []string{"nodecallee", "nodecaller"} {
+		txinfo := send(ptypes.JsX, "Create", &jsproto.Create{Code: code[name], Name: name})
+		assert.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	}
+	for i := 0; i < 2; i++ {
+		txinfo := send("user."+ptypes.JsX+".nodecaller", "Call", &jsproto.Call{Funcname: "deposit", Name: "nodecaller", Args: `{"n":5}`})
+		assert.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+	}
+
+	//第二次调用读到第一次调用写入的数据
+	value, err := mocker.GetAPI().StoreGet(&types.StoreGet{
+		StateHash: mocker.GetLastBlock().StateHash,
+		Keys:      [][]byte{[]byte("mavl-user." + ptypes.JsX + ".nodecallee-n")},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "10", string(value.Values[0]))
+}
+
 func configCreator(mocker *testnode.Chain33Mock, t *testing.T) {
 	// 需要配置
 	addr := address.PubKeyToAddress(mocker.GetHotKey().PubKey().Bytes()).String()
@@ -321,7 +321,7 @@ function kvcreator(dbtype) {
 	this.getstate = getstatedb
 	this.getloal = getlocaldb
 	this.list = listdb
-    if (dbtype == "exec" || dbtype == "init") {
+    if (dbtype == "exec" || dbtype == "init" || dbtype == "migrate") {
         this.getdb = this.getstate
     } else if (dbtype == "local") {
         this.getdb = this.getlocal
@@ -404,8 +404,13 @@ kvcreator.prototype.addlog = function(log, ty, format) {
     this.logs.push({log:log, ty: ty, format: format})
 }
 
-kvcreator.prototype.receipt = function() {
-    return {kvs: this.kvs, logs: this.logs}
+//result 是被其他合约调用时返回给调用者的结果
+kvcreator.prototype.receipt = function(result) {
+    var receipt = {kvs: this.kvs, logs: this.logs}
+    if (typeof result != "undefined") {
+        receipt.result = JSON.stringify(result)
+    }
+    return receipt
 }
 
 function GetExecName() {
@@ -437,6 +442,29 @@ function Sha256(data) {
     return hash.value
 }
 
+//调用其他合约的exec函数，被调用合约的数据和日志合并到当前交易中
+function callContract(name, funcname, args) {
+    var ret = call_contract(name, funcname, JSON.stringify(args || {}))
+    throwerr(ret.err, "callContract")
+    if (!ret.value) {
+        return null
+    }
+    return JSON.parse(ret.value)
+}
+
+//调用其他合约的query函数，返回query函数的结果
+function queryContract(name, funcname, args) {
+    var ret = query_contract(name, funcname, JSON.stringify(args || {}))
+    throwerr(ret.err, "queryContract")
+    return ret.value
+}
+
+//产生一个事件，事件按照合约和事件名称保存在localdb中
+function emit(name, fields) {
+    var ret = emit_event(name, JSON.stringify(fields || {}))
+    throwerr(ret.err, "emit")
+}
+
 function Exec(context) {
     this.kvc = new kvcreator("exec")
     this.context = context
@@ -483,6 +511,12 @@ function callcode(context, f, args, loglist) {
 	if (f == "init") {
 		return Init(JSON.parse(context))
 	}
+	if (f == "migrate") {
+		if (typeof Migrate != "function") {
+			return {kvs: [], logs: []}
+		}
+		return Migrate(JSON.parse(context), JSON.parse(args))
+	}
     var farr = f.split("_", 2)
     if (farr.length !=  2) {
         throw new Error("chain33.js: invalid function name format")
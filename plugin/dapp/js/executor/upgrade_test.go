@@ -0,0 +1,179 @@
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/rpc/grpcclient"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	ptypes "github.com/33cn/plugin/plugin/dapp/js/types"
+	"github.com/33cn/plugin/plugin/dapp/js/types/jsproto"
+	"github.com/stretchr/testify/assert"
+)
+
+var upgradeCodeV1 = `
+function Init(context) {
+    this.kvc = new kvcreator("init")
+    this.kvc.add("value", "1")
+    return this.kvc.receipt()
+}
+
+Query.prototype.version = function(args) {
+    return "v1"
+}
+`
+
+var upgradeCodeV2 = `
+function Migrate(context, args) {
+    this.kvc = new kvcreator("migrate")
+    this.kvc.add("value", args.value)
+    return this.kvc.receipt()
+}
+
+Query.prototype.version = function(args) {
+    return "v2"
+}
+`
+
+var upgradeCodeV3 = `
+Query.prototype.version = function(args) {
+    return "v3"
+}
+`
+
+func setManageConfig(kvdb db.KVDB, key string, addrs ...string) {
+	item := &types.ConfigItem{
+		Key: "mavl-manage-" + key,
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: addrs},
+		},
+	}
+	kvdb.Set([]byte(item.Key), types.Encode(item))
+}
+
+func upgradeCodeTx(name, jscode, args string) (*jsproto.Upgrade, *types.Transaction) {
+	data := &jsproto.Upgrade{
+		Code: jscode,
+		Name: name,
+		Args: args,
+	}
+	return data, &types.Transaction{Execer: []byte(ptypes.JsX), Payload: types.Encode(data), Fee: 100000}
+}
+
+func TestUpgrade(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	e := newjs().(*js)
+	e.SetEnv(types.GetDappFork(ptypes.JsX, ptypes.ForkJsUpgrade), time.Now().Unix(), 1)
+	mockapi := &mocks.QueueProtocolAPI{}
+	e.SetAPI(mockapi)
+	gclient, err := grpcclient.NewMainChainClient("")
+	assert.Nil(t, err)
+	e.SetExecutorAPI(mockapi, gclient)
+	e.SetLocalDB(kvdb)
+	e.SetStateDB(kvdb)
+
+	creator := util.TestPrivkeyList[0]
+	admin := util.TestPrivkeyList[1]
+	creatorAddr := address.PubKeyToAddress(creator.PubKey().Bytes()).String()
+	adminAddr := address.PubKeyToAddress(admin.PubKey().Bytes()).String()
+	setManageConfig(kvdb, ptypes.JsCreator, creatorAddr)
+
+	c, tx := createCodeTx("counter", upgradeCodeV1)
+	tx.Fee = 100000
+	tx.Sign(types.SECP256K1, creator)
+	receipt, err := e.Exec_Create(c, tx, 0)
+	assert.Nil(t, err)
+	util.SaveKVList(ldb, receipt.KV)
+	version := func() string {
+		call, _ := callCodeTx("counter", "version", "")
+		msg, err := e.Query_Query(call)
+		assert.Nil(t, err)
+		return msg.(*jsproto.QueryResult).Data
+	}
+	assert.Equal(t, "v1", version())
+
+	upgrade := func(code, args string, priv crypto.PrivKey, index int) (*types.Receipt, error) {
+		u, tx := upgradeCodeTx("counter", code, args)
+		tx.Sign(types.SECP256K1, priv)
+		receipt, err := e.Exec_Upgrade(u, tx, index)
+		if err != nil {
+			return nil, err
+		}
+		util.SaveKVList(ldb, receipt.KV)
+		kvset, err := e.ExecLocal_Upgrade(u, tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, index)
+		assert.Nil(t, err)
+		util.SaveKVList(ldb, kvset.KV)
+		return receipt, nil
+	}
+
+	//没有权限的地址不能升级合约
+	_, err = upgrade(upgradeCodeV2, "", admin, 1)
+	assert.Equal(t, ptypes.ErrJsUpgrader, err)
+
+	//创建者升级合约，执行数据迁移
+	receipt, err = upgrade(upgradeCodeV2, `{"value":"2"}`, creator, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "v2", version())
+	migrated := false
+	for _, kv := range receipt.KV {
+		if string(kv.Key) == "mavl-"+e.userExecName("counter", false)+"-value" {
+			migrated = true
+			assert.Equal(t, "2", string(kv.Value))
+		}
+	}
+	assert.True(t, migrated)
+	code, err := kvdb.Get(calcCodeVersionKey("counter", 1))
+	assert.Nil(t, err)
+	assert.Equal(t, upgradeCodeV1, string(code))
+
+	//管理员升级合约，没有Migrate函数时不做数据迁移
+	setManageConfig(kvdb, ptypes.JsUpgrader, adminAddr)
+	receipt, err = upgrade(upgradeCodeV3, "", admin, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "v3", version())
+	var log jsproto.JsUpgradeLog
+	assert.Equal(t, int32(ptypes.TyLogJsUpgrade), receipt.Logs[0].Ty)
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &log))
+	assert.Equal(t, int64(3), log.Version)
+	assert.Equal(t, adminAddr, log.Operator)
+
+	//分页查询升级记录
+	history := func(primary string) *jsproto.JsUpgradeHistory {
+		msg, err := e.Query_GetUpgradeHistory(&jsproto.JsUpgradeHistoryReq{Name: "counter", Count: 1, PrimaryKey: primary})
+		assert.Nil(t, err)
+		return msg.(*jsproto.JsUpgradeHistory)
+	}
+	page := history("")
+	assert.Equal(t, 1, len(page.Records))
+	assert.Equal(t, int64(2), page.Records[0].Version)
+	assert.Equal(t, creatorAddr, page.Records[0].Operator)
+	page = history(page.PrimaryKey)
+	assert.Equal(t, 1, len(page.Records))
+	assert.Equal(t, int64(3), page.Records[0].Version)
+
+	//回滚升级记录
+	u, tx := upgradeCodeTx("counter", upgradeCodeV3, "")
+	kvset, err := e.ExecDelLocal_Upgrade(u, tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 3)
+	assert.Nil(t, err)
+	util.SaveKVList(ldb, kvset.KV)
+	page = history("")
+	assert.Equal(t, int64(2), page.Records[0].Version)
+	page = history(page.PrimaryKey)
+	assert.Equal(t, 0, len(page.Records))
+	//状态数据回滚到上一个版本的代码后，不会用到缓存的新代码虚拟机
+	assert.Equal(t, "v3", version())
+	kvdb.Set(calcCodeKey("counter"), []byte(upgradeCodeV2))
+	assert.Equal(t, "v2", version())
+
+	//合约不存在
+	u, tx = upgradeCodeTx("unknown", upgradeCodeV3, "")
+	_, err = e.Exec_Upgrade(u, tx, 4)
+	assert.Equal(t, ptypes.ErrJsCodeNotFound, err)
+}
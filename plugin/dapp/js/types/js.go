@@ -10,25 +10,55 @@ import (
 
 // action for executor
 const (
-	jsActionCreate = 0
-	jsActionCall   = 1
+	jsActionCreate  = 0
+	jsActionCall    = 1
+	jsActionUpgrade = 2
 )
 
 //日志类型
 const (
-	TyLogJs = 10000
+	TyLogJs        = 10000
+	TyLogJsUpgrade = 10001
+	TyLogJsSteps   = 10002
+	TyLogJsEvent   = 10003
 )
 
 // JsCreator 配置项 创建js合约的管理员
 const JsCreator = "js-creator"
 
+// JsUpgrader 配置项 可以升级所有js合约的管理员
+const JsUpgrader = "js-upgrader"
+
+// ForkJsUpgrade 支持合约升级，创建合约时记录创建者
+const ForkJsUpgrade = "ForkJsUpgrade"
+
+// ForkJsMetering 按照交易手续费限制合约的执行步数
+const ForkJsMetering = "ForkJsMetering"
+
+const (
+	// JsStepFee 每执行一步需要的手续费
+	JsStepFee = 1
+	// JsMaxSteps 一次合约调用最多执行的步数，本地执行和查询也按照这个限制
+	JsMaxSteps = 10000000
+)
+
+// ForkJsCallContract 支持合约之间的调用和结构化事件
+const ForkJsCallContract = "ForkJsCallContract"
+
+// JsMaxCallDepth 合约之间调用的最大深度
+const JsMaxCallDepth = 8
+
 var (
 	typeMap = map[string]int32{
-		"Create": jsActionCreate,
-		"Call":   jsActionCall,
+		"Create":  jsActionCreate,
+		"Call":    jsActionCall,
+		"Upgrade": jsActionUpgrade,
 	}
 	logMap = map[int64]*types.LogInfo{
-		TyLogJs: {Ty: reflect.TypeOf(jsproto.JsLog{}), Name: "TyLogJs"},
+		TyLogJs:        {Ty: reflect.TypeOf(jsproto.JsLog{}), Name: "TyLogJs"},
+		TyLogJsUpgrade: {Ty: reflect.TypeOf(jsproto.JsUpgradeLog{}), Name: "TyLogJsUpgrade"},
+		TyLogJsSteps:   {Ty: reflect.TypeOf(jsproto.JsLog{}), Name: "TyLogJsSteps"},
+		TyLogJsEvent:   {Ty: reflect.TypeOf(jsproto.JsEvent{}), Name: "TyLogJsEvent"},
 	}
 )
 
@@ -52,12 +82,27 @@ var (
 	ErrDBType       = errors.New("chain33.js: ErrDBType")
 	// ErrJsCreator
 	ErrJsCreator = errors.New("ErrJsCreator")
+	// ErrJsUpgrader 没有升级合约的权限
+	ErrJsUpgrader = errors.New("ErrJsUpgrader")
+	// ErrJsCodeNotFound 合约不存在
+	ErrJsCodeNotFound = errors.New("ErrJsCodeNotFound")
+	// ErrJsOutOfGas 执行步数超过手续费允许的限制
+	ErrJsOutOfGas = errors.New("ErrJsOutOfGas")
+	// ErrJsCallDepth 合约调用超过最大深度
+	ErrJsCallDepth = errors.New("ErrJsCallDepth")
+	// ErrJsReadOnly 本地执行和查询时不能修改状态
+	ErrJsReadOnly = errors.New("ErrJsReadOnly")
+	// ErrJsLocalDB 交易执行中调用的query函数不能读取本地数据
+	ErrJsLocalDB = errors.New("ErrJsLocalDB")
 )
 
 func init() {
 	types.AllowUserExec = append(types.AllowUserExec, []byte(JsX))
 	types.RegistorExecutor(JsX, NewType())
 	types.RegisterDappFork(JsX, "Enable", 0)
+	types.RegisterDappFork(JsX, ForkJsUpgrade, 3800000)
+	types.RegisterDappFork(JsX, ForkJsMetering, 3800000)
+	types.RegisterDappFork(JsX, ForkJsCallContract, 3800000)
 }
 
 //JsType 类型
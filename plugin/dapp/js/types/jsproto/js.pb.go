@@ -5,9 +5,7 @@ package jsproto
 
 import (
 	fmt "fmt"
-
 	proto "github.com/golang/protobuf/proto"
-
 	math "math"
 )
 
@@ -20,7 +18,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // create action
 type Create struct {
@@ -35,16 +33,17 @@ func (m *Create) Reset()         { *m = Create{} }
 func (m *Create) String() string { return proto.CompactTextString(m) }
 func (*Create) ProtoMessage()    {}
 func (*Create) Descriptor() ([]byte, []int) {
-	return fileDescriptor_js_afd63f08422ad153, []int{0}
+	return fileDescriptor_d11539bc790542aa, []int{0}
 }
+
 func (m *Create) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Create.Unmarshal(m, b)
 }
 func (m *Create) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Create.Marshal(b, m, deterministic)
 }
-func (dst *Create) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Create.Merge(dst, src)
+func (m *Create) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Create.Merge(m, src)
 }
 func (m *Create) XXX_Size() int {
 	return xxx_messageInfo_Create.Size(m)
@@ -74,6 +73,7 @@ type Call struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Funcname             string   `protobuf:"bytes,2,opt,name=funcname,proto3" json:"funcname,omitempty"`
 	Args                 string   `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	Estimate             bool     `protobuf:"varint,4,opt,name=estimate,proto3" json:"estimate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -83,16 +83,17 @@ func (m *Call) Reset()         { *m = Call{} }
 func (m *Call) String() string { return proto.CompactTextString(m) }
 func (*Call) ProtoMessage()    {}
 func (*Call) Descriptor() ([]byte, []int) {
-	return fileDescriptor_js_afd63f08422ad153, []int{1}
+	return fileDescriptor_d11539bc790542aa, []int{1}
 }
+
 func (m *Call) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Call.Unmarshal(m, b)
 }
 func (m *Call) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Call.Marshal(b, m, deterministic)
 }
-func (dst *Call) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Call.Merge(dst, src)
+func (m *Call) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Call.Merge(m, src)
 }
 func (m *Call) XXX_Size() int {
 	return xxx_messageInfo_Call.Size(m)
@@ -124,10 +125,74 @@ func (m *Call) GetArgs() string {
 	return ""
 }
 
+func (m *Call) GetEstimate() bool {
+	if m != nil {
+		return m.Estimate
+	}
+	return false
+}
+
+// upgrade action
+type Upgrade struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Args                 string   `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Upgrade) Reset()         { *m = Upgrade{} }
+func (m *Upgrade) String() string { return proto.CompactTextString(m) }
+func (*Upgrade) ProtoMessage()    {}
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{2}
+}
+
+func (m *Upgrade) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Upgrade.Unmarshal(m, b)
+}
+func (m *Upgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Upgrade.Marshal(b, m, deterministic)
+}
+func (m *Upgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Upgrade.Merge(m, src)
+}
+func (m *Upgrade) XXX_Size() int {
+	return xxx_messageInfo_Upgrade.Size(m)
+}
+func (m *Upgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Upgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Upgrade proto.InternalMessageInfo
+
+func (m *Upgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Upgrade) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Upgrade) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
 type JsAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*JsAction_Create
 	//	*JsAction_Call
+	//	*JsAction_Upgrade
 	Value                isJsAction_Value `protobuf_oneof:"value"`
 	Ty                   int32            `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -139,16 +204,17 @@ func (m *JsAction) Reset()         { *m = JsAction{} }
 func (m *JsAction) String() string { return proto.CompactTextString(m) }
 func (*JsAction) ProtoMessage()    {}
 func (*JsAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_js_afd63f08422ad153, []int{2}
+	return fileDescriptor_d11539bc790542aa, []int{3}
 }
+
 func (m *JsAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JsAction.Unmarshal(m, b)
 }
 func (m *JsAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_JsAction.Marshal(b, m, deterministic)
 }
-func (dst *JsAction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JsAction.Merge(dst, src)
+func (m *JsAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsAction.Merge(m, src)
 }
 func (m *JsAction) XXX_Size() int {
 	return xxx_messageInfo_JsAction.Size(m)
@@ -171,10 +237,16 @@ type JsAction_Call struct {
 	Call *Call `protobuf:"bytes,2,opt,name=call,proto3,oneof"`
 }
 
+type JsAction_Upgrade struct {
+	Upgrade *Upgrade `protobuf:"bytes,4,opt,name=upgrade,proto3,oneof"`
+}
+
 func (*JsAction_Create) isJsAction_Value() {}
 
 func (*JsAction_Call) isJsAction_Value() {}
 
+func (*JsAction_Upgrade) isJsAction_Value() {}
+
 func (m *JsAction) GetValue() isJsAction_Value {
 	if m != nil {
 		return m.Value
@@ -196,6 +268,13 @@ func (m *JsAction) GetCall() *Call {
 	return nil
 }
 
+func (m *JsAction) GetUpgrade() *Upgrade {
+	if x, ok := m.GetValue().(*JsAction_Upgrade); ok {
+		return x.Upgrade
+	}
+	return nil
+}
+
 func (m *JsAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -208,6 +287,7 @@ func (*JsAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 	return _JsAction_OneofMarshaler, _JsAction_OneofUnmarshaler, _JsAction_OneofSizer, []interface{}{
 		(*JsAction_Create)(nil),
 		(*JsAction_Call)(nil),
+		(*JsAction_Upgrade)(nil),
 	}
 }
 
@@ -225,6 +305,11 @@ func _JsAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Call); err != nil {
 			return err
 		}
+	case *JsAction_Upgrade:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Upgrade); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("JsAction.Value has unexpected type %T", x)
@@ -251,6 +336,14 @@ func _JsAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Value = &JsAction_Call{msg}
 		return true, err
+	case 4: // value.upgrade
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Upgrade)
+		err := b.DecodeMessage(msg)
+		m.Value = &JsAction_Upgrade{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -270,6 +363,11 @@ func _JsAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *JsAction_Upgrade:
+		s := proto.Size(x.Upgrade)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -277,8 +375,319 @@ func _JsAction_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// 合约的创建者和当前版本
+type JsContractInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsContractInfo) Reset()         { *m = JsContractInfo{} }
+func (m *JsContractInfo) String() string { return proto.CompactTextString(m) }
+func (*JsContractInfo) ProtoMessage()    {}
+func (*JsContractInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{4}
+}
+
+func (m *JsContractInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsContractInfo.Unmarshal(m, b)
+}
+func (m *JsContractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsContractInfo.Marshal(b, m, deterministic)
+}
+func (m *JsContractInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsContractInfo.Merge(m, src)
+}
+func (m *JsContractInfo) XXX_Size() int {
+	return xxx_messageInfo_JsContractInfo.Size(m)
+}
+func (m *JsContractInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsContractInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsContractInfo proto.InternalMessageInfo
+
+func (m *JsContractInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsContractInfo) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *JsContractInfo) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// 合约升级日志
+type JsUpgradeLog struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Operator             string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	CodeHash             string   `protobuf:"bytes,4,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsUpgradeLog) Reset()         { *m = JsUpgradeLog{} }
+func (m *JsUpgradeLog) String() string { return proto.CompactTextString(m) }
+func (*JsUpgradeLog) ProtoMessage()    {}
+func (*JsUpgradeLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{5}
+}
+
+func (m *JsUpgradeLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsUpgradeLog.Unmarshal(m, b)
+}
+func (m *JsUpgradeLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsUpgradeLog.Marshal(b, m, deterministic)
+}
+func (m *JsUpgradeLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsUpgradeLog.Merge(m, src)
+}
+func (m *JsUpgradeLog) XXX_Size() int {
+	return xxx_messageInfo_JsUpgradeLog.Size(m)
+}
+func (m *JsUpgradeLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsUpgradeLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsUpgradeLog proto.InternalMessageInfo
+
+func (m *JsUpgradeLog) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsUpgradeLog) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *JsUpgradeLog) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *JsUpgradeLog) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+// 合约升级记录
+type JsUpgradeRecord struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Operator             string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	CodeHash             string   `protobuf:"bytes,4,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	TxHash               string   `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64    `protobuf:"varint,7,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsUpgradeRecord) Reset()         { *m = JsUpgradeRecord{} }
+func (m *JsUpgradeRecord) String() string { return proto.CompactTextString(m) }
+func (*JsUpgradeRecord) ProtoMessage()    {}
+func (*JsUpgradeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{6}
+}
+
+func (m *JsUpgradeRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsUpgradeRecord.Unmarshal(m, b)
+}
+func (m *JsUpgradeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsUpgradeRecord.Marshal(b, m, deterministic)
+}
+func (m *JsUpgradeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsUpgradeRecord.Merge(m, src)
+}
+func (m *JsUpgradeRecord) XXX_Size() int {
+	return xxx_messageInfo_JsUpgradeRecord.Size(m)
+}
+func (m *JsUpgradeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsUpgradeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsUpgradeRecord proto.InternalMessageInfo
+
+func (m *JsUpgradeRecord) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsUpgradeRecord) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *JsUpgradeRecord) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *JsUpgradeRecord) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *JsUpgradeRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *JsUpgradeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JsUpgradeRecord) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+type JsUpgradeHistoryReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsUpgradeHistoryReq) Reset()         { *m = JsUpgradeHistoryReq{} }
+func (m *JsUpgradeHistoryReq) String() string { return proto.CompactTextString(m) }
+func (*JsUpgradeHistoryReq) ProtoMessage()    {}
+func (*JsUpgradeHistoryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{7}
+}
+
+func (m *JsUpgradeHistoryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsUpgradeHistoryReq.Unmarshal(m, b)
+}
+func (m *JsUpgradeHistoryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsUpgradeHistoryReq.Marshal(b, m, deterministic)
+}
+func (m *JsUpgradeHistoryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsUpgradeHistoryReq.Merge(m, src)
+}
+func (m *JsUpgradeHistoryReq) XXX_Size() int {
+	return xxx_messageInfo_JsUpgradeHistoryReq.Size(m)
+}
+func (m *JsUpgradeHistoryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsUpgradeHistoryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsUpgradeHistoryReq proto.InternalMessageInfo
+
+func (m *JsUpgradeHistoryReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsUpgradeHistoryReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *JsUpgradeHistoryReq) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type JsUpgradeHistory struct {
+	Records              []*JsUpgradeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	PrimaryKey           string             `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *JsUpgradeHistory) Reset()         { *m = JsUpgradeHistory{} }
+func (m *JsUpgradeHistory) String() string { return proto.CompactTextString(m) }
+func (*JsUpgradeHistory) ProtoMessage()    {}
+func (*JsUpgradeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{8}
+}
+
+func (m *JsUpgradeHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsUpgradeHistory.Unmarshal(m, b)
+}
+func (m *JsUpgradeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsUpgradeHistory.Marshal(b, m, deterministic)
+}
+func (m *JsUpgradeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsUpgradeHistory.Merge(m, src)
+}
+func (m *JsUpgradeHistory) XXX_Size() int {
+	return xxx_messageInfo_JsUpgradeHistory.Size(m)
+}
+func (m *JsUpgradeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsUpgradeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsUpgradeHistory proto.InternalMessageInfo
+
+func (m *JsUpgradeHistory) GetRecords() []*JsUpgradeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *JsUpgradeHistory) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
 type JsLog struct {
 	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Steps                int64    `protobuf:"varint,2,opt,name=steps,proto3" json:"steps,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -288,16 +697,17 @@ func (m *JsLog) Reset()         { *m = JsLog{} }
 func (m *JsLog) String() string { return proto.CompactTextString(m) }
 func (*JsLog) ProtoMessage()    {}
 func (*JsLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_js_afd63f08422ad153, []int{3}
+	return fileDescriptor_d11539bc790542aa, []int{9}
 }
+
 func (m *JsLog) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JsLog.Unmarshal(m, b)
 }
 func (m *JsLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_JsLog.Marshal(b, m, deterministic)
 }
-func (dst *JsLog) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JsLog.Merge(dst, src)
+func (m *JsLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsLog.Merge(m, src)
 }
 func (m *JsLog) XXX_Size() int {
 	return xxx_messageInfo_JsLog.Size(m)
@@ -315,8 +725,17 @@ func (m *JsLog) GetData() string {
 	return ""
 }
 
+func (m *JsLog) GetSteps() int64 {
+	if m != nil {
+		return m.Steps
+	}
+	return 0
+}
+
 type QueryResult struct {
 	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Steps                int64    `protobuf:"varint,2,opt,name=steps,proto3" json:"steps,omitempty"`
+	Fee                  int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -326,16 +745,17 @@ func (m *QueryResult) Reset()         { *m = QueryResult{} }
 func (m *QueryResult) String() string { return proto.CompactTextString(m) }
 func (*QueryResult) ProtoMessage()    {}
 func (*QueryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_js_afd63f08422ad153, []int{4}
+	return fileDescriptor_d11539bc790542aa, []int{10}
 }
+
 func (m *QueryResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResult.Unmarshal(m, b)
 }
 func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_QueryResult.Marshal(b, m, deterministic)
 }
-func (dst *QueryResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResult.Merge(dst, src)
+func (m *QueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResult.Merge(m, src)
 }
 func (m *QueryResult) XXX_Size() int {
 	return xxx_messageInfo_QueryResult.Size(m)
@@ -353,31 +773,342 @@ func (m *QueryResult) GetData() string {
 	return ""
 }
 
+func (m *QueryResult) GetSteps() int64 {
+	if m != nil {
+		return m.Steps
+	}
+	return 0
+}
+
+func (m *QueryResult) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+// 合约产生的事件
+type JsEvent struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsEvent) Reset()         { *m = JsEvent{} }
+func (m *JsEvent) String() string { return proto.CompactTextString(m) }
+func (*JsEvent) ProtoMessage()    {}
+func (*JsEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{11}
+}
+
+func (m *JsEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsEvent.Unmarshal(m, b)
+}
+func (m *JsEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsEvent.Marshal(b, m, deterministic)
+}
+func (m *JsEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsEvent.Merge(m, src)
+}
+func (m *JsEvent) XXX_Size() int {
+	return xxx_messageInfo_JsEvent.Size(m)
+}
+func (m *JsEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsEvent proto.InternalMessageInfo
+
+func (m *JsEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *JsEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// localdb中保存的事件
+type JsEventRecord struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	TxHash               string   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Seq                  int32    `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsEventRecord) Reset()         { *m = JsEventRecord{} }
+func (m *JsEventRecord) String() string { return proto.CompactTextString(m) }
+func (*JsEventRecord) ProtoMessage()    {}
+func (*JsEventRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{12}
+}
+
+func (m *JsEventRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsEventRecord.Unmarshal(m, b)
+}
+func (m *JsEventRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsEventRecord.Marshal(b, m, deterministic)
+}
+func (m *JsEventRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsEventRecord.Merge(m, src)
+}
+func (m *JsEventRecord) XXX_Size() int {
+	return xxx_messageInfo_JsEventRecord.Size(m)
+}
+func (m *JsEventRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsEventRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsEventRecord proto.InternalMessageInfo
+
+func (m *JsEventRecord) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *JsEventRecord) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsEventRecord) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *JsEventRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *JsEventRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JsEventRecord) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *JsEventRecord) GetSeq() int32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type JsEventReq struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,5,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsEventReq) Reset()         { *m = JsEventReq{} }
+func (m *JsEventReq) String() string { return proto.CompactTextString(m) }
+func (*JsEventReq) ProtoMessage()    {}
+func (*JsEventReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{13}
+}
+
+func (m *JsEventReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsEventReq.Unmarshal(m, b)
+}
+func (m *JsEventReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsEventReq.Marshal(b, m, deterministic)
+}
+func (m *JsEventReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsEventReq.Merge(m, src)
+}
+func (m *JsEventReq) XXX_Size() int {
+	return xxx_messageInfo_JsEventReq.Size(m)
+}
+func (m *JsEventReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsEventReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsEventReq proto.InternalMessageInfo
+
+func (m *JsEventReq) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *JsEventReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsEventReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *JsEventReq) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *JsEventReq) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type JsEventList struct {
+	Events               []*JsEventRecord `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	PrimaryKey           string           `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *JsEventList) Reset()         { *m = JsEventList{} }
+func (m *JsEventList) String() string { return proto.CompactTextString(m) }
+func (*JsEventList) ProtoMessage()    {}
+func (*JsEventList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{14}
+}
+
+func (m *JsEventList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsEventList.Unmarshal(m, b)
+}
+func (m *JsEventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsEventList.Marshal(b, m, deterministic)
+}
+func (m *JsEventList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsEventList.Merge(m, src)
+}
+func (m *JsEventList) XXX_Size() int {
+	return xxx_messageInfo_JsEventList.Size(m)
+}
+func (m *JsEventList) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsEventList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsEventList proto.InternalMessageInfo
+
+func (m *JsEventList) GetEvents() []*JsEventRecord {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *JsEventList) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Create)(nil), "jsproto.Create")
 	proto.RegisterType((*Call)(nil), "jsproto.Call")
+	proto.RegisterType((*Upgrade)(nil), "jsproto.Upgrade")
 	proto.RegisterType((*JsAction)(nil), "jsproto.JsAction")
+	proto.RegisterType((*JsContractInfo)(nil), "jsproto.JsContractInfo")
+	proto.RegisterType((*JsUpgradeLog)(nil), "jsproto.JsUpgradeLog")
+	proto.RegisterType((*JsUpgradeRecord)(nil), "jsproto.JsUpgradeRecord")
+	proto.RegisterType((*JsUpgradeHistoryReq)(nil), "jsproto.JsUpgradeHistoryReq")
+	proto.RegisterType((*JsUpgradeHistory)(nil), "jsproto.JsUpgradeHistory")
 	proto.RegisterType((*JsLog)(nil), "jsproto.JsLog")
 	proto.RegisterType((*QueryResult)(nil), "jsproto.QueryResult")
+	proto.RegisterType((*JsEvent)(nil), "jsproto.JsEvent")
+	proto.RegisterType((*JsEventRecord)(nil), "jsproto.JsEventRecord")
+	proto.RegisterType((*JsEventReq)(nil), "jsproto.JsEventReq")
+	proto.RegisterType((*JsEventList)(nil), "jsproto.JsEventList")
 }
 
-func init() { proto.RegisterFile("js.proto", fileDescriptor_js_afd63f08422ad153) }
-
-var fileDescriptor_js_afd63f08422ad153 = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x9b, 0x90, 0xa4, 0xe1, 0x22, 0x40, 0xf2, 0x14, 0xc1, 0x02, 0x66, 0x81, 0x25, 0x42,
-	0xe5, 0x09, 0xa0, 0x4b, 0x15, 0xb1, 0xe0, 0x37, 0x38, 0x5c, 0x53, 0x51, 0x1d, 0x31, 0xf2, 0x1f,
-	0xa4, 0xbc, 0x3d, 0xf2, 0xa5, 0x34, 0x0c, 0x6c, 0x3f, 0xdf, 0xfd, 0xec, 0xef, 0x33, 0xd4, 0x7b,
-	0xdf, 0x7d, 0x39, 0x1b, 0xac, 0x58, 0xee, 0x3d, 0x83, 0x7c, 0x80, 0x6a, 0xed, 0x0c, 0x06, 0x23,
-	0x04, 0x14, 0xda, 0x6e, 0x4d, 0x9b, 0x5d, 0x67, 0x77, 0xa7, 0x8a, 0x39, 0xcd, 0x06, 0xfc, 0x34,
-	0x6d, 0x3e, 0xcd, 0x12, 0xcb, 0x1e, 0x8a, 0x35, 0x12, 0x1d, 0x77, 0xd9, 0xbc, 0x13, 0x97, 0x50,
-	0xbf, 0xc7, 0x41, 0xff, 0xb9, 0x73, 0x3c, 0x27, 0x1f, 0xdd, 0xce, 0xb7, 0x27, 0x93, 0x9f, 0x58,
-	0x7a, 0xa8, 0x7b, 0xff, 0xa4, 0xc3, 0x87, 0x1d, 0xc4, 0x3d, 0x54, 0x9a, 0x9b, 0xf0, 0x8b, 0xcd,
-	0xea, 0xa2, 0x3b, 0x74, 0xec, 0xa6, 0x82, 0x9b, 0x85, 0x3a, 0x08, 0xe2, 0x16, 0x0a, 0x8d, 0x44,
-	0x1c, 0xd1, 0xac, 0xce, 0x66, 0x11, 0x89, 0x36, 0x0b, 0xc5, 0x4b, 0x71, 0x0e, 0x79, 0x18, 0x39,
-	0xad, 0x54, 0x79, 0x18, 0x9f, 0x97, 0x50, 0x7e, 0x23, 0x45, 0x23, 0xaf, 0xa0, 0xec, 0xfd, 0x8b,
-	0xdd, 0xa5, 0x46, 0x5b, 0x0c, 0xf8, 0xfb, 0x83, 0xc4, 0xf2, 0x06, 0x9a, 0xd7, 0x68, 0xdc, 0xa8,
-	0x8c, 0x8f, 0x14, 0xfe, 0x53, 0xde, 0x2a, 0x0e, 0x7b, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0xdf,
-	0xa4, 0x90, 0x55, 0x4e, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("js.proto", fileDescriptor_d11539bc790542aa) }
+
+var fileDescriptor_d11539bc790542aa = []byte{
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xad, 0x93, 0x38, 0x4e, 0x26, 0x5f, 0x7f, 0xb4, 0x5f, 0x55, 0x59, 0xa8, 0x42, 0xd5, 0x72,
+	0x53, 0x24, 0x14, 0x41, 0x78, 0x02, 0xa8, 0x2a, 0xa5, 0xa1, 0x5c, 0xb0, 0x02, 0x89, 0x1b, 0x84,
+	0xb6, 0xf6, 0x24, 0x75, 0x71, 0xbc, 0xe9, 0xee, 0xba, 0x6a, 0xde, 0x82, 0x57, 0xe0, 0x9a, 0xe7,
+	0xe0, 0xbd, 0xd0, 0xae, 0xd7, 0x1b, 0x37, 0x4d, 0x05, 0x48, 0x88, 0xbb, 0x39, 0x33, 0xb3, 0x73,
+	0xce, 0x8c, 0x8f, 0x0c, 0xbd, 0x2b, 0x35, 0x5c, 0x48, 0xa1, 0x05, 0x89, 0xae, 0x94, 0x0d, 0xe8,
+	0x73, 0xe8, 0x9e, 0x48, 0xe4, 0x1a, 0x09, 0x81, 0x4e, 0x22, 0x52, 0x8c, 0x83, 0xa3, 0xe0, 0xb8,
+	0xcf, 0x6c, 0x6c, 0x72, 0x05, 0x9f, 0x63, 0xdc, 0xaa, 0x72, 0x26, 0xa6, 0x53, 0xe8, 0x9c, 0xf0,
+	0x3c, 0xf7, 0xb5, 0x60, 0x55, 0x23, 0x8f, 0xa0, 0x37, 0x2d, 0x8b, 0xa4, 0xf1, 0xc6, 0x63, 0xd3,
+	0xcf, 0xe5, 0x4c, 0xc5, 0xed, 0xaa, 0xdf, 0xc4, 0xa6, 0x1f, 0x95, 0xce, 0xe6, 0x5c, 0x63, 0xdc,
+	0x39, 0x0a, 0x8e, 0x7b, 0xcc, 0x63, 0x7a, 0x0a, 0xd1, 0x87, 0xc5, 0x4c, 0xf2, 0x14, 0x37, 0x52,
+	0xd5, 0x72, 0x5b, 0x77, 0xe5, 0xae, 0x53, 0xd0, 0x6f, 0x01, 0xf4, 0x26, 0xea, 0x55, 0xa2, 0x33,
+	0x51, 0x90, 0xa7, 0xd0, 0x4d, 0xec, 0xb6, 0x76, 0xd4, 0x60, 0xb4, 0x3b, 0x74, 0x77, 0x18, 0x56,
+	0x47, 0x18, 0x6f, 0x31, 0xd7, 0x40, 0x9e, 0x40, 0x27, 0xe1, 0x79, 0x6e, 0xe7, 0x0f, 0x46, 0xdb,
+	0xab, 0x46, 0x9e, 0xe7, 0xe3, 0x2d, 0x66, 0x8b, 0xe4, 0x19, 0x44, 0x65, 0xa5, 0xd1, 0xca, 0x1f,
+	0x8c, 0xf6, 0x7c, 0x9f, 0xd3, 0x3e, 0xde, 0x62, 0x75, 0x0b, 0xd9, 0x81, 0x96, 0x5e, 0x5a, 0x71,
+	0x21, 0x6b, 0xe9, 0xe5, 0xeb, 0x08, 0xc2, 0x1b, 0x9e, 0x97, 0x48, 0x3f, 0xc2, 0xce, 0x44, 0x9d,
+	0x88, 0x42, 0x4b, 0x9e, 0xe8, 0xb3, 0x62, 0x2a, 0x36, 0x6e, 0x1c, 0x43, 0x64, 0xb5, 0x09, 0xe9,
+	0x96, 0xae, 0xa1, 0xa9, 0xdc, 0xa0, 0x54, 0x99, 0x28, 0xec, 0xf4, 0x36, 0xab, 0x21, 0xd5, 0xf0,
+	0xdf, 0x44, 0x39, 0x29, 0xe7, 0x62, 0xf6, 0xd0, 0xdc, 0xfa, 0x75, 0xeb, 0xce, 0x6b, 0xf3, 0x79,
+	0xc4, 0x02, 0xa5, 0xa5, 0xac, 0x6e, 0xea, 0xb1, 0xa9, 0x99, 0x9b, 0x8f, 0xb9, 0xba, 0xb4, 0xbb,
+	0xf7, 0x99, 0xc7, 0xf4, 0x47, 0x00, 0xbb, 0x9e, 0x96, 0x61, 0x22, 0x64, 0xfa, 0x6f, 0x98, 0xc9,
+	0x01, 0x74, 0xf5, 0xad, 0xad, 0x84, 0xb6, 0xe2, 0x90, 0xc9, 0x5f, 0x62, 0x36, 0xbb, 0xd4, 0x71,
+	0xd7, 0x12, 0x39, 0x44, 0x0e, 0xa1, 0x7f, 0x91, 0x8b, 0xe4, 0xcb, 0xfb, 0x6c, 0x8e, 0x71, 0x64,
+	0x4b, 0xab, 0x04, 0xfd, 0x0c, 0xff, 0xfb, 0x35, 0xc6, 0x99, 0xd2, 0x42, 0x2e, 0x19, 0x5e, 0x6f,
+	0x5c, 0x65, 0x1f, 0xc2, 0x44, 0x94, 0x85, 0xb6, 0x8b, 0x84, 0xac, 0x02, 0xe4, 0x31, 0xc0, 0x42,
+	0x66, 0x73, 0x2e, 0x97, 0x6f, 0x70, 0xe9, 0x16, 0x69, 0x64, 0xe8, 0x14, 0xf6, 0xd6, 0x09, 0xc8,
+	0x08, 0x22, 0x69, 0x4f, 0xa6, 0xe2, 0xe0, 0xa8, 0x7d, 0x3c, 0x18, 0xc5, 0xde, 0x53, 0x6b, 0x37,
+	0x65, 0x75, 0xe3, 0x1a, 0x4f, 0xeb, 0x1e, 0xcf, 0x0b, 0x08, 0x27, 0xca, 0x7d, 0xff, 0x94, 0x6b,
+	0x5e, 0x4b, 0x37, 0xb1, 0x91, 0xae, 0x34, 0x2e, 0x94, 0xfb, 0x06, 0x15, 0xa0, 0x67, 0x30, 0x78,
+	0x57, 0xa2, 0x59, 0x58, 0x95, 0xb9, 0xfe, 0xfd, 0x87, 0x64, 0x0f, 0xda, 0x53, 0x44, 0x67, 0x44,
+	0x13, 0xd2, 0xb7, 0x10, 0x4d, 0xd4, 0xe9, 0x0d, 0x16, 0xba, 0xfa, 0x76, 0x95, 0xcf, 0xdd, 0x28,
+	0x8f, 0x37, 0xfd, 0x6c, 0x3c, 0x6d, 0x7b, 0x45, 0x4b, 0xbf, 0x07, 0xb0, 0xed, 0xe6, 0x39, 0x6f,
+	0xfd, 0x85, 0xa9, 0x0d, 0xe7, 0x74, 0x1e, 0x70, 0x4e, 0x78, 0xc7, 0x39, 0xfb, 0x10, 0x66, 0x45,
+	0x8a, 0xb7, 0xce, 0x50, 0x15, 0x30, 0xcb, 0x2b, 0xbc, 0xb6, 0x4e, 0x0a, 0x99, 0x09, 0xe9, 0xd7,
+	0x00, 0xc0, 0xab, 0xbd, 0xfe, 0x63, 0xa9, 0xde, 0x57, 0xed, 0xa6, 0xaf, 0x0e, 0xa1, 0x9f, 0x66,
+	0x12, 0xed, 0x4f, 0xcd, 0xea, 0x0d, 0xd9, 0x2a, 0xb1, 0xe6, 0x86, 0xf0, 0x9e, 0x1b, 0x3e, 0xc1,
+	0xc0, 0x29, 0x3a, 0xcf, 0x94, 0x26, 0x43, 0xe8, 0xa2, 0x01, 0xb5, 0xdf, 0x0e, 0x1a, 0x7e, 0x6b,
+	0x5c, 0x99, 0xb9, 0xae, 0x5f, 0x99, 0xed, 0xa2, 0x6b, 0x1f, 0xbf, 0xfc, 0x39, 0x00, 0xcc, 0x3a,
+	0xd3, 0xe3, 0x6e, 0x06, 0x00, 0x00,
 }
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -45,6 +46,7 @@ func MultiSigAccountCmd() *cobra.Command {
 		CreateMultiSigAccCreateCmd(),
 		CreateMultiSigAccWeightModifyCmd(),
 		CreateMultiSigAccDailyLimitModifyCmd(),
+		CreateMultiSigAccTimelockModifyCmd(),
 		GetMultiSigAccCountCmd(),
 		GetMultiSigAccountsCmd(),
 		GetMultiSigAccountInfoCmd(),
@@ -83,6 +85,8 @@ func MultiSigTxCmd() *cobra.Command {
 		CreateMultiSigConfirmTxCmd(),
 		CreateMultiSigAccTransferInCmd(),
 		CreateMultiSigAccTransferOutCmd(),
+		CreateMultiSigSubmitTxCmd(),
+		CreateMultiSigExecuteTxCmd(),
 		GetMultiSigAccTxCountCmd(),
 		GetMultiSigTxidsCmd(),
 		GetMultiSigTxInfoCmd(),
@@ -121,6 +125,9 @@ func createMultiSigAccTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("daily_limit", "d", 0, "daily_limit of assets ")
 	cmd.MarkFlagRequired("daily_limit")
+
+	cmd.Flags().Int64P("execute_delay", "l", 0, "blocks to wait after required weight reached before execution")
+	cmd.Flags().Int64P("proposal_expiry", "x", 0, "blocks after submission that an unexecuted tx expires, 0 for never")
 }
 
 func createMultiSigAccTransfer(cmd *cobra.Command, args []string) {
@@ -185,10 +192,15 @@ func createMultiSigAccTransfer(cmd *cobra.Command, args []string) {
 		DailyLimit: uint64(math.Trunc((dailylimit+0.0000001)*1e4)) * 1e4,
 	}
 
+	executeDelay, _ := cmd.Flags().GetInt64("execute_delay")
+	proposalExpiry, _ := cmd.Flags().GetInt64("proposal_expiry")
+
 	params := &mty.MultiSigAccCreate{
 		Owners:         owners,
 		RequiredWeight: requiredweight,
 		DailyLimit:     symboldailylimit,
+		ExecuteDelay:   executeDelay,
+		ProposalExpiry: proposalExpiry,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccCreateTx", params, &res)
@@ -380,6 +392,41 @@ func createMultiSigAccWeightModifyTransfer(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigAccTimelockModifyCmd create raw MultiSigAccTimelockModify transaction
+func CreateMultiSigAccTimelockModifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock",
+		Short: "Create a modify execute delay and proposal expiry transaction",
+		Run:   createMultiSigAccTimelockModify,
+	}
+	createMultiSigAccTimelockModifyFlags(cmd)
+	return cmd
+}
+
+func createMultiSigAccTimelockModifyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Int64P("execute_delay", "l", 0, "blocks to wait after required weight reached before execution")
+	cmd.Flags().Int64P("proposal_expiry", "x", 0, "blocks after submission that an unexecuted tx expires, 0 for never")
+}
+
+func createMultiSigAccTimelockModify(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	executeDelay, _ := cmd.Flags().GetInt64("execute_delay")
+	proposalExpiry, _ := cmd.Flags().GetInt64("proposal_expiry")
+
+	params := &mty.MultiSigAccOperate{
+		MultiSigAccAddr: multiSigAddr,
+		TimelockFlag:    true,
+		ExecuteDelay:    executeDelay,
+		ProposalExpiry:  proposalExpiry,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 // CreateMultiSigAccDailyLimitModifyCmd create raw MultiSigAccDailyLimitModify transaction
 func CreateMultiSigAccDailyLimitModifyCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -478,6 +525,86 @@ func createMultiSigConfirmTransfer(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigSubmitTxCmd create raw MultiSigSubmitTx transaction
+func CreateMultiSigSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Create a transaction to submit a payload of any executor from multisig account",
+		Run:   createMultiSigSubmitTx,
+	}
+	createMultiSigSubmitTxFlags(cmd)
+	return cmd
+}
+
+func createMultiSigSubmitTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().StringP("execer", "e", "", "execer of the inner transaction")
+	cmd.MarkFlagRequired("execer")
+
+	cmd.Flags().StringP("payload", "p", "", "hex encoded action payload of the inner transaction")
+	cmd.MarkFlagRequired("payload")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+}
+
+func createMultiSigSubmitTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	execer, _ := cmd.Flags().GetString("execer")
+	payload, _ := cmd.Flags().GetString("payload")
+	note, _ := cmd.Flags().GetString("note")
+
+	data, err := common.FromHex(payload)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := &mty.MultiSigSubmitTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          execer,
+		Payload:         data,
+		Note:            note,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigSubmitTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigExecuteTxCmd create raw MultiSigExecuteTx transaction
+func CreateMultiSigExecuteTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Create a transaction to execute a queued tx whose delay has passed",
+		Run:   createMultiSigExecuteTx,
+	}
+	createMultiSigExecuteTxFlags(cmd)
+	return cmd
+}
+
+func createMultiSigExecuteTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().Uint64P("txid", "i", 0, "txid of  multisig transaction")
+	cmd.MarkFlagRequired("txid")
+}
+
+func createMultiSigExecuteTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	params := &mty.MultiSigExecuteTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigExecuteTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 // CreateMultiSigAccTransferInCmd create raw MultiSigAccTransferInCmd transaction
 func CreateMultiSigAccTransferInCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -718,6 +845,8 @@ func parseAccInfo(view interface{}) (interface{}, error) {
 		DailyLimits:    dailyLimitResults,
 		TxCount:        res.TxCount,
 		RequiredWeight: res.RequiredWeight,
+		ExecuteDelay:   res.ExecuteDelay,
+		ProposalExpiry: res.ProposalExpiry,
 	}
 
 	return result, nil
@@ -783,6 +912,10 @@ func getMultiSigTxidsCmdFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("executed", "x", "t", "whether executed tx (0/f/false for No; 1/t/true for Yes)")
 
+	cmd.Flags().StringP("queued", "q", "f", "whether queued tx waiting for execute delay (0/f/false for No; 1/t/true for Yes)")
+
+	cmd.Flags().StringP("expired", "d", "f", "whether expired tx (0/f/false for No; 1/t/true for Yes)")
+
 }
 
 func getMultiSigTxids(cmd *cobra.Command, args []string) {
@@ -810,12 +943,28 @@ func getMultiSigTxids(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	queued, _ := cmd.Flags().GetString("queued")
+	queuedBool, err := strconv.ParseBool(queued)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	expired, _ := cmd.Flags().GetString("expired")
+	expiredBool, err := strconv.ParseBool(expired)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
 	req := mty.ReqMultiSigTxids{
 		MultiSigAddr: addr,
 		FromTxId:     start,
 		ToTxId:       end,
 		Pending:      pendingBool,
 		Executed:     executedBool,
+		Queued:       queuedBool,
+		Expired:      expiredBool,
 	}
 
 	var params rpctypes.Query4Jrpc
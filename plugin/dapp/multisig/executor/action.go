@@ -28,13 +28,17 @@ type action struct {
 	index        int32
 	execaddr     string
 	api          client.QueueProtocolAPI
+	//MultiSigExecuteTx 执行等待期已满的交易时为true
+	executeQueued bool
+	//区块中的交易，目标执行器通过多重签名交易检查内层交易的发起人
+	txs []*types.Transaction
 }
 
 func newAction(t *MultiSig, tx *types.Transaction, index int32) *action {
 	hash := tx.Hash()
 	fromaddr := tx.From()
 	return &action{t.GetCoinsAccount(), t.GetStateDB(), t.GetLocalDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI(), false, t.GetTxs()}
 }
 
 //MultiSigAccCreate 创建多重签名账户
@@ -75,6 +79,13 @@ func (a *action) MultiSigAccCreate(accountCreate *mty.MultiSigAccCreate) (*types
 	multiSigAccount.Owners = accountCreate.Owners
 	multiSigAccount.TxCount = 0
 	multiSigAccount.RequiredWeight = accountCreate.RequiredWeight
+	if types.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimelockX) {
+		if err := checkTimelock(accountCreate.ExecuteDelay, accountCreate.ProposalExpiry); err != nil {
+			return nil, err
+		}
+		multiSigAccount.ExecuteDelay = accountCreate.ExecuteDelay
+		multiSigAccount.ProposalExpiry = accountCreate.ProposalExpiry
+	}
 
 	//获取资产的每日限额设置
 	if accountCreate.DailyLimit != nil {
@@ -138,8 +149,15 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 		return nil, mty.ErrIsNotOwner
 	}
 
-	//dailylimit每日限额属性的修改需要校验assets资产的合法性
-	if !AccountOperate.OperateFlag {
+	//等待期和过期高度的修改
+	if AccountOperate.TimelockFlag {
+		if !types.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimelockX) {
+			return nil, types.ErrActionNotSupport
+		}
+		if err := checkTimelock(AccountOperate.ExecuteDelay, AccountOperate.ProposalExpiry); err != nil {
+			return nil, err
+		}
+	} else if !AccountOperate.OperateFlag { //dailylimit每日限额属性的修改需要校验assets资产的合法性
 		execer := AccountOperate.DailyLimit.Execer
 		symbol := AccountOperate.DailyLimit.Symbol
 		err := mty.IsAssetsInvalid(execer, symbol)
@@ -155,6 +173,7 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 	newMultiSigTx.Executed = false
 	newMultiSigTx.TxType = mty.AccountOperate
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	newMultiSigTx.ExpireHeight = a.expireHeight(multiSigAccount)
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
@@ -192,6 +211,7 @@ func (a *action) MultiSigOwnerOperate(AccOwnerOperate *mty.MultiSigOwnerOperate)
 	newMultiSigTx.Executed = false
 	newMultiSigTx.TxType = mty.OwnerOperate
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	newMultiSigTx.ExpireHeight = a.expireHeight(multiSigAccount)
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
@@ -238,6 +258,7 @@ func (a *action) MultiSigExecTransferFrom(multiSigAccTransfer *mty.MultiSigExecT
 	newMultiSigTx.Executed = false
 	newMultiSigTx.TxType = mty.TransferOperate
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	newMultiSigTx.ExpireHeight = a.expireHeight(multiSigAcc)
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
@@ -298,6 +319,42 @@ func (a *action) MultiSigExecTransferTo(execTransfer *mty.MultiSigExecTransferTo
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
 
+//MultiSigSubmitTx 多重签名账户提交任意执行器的交易，权重满足之后批准此交易，
+//并在目标执行器中以多重签名账户地址作为发起人执行，目标执行器需要实现ExecFrom接口
+func (a *action) MultiSigSubmitTx(submit *mty.MultiSigSubmitTx) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigSubmitTxX) {
+		return nil, types.ErrActionNotSupport
+	}
+	//首先从statedb中获取MultiSigAccAddr的状态信息
+	multiSigAccAddr := submit.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigSubmitTx", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+
+	//校验交易提交者是否是本账户的owner
+	owneraddr := a.fromaddr
+	ownerWeight, isowner := isOwner(multiSigAcc, owneraddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+
+	//生成新的txid,并将此交易信息添加到Txs列表中
+	newMultiSigTx := &mty.MultiSigTx{}
+	newMultiSigTx.Txid = multiSigAcc.TxCount
+	newMultiSigTx.TxHash = hex.EncodeToString(a.txhash)
+	newMultiSigTx.Executed = false
+	newMultiSigTx.TxType = mty.SubmitTxOperate
+	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	newMultiSigTx.ExpireHeight = a.expireHeight(multiSigAcc)
+	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
+	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+
+	//确认并批准此交易
+	return a.executeSubmitTx(multiSigAcc, newMultiSigTx, submit, confirmOwner, mty.IsSubmit)
+}
+
 //MultiSigConfirmTx 多重签名账户上MultiSigAcc账户Transfer交易的确认和撤销
 //确认交易需要判断权重是否满足，满足就直接执行交易，调用ExecTransferFrozen进行转账
 //不满足就只更新本交易的确认owner
@@ -333,6 +390,10 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if multiSigTx.Executed {
 		return nil, mty.ErrTxHasExecuted
 	}
+	//已经过期作废的交易不可以再确认/撤销
+	if isExpired(multiSigTx, a.height) {
+		return nil, mty.ErrTxExpired
+	}
 	//此owneraddr是否已经确认过此txid对应的交易
 	findindex, exist := isOwnerConfirmedTx(multiSigTx, owneraddr)
 
@@ -358,10 +419,19 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	multiSigTxOwner := &mty.MultiSigTxOwner{MultiSigAddr: multiSigAccAddr, Txid: ConfirmTx.TxId, ConfirmedOwner: owner}
 	isConfirm := isConfirmed(multiSigAcc.RequiredWeight, multiSigTx)
 
+	//撤销之后权重不再满足，交易退出等待队列
+	if !isConfirm {
+		multiSigTx.QueuedHeight = 0
+	}
 	//权重未达到要求或者撤销确认交易，构造MultiSigConfirmTx的receiptLog
 	if !isConfirm || !ConfirmTx.ConfirmOrRevoke {
 		return a.confirmTransaction(multiSigTx, multiSigTxOwner, ConfirmTx.ConfirmOrRevoke)
 	}
+	return a.dispatchMultiSigTx(multiSigAcc, multiSigTx, owner)
+}
+
+//根据txhash获取提交的交易，按照交易类型确认并执行
+func (a *action) dispatchMultiSigTx(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, owner *mty.Owner) (*types.Receipt, error) {
 	//获取txhash对应交易详细信息
 	tx, err := getTxByHash(a.api, multiSigTx.TxHash)
 	if err != nil {
@@ -382,8 +452,11 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	} else if multiSigTx.TxType == mty.TransferOperate {
 		transfer := payload.GetMultiSigExecTransferFrom()
 		return a.executeTransferTx(multiSigAcc, multiSigTx, transfer, owner, mty.IsConfirm)
+	} else if multiSigTx.TxType == mty.SubmitTxOperate {
+		submit := payload.GetMultiSigSubmitTx()
+		return a.executeSubmitTx(multiSigAcc, multiSigTx, submit, owner, mty.IsConfirm)
 	}
-	multisiglog.Error("MultiSigConfirmTx:GetMultiSigTx", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "TxType unknown", multiSigTx.TxType)
+	multisiglog.Error("MultiSigConfirmTx:GetMultiSigTx", "multiSigAccAddr", multiSigTx.MultiSigAddr, "Confirm TxId", multiSigTx.Txid, "TxType unknown", multiSigTx.TxType)
 	return nil, mty.ErrTxTypeNoMatch
 }
 
@@ -714,6 +787,7 @@ func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner,
 	receiptLogTx.PrevExecuted = prevExecutes
 	receiptLogTx.CurExecuted = multiSigTx.Executed
 	receiptLogTx.SubmitOrConfirm = subOrConfirm
+	receiptLogTx.ExecuteQueued = a.executeQueued
 	if subOrConfirm {
 		receiptLogTx.TxHash = multiSigTx.TxHash
 		receiptLogTx.TxType = multiSigTx.TxType
@@ -759,12 +833,14 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	}
 
 	prevExecuted := newMultiSigTx.Executed
+	//权重满足但是需要等待，小于每日限额的转账不受影响
+	byWeight := confirmed && !a.holdTx(multiSigAcc, newMultiSigTx)
 
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
 	//权重满足或者小于每日限额，允许执行此交易，如果转账交易执行失败，不应该直接返回，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed || underLimit {
+	if byWeight || underLimit {
 
 		//执行此交易，从多重签名账户转币到指定账户，在multiSig合约中转账
 		symbol := getRealSymbol(transfer.Symbol)
@@ -787,7 +863,7 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 		newMultiSigTx.Executed = true
 
 		//增加今日已用金额, 只有在提交交易时才会使用每日限额的额度
-		if !confirmed && subOrConfirm {
+		if !byWeight && subOrConfirm {
 			curDailyLimit.SpentToday += uint64(amount)
 		}
 	}
@@ -840,9 +916,14 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 	var err error
 
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed {
-		//修改账户RequiredWeight的操作
-		if accountOperate.OperateFlag {
+	if confirmed && !a.holdTx(multiSigAcc, newMultiSigTx) {
+		if accountOperate.TimelockFlag { //修改等待期和过期高度
+			accAttrkv, accAttrReceiptLog, err = a.multiSigTimelockModify(multiSigAcc.MultiSigAddr, accountOperate)
+			if err != nil {
+				multisiglog.Error("executeAccOperateTx", "multiSigTimelockModify", err)
+				return nil, err
+			}
+		} else if accountOperate.OperateFlag { //修改账户RequiredWeight的操作
 			accAttrkv, accAttrReceiptLog, err = a.multiSigWeightModify(multiSigAcc.MultiSigAddr, accountOperate.NewRequiredWeight)
 			if err != nil {
 				multisiglog.Error("executeAccOperateTx", "multiSigWeightModify", err)
@@ -896,7 +977,7 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	flag := accountOperate.OperateFlag
 
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed {
+	if confirmed && !a.holdTx(multiSigAccount, newMultiSigTx) {
 		//add
 		if mty.OwnerAdd == flag {
 			multiSigkv, receiptLog, err = a.multiSigOwnerAdd(multiSigAccount.MultiSigAddr, accountOperate)
@@ -958,6 +1039,58 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	}, nil
 }
 
+//确认并批准任意执行器的交易：区分submitTx和confirmtx阶段。
+func (a *action) executeSubmitTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, submit *mty.MultiSigSubmitTx, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
+	if submit == nil {
+		return nil, mty.ErrInvalidSubmitTx
+	}
+	//确认权重是否已达到要求
+	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
+	prevExecuted := newMultiSigTx.Executed
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	//权重满足，以多重签名账户地址作为发起人执行内层交易，并输出被批准的交易信息
+	if confirmed && !a.holdTx(multiSigAcc, newMultiSigTx) {
+		receipt, err := a.execSubmitTx(multiSigAcc.MultiSigAddr, submit)
+		if err != nil {
+			multisiglog.Error("executeSubmitTx:execSubmitTx", "MultiSigAddr", multiSigAcc.MultiSigAddr, "Execer", submit.Execer, "error", err)
+			return nil, err
+		}
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+		approved := &mty.ReceiptMultiSigSubmitTx{
+			MultiSigAddr: multiSigAcc.MultiSigAddr,
+			Txid:         newMultiSigTx.Txid,
+			Execer:       submit.Execer,
+			Payload:      submit.Payload,
+		}
+		logs = append(logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigSubmitTxApproved, Log: types.Encode(approved)})
+		//标识此交易已经被执行
+		newMultiSigTx.Executed = true
+	}
+
+	//更新multiSigAcc状态:txcount有增加在submit阶段
+	if subOrConfirm {
+		keyvalue, receiptlog, err := a.receiptTxCountUpdate(multiSigAcc.MultiSigAddr)
+		if err != nil {
+			multisiglog.Error("executeSubmitTx:receiptTxCountUpdate", "error", err)
+		}
+		kv = append(kv, keyvalue)
+		logs = append(logs, receiptlog)
+	}
+	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	logs = append(logs, receiptlogtx)
+	kv = append(kv, keyvaluetx)
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   kv,
+		Logs: logs,
+	}, nil
+}
+
 //构造确认交易的receiptLog
 func (a *action) confirmTransaction(multiSigTx *mty.MultiSigTx, multiSigTxOwner *mty.MultiSigTxOwner, ConfirmOrRevoke bool) (*types.Receipt, error) {
 	receiptLog := &types.ReceiptLog{}
@@ -980,3 +1113,58 @@ func (a *action) confirmTransaction(multiSigTx *mty.MultiSigTx, multiSigTxOwner
 		Logs: []*types.ReceiptLog{receiptLog},
 	}, nil
 }
+
+//目标执行器需要支持以指定地址作为发起人执行交易，目前支持token、paracross的节点配置和autonomy的投票
+type execFromDriver interface {
+	ExecFrom(from string, tx *types.Transaction, index int) (*types.Receipt, error)
+}
+
+//在目标执行器中执行被批准的内层交易，内层交易的发起人为多重签名账户地址
+//内层交易只修改状态数据，chain33只允许交易修改自己执行器前缀的localdb，目标执行器的ExecLocal无法在多重签名交易中执行
+func (a *action) execSubmitTx(multiSigAddr string, submit *mty.MultiSigSubmitTx) (*types.Receipt, error) {
+	inner := &types.Transaction{Execer: []byte(submit.Execer), Payload: submit.Payload, To: address.ExecAddress(submit.Execer)}
+	//和创建资产交易时一样，资产操作的to为payload中的to
+	ety := types.LoadExecutorType(string(types.GetRealExecName(inner.Execer)))
+	if ety == nil {
+		return nil, mty.ErrInvalidSubmitTx
+	}
+	_, v, err := ety.DecodePayloadValue(inner)
+	if err != nil {
+		return nil, err
+	}
+	switch payload := v.Interface().(type) {
+	case *types.AssetsTransfer:
+		inner.To = payload.GetTo()
+	case *types.AssetsWithdraw:
+		inner.To = payload.GetTo()
+	case *types.AssetsTransferToExec:
+		inner.To = payload.GetTo()
+	}
+	driver, err := dapp.LoadDriver(string(types.GetRealExecName(inner.Execer)), a.height)
+	if err != nil {
+		return nil, err
+	}
+	execFrom, ok := driver.(execFromDriver)
+	if !ok {
+		return nil, mty.ErrSubmitTxNotSupport
+	}
+	driver.SetStateDB(a.db)
+	driver.SetLocalDB(a.localdb)
+	driver.SetAPI(a.api)
+	driver.SetEnv(a.height, a.blocktime, 0)
+	driver.SetTxs(a.txs)
+	driver.SetName(string(types.GetRealExecName(inner.Execer)))
+	driver.SetCurrentExecName(submit.Execer)
+	err = driver.CheckTx(inner, int(a.index))
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := execFrom.ExecFrom(multiSigAddr, inner, int(a.index))
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return &types.Receipt{}, nil
+	}
+	return receipt, nil
+}
@@ -44,3 +44,15 @@ func (m *MultiSig) Exec_MultiSigExecTransferFrom(payload *mty.MultiSigExecTransf
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigExecTransferFrom(payload)
 }
+
+//Exec_MultiSigSubmitTx 多重签名账户提交任意执行器的交易
+func (m *MultiSig) Exec_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigSubmitTx(payload)
+}
+
+//Exec_MultiSigExecuteTx 执行权重满足并且等待期已满的交易
+func (m *MultiSig) Exec_MultiSigExecuteTx(payload *mty.MultiSigExecuteTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigExecuteTx(payload)
+}
@@ -86,3 +86,29 @@ func (m *MultiSig) ExecDelLocal_MultiSigExecTransferFrom(payload *mty.MultiSigEx
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecDelLocal_MultiSigSubmitTx 多重签名账户提交任意执行器的交易
+func (m *MultiSig) ExecDelLocal_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecDelLocal_MultiSigExecuteTx 执行权重满足并且等待期已满的交易
+func (m *MultiSig) ExecDelLocal_MultiSigExecuteTx(payload *mty.MultiSigExecuteTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
@@ -91,3 +91,29 @@ func (m *MultiSig) ExecLocal_MultiSigExecTransferFrom(payload *mty.MultiSigExecT
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecLocal_MultiSigSubmitTx 多重签名账户提交任意执行器的交易
+func (m *MultiSig) ExecLocal_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecLocal_MultiSigExecuteTx 执行权重满足并且等待期已满的交易
+func (m *MultiSig) ExecLocal_MultiSigExecuteTx(payload *mty.MultiSigExecuteTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
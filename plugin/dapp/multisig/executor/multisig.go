@@ -125,7 +125,41 @@ func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
 		//assets check
 		return mty.IsAssetsInvalid(ato.GetExecname(), ato.GetSymbol())
 	}
+	//MultiSigExecuteTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigExecuteTx); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
+	//MultiSigSubmitTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigSubmitTx); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return checkSubmitTx(ato)
+	}
+
+	return nil
+}
 
+//内层交易必须能被目标执行器解析，并且不能再嵌套multisig自身的交易
+func checkSubmitTx(ato *mty.MultiSigSubmitTx) error {
+	execer := []byte(ato.GetExecer())
+	if len(ato.GetPayload()) == 0 || !types.IsAllowExecName(execer, execer) {
+		return mty.ErrInvalidSubmitTx
+	}
+	if string(types.GetRealExecName(execer)) == mty.MultiSigX {
+		return mty.ErrInvalidSubmitTx
+	}
+	ety := types.LoadExecutorType(string(types.GetRealExecName(execer)))
+	if ety == nil {
+		return mty.ErrInvalidSubmitTx
+	}
+	inner := &types.Transaction{Execer: execer, Payload: ato.GetPayload()}
+	if _, _, err := ety.DecodePayloadValue(inner); err != nil {
+		return mty.ErrInvalidSubmitTx
+	}
 	return nil
 }
 func checkAccountCreateTx(ato *mty.MultiSigAccCreate) error {
@@ -170,6 +204,10 @@ func checkAccountCreateTx(ato *mty.MultiSigAccCreate) error {
 		return mty.ErrMaxOwnerCount
 	}
 
+	if err := checkTimelock(ato.GetExecuteDelay(), ato.GetProposalExpiry()); err != nil {
+		return err
+	}
+
 	dailyLimit := ato.GetDailyLimit()
 	//assets check
 	return mty.IsAssetsInvalid(dailyLimit.GetExecer(), dailyLimit.GetSymbol())
@@ -222,6 +260,9 @@ func checkAccountOperateTx(ato *mty.MultiSigAccOperate) error {
 		return types.ErrInvalidAddress
 	}
 
+	if ato.TimelockFlag {
+		return checkTimelock(ato.GetExecuteDelay(), ato.GetProposalExpiry())
+	}
 	if ato.OperateFlag == mty.AccWeightOp {
 		NewWeight := ato.GetNewRequiredWeight()
 		if NewWeight <= 0 {
@@ -298,6 +339,19 @@ func (m *MultiSig) execLocalMultiSigReceipt(receiptData *types.ReceiptData, tx *
 				}
 				set = append(set, kv...)
 			}
+		case mty.TyLogMultiSigAccTimelockModify:
+			{
+				var receipt mty.ReceiptTimelockModify
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv, err := m.saveMultiSigAccTimelock(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
 		case mty.TyLogMultiSigAccDailyLimitAdd,
 			mty.TyLogMultiSigAccDailyLimitModify:
 			{
@@ -415,12 +469,19 @@ func (m *MultiSig) saveMultiSigTransfer(tx *types.Transaction, SubmitOrConfirm,
 			return set, nil
 		}
 	} else {
-		if action.Ty != mty.ActionMultiSigConfirmTx || action.GetMultiSigConfirmTx() == nil {
+		var multiSigAccAddr string
+		var txid uint64
+		if action.Ty == mty.ActionMultiSigConfirmTx && action.GetMultiSigConfirmTx() != nil {
+			multiSigAccAddr = action.GetMultiSigConfirmTx().MultiSigAccAddr
+			txid = action.GetMultiSigConfirmTx().TxId
+		} else if action.Ty == mty.ActionMultiSigExecuteTx && action.GetMultiSigExecuteTx() != nil {
+			multiSigAccAddr = action.GetMultiSigExecuteTx().MultiSigAccAddr
+			txid = action.GetMultiSigExecuteTx().TxId
+		} else {
 			return nil, mty.ErrActionTyNoMatch
 		}
 		//通过需要确认的txid从数据库中获取对应的multiSigTx信息，然后根据txhash查询具体的交易详情
-		multiSigConfirmTx := action.GetMultiSigConfirmTx()
-		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigConfirmTx.MultiSigAccAddr, multiSigConfirmTx.TxId)
+		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAccAddr, txid)
 		if err != nil {
 			return set, err
 		}
@@ -761,7 +822,16 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 	}
 
 	index, exist := isOwnerConfirmedTx(multiSigTx, owner.OwnerAddr)
-	if addOrRollback { //正常添加交易
+	if execTx.ExecuteQueued { //等待期满后执行的交易，只更新执行状态
+		if addOrRollback {
+			if prevExecuted != multiSigTx.Executed {
+				return nil, mty.ErrExecutedNoMatch
+			}
+			multiSigTx.Executed = curExecuted
+		} else {
+			multiSigTx.Executed = prevExecuted
+		}
+	} else if addOrRollback { //正常添加交易
 		if !exist { //add Confirmed Owner and modify Executed
 			multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner, owner)
 			if prevExecuted != multiSigTx.Executed {
@@ -159,6 +159,18 @@ func (m *MultiSig) Query_MultiSigTxids(in *mty.ReqMultiSigTxids) (types.Message,
 		//查找Pending/Executed的交易txid
 		if in.Pending && !multiSigTx.Executed || in.Executed && multiSigTx.Executed {
 			multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+			continue
+		}
+		//查找Queued/Expired的交易txid，等待和过期状态只记录在statedb中
+		if (in.Queued || in.Expired) && !multiSigTx.Executed {
+			stateTx, err := getMultiSigAccTxFromDb(m.GetStateDB(), addr, txid)
+			if err != nil {
+				continue
+			}
+			expired := isExpired(stateTx, m.GetHeight())
+			if in.Expired && expired || in.Queued && !expired && stateTx.QueuedHeight > 0 {
+				multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+			}
 		}
 	}
 	return multiSigTxids, nil
@@ -195,6 +207,11 @@ func (m *MultiSig) Query_MultiSigTxInfo(in *mty.ReqMultiSigTxInfo) (types.Messag
 	} else { //由于代码中使用hex.EncodeToString()接口转换的，没有加0x，为了方便上层统一处理再次返回时增加0x即可
 		multiSigTx.TxHash = "0x" + multiSigTx.TxHash
 	}
+	//等待和过期高度从statedb中获取
+	if stateTx, err := getMultiSigAccTxFromDb(m.GetStateDB(), addr, txid); err == nil {
+		multiSigTx.ExpireHeight = stateTx.ExpireHeight
+		multiSigTx.QueuedHeight = stateTx.QueuedHeight
+	}
 	return multiSigTx, nil
 }
 
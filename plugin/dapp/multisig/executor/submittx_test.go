@@ -0,0 +1,157 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/system/dapp"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiSigSubmitTx(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		types.GetDappFork(mty.MultiSigX, mty.ForkMultiSigSubmitTxX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+	driver.SetAPI(api)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+
+	//多重签名账户持有的token，批准之后由token执行器从多重签名账户转出
+	tokenexec.Init(tokenty.TokenX, nil)
+	tokenDB, err := account.NewAccountDB(tokenty.TokenX, "TEST", stateDB)
+	assert.Nil(t, err)
+	tokenDB.SaveAccount(&types.Account{Addr: multiSigAddr, Balance: 1000})
+	transfer := &tokenty.TokenAction{
+		Ty:    tokenty.ActionTransfer,
+		Value: &tokenty.TokenAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: "TEST", Amount: 100, To: AddrB}},
+	}
+	param := &mty.MultiSigSubmitTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          tokenty.TokenX,
+		Payload:         types.Encode(transfer),
+	}
+
+	//内层交易不合法
+	tx, _ := multiSigSubmitTx(&mty.MultiSigSubmitTx{MultiSigAccAddr: multiSigAddr, Execer: "coins"})
+	assert.Equal(t, mty.ErrInvalidSubmitTx, driver.CheckTx(tx, env.index))
+	tx, _ = multiSigSubmitTx(&mty.MultiSigSubmitTx{MultiSigAccAddr: multiSigAddr, Execer: mty.MultiSigX, Payload: param.Payload})
+	assert.Equal(t, mty.ErrInvalidSubmitTx, driver.CheckTx(tx, env.index))
+	coinsTransfer := &cty.CoinsAction{
+		Ty:    cty.CoinsActionTransfer,
+		Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: Symbol, Amount: OutAmount, To: AddrB}},
+	}
+	coinsParam := &mty.MultiSigSubmitTx{MultiSigAccAddr: multiSigAddr, Execer: "coins", Payload: types.Encode(coinsTransfer)}
+	tx, _ = multiSigSubmitTx(coinsParam)
+	assert.Nil(t, driver.CheckTx(tx, env.index))
+
+	//不是owner不能提交
+	tx, _ = multiSigSubmitTx(param)
+	tx, _ = signTx(tx, PrivKeyA)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+
+	//AddrC权重不够，只记录交易
+	tx, _ = multiSigSubmitTx(param)
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Nil(t, driver.CheckTx(tx, env.index))
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(receipt.Logs))
+	var receiptTx mty.ReceiptMultiSigTx
+	assert.Nil(t, types.Decode(receipt.Logs[1].Log, &receiptTx))
+	assert.Equal(t, mty.SubmitTxOperate, receiptTx.TxType)
+	assert.False(t, receiptTx.CurExecuted)
+	txid := receiptTx.MultiSigTxOwner.Txid
+
+	assert.Equal(t, int64(1000), tokenDB.LoadAccount(multiSigAddr).Balance)
+
+	//AddrD确认之后权重满足，交易被批准并在token执行器中以多重签名账户的名义执行
+	txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+
+	confirm, _ := multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: txid, ConfirmOrRevoke: true})
+	confirm, _ = signTx(confirm, PrivKeyD)
+	//目标执行器通过区块中的多重签名交易检查发起人
+	txs := make([]*types.Transaction, env.index+1)
+	txs[env.index] = confirm
+	driver.SetTxs(txs)
+	receipt, err = driver.Exec(confirm, env.index)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		stateDB.Set(kv.Key, kv.Value)
+	}
+	var approved mty.ReceiptMultiSigSubmitTx
+	for _, item := range receipt.Logs {
+		if item.Ty == mty.TyLogMultiSigSubmitTxApproved {
+			assert.Nil(t, types.Decode(item.Log, &approved))
+		}
+	}
+	assert.Equal(t, multiSigAddr, approved.MultiSigAddr)
+	assert.Equal(t, txid, approved.Txid)
+	assert.Equal(t, tokenty.TokenX, approved.Execer)
+	assert.Equal(t, param.Payload, approved.Payload)
+	assert.Equal(t, int64(900), tokenDB.LoadAccount(multiSigAddr).Balance)
+	assert.Equal(t, int64(100), tokenDB.LoadAccount(AddrB).Balance)
+
+	multiSigTx, err := getMultiSigAccTxFromDb(stateDB, multiSigAddr, txid)
+	assert.Nil(t, err)
+	assert.True(t, multiSigTx.Executed)
+	assert.Equal(t, 2, len(multiSigTx.ConfirmedOwner))
+
+	//token只允许多重签名交易修改账户余额和多重签名账户的授权额度
+	token, err := dapp.LoadDriver(tokenty.TokenX, env.blockHeight)
+	assert.Nil(t, err)
+	assert.True(t, token.IsFriend([]byte(tokenty.TokenX), []byte("mavl-token-TEST-"+AddrB), confirm))
+	assert.True(t, token.IsFriend([]byte(tokenty.TokenX), []byte("mavl-token-TEST-"+multiSigAddr), confirm))
+	assert.True(t, token.IsFriend([]byte(tokenty.TokenX), []byte("mavl-token-allowance-TEST-"+multiSigAddr+"-"+AddrB), confirm))
+	assert.False(t, token.IsFriend([]byte(tokenty.TokenX), []byte("mavl-token-allowance-TEST-"+AddrB+"-"+multiSigAddr), confirm))
+	assert.False(t, token.IsFriend([]byte(tokenty.TokenX), []byte("mavl-token-TEST"), confirm))
+
+	//不是多重签名交易时，token不接受指定的发起人
+	inner := &types.Transaction{Execer: []byte(tokenty.TokenX), Payload: param.Payload}
+	_, err = token.(execFromDriver).ExecFrom(multiSigAddr, inner, 0)
+	assert.Equal(t, types.ErrNotAllow, err)
+
+	//已经批准的交易不能再确认
+	confirm, _ = multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: txid, ConfirmOrRevoke: false})
+	confirm, _ = signTx(confirm, PrivKeyC)
+	_, err = driver.Exec(confirm, env.index)
+	assert.Equal(t, mty.ErrTxHasExecuted, err)
+}
+
+func multiSigSubmitTx(parm *mty.MultiSigSubmitTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigSubmitTx,
+		Value: &mty.MultiSigAction_MultiSigSubmitTx{MultiSigSubmitTx: parm},
+	}
+	return types.CreateFormatTx(types.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
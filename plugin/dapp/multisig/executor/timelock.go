@@ -0,0 +1,138 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+)
+
+//等待区块数和过期区块数都不能为负数，设置了过期时必须大于等待区块数
+func checkTimelock(executeDelay, proposalExpiry int64) error {
+	if executeDelay < 0 || proposalExpiry < 0 {
+		return mty.ErrInvalidTimelock
+	}
+	if proposalExpiry > 0 && executeDelay >= proposalExpiry {
+		return mty.ErrInvalidTimelock
+	}
+	return nil
+}
+
+//新提交交易的过期高度，没有设置过期时为0
+func (a *action) expireHeight(multiSigAcc *mty.MultiSig) int64 {
+	if multiSigAcc.ProposalExpiry == 0 || !types.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimelockX) {
+		return 0
+	}
+	return a.height + multiSigAcc.ProposalExpiry
+}
+
+//未执行的交易超过过期高度之后作废
+func isExpired(multiSigTx *mty.MultiSigTx, height int64) bool {
+	return !multiSigTx.Executed && multiSigTx.ExpireHeight > 0 && height > multiSigTx.ExpireHeight
+}
+
+//权重满足之后是否需要进入等待队列，第一次满足时记录进入队列的高度
+//MultiSigExecuteTx 执行时已经检查过等待期，不再等待
+func (a *action) holdTx(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx) bool {
+	if a.executeQueued || multiSigAcc.ExecuteDelay == 0 {
+		return false
+	}
+	if !types.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimelockX) {
+		return false
+	}
+	if multiSigTx.QueuedHeight == 0 {
+		multiSigTx.QueuedHeight = a.height
+	}
+	return true
+}
+
+//MultiSigExecuteTx 执行权重已经满足并且等待期已满的交易，任意owner都可以发起
+func (a *action) MultiSigExecuteTx(execTx *mty.MultiSigExecuteTx) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimelockX) {
+		return nil, types.ErrActionNotSupport
+	}
+	multiSigAccAddr := execTx.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigExecuteTx:getMultiSigAccFromDb", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+	//校验交易提交者是否是本账户的owner
+	ownerWeight, isowner := isOwner(multiSigAcc, a.fromaddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+	multiSigTx, err := getMultiSigAccTxFromDb(a.db, multiSigAccAddr, execTx.TxId)
+	if err != nil {
+		multisiglog.Error("MultiSigExecuteTx:getMultiSigAccTxFromDb", "multiSigAccAddr", multiSigAccAddr, "TxId", execTx.TxId, "err", err)
+		return nil, mty.ErrTxidNotExist
+	}
+	if multiSigTx.Executed {
+		return nil, mty.ErrTxHasExecuted
+	}
+	if isExpired(multiSigTx, a.height) {
+		return nil, mty.ErrTxExpired
+	}
+	//权重需要仍然满足要求
+	if multiSigTx.QueuedHeight == 0 || !isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) {
+		return nil, mty.ErrTxNotQueued
+	}
+	if a.height < multiSigTx.QueuedHeight+multiSigAcc.ExecuteDelay {
+		multisiglog.Error("MultiSigExecuteTx", "QueuedHeight", multiSigTx.QueuedHeight, "ExecuteDelay", multiSigAcc.ExecuteDelay, "height", a.height)
+		return nil, mty.ErrTxTimelocked
+	}
+
+	a.executeQueued = true
+	owner := &mty.Owner{OwnerAddr: a.fromaddr, Weight: ownerWeight}
+	return a.dispatchMultiSigTx(multiSigAcc, multiSigTx, owner)
+}
+
+//多重签名账户等待区块数和过期区块数的修改,返回新的KeyValue对和ReceiptLog信息
+func (a *action) multiSigTimelockModify(multiSigAccAddr string, accountOperate *mty.MultiSigAccOperate) (*types.KeyValue, *types.ReceiptLog, error) {
+	multiSigAccount, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("multiSigTimelockModify", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, nil, err
+	}
+	if err := checkTimelock(accountOperate.ExecuteDelay, accountOperate.ProposalExpiry); err != nil {
+		return nil, nil, err
+	}
+
+	receiptTimelock := &mty.ReceiptTimelockModify{
+		MultiSigAddr:       multiSigAccount.MultiSigAddr,
+		PrevExecuteDelay:   multiSigAccount.ExecuteDelay,
+		PrevProposalExpiry: multiSigAccount.ProposalExpiry,
+		CurExecuteDelay:    accountOperate.ExecuteDelay,
+		CurProposalExpiry:  accountOperate.ProposalExpiry,
+	}
+	multiSigAccount.ExecuteDelay = accountOperate.ExecuteDelay
+	multiSigAccount.ProposalExpiry = accountOperate.ProposalExpiry
+	receiptLog := &types.ReceiptLog{Ty: mty.TyLogMultiSigAccTimelockModify, Log: types.Encode(receiptTimelock)}
+
+	key, value := setMultiSigAccToDb(a.db, multiSigAccount)
+	kv := &types.KeyValue{Key: key, Value: value}
+	return kv, receiptLog, nil
+}
+
+//localdb中多重签名账户的等待区块数和过期区块数更新
+func (m *MultiSig) saveMultiSigAccTimelock(accountOp mty.ReceiptTimelockModify, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSig, err := getMultiSigAccount(m.GetLocalDB(), accountOp.MultiSigAddr)
+	if err != nil || multiSig == nil {
+		return nil, err
+	}
+	if addOrRollback {
+		multiSig.ExecuteDelay = accountOp.CurExecuteDelay
+		multiSig.ProposalExpiry = accountOp.CurProposalExpiry
+	} else {
+		multiSig.ExecuteDelay = accountOp.PrevExecuteDelay
+		multiSig.ProposalExpiry = accountOp.PrevProposalExpiry
+	}
+
+	err = setMultiSigAccount(m.GetLocalDB(), multiSig, true)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.KeyValue{getMultiSigAccountKV(multiSig, true)}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMultiSigTimelock(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		types.GetDappFork(mty.MultiSigX, mty.ForkMultiSigTimelockX),
+		2,
+		1539918074,
+		"hash",
+	}
+	executeDelay := int64(10)
+	proposalExpiry := int64(100)
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	dir, ldb, localDB := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	api := new(apimock.QueueProtocolAPI)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+	driver.SetAPI(api)
+
+	txs := make(map[string]*types.Transaction)
+	api.On("GetTransactionByHash", mock.Anything).Return(func(req *types.ReqHashes) *types.TransactionDetails {
+		return &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: txs[string(req.Hashes[0])]}}}
+	}, nil)
+	run := func(tx *types.Transaction, priv string) (*types.Receipt, error) {
+		tx, _ = signTx(tx, priv)
+		txs[string(tx.Hash())] = tx
+		receipt, err := driver.Exec(tx, env.index)
+		if err != nil {
+			return nil, err
+		}
+		set, err := driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			localDB.Set(kv.Key, kv.Value)
+		}
+		return receipt, nil
+	}
+	setHeight := func(height int64) {
+		driver.SetEnv(height, env.blockTime, env.difficulty)
+	}
+	weightModify := func(multiSigAddr string, weight uint64) *types.Transaction {
+		tx, _ := multiSigAccOperate(&mty.MultiSigAccOperate{MultiSigAccAddr: multiSigAddr, NewRequiredWeight: weight, OperateFlag: mty.AccWeightOp})
+		return tx
+	}
+	execute := func(multiSigAddr string, txid uint64) *types.Transaction {
+		tx, _ := multiSigExecuteTx(&mty.MultiSigExecuteTx{MultiSigAccAddr: multiSigAddr, TxId: txid})
+		return tx
+	}
+
+	//过期区块数必须大于等待区块数
+	create := &mty.MultiSigAccCreate{
+		Owners:         []*mty.Owner{{OwnerAddr: AddrC, Weight: AddrCWeight}, {OwnerAddr: AddrD, Weight: AddrDWeight}},
+		RequiredWeight: Requiredweight,
+		DailyLimit:     &mty.SymbolDailyLimit{Symbol: Symbol, Execer: "coins", DailyLimit: CoinsBtyDailylimit},
+		ExecuteDelay:   proposalExpiry,
+		ProposalExpiry: proposalExpiry,
+	}
+	tx, _ := multiSigAccCreate(create)
+	assert.Equal(t, mty.ErrInvalidTimelock, driver.CheckTx(tx, env.index))
+
+	create.ExecuteDelay = executeDelay
+	tx, _ = multiSigAccCreate(create)
+	receipt, err := run(tx, PrivKeyA)
+	assert.Nil(t, err)
+	var multiSigAcc mty.MultiSig
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &multiSigAcc))
+	multiSigAddr := multiSigAcc.MultiSigAddr
+	assert.Equal(t, executeDelay, multiSigAcc.ExecuteDelay)
+
+	//AddrD权重满足，交易进入等待队列
+	height := env.blockHeight
+	receipt, err = run(weightModify(multiSigAddr, NewRequiredweight), PrivKeyD)
+	assert.Nil(t, err)
+	multiSigTx, err := getMultiSigAccTxFromDb(stateDB, multiSigAddr, 0)
+	assert.Nil(t, err)
+	assert.False(t, multiSigTx.Executed)
+	assert.Equal(t, height, multiSigTx.QueuedHeight)
+	assert.Equal(t, height+proposalExpiry, multiSigTx.ExpireHeight)
+
+	//等待期未满不能执行，非owner不能执行
+	_, err = run(execute(multiSigAddr, 0), PrivKeyC)
+	assert.Equal(t, mty.ErrTxTimelocked, err)
+	setHeight(height + executeDelay)
+	_, err = run(execute(multiSigAddr, 0), PrivKeyA)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+
+	//AddrC提交的交易权重不够，过期之后不能再确认
+	receipt, err = run(weightModify(multiSigAddr, Requiredweight), PrivKeyC)
+	assert.Nil(t, err)
+
+	out, err := driver.Query("MultiSigTxids", types.Encode(&mty.ReqMultiSigTxids{MultiSigAddr: multiSigAddr, FromTxId: 0, ToTxId: 1, Queued: true}))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{0}, out.(*mty.ReplyMultiSigTxids).Txids)
+
+	//等待期满，AddrC执行交易
+	receipt, err = run(execute(multiSigAddr, 0), PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(mty.TyLogMultiSigAccWeightModify), receipt.Logs[0].Ty)
+	multiSigTx, err = getMultiSigAccTxFromDb(stateDB, multiSigAddr, 0)
+	assert.Nil(t, err)
+	assert.True(t, multiSigTx.Executed)
+	assert.Equal(t, 1, len(multiSigTx.ConfirmedOwner))
+	multiSig, err := getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, NewRequiredweight, multiSig.RequiredWeight)
+	_, err = run(execute(multiSigAddr, 0), PrivKeyC)
+	assert.Equal(t, mty.ErrTxHasExecuted, err)
+
+	out, err = driver.Query("MultiSigTxids", types.Encode(&mty.ReqMultiSigTxids{MultiSigAddr: multiSigAddr, FromTxId: 0, ToTxId: 1, Executed: true}))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{0}, out.(*mty.ReplyMultiSigTxids).Txids)
+
+	setHeight(height + executeDelay + proposalExpiry + 1)
+	confirm, _ := multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: 1, ConfirmOrRevoke: true})
+	_, err = run(confirm, PrivKeyD)
+	assert.Equal(t, mty.ErrTxExpired, err)
+
+	out, err = driver.Query("MultiSigTxids", types.Encode(&mty.ReqMultiSigTxids{MultiSigAddr: multiSigAddr, FromTxId: 0, ToTxId: 1, Expired: true}))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1}, out.(*mty.ReplyMultiSigTxids).Txids)
+}
+
+func multiSigExecuteTx(parm *mty.MultiSigExecuteTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigExecuteTx,
+		Value: &mty.MultiSigAction_MultiSigExecuteTx{MultiSigExecuteTx: parm},
+	}
+	return types.CreateFormatTx(types.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
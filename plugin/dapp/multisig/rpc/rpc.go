@@ -92,6 +92,32 @@ func (c *Jrpc) MultiSigAccTransferOutTx(param *mty.MultiSigExecTransferFrom, res
 	return nil
 }
 
+// MultiSigSubmitTx :构造多重签名账户提交任意执行器交易的交易
+func (c *Jrpc) MultiSigSubmitTx(param *mty.MultiSigSubmitTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(mty.MultiSigX), "MultiSigSubmitTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigExecuteTx :构造执行等待期已满的多重签名交易
+func (c *Jrpc) MultiSigExecuteTx(param *mty.MultiSigExecuteTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(mty.MultiSigX), "MultiSigExecuteTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
 // MultiSigAddresList 获取owner地址上的多重签名账户列表{multiSigAddr，owneraddr，weight}
 func (c *Jrpc) MultiSigAddresList(in *types.ReqString, result *interface{}) error {
 	v := *in
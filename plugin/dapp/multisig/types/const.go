@@ -24,6 +24,7 @@ var (
 	OwnerOperate    uint64 = 1
 	AccountOperate  uint64 = 2
 	TransferOperate uint64 = 3
+	SubmitTxOperate uint64 = 4
 	//IsSubmit ：
 	IsSubmit  = true
 	IsConfirm = false
@@ -37,6 +38,13 @@ var (
 	Multisiglog = log15.New("module", MultiSigX)
 )
 
+//ForkMultiSigSubmitTxX 支持提交任意执行器交易的分叉
+//ForkMultiSigTimelockX 支持交易等待期和过期高度的分叉
+const (
+	ForkMultiSigSubmitTxX = "ForkMultiSigSubmitTx"
+	ForkMultiSigTimelockX = "ForkMultiSigTimelock"
+)
+
 // MultiSig 交易的actionid
 const (
 	ActionMultiSigAccCreate        = 10000
@@ -45,6 +53,8 @@ const (
 	ActionMultiSigConfirmTx        = 10003
 	ActionMultiSigExecTransferTo   = 10004
 	ActionMultiSigExecTransferFrom = 10005
+	ActionMultiSigSubmitTx         = 10006
+	ActionMultiSigExecuteTx        = 10007
 )
 
 //多重签名账户执行输出的logid
@@ -67,6 +77,9 @@ const (
 	TyLogMultiSigTx       = 10011 //在Submit提交交易阶段才会有更新
 	TyLogTxCountUpdate    = 10012 //txcount只在在Submit阶段提交新的交易是才会增加计数
 
+	TyLogMultiSigSubmitTxApproved  = 10013 //SubmitTx交易权重满足，输出被批准的交易信息
+	TyLogMultiSigAccTimelockModify = 10014 //输出修改前后的executeDelay和proposalExpiry
+
 )
 
 //AccAssetsResult 账户资产cli的显示，主要是amount需要转换成浮点型字符串
@@ -97,6 +110,8 @@ type MultiSigResult struct {
 	DailyLimits    []*DailyLimitResult `json:"dailyLimits,omitempty"`
 	TxCount        uint64              `json:"txCount,omitempty"`
 	RequiredWeight uint64              `json:"requiredWeight,omitempty"`
+	ExecuteDelay   int64               `json:"executeDelay,omitempty"`
+	ProposalExpiry int64               `json:"proposalExpiry,omitempty"`
 }
 
 //UnSpentAssetsResult 每日限额之内未花费额度的显示cli
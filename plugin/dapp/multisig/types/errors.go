@@ -41,4 +41,10 @@ var (
 	ErrInvalidExec          = errors.New("ErrInvalidExec")
 	ErrInvalidWeight        = errors.New("ErrInvalidWeight")
 	ErrInvalidDailyLimit    = errors.New("ErrInvalidDailyLimit")
+	ErrInvalidSubmitTx      = errors.New("ErrInvalidSubmitTx")
+	ErrSubmitTxNotSupport   = errors.New("ErrSubmitTxNotSupport")
+	ErrInvalidTimelock      = errors.New("ErrInvalidTimelock")
+	ErrTxExpired            = errors.New("ErrTxExpired")
+	ErrTxNotQueued          = errors.New("ErrTxNotQueued")
+	ErrTxTimelocked         = errors.New("ErrTxTimelocked")
 )
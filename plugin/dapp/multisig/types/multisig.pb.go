@@ -5,12 +5,9 @@ package types
 
 import (
 	fmt "fmt"
-
+	types "github.com/33cn/chain33/types"
 	proto "github.com/golang/protobuf/proto"
-
 	math "math"
-
-	types "github.com/33cn/chain33/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -22,7 +19,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // ////////////////////////////////////////////////////////////////////////////
 // message for multisig start/////////////////////////////////////////////////////
@@ -41,6 +38,8 @@ type MultiSig struct {
 	DailyLimits          []*DailyLimit `protobuf:"bytes,4,rep,name=dailyLimits,proto3" json:"dailyLimits,omitempty"`
 	TxCount              uint64        `protobuf:"varint,5,opt,name=txCount,proto3" json:"txCount,omitempty"`
 	RequiredWeight       uint64        `protobuf:"varint,6,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	ExecuteDelay         int64         `protobuf:"varint,7,opt,name=executeDelay,proto3" json:"executeDelay,omitempty"`
+	ProposalExpiry       int64         `protobuf:"varint,8,opt,name=proposalExpiry,proto3" json:"proposalExpiry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -50,16 +49,17 @@ func (m *MultiSig) Reset()         { *m = MultiSig{} }
 func (m *MultiSig) String() string { return proto.CompactTextString(m) }
 func (*MultiSig) ProtoMessage()    {}
 func (*MultiSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{0}
+	return fileDescriptor_62b8b91adf3febfa, []int{0}
 }
+
 func (m *MultiSig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSig.Unmarshal(m, b)
 }
 func (m *MultiSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSig.Marshal(b, m, deterministic)
 }
-func (dst *MultiSig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSig.Merge(dst, src)
+func (m *MultiSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSig.Merge(m, src)
 }
 func (m *MultiSig) XXX_Size() int {
 	return xxx_messageInfo_MultiSig.Size(m)
@@ -112,6 +112,20 @@ func (m *MultiSig) GetRequiredWeight() uint64 {
 	return 0
 }
 
+func (m *MultiSig) GetExecuteDelay() int64 {
+	if m != nil {
+		return m.ExecuteDelay
+	}
+	return 0
+}
+
+func (m *MultiSig) GetProposalExpiry() int64 {
+	if m != nil {
+		return m.ProposalExpiry
+	}
+	return 0
+}
+
 // 这个地址是否已经确认某个交易
 type ConfirmedOwner struct {
 	ConfirmedOwner       []*Owner `protobuf:"bytes,1,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
@@ -124,16 +138,17 @@ func (m *ConfirmedOwner) Reset()         { *m = ConfirmedOwner{} }
 func (m *ConfirmedOwner) String() string { return proto.CompactTextString(m) }
 func (*ConfirmedOwner) ProtoMessage()    {}
 func (*ConfirmedOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{1}
+	return fileDescriptor_62b8b91adf3febfa, []int{1}
 }
+
 func (m *ConfirmedOwner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmedOwner.Unmarshal(m, b)
 }
 func (m *ConfirmedOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ConfirmedOwner.Marshal(b, m, deterministic)
 }
-func (dst *ConfirmedOwner) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfirmedOwner.Merge(dst, src)
+func (m *ConfirmedOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmedOwner.Merge(m, src)
 }
 func (m *ConfirmedOwner) XXX_Size() int {
 	return xxx_messageInfo_ConfirmedOwner.Size(m)
@@ -160,6 +175,8 @@ type MultiSigTx struct {
 	TxType               uint64   `protobuf:"varint,4,opt,name=txType,proto3" json:"txType,omitempty"`
 	MultiSigAddr         string   `protobuf:"bytes,5,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	ConfirmedOwner       []*Owner `protobuf:"bytes,6,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,7,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	QueuedHeight         int64    `protobuf:"varint,8,opt,name=queuedHeight,proto3" json:"queuedHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -169,16 +186,17 @@ func (m *MultiSigTx) Reset()         { *m = MultiSigTx{} }
 func (m *MultiSigTx) String() string { return proto.CompactTextString(m) }
 func (*MultiSigTx) ProtoMessage()    {}
 func (*MultiSigTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{2}
+	return fileDescriptor_62b8b91adf3febfa, []int{2}
 }
+
 func (m *MultiSigTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigTx.Unmarshal(m, b)
 }
 func (m *MultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigTx.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigTx.Merge(dst, src)
+func (m *MultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTx.Merge(m, src)
 }
 func (m *MultiSigTx) XXX_Size() int {
 	return xxx_messageInfo_MultiSigTx.Size(m)
@@ -231,6 +249,20 @@ func (m *MultiSigTx) GetConfirmedOwner() []*Owner {
 	return nil
 }
 
+func (m *MultiSigTx) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetQueuedHeight() int64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
 // owner 结构体：owner账户地址，以及权重
 type Owner struct {
 	OwnerAddr            string   `protobuf:"bytes,1,opt,name=ownerAddr,proto3" json:"ownerAddr,omitempty"`
@@ -244,16 +276,17 @@ func (m *Owner) Reset()         { *m = Owner{} }
 func (m *Owner) String() string { return proto.CompactTextString(m) }
 func (*Owner) ProtoMessage()    {}
 func (*Owner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{3}
+	return fileDescriptor_62b8b91adf3febfa, []int{3}
 }
+
 func (m *Owner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Owner.Unmarshal(m, b)
 }
 func (m *Owner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Owner.Marshal(b, m, deterministic)
 }
-func (dst *Owner) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Owner.Merge(dst, src)
+func (m *Owner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Owner.Merge(m, src)
 }
 func (m *Owner) XXX_Size() int {
 	return xxx_messageInfo_Owner.Size(m)
@@ -296,16 +329,17 @@ func (m *DailyLimit) Reset()         { *m = DailyLimit{} }
 func (m *DailyLimit) String() string { return proto.CompactTextString(m) }
 func (*DailyLimit) ProtoMessage()    {}
 func (*DailyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{4}
+	return fileDescriptor_62b8b91adf3febfa, []int{4}
 }
+
 func (m *DailyLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DailyLimit.Unmarshal(m, b)
 }
 func (m *DailyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_DailyLimit.Marshal(b, m, deterministic)
 }
-func (dst *DailyLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DailyLimit.Merge(dst, src)
+func (m *DailyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyLimit.Merge(m, src)
 }
 func (m *DailyLimit) XXX_Size() int {
 	return xxx_messageInfo_DailyLimit.Size(m)
@@ -364,16 +398,17 @@ func (m *SymbolDailyLimit) Reset()         { *m = SymbolDailyLimit{} }
 func (m *SymbolDailyLimit) String() string { return proto.CompactTextString(m) }
 func (*SymbolDailyLimit) ProtoMessage()    {}
 func (*SymbolDailyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{5}
+	return fileDescriptor_62b8b91adf3febfa, []int{5}
 }
+
 func (m *SymbolDailyLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SymbolDailyLimit.Unmarshal(m, b)
 }
 func (m *SymbolDailyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_SymbolDailyLimit.Marshal(b, m, deterministic)
 }
-func (dst *SymbolDailyLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SymbolDailyLimit.Merge(dst, src)
+func (m *SymbolDailyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolDailyLimit.Merge(m, src)
 }
 func (m *SymbolDailyLimit) XXX_Size() int {
 	return xxx_messageInfo_SymbolDailyLimit.Size(m)
@@ -414,6 +449,8 @@ type MultiSigAction struct {
 	//	*MultiSigAction_MultiSigConfirmTx
 	//	*MultiSigAction_MultiSigExecTransferTo
 	//	*MultiSigAction_MultiSigExecTransferFrom
+	//	*MultiSigAction_MultiSigSubmitTx
+	//	*MultiSigAction_MultiSigExecuteTx
 	Value                isMultiSigAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -425,16 +462,17 @@ func (m *MultiSigAction) Reset()         { *m = MultiSigAction{} }
 func (m *MultiSigAction) String() string { return proto.CompactTextString(m) }
 func (*MultiSigAction) ProtoMessage()    {}
 func (*MultiSigAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{6}
+	return fileDescriptor_62b8b91adf3febfa, []int{6}
 }
+
 func (m *MultiSigAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigAction.Unmarshal(m, b)
 }
 func (m *MultiSigAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigAction.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigAction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigAction.Merge(dst, src)
+func (m *MultiSigAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAction.Merge(m, src)
 }
 func (m *MultiSigAction) XXX_Size() int {
 	return xxx_messageInfo_MultiSigAction.Size(m)
@@ -473,6 +511,14 @@ type MultiSigAction_MultiSigExecTransferFrom struct {
 	MultiSigExecTransferFrom *MultiSigExecTransferFrom `protobuf:"bytes,6,opt,name=multiSigExecTransferFrom,proto3,oneof"`
 }
 
+type MultiSigAction_MultiSigSubmitTx struct {
+	MultiSigSubmitTx *MultiSigSubmitTx `protobuf:"bytes,8,opt,name=multiSigSubmitTx,proto3,oneof"`
+}
+
+type MultiSigAction_MultiSigExecuteTx struct {
+	MultiSigExecuteTx *MultiSigExecuteTx `protobuf:"bytes,9,opt,name=multiSigExecuteTx,proto3,oneof"`
+}
+
 func (*MultiSigAction_MultiSigAccCreate) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigOwnerOperate) isMultiSigAction_Value() {}
@@ -485,6 +531,10 @@ func (*MultiSigAction_MultiSigExecTransferTo) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigExecTransferFrom) isMultiSigAction_Value() {}
 
+func (*MultiSigAction_MultiSigSubmitTx) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_MultiSigExecuteTx) isMultiSigAction_Value() {}
+
 func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
 	if m != nil {
 		return m.Value
@@ -534,6 +584,20 @@ func (m *MultiSigAction) GetMultiSigExecTransferFrom() *MultiSigExecTransferFrom
 	return nil
 }
 
+func (m *MultiSigAction) GetMultiSigSubmitTx() *MultiSigSubmitTx {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigSubmitTx); ok {
+		return x.MultiSigSubmitTx
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetMultiSigExecuteTx() *MultiSigExecuteTx {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigExecuteTx); ok {
+		return x.MultiSigExecuteTx
+	}
+	return nil
+}
+
 func (m *MultiSigAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -550,6 +614,8 @@ func (*MultiSigAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer
 		(*MultiSigAction_MultiSigConfirmTx)(nil),
 		(*MultiSigAction_MultiSigExecTransferTo)(nil),
 		(*MultiSigAction_MultiSigExecTransferFrom)(nil),
+		(*MultiSigAction_MultiSigSubmitTx)(nil),
+		(*MultiSigAction_MultiSigExecuteTx)(nil),
 	}
 }
 
@@ -587,6 +653,16 @@ func _MultiSigAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MultiSigExecTransferFrom); err != nil {
 			return err
 		}
+	case *MultiSigAction_MultiSigSubmitTx:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultiSigSubmitTx); err != nil {
+			return err
+		}
+	case *MultiSigAction_MultiSigExecuteTx:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultiSigExecuteTx); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("MultiSigAction.Value has unexpected type %T", x)
@@ -645,6 +721,22 @@ func _MultiSigAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Value = &MultiSigAction_MultiSigExecTransferFrom{msg}
 		return true, err
+	case 8: // value.multiSigSubmitTx
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigSubmitTx)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_MultiSigSubmitTx{msg}
+		return true, err
+	case 9: // value.multiSigExecuteTx
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigExecuteTx)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_MultiSigExecuteTx{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -684,6 +776,16 @@ func _MultiSigAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *MultiSigAction_MultiSigSubmitTx:
+		s := proto.Size(x.MultiSigSubmitTx)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_MultiSigExecuteTx:
+		s := proto.Size(x.MultiSigExecuteTx)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -696,6 +798,8 @@ type MultiSigAccCreate struct {
 	Owners               []*Owner          `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
 	RequiredWeight       uint64            `protobuf:"varint,2,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
 	DailyLimit           *SymbolDailyLimit `protobuf:"bytes,3,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	ExecuteDelay         int64             `protobuf:"varint,4,opt,name=executeDelay,proto3" json:"executeDelay,omitempty"`
+	ProposalExpiry       int64             `protobuf:"varint,5,opt,name=proposalExpiry,proto3" json:"proposalExpiry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -705,16 +809,17 @@ func (m *MultiSigAccCreate) Reset()         { *m = MultiSigAccCreate{} }
 func (m *MultiSigAccCreate) String() string { return proto.CompactTextString(m) }
 func (*MultiSigAccCreate) ProtoMessage()    {}
 func (*MultiSigAccCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{7}
+	return fileDescriptor_62b8b91adf3febfa, []int{7}
 }
+
 func (m *MultiSigAccCreate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigAccCreate.Unmarshal(m, b)
 }
 func (m *MultiSigAccCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigAccCreate.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigAccCreate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigAccCreate.Merge(dst, src)
+func (m *MultiSigAccCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccCreate.Merge(m, src)
 }
 func (m *MultiSigAccCreate) XXX_Size() int {
 	return xxx_messageInfo_MultiSigAccCreate.Size(m)
@@ -746,6 +851,20 @@ func (m *MultiSigAccCreate) GetDailyLimit() *SymbolDailyLimit {
 	return nil
 }
 
+func (m *MultiSigAccCreate) GetExecuteDelay() int64 {
+	if m != nil {
+		return m.ExecuteDelay
+	}
+	return 0
+}
+
+func (m *MultiSigAccCreate) GetProposalExpiry() int64 {
+	if m != nil {
+		return m.ProposalExpiry
+	}
+	return 0
+}
+
 // 对MultiSigAccount账户owner的操作：add/del/replace/modify
 type MultiSigOwnerOperate struct {
 	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
@@ -762,16 +881,17 @@ func (m *MultiSigOwnerOperate) Reset()         { *m = MultiSigOwnerOperate{} }
 func (m *MultiSigOwnerOperate) String() string { return proto.CompactTextString(m) }
 func (*MultiSigOwnerOperate) ProtoMessage()    {}
 func (*MultiSigOwnerOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{8}
+	return fileDescriptor_62b8b91adf3febfa, []int{8}
 }
+
 func (m *MultiSigOwnerOperate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigOwnerOperate.Unmarshal(m, b)
 }
 func (m *MultiSigOwnerOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigOwnerOperate.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigOwnerOperate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigOwnerOperate.Merge(dst, src)
+func (m *MultiSigOwnerOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigOwnerOperate.Merge(m, src)
 }
 func (m *MultiSigOwnerOperate) XXX_Size() int {
 	return xxx_messageInfo_MultiSigOwnerOperate.Size(m)
@@ -824,6 +944,9 @@ type MultiSigAccOperate struct {
 	DailyLimit           *SymbolDailyLimit `protobuf:"bytes,2,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
 	NewRequiredWeight    uint64            `protobuf:"varint,3,opt,name=newRequiredWeight,proto3" json:"newRequiredWeight,omitempty"`
 	OperateFlag          bool              `protobuf:"varint,4,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	TimelockFlag         bool              `protobuf:"varint,5,opt,name=timelockFlag,proto3" json:"timelockFlag,omitempty"`
+	ExecuteDelay         int64             `protobuf:"varint,6,opt,name=executeDelay,proto3" json:"executeDelay,omitempty"`
+	ProposalExpiry       int64             `protobuf:"varint,7,opt,name=proposalExpiry,proto3" json:"proposalExpiry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -833,16 +956,17 @@ func (m *MultiSigAccOperate) Reset()         { *m = MultiSigAccOperate{} }
 func (m *MultiSigAccOperate) String() string { return proto.CompactTextString(m) }
 func (*MultiSigAccOperate) ProtoMessage()    {}
 func (*MultiSigAccOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{9}
+	return fileDescriptor_62b8b91adf3febfa, []int{9}
 }
+
 func (m *MultiSigAccOperate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigAccOperate.Unmarshal(m, b)
 }
 func (m *MultiSigAccOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigAccOperate.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigAccOperate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigAccOperate.Merge(dst, src)
+func (m *MultiSigAccOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccOperate.Merge(m, src)
 }
 func (m *MultiSigAccOperate) XXX_Size() int {
 	return xxx_messageInfo_MultiSigAccOperate.Size(m)
@@ -881,6 +1005,27 @@ func (m *MultiSigAccOperate) GetOperateFlag() bool {
 	return false
 }
 
+func (m *MultiSigAccOperate) GetTimelockFlag() bool {
+	if m != nil {
+		return m.TimelockFlag
+	}
+	return false
+}
+
+func (m *MultiSigAccOperate) GetExecuteDelay() int64 {
+	if m != nil {
+		return m.ExecuteDelay
+	}
+	return 0
+}
+
+func (m *MultiSigAccOperate) GetProposalExpiry() int64 {
+	if m != nil {
+		return m.ProposalExpiry
+	}
+	return 0
+}
+
 // 多重签名合约中账户之间转币操作:增加一个from的字段实现MultiSigAddr--->addr之间的转账
 // 需要判断from地址是否是多重签名地址
 // 将MultiSig合约中from地址上execname+symbol的资产转移到to地址
@@ -900,16 +1045,17 @@ func (m *MultiSigExecTransferFrom) Reset()         { *m = MultiSigExecTransferFr
 func (m *MultiSigExecTransferFrom) String() string { return proto.CompactTextString(m) }
 func (*MultiSigExecTransferFrom) ProtoMessage()    {}
 func (*MultiSigExecTransferFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{10}
+	return fileDescriptor_62b8b91adf3febfa, []int{10}
 }
+
 func (m *MultiSigExecTransferFrom) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigExecTransferFrom.Unmarshal(m, b)
 }
 func (m *MultiSigExecTransferFrom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigExecTransferFrom.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigExecTransferFrom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigExecTransferFrom.Merge(dst, src)
+func (m *MultiSigExecTransferFrom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigExecTransferFrom.Merge(m, src)
 }
 func (m *MultiSigExecTransferFrom) XXX_Size() int {
 	return xxx_messageInfo_MultiSigExecTransferFrom.Size(m)
@@ -980,16 +1126,17 @@ func (m *MultiSigExecTransferTo) Reset()         { *m = MultiSigExecTransferTo{}
 func (m *MultiSigExecTransferTo) String() string { return proto.CompactTextString(m) }
 func (*MultiSigExecTransferTo) ProtoMessage()    {}
 func (*MultiSigExecTransferTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{11}
+	return fileDescriptor_62b8b91adf3febfa, []int{11}
 }
+
 func (m *MultiSigExecTransferTo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigExecTransferTo.Unmarshal(m, b)
 }
 func (m *MultiSigExecTransferTo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigExecTransferTo.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigExecTransferTo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigExecTransferTo.Merge(dst, src)
+func (m *MultiSigExecTransferTo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigExecTransferTo.Merge(m, src)
 }
 func (m *MultiSigExecTransferTo) XXX_Size() int {
 	return xxx_messageInfo_MultiSigExecTransferTo.Size(m)
@@ -1035,6 +1182,119 @@ func (m *MultiSigExecTransferTo) GetTo() string {
 	return ""
 }
 
+// 多重签名账户提交的任意执行器交易，payload为目标执行器的action
+// 权重满足之后在目标执行器中以多重签名地址作为发起人执行
+type MultiSigSubmitTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigSubmitTx) Reset()         { *m = MultiSigSubmitTx{} }
+func (m *MultiSigSubmitTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigSubmitTx) ProtoMessage()    {}
+func (*MultiSigSubmitTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{12}
+}
+
+func (m *MultiSigSubmitTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigSubmitTx.Unmarshal(m, b)
+}
+func (m *MultiSigSubmitTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigSubmitTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigSubmitTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigSubmitTx.Merge(m, src)
+}
+func (m *MultiSigSubmitTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigSubmitTx.Size(m)
+}
+func (m *MultiSigSubmitTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigSubmitTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigSubmitTx proto.InternalMessageInfo
+
+func (m *MultiSigSubmitTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigSubmitTx) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigSubmitTx) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MultiSigSubmitTx) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// 执行已经满足权重并且等待期已满的交易
+type MultiSigExecuteTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigExecuteTx) Reset()         { *m = MultiSigExecuteTx{} }
+func (m *MultiSigExecuteTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigExecuteTx) ProtoMessage()    {}
+func (*MultiSigExecuteTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{13}
+}
+
+func (m *MultiSigExecuteTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigExecuteTx.Unmarshal(m, b)
+}
+func (m *MultiSigExecuteTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigExecuteTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigExecuteTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigExecuteTx.Merge(m, src)
+}
+func (m *MultiSigExecuteTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigExecuteTx.Size(m)
+}
+func (m *MultiSigExecuteTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigExecuteTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigExecuteTx proto.InternalMessageInfo
+
+func (m *MultiSigExecuteTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigExecuteTx) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
 // 多重签名账户withdraw交易的确认或者取消确认
 // multisigaccaddr:多重签名账户地址
 // transactionid:多重签名账户上的withdraw交易的内部id
@@ -1051,16 +1311,17 @@ func (m *MultiSigConfirmTx) Reset()         { *m = MultiSigConfirmTx{} }
 func (m *MultiSigConfirmTx) String() string { return proto.CompactTextString(m) }
 func (*MultiSigConfirmTx) ProtoMessage()    {}
 func (*MultiSigConfirmTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{12}
+	return fileDescriptor_62b8b91adf3febfa, []int{14}
 }
+
 func (m *MultiSigConfirmTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigConfirmTx.Unmarshal(m, b)
 }
 func (m *MultiSigConfirmTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigConfirmTx.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigConfirmTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigConfirmTx.Merge(dst, src)
+func (m *MultiSigConfirmTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigConfirmTx.Merge(m, src)
 }
 func (m *MultiSigConfirmTx) XXX_Size() int {
 	return xxx_messageInfo_MultiSigConfirmTx.Size(m)
@@ -1105,16 +1366,17 @@ func (m *ReqMultiSigAccs) Reset()         { *m = ReqMultiSigAccs{} }
 func (m *ReqMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccs) ProtoMessage()    {}
 func (*ReqMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{13}
+	return fileDescriptor_62b8b91adf3febfa, []int{15}
 }
+
 func (m *ReqMultiSigAccs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqMultiSigAccs.Unmarshal(m, b)
 }
 func (m *ReqMultiSigAccs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqMultiSigAccs.Marshal(b, m, deterministic)
 }
-func (dst *ReqMultiSigAccs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqMultiSigAccs.Merge(dst, src)
+func (m *ReqMultiSigAccs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigAccs.Merge(m, src)
 }
 func (m *ReqMultiSigAccs) XXX_Size() int {
 	return xxx_messageInfo_ReqMultiSigAccs.Size(m)
@@ -1150,16 +1412,17 @@ func (m *ReplyMultiSigAccs) Reset()         { *m = ReplyMultiSigAccs{} }
 func (m *ReplyMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccs) ProtoMessage()    {}
 func (*ReplyMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{14}
+	return fileDescriptor_62b8b91adf3febfa, []int{16}
 }
+
 func (m *ReplyMultiSigAccs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyMultiSigAccs.Unmarshal(m, b)
 }
 func (m *ReplyMultiSigAccs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyMultiSigAccs.Marshal(b, m, deterministic)
 }
-func (dst *ReplyMultiSigAccs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyMultiSigAccs.Merge(dst, src)
+func (m *ReplyMultiSigAccs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigAccs.Merge(m, src)
 }
 func (m *ReplyMultiSigAccs) XXX_Size() int {
 	return xxx_messageInfo_ReplyMultiSigAccs.Size(m)
@@ -1189,16 +1452,17 @@ func (m *ReqMultiSigAccInfo) Reset()         { *m = ReqMultiSigAccInfo{} }
 func (m *ReqMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccInfo) ProtoMessage()    {}
 func (*ReqMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{15}
+	return fileDescriptor_62b8b91adf3febfa, []int{17}
 }
+
 func (m *ReqMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqMultiSigAccInfo.Unmarshal(m, b)
 }
 func (m *ReqMultiSigAccInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqMultiSigAccInfo.Marshal(b, m, deterministic)
 }
-func (dst *ReqMultiSigAccInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqMultiSigAccInfo.Merge(dst, src)
+func (m *ReqMultiSigAccInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigAccInfo.Merge(m, src)
 }
 func (m *ReqMultiSigAccInfo) XXX_Size() int {
 	return xxx_messageInfo_ReqMultiSigAccInfo.Size(m)
@@ -1233,16 +1497,17 @@ func (m *ReplyMultiSigAccInfo) Reset()         { *m = ReplyMultiSigAccInfo{} }
 func (m *ReplyMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccInfo) ProtoMessage()    {}
 func (*ReplyMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{16}
+	return fileDescriptor_62b8b91adf3febfa, []int{18}
 }
+
 func (m *ReplyMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyMultiSigAccInfo.Unmarshal(m, b)
 }
 func (m *ReplyMultiSigAccInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyMultiSigAccInfo.Marshal(b, m, deterministic)
 }
-func (dst *ReplyMultiSigAccInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyMultiSigAccInfo.Merge(dst, src)
+func (m *ReplyMultiSigAccInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigAccInfo.Merge(m, src)
 }
 func (m *ReplyMultiSigAccInfo) XXX_Size() int {
 	return xxx_messageInfo_ReplyMultiSigAccInfo.Size(m)
@@ -1302,6 +1567,8 @@ type ReqMultiSigTxids struct {
 	ToTxId               uint64   `protobuf:"varint,3,opt,name=toTxId,proto3" json:"toTxId,omitempty"`
 	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
 	Executed             bool     `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	Queued               bool     `protobuf:"varint,6,opt,name=queued,proto3" json:"queued,omitempty"`
+	Expired              bool     `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1311,16 +1578,17 @@ func (m *ReqMultiSigTxids) Reset()         { *m = ReqMultiSigTxids{} }
 func (m *ReqMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxids) ProtoMessage()    {}
 func (*ReqMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{17}
+	return fileDescriptor_62b8b91adf3febfa, []int{19}
 }
+
 func (m *ReqMultiSigTxids) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqMultiSigTxids.Unmarshal(m, b)
 }
 func (m *ReqMultiSigTxids) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqMultiSigTxids.Marshal(b, m, deterministic)
 }
-func (dst *ReqMultiSigTxids) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqMultiSigTxids.Merge(dst, src)
+func (m *ReqMultiSigTxids) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigTxids.Merge(m, src)
 }
 func (m *ReqMultiSigTxids) XXX_Size() int {
 	return xxx_messageInfo_ReqMultiSigTxids.Size(m)
@@ -1366,6 +1634,20 @@ func (m *ReqMultiSigTxids) GetExecuted() bool {
 	return false
 }
 
+func (m *ReqMultiSigTxids) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *ReqMultiSigTxids) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
 type ReplyMultiSigTxids struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	Txids                []uint64 `protobuf:"varint,2,rep,packed,name=txids,proto3" json:"txids,omitempty"`
@@ -1378,16 +1660,17 @@ func (m *ReplyMultiSigTxids) Reset()         { *m = ReplyMultiSigTxids{} }
 func (m *ReplyMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxids) ProtoMessage()    {}
 func (*ReplyMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{18}
+	return fileDescriptor_62b8b91adf3febfa, []int{20}
 }
+
 func (m *ReplyMultiSigTxids) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyMultiSigTxids.Unmarshal(m, b)
 }
 func (m *ReplyMultiSigTxids) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyMultiSigTxids.Marshal(b, m, deterministic)
 }
-func (dst *ReplyMultiSigTxids) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyMultiSigTxids.Merge(dst, src)
+func (m *ReplyMultiSigTxids) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigTxids.Merge(m, src)
 }
 func (m *ReplyMultiSigTxids) XXX_Size() int {
 	return xxx_messageInfo_ReplyMultiSigTxids.Size(m)
@@ -1425,16 +1708,17 @@ func (m *ReqMultiSigTxInfo) Reset()         { *m = ReqMultiSigTxInfo{} }
 func (m *ReqMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxInfo) ProtoMessage()    {}
 func (*ReqMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{19}
+	return fileDescriptor_62b8b91adf3febfa, []int{21}
 }
+
 func (m *ReqMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqMultiSigTxInfo.Unmarshal(m, b)
 }
 func (m *ReqMultiSigTxInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqMultiSigTxInfo.Marshal(b, m, deterministic)
 }
-func (dst *ReqMultiSigTxInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqMultiSigTxInfo.Merge(dst, src)
+func (m *ReqMultiSigTxInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigTxInfo.Merge(m, src)
 }
 func (m *ReqMultiSigTxInfo) XXX_Size() int {
 	return xxx_messageInfo_ReqMultiSigTxInfo.Size(m)
@@ -1470,16 +1754,17 @@ func (m *ReplyMultiSigTxInfo) Reset()         { *m = ReplyMultiSigTxInfo{} }
 func (m *ReplyMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxInfo) ProtoMessage()    {}
 func (*ReplyMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{20}
+	return fileDescriptor_62b8b91adf3febfa, []int{22}
 }
+
 func (m *ReplyMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyMultiSigTxInfo.Unmarshal(m, b)
 }
 func (m *ReplyMultiSigTxInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyMultiSigTxInfo.Marshal(b, m, deterministic)
 }
-func (dst *ReplyMultiSigTxInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyMultiSigTxInfo.Merge(dst, src)
+func (m *ReplyMultiSigTxInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigTxInfo.Merge(m, src)
 }
 func (m *ReplyMultiSigTxInfo) XXX_Size() int {
 	return xxx_messageInfo_ReplyMultiSigTxInfo.Size(m)
@@ -1511,16 +1796,17 @@ func (m *ReqMultiSigAccUnSpentToday) Reset()         { *m = ReqMultiSigAccUnSpen
 func (m *ReqMultiSigAccUnSpentToday) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccUnSpentToday) ProtoMessage()    {}
 func (*ReqMultiSigAccUnSpentToday) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{21}
+	return fileDescriptor_62b8b91adf3febfa, []int{23}
 }
+
 func (m *ReqMultiSigAccUnSpentToday) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqMultiSigAccUnSpentToday.Unmarshal(m, b)
 }
 func (m *ReqMultiSigAccUnSpentToday) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqMultiSigAccUnSpentToday.Marshal(b, m, deterministic)
 }
-func (dst *ReqMultiSigAccUnSpentToday) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqMultiSigAccUnSpentToday.Merge(dst, src)
+func (m *ReqMultiSigAccUnSpentToday) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigAccUnSpentToday.Merge(m, src)
 }
 func (m *ReqMultiSigAccUnSpentToday) XXX_Size() int {
 	return xxx_messageInfo_ReqMultiSigAccUnSpentToday.Size(m)
@@ -1563,16 +1849,17 @@ func (m *ReplyUnSpentAssets) Reset()         { *m = ReplyUnSpentAssets{} }
 func (m *ReplyUnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnSpentAssets) ProtoMessage()    {}
 func (*ReplyUnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{22}
+	return fileDescriptor_62b8b91adf3febfa, []int{24}
 }
+
 func (m *ReplyUnSpentAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyUnSpentAssets.Unmarshal(m, b)
 }
 func (m *ReplyUnSpentAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyUnSpentAssets.Marshal(b, m, deterministic)
 }
-func (dst *ReplyUnSpentAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyUnSpentAssets.Merge(dst, src)
+func (m *ReplyUnSpentAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyUnSpentAssets.Merge(m, src)
 }
 func (m *ReplyUnSpentAssets) XXX_Size() int {
 	return xxx_messageInfo_ReplyUnSpentAssets.Size(m)
@@ -1602,16 +1889,17 @@ func (m *UnSpentAssets) Reset()         { *m = UnSpentAssets{} }
 func (m *UnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*UnSpentAssets) ProtoMessage()    {}
 func (*UnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{23}
+	return fileDescriptor_62b8b91adf3febfa, []int{25}
 }
+
 func (m *UnSpentAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnSpentAssets.Unmarshal(m, b)
 }
 func (m *UnSpentAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_UnSpentAssets.Marshal(b, m, deterministic)
 }
-func (dst *UnSpentAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnSpentAssets.Merge(dst, src)
+func (m *UnSpentAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnSpentAssets.Merge(m, src)
 }
 func (m *UnSpentAssets) XXX_Size() int {
 	return xxx_messageInfo_UnSpentAssets.Size(m)
@@ -1648,16 +1936,17 @@ func (m *ReceiptMultiSig) Reset()         { *m = ReceiptMultiSig{} }
 func (m *ReceiptMultiSig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSig) ProtoMessage()    {}
 func (*ReceiptMultiSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{24}
+	return fileDescriptor_62b8b91adf3febfa, []int{26}
 }
+
 func (m *ReceiptMultiSig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptMultiSig.Unmarshal(m, b)
 }
 func (m *ReceiptMultiSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptMultiSig.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptMultiSig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptMultiSig.Merge(dst, src)
+func (m *ReceiptMultiSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSig.Merge(m, src)
 }
 func (m *ReceiptMultiSig) XXX_Size() int {
 	return xxx_messageInfo_ReceiptMultiSig.Size(m)
@@ -1688,16 +1977,17 @@ func (m *ReceiptOwnerAddOrDel) Reset()         { *m = ReceiptOwnerAddOrDel{} }
 func (m *ReceiptOwnerAddOrDel) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerAddOrDel) ProtoMessage()    {}
 func (*ReceiptOwnerAddOrDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{25}
+	return fileDescriptor_62b8b91adf3febfa, []int{27}
 }
+
 func (m *ReceiptOwnerAddOrDel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptOwnerAddOrDel.Unmarshal(m, b)
 }
 func (m *ReceiptOwnerAddOrDel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptOwnerAddOrDel.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptOwnerAddOrDel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptOwnerAddOrDel.Merge(dst, src)
+func (m *ReceiptOwnerAddOrDel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptOwnerAddOrDel.Merge(m, src)
 }
 func (m *ReceiptOwnerAddOrDel) XXX_Size() int {
 	return xxx_messageInfo_ReceiptOwnerAddOrDel.Size(m)
@@ -1745,16 +2035,17 @@ func (m *ReceiptOwnerModOrRep) Reset()         { *m = ReceiptOwnerModOrRep{} }
 func (m *ReceiptOwnerModOrRep) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerModOrRep) ProtoMessage()    {}
 func (*ReceiptOwnerModOrRep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{26}
+	return fileDescriptor_62b8b91adf3febfa, []int{28}
 }
+
 func (m *ReceiptOwnerModOrRep) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptOwnerModOrRep.Unmarshal(m, b)
 }
 func (m *ReceiptOwnerModOrRep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptOwnerModOrRep.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptOwnerModOrRep) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptOwnerModOrRep.Merge(dst, src)
+func (m *ReceiptOwnerModOrRep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptOwnerModOrRep.Merge(m, src)
 }
 func (m *ReceiptOwnerModOrRep) XXX_Size() int {
 	return xxx_messageInfo_ReceiptOwnerModOrRep.Size(m)
@@ -1808,16 +2099,17 @@ func (m *ReceiptWeightModify) Reset()         { *m = ReceiptWeightModify{} }
 func (m *ReceiptWeightModify) String() string { return proto.CompactTextString(m) }
 func (*ReceiptWeightModify) ProtoMessage()    {}
 func (*ReceiptWeightModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{27}
+	return fileDescriptor_62b8b91adf3febfa, []int{29}
 }
+
 func (m *ReceiptWeightModify) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptWeightModify.Unmarshal(m, b)
 }
 func (m *ReceiptWeightModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptWeightModify.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptWeightModify) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptWeightModify.Merge(dst, src)
+func (m *ReceiptWeightModify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptWeightModify.Merge(m, src)
 }
 func (m *ReceiptWeightModify) XXX_Size() int {
 	return xxx_messageInfo_ReceiptWeightModify.Size(m)
@@ -1865,16 +2157,17 @@ func (m *ReceiptDailyLimitOperate) Reset()         { *m = ReceiptDailyLimitOpera
 func (m *ReceiptDailyLimitOperate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptDailyLimitOperate) ProtoMessage()    {}
 func (*ReceiptDailyLimitOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{28}
+	return fileDescriptor_62b8b91adf3febfa, []int{30}
 }
+
 func (m *ReceiptDailyLimitOperate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptDailyLimitOperate.Unmarshal(m, b)
 }
 func (m *ReceiptDailyLimitOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptDailyLimitOperate.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptDailyLimitOperate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptDailyLimitOperate.Merge(dst, src)
+func (m *ReceiptDailyLimitOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptDailyLimitOperate.Merge(m, src)
 }
 func (m *ReceiptDailyLimitOperate) XXX_Size() int {
 	return xxx_messageInfo_ReceiptDailyLimitOperate.Size(m)
@@ -1928,16 +2221,17 @@ func (m *ReceiptConfirmTx) Reset()         { *m = ReceiptConfirmTx{} }
 func (m *ReceiptConfirmTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptConfirmTx) ProtoMessage()    {}
 func (*ReceiptConfirmTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{29}
+	return fileDescriptor_62b8b91adf3febfa, []int{31}
 }
+
 func (m *ReceiptConfirmTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptConfirmTx.Unmarshal(m, b)
 }
 func (m *ReceiptConfirmTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptConfirmTx.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptConfirmTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptConfirmTx.Merge(dst, src)
+func (m *ReceiptConfirmTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptConfirmTx.Merge(m, src)
 }
 func (m *ReceiptConfirmTx) XXX_Size() int {
 	return xxx_messageInfo_ReceiptConfirmTx.Size(m)
@@ -1976,16 +2270,17 @@ func (m *ReceiptAccDailyLimitUpdate) Reset()         { *m = ReceiptAccDailyLimit
 func (m *ReceiptAccDailyLimitUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptAccDailyLimitUpdate) ProtoMessage()    {}
 func (*ReceiptAccDailyLimitUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{30}
+	return fileDescriptor_62b8b91adf3febfa, []int{32}
 }
+
 func (m *ReceiptAccDailyLimitUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptAccDailyLimitUpdate.Unmarshal(m, b)
 }
 func (m *ReceiptAccDailyLimitUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptAccDailyLimitUpdate.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptAccDailyLimitUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptAccDailyLimitUpdate.Merge(dst, src)
+func (m *ReceiptAccDailyLimitUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptAccDailyLimitUpdate.Merge(m, src)
 }
 func (m *ReceiptAccDailyLimitUpdate) XXX_Size() int {
 	return xxx_messageInfo_ReceiptAccDailyLimitUpdate.Size(m)
@@ -2025,6 +2320,7 @@ type ReceiptMultiSigTx struct {
 	SubmitOrConfirm      bool             `protobuf:"varint,4,opt,name=submitOrConfirm,proto3" json:"submitOrConfirm,omitempty"`
 	TxHash               string           `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
 	TxType               uint64           `protobuf:"varint,6,opt,name=txType,proto3" json:"txType,omitempty"`
+	ExecuteQueued        bool             `protobuf:"varint,7,opt,name=executeQueued,proto3" json:"executeQueued,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -2034,16 +2330,17 @@ func (m *ReceiptMultiSigTx) Reset()         { *m = ReceiptMultiSigTx{} }
 func (m *ReceiptMultiSigTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSigTx) ProtoMessage()    {}
 func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{31}
+	return fileDescriptor_62b8b91adf3febfa, []int{33}
 }
+
 func (m *ReceiptMultiSigTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptMultiSigTx.Unmarshal(m, b)
 }
 func (m *ReceiptMultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptMultiSigTx.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptMultiSigTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptMultiSigTx.Merge(dst, src)
+func (m *ReceiptMultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigTx.Merge(m, src)
 }
 func (m *ReceiptMultiSigTx) XXX_Size() int {
 	return xxx_messageInfo_ReceiptMultiSigTx.Size(m)
@@ -2096,6 +2393,149 @@ func (m *ReceiptMultiSigTx) GetTxType() uint64 {
 	return 0
 }
 
+func (m *ReceiptMultiSigTx) GetExecuteQueued() bool {
+	if m != nil {
+		return m.ExecuteQueued
+	}
+	return false
+}
+
+// TyLogMultiSigAccTimelockModify 输出修改前后的等待区块数和过期区块数
+type ReceiptTimelockModify struct {
+	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	PrevExecuteDelay     int64    `protobuf:"varint,2,opt,name=prevExecuteDelay,proto3" json:"prevExecuteDelay,omitempty"`
+	PrevProposalExpiry   int64    `protobuf:"varint,3,opt,name=prevProposalExpiry,proto3" json:"prevProposalExpiry,omitempty"`
+	CurExecuteDelay      int64    `protobuf:"varint,4,opt,name=curExecuteDelay,proto3" json:"curExecuteDelay,omitempty"`
+	CurProposalExpiry    int64    `protobuf:"varint,5,opt,name=curProposalExpiry,proto3" json:"curProposalExpiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTimelockModify) Reset()         { *m = ReceiptTimelockModify{} }
+func (m *ReceiptTimelockModify) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTimelockModify) ProtoMessage()    {}
+func (*ReceiptTimelockModify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{34}
+}
+
+func (m *ReceiptTimelockModify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTimelockModify.Unmarshal(m, b)
+}
+func (m *ReceiptTimelockModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTimelockModify.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTimelockModify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTimelockModify.Merge(m, src)
+}
+func (m *ReceiptTimelockModify) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTimelockModify.Size(m)
+}
+func (m *ReceiptTimelockModify) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTimelockModify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTimelockModify proto.InternalMessageInfo
+
+func (m *ReceiptTimelockModify) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ReceiptTimelockModify) GetPrevExecuteDelay() int64 {
+	if m != nil {
+		return m.PrevExecuteDelay
+	}
+	return 0
+}
+
+func (m *ReceiptTimelockModify) GetPrevProposalExpiry() int64 {
+	if m != nil {
+		return m.PrevProposalExpiry
+	}
+	return 0
+}
+
+func (m *ReceiptTimelockModify) GetCurExecuteDelay() int64 {
+	if m != nil {
+		return m.CurExecuteDelay
+	}
+	return 0
+}
+
+func (m *ReceiptTimelockModify) GetCurProposalExpiry() int64 {
+	if m != nil {
+		return m.CurProposalExpiry
+	}
+	return 0
+}
+
+// SubmitTx交易权重满足后的批准信息
+type ReceiptMultiSigSubmitTx struct {
+	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	Txid                 uint64   `protobuf:"varint,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Execer               string   `protobuf:"bytes,3,opt,name=execer,proto3" json:"execer,omitempty"`
+	Payload              []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptMultiSigSubmitTx) Reset()         { *m = ReceiptMultiSigSubmitTx{} }
+func (m *ReceiptMultiSigSubmitTx) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigSubmitTx) ProtoMessage()    {}
+func (*ReceiptMultiSigSubmitTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{35}
+}
+
+func (m *ReceiptMultiSigSubmitTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigSubmitTx.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigSubmitTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigSubmitTx.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigSubmitTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigSubmitTx.Merge(m, src)
+}
+func (m *ReceiptMultiSigSubmitTx) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigSubmitTx.Size(m)
+}
+func (m *ReceiptMultiSigSubmitTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigSubmitTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigSubmitTx proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigSubmitTx) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ReceiptMultiSigSubmitTx) GetTxid() uint64 {
+	if m != nil {
+		return m.Txid
+	}
+	return 0
+}
+
+func (m *ReceiptMultiSigSubmitTx) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReceiptMultiSigSubmitTx) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 type ReceiptTxCountUpdate struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	CurTxCount           uint64   `protobuf:"varint,2,opt,name=curTxCount,proto3" json:"curTxCount,omitempty"`
@@ -2108,16 +2548,17 @@ func (m *ReceiptTxCountUpdate) Reset()         { *m = ReceiptTxCountUpdate{} }
 func (m *ReceiptTxCountUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTxCountUpdate) ProtoMessage()    {}
 func (*ReceiptTxCountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{32}
+	return fileDescriptor_62b8b91adf3febfa, []int{36}
 }
+
 func (m *ReceiptTxCountUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptTxCountUpdate.Unmarshal(m, b)
 }
 func (m *ReceiptTxCountUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptTxCountUpdate.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptTxCountUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTxCountUpdate.Merge(dst, src)
+func (m *ReceiptTxCountUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTxCountUpdate.Merge(m, src)
 }
 func (m *ReceiptTxCountUpdate) XXX_Size() int {
 	return xxx_messageInfo_ReceiptTxCountUpdate.Size(m)
@@ -2155,16 +2596,17 @@ func (m *MultiSigTxOwner) Reset()         { *m = MultiSigTxOwner{} }
 func (m *MultiSigTxOwner) String() string { return proto.CompactTextString(m) }
 func (*MultiSigTxOwner) ProtoMessage()    {}
 func (*MultiSigTxOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{33}
+	return fileDescriptor_62b8b91adf3febfa, []int{37}
 }
+
 func (m *MultiSigTxOwner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MultiSigTxOwner.Unmarshal(m, b)
 }
 func (m *MultiSigTxOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MultiSigTxOwner.Marshal(b, m, deterministic)
 }
-func (dst *MultiSigTxOwner) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiSigTxOwner.Merge(dst, src)
+func (m *MultiSigTxOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTxOwner.Merge(m, src)
 }
 func (m *MultiSigTxOwner) XXX_Size() int {
 	return xxx_messageInfo_MultiSigTxOwner.Size(m)
@@ -2207,16 +2649,17 @@ func (m *Uint64) Reset()         { *m = Uint64{} }
 func (m *Uint64) String() string { return proto.CompactTextString(m) }
 func (*Uint64) ProtoMessage()    {}
 func (*Uint64) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{34}
+	return fileDescriptor_62b8b91adf3febfa, []int{38}
 }
+
 func (m *Uint64) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Uint64.Unmarshal(m, b)
 }
 func (m *Uint64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Uint64.Marshal(b, m, deterministic)
 }
-func (dst *Uint64) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Uint64.Merge(dst, src)
+func (m *Uint64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Uint64.Merge(m, src)
 }
 func (m *Uint64) XXX_Size() int {
 	return xxx_messageInfo_Uint64.Size(m)
@@ -2248,16 +2691,17 @@ func (m *AccountAssets) Reset()         { *m = AccountAssets{} }
 func (m *AccountAssets) String() string { return proto.CompactTextString(m) }
 func (*AccountAssets) ProtoMessage()    {}
 func (*AccountAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{35}
+	return fileDescriptor_62b8b91adf3febfa, []int{39}
 }
+
 func (m *AccountAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountAssets.Unmarshal(m, b)
 }
 func (m *AccountAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_AccountAssets.Marshal(b, m, deterministic)
 }
-func (dst *AccountAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountAssets.Merge(dst, src)
+func (m *AccountAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAssets.Merge(m, src)
 }
 func (m *AccountAssets) XXX_Size() int {
 	return xxx_messageInfo_AccountAssets.Size(m)
@@ -2303,16 +2747,17 @@ func (m *ReqAccAssets) Reset()         { *m = ReqAccAssets{} }
 func (m *ReqAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccAssets) ProtoMessage()    {}
 func (*ReqAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{36}
+	return fileDescriptor_62b8b91adf3febfa, []int{40}
 }
+
 func (m *ReqAccAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqAccAssets.Unmarshal(m, b)
 }
 func (m *ReqAccAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqAccAssets.Marshal(b, m, deterministic)
 }
-func (dst *ReqAccAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqAccAssets.Merge(dst, src)
+func (m *ReqAccAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAccAssets.Merge(m, src)
 }
 func (m *ReqAccAssets) XXX_Size() int {
 	return xxx_messageInfo_ReqAccAssets.Size(m)
@@ -2355,16 +2800,17 @@ func (m *ReplyAccAssets) Reset()         { *m = ReplyAccAssets{} }
 func (m *ReplyAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccAssets) ProtoMessage()    {}
 func (*ReplyAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{37}
+	return fileDescriptor_62b8b91adf3febfa, []int{41}
 }
+
 func (m *ReplyAccAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyAccAssets.Unmarshal(m, b)
 }
 func (m *ReplyAccAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyAccAssets.Marshal(b, m, deterministic)
 }
-func (dst *ReplyAccAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyAccAssets.Merge(dst, src)
+func (m *ReplyAccAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyAccAssets.Merge(m, src)
 }
 func (m *ReplyAccAssets) XXX_Size() int {
 	return xxx_messageInfo_ReplyAccAssets.Size(m)
@@ -2396,16 +2842,17 @@ func (m *AccAssets) Reset()         { *m = AccAssets{} }
 func (m *AccAssets) String() string { return proto.CompactTextString(m) }
 func (*AccAssets) ProtoMessage()    {}
 func (*AccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{38}
+	return fileDescriptor_62b8b91adf3febfa, []int{42}
 }
+
 func (m *AccAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccAssets.Unmarshal(m, b)
 }
 func (m *AccAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_AccAssets.Marshal(b, m, deterministic)
 }
-func (dst *AccAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccAssets.Merge(dst, src)
+func (m *AccAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccAssets.Merge(m, src)
 }
 func (m *AccAssets) XXX_Size() int {
 	return xxx_messageInfo_AccAssets.Size(m)
@@ -2449,16 +2896,17 @@ func (m *Assets) Reset()         { *m = Assets{} }
 func (m *Assets) String() string { return proto.CompactTextString(m) }
 func (*Assets) ProtoMessage()    {}
 func (*Assets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{39}
+	return fileDescriptor_62b8b91adf3febfa, []int{43}
 }
+
 func (m *Assets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Assets.Unmarshal(m, b)
 }
 func (m *Assets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Assets.Marshal(b, m, deterministic)
 }
-func (dst *Assets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Assets.Merge(dst, src)
+func (m *Assets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Assets.Merge(m, src)
 }
 func (m *Assets) XXX_Size() int {
 	return xxx_messageInfo_Assets.Size(m)
@@ -2495,16 +2943,17 @@ func (m *AccAddress) Reset()         { *m = AccAddress{} }
 func (m *AccAddress) String() string { return proto.CompactTextString(m) }
 func (*AccAddress) ProtoMessage()    {}
 func (*AccAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{40}
+	return fileDescriptor_62b8b91adf3febfa, []int{44}
 }
+
 func (m *AccAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccAddress.Unmarshal(m, b)
 }
 func (m *AccAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_AccAddress.Marshal(b, m, deterministic)
 }
-func (dst *AccAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccAddress.Merge(dst, src)
+func (m *AccAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccAddress.Merge(m, src)
 }
 func (m *AccAddress) XXX_Size() int {
 	return xxx_messageInfo_AccAddress.Size(m)
@@ -2536,16 +2985,17 @@ func (m *OwnerAttr) Reset()         { *m = OwnerAttr{} }
 func (m *OwnerAttr) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttr) ProtoMessage()    {}
 func (*OwnerAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{41}
+	return fileDescriptor_62b8b91adf3febfa, []int{45}
 }
+
 func (m *OwnerAttr) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OwnerAttr.Unmarshal(m, b)
 }
 func (m *OwnerAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_OwnerAttr.Marshal(b, m, deterministic)
 }
-func (dst *OwnerAttr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OwnerAttr.Merge(dst, src)
+func (m *OwnerAttr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerAttr.Merge(m, src)
 }
 func (m *OwnerAttr) XXX_Size() int {
 	return xxx_messageInfo_OwnerAttr.Size(m)
@@ -2588,16 +3038,17 @@ func (m *OwnerAttrs) Reset()         { *m = OwnerAttrs{} }
 func (m *OwnerAttrs) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttrs) ProtoMessage()    {}
 func (*OwnerAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_multisig_e7bea0a7633ad01b, []int{42}
+	return fileDescriptor_62b8b91adf3febfa, []int{46}
 }
+
 func (m *OwnerAttrs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OwnerAttrs.Unmarshal(m, b)
 }
 func (m *OwnerAttrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_OwnerAttrs.Marshal(b, m, deterministic)
 }
-func (dst *OwnerAttrs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OwnerAttrs.Merge(dst, src)
+func (m *OwnerAttrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerAttrs.Merge(m, src)
 }
 func (m *OwnerAttrs) XXX_Size() int {
 	return xxx_messageInfo_OwnerAttrs.Size(m)
@@ -2628,6 +3079,8 @@ func init() {
 	proto.RegisterType((*MultiSigAccOperate)(nil), "types.MultiSigAccOperate")
 	proto.RegisterType((*MultiSigExecTransferFrom)(nil), "types.MultiSigExecTransferFrom")
 	proto.RegisterType((*MultiSigExecTransferTo)(nil), "types.MultiSigExecTransferTo")
+	proto.RegisterType((*MultiSigSubmitTx)(nil), "types.MultiSigSubmitTx")
+	proto.RegisterType((*MultiSigExecuteTx)(nil), "types.MultiSigExecuteTx")
 	proto.RegisterType((*MultiSigConfirmTx)(nil), "types.MultiSigConfirmTx")
 	proto.RegisterType((*ReqMultiSigAccs)(nil), "types.ReqMultiSigAccs")
 	proto.RegisterType((*ReplyMultiSigAccs)(nil), "types.ReplyMultiSigAccs")
@@ -2648,6 +3101,8 @@ func init() {
 	proto.RegisterType((*ReceiptConfirmTx)(nil), "types.ReceiptConfirmTx")
 	proto.RegisterType((*ReceiptAccDailyLimitUpdate)(nil), "types.ReceiptAccDailyLimitUpdate")
 	proto.RegisterType((*ReceiptMultiSigTx)(nil), "types.ReceiptMultiSigTx")
+	proto.RegisterType((*ReceiptTimelockModify)(nil), "types.ReceiptTimelockModify")
+	proto.RegisterType((*ReceiptMultiSigSubmitTx)(nil), "types.ReceiptMultiSigSubmitTx")
 	proto.RegisterType((*ReceiptTxCountUpdate)(nil), "types.ReceiptTxCountUpdate")
 	proto.RegisterType((*MultiSigTxOwner)(nil), "types.MultiSigTxOwner")
 	proto.RegisterType((*Uint64)(nil), "types.Uint64")
@@ -2661,107 +3116,124 @@ func init() {
 	proto.RegisterType((*OwnerAttrs)(nil), "types.OwnerAttrs")
 }
 
-func init() { proto.RegisterFile("multisig.proto", fileDescriptor_multisig_e7bea0a7633ad01b) }
-
-var fileDescriptor_multisig_e7bea0a7633ad01b = []byte{
-	// 1572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x18, 0xdd, 0x6e, 0xdc, 0x44,
-	0x37, 0xf6, 0xfe, 0x24, 0x7b, 0x92, 0x6c, 0x93, 0xf9, 0xa2, 0x7c, 0x26, 0x94, 0x12, 0x8d, 0x4a,
-	0xb5, 0xaa, 0x20, 0x42, 0x69, 0xa1, 0x14, 0x09, 0xd4, 0xa5, 0x69, 0x95, 0xaa, 0xa4, 0x29, 0xd3,
-	0xad, 0x2a, 0x21, 0x71, 0xe1, 0xd8, 0x93, 0xd4, 0x62, 0xd7, 0xde, 0xda, 0xde, 0x64, 0x17, 0x90,
-	0xca, 0x25, 0x2f, 0x00, 0x17, 0x5c, 0x20, 0x1e, 0x82, 0x0b, 0x9e, 0x00, 0x5e, 0x80, 0xb7, 0xe0,
-	0x1a, 0x71, 0x8b, 0xe6, 0xcf, 0x9e, 0xb1, 0xbd, 0x91, 0x2b, 0x15, 0x84, 0xb8, 0xf3, 0x39, 0x73,
-	0xe6, 0xfc, 0xff, 0x79, 0xa0, 0x3b, 0x9a, 0x0c, 0xd3, 0x20, 0x09, 0x4e, 0x76, 0xc6, 0x71, 0x94,
-	0x46, 0xa8, 0x95, 0xce, 0xc6, 0x34, 0xd9, 0x5a, 0x75, 0x3d, 0x2f, 0x9a, 0x84, 0xa9, 0xc0, 0xe2,
-	0xdf, 0x2d, 0x58, 0x3a, 0x60, 0x84, 0x8f, 0x82, 0x13, 0x74, 0x09, 0xc0, 0x8b, 0xa9, 0x9b, 0xd2,
-	0xbe, 0xef, 0xc7, 0x8e, 0xb5, 0x6d, 0xf5, 0x3a, 0x44, 0xc3, 0x20, 0x0c, 0x2b, 0x23, 0x49, 0xcb,
-	0x29, 0x6c, 0x4e, 0x61, 0xe0, 0xd0, 0x65, 0x68, 0x47, 0x67, 0x21, 0x8d, 0x13, 0xa7, 0xb1, 0xdd,
-	0xe8, 0x2d, 0xef, 0xae, 0xec, 0x70, 0xb9, 0x3b, 0x87, 0x0c, 0x49, 0xe4, 0x19, 0xba, 0x06, 0xcb,
-	0xbe, 0x1b, 0x0c, 0x67, 0x1f, 0x07, 0xa3, 0x20, 0x4d, 0x9c, 0x26, 0x27, 0x5d, 0x97, 0xa4, 0x7b,
-	0xd9, 0x09, 0xd1, 0xa9, 0x90, 0x03, 0x8b, 0xe9, 0xf4, 0x36, 0x53, 0xde, 0x69, 0x6d, 0x5b, 0xbd,
-	0x26, 0x51, 0x20, 0xba, 0x02, 0xdd, 0x98, 0x3e, 0x9b, 0x04, 0x31, 0xf5, 0x9f, 0xd0, 0xe0, 0xe4,
-	0x69, 0xea, 0xb4, 0x39, 0x41, 0x01, 0x8b, 0xef, 0x42, 0xf7, 0x76, 0x14, 0x1e, 0x07, 0xf1, 0x88,
-	0xfa, 0x5c, 0x21, 0x74, 0x1d, 0xba, 0x9e, 0x81, 0x71, 0xac, 0x0a, 0xb5, 0x0b, 0x34, 0xf8, 0x17,
-	0x0b, 0x40, 0x79, 0x6d, 0x30, 0x45, 0x08, 0x9a, 0xe9, 0x34, 0xf0, 0xb9, 0xc7, 0x9a, 0x84, 0x7f,
-	0xa3, 0x4d, 0x68, 0xa7, 0xd3, 0x7d, 0x37, 0x79, 0x2a, 0xbd, 0x24, 0x21, 0xb4, 0x05, 0x4b, 0x74,
-	0x4a, 0xbd, 0x49, 0x4a, 0x7d, 0xa7, 0xb1, 0x6d, 0xf5, 0x96, 0x48, 0x06, 0x8b, 0x3b, 0x83, 0xd9,
-	0x98, 0x3a, 0x4d, 0xce, 0x49, 0x42, 0x25, 0xbf, 0xb7, 0x2a, 0xfc, 0x5e, 0x36, 0xa4, 0x5d, 0xc3,
-	0x90, 0x0f, 0xa0, 0x25, 0xfc, 0x70, 0x11, 0x3a, 0x3c, 0x34, 0x5a, 0xe4, 0x73, 0x04, 0x53, 0xec,
-	0x4c, 0xf8, 0xd5, 0x16, 0x8a, 0x09, 0x08, 0x7f, 0x67, 0x01, 0xe4, 0xd1, 0x62, 0x64, 0xc9, 0x6c,
-	0x74, 0x14, 0x0d, 0x25, 0x07, 0x09, 0x31, 0x3c, 0xb3, 0x91, 0xaa, 0x8c, 0x91, 0x10, 0xcb, 0xb7,
-	0x3c, 0xbe, 0xdc, 0x1b, 0x4d, 0xa2, 0x61, 0xd8, 0x79, 0x32, 0xa6, 0x61, 0x3a, 0x88, 0x7c, 0x77,
-	0x26, 0x7d, 0xa2, 0x61, 0x58, 0x42, 0x0c, 0xdd, 0x24, 0xdd, 0x73, 0x67, 0xdc, 0x25, 0x0d, 0xa2,
-	0x40, 0x7c, 0x04, 0x6b, 0x8f, 0xb8, 0xec, 0xbf, 0x4f, 0x3b, 0xfc, 0x6d, 0x13, 0xba, 0x2a, 0x09,
-	0xfa, 0x5e, 0x1a, 0x44, 0x21, 0xda, 0x87, 0xf5, 0x2c, 0x28, 0x9e, 0x77, 0x9b, 0x57, 0x0e, 0x97,
-	0xb6, 0xbc, 0xeb, 0xc8, 0x38, 0x1c, 0x14, 0xcf, 0xf7, 0x17, 0x48, 0xf9, 0x12, 0xfa, 0x04, 0x36,
-	0x14, 0x92, 0x07, 0xe8, 0x70, 0x4c, 0x63, 0xc6, 0xcc, 0xe6, 0xcc, 0x5e, 0x2d, 0x30, 0xd3, 0x49,
-	0xf6, 0x17, 0x48, 0xe5, 0x55, 0x74, 0x1f, 0x90, 0x26, 0x47, 0x31, 0x6c, 0x70, 0x86, 0xaf, 0x94,
-	0xb5, 0xcb, 0xd9, 0x55, 0x5c, 0xd3, 0x2d, 0x95, 0x15, 0x35, 0x98, 0xf2, 0x08, 0x95, 0x2d, 0xcd,
-	0xce, 0x75, 0x4b, 0x33, 0x24, 0x7a, 0x02, 0x9b, 0x0a, 0x79, 0x67, 0x4a, 0xbd, 0x41, 0xec, 0x86,
-	0xc9, 0x31, 0x8d, 0x07, 0x11, 0x8f, 0xe9, 0xf2, 0xee, 0x6b, 0x05, 0x76, 0x26, 0xd1, 0xfe, 0x02,
-	0x99, 0x73, 0x1d, 0x7d, 0x06, 0x4e, 0xd5, 0xc9, 0xdd, 0x38, 0x1a, 0xf1, 0xf6, 0xb0, 0xbc, 0xfb,
-	0xfa, 0x39, 0xac, 0x19, 0xd9, 0xfe, 0x02, 0x99, 0xcb, 0x02, 0x75, 0xc1, 0x1e, 0xcc, 0x9c, 0xc5,
-	0x6d, 0xab, 0xd7, 0x22, 0xf6, 0x60, 0xf6, 0xd1, 0x22, 0xb4, 0x4e, 0xdd, 0xe1, 0x84, 0xe2, 0xef,
-	0x2d, 0x58, 0x2f, 0x45, 0x59, 0xeb, 0x8b, 0xd6, 0x39, 0x7d, 0xb1, 0xdc, 0xc8, 0xec, 0xaa, 0x46,
-	0x86, 0x6e, 0x94, 0x72, 0x73, 0x79, 0xf7, 0xff, 0x92, 0x63, 0x31, 0xf1, 0x8d, 0xa4, 0xfd, 0xd9,
-	0x82, 0x8d, 0xaa, 0xac, 0x41, 0x3d, 0xb8, 0xa0, 0x85, 0x59, 0x6b, 0x03, 0x45, 0x34, 0xeb, 0x60,
-	0xd1, 0x50, 0xf6, 0x18, 0x51, 0x31, 0x19, 0xcc, 0xce, 0x42, 0x7a, 0x26, 0xce, 0x1a, 0xe2, 0x4c,
-	0xc1, 0xac, 0xc5, 0x84, 0xf4, 0x4c, 0x9a, 0x25, 0x8a, 0x39, 0x47, 0xa0, 0x6d, 0x58, 0x8e, 0x84,
-	0x2a, 0x77, 0x87, 0xee, 0x89, 0x6c, 0xf0, 0x3a, 0x0a, 0xff, 0x6a, 0x01, 0x2a, 0xe7, 0xe7, 0x0b,
-	0x28, 0x6e, 0x3a, 0xcd, 0xae, 0xed, 0x34, 0xf4, 0x26, 0xac, 0x87, 0xf4, 0x8c, 0x98, 0x81, 0x11,
-	0x0d, 0xa1, 0x7c, 0x50, 0xb4, 0xa4, 0xc9, 0x9b, 0xbc, 0x61, 0xc9, 0x0f, 0x16, 0x38, 0xf3, 0x72,
-	0xee, 0xbc, 0x36, 0xe5, 0x8e, 0xf8, 0xf0, 0xb3, 0x79, 0xaf, 0x93, 0x10, 0x1b, 0x3e, 0x61, 0x24,
-	0x0b, 0xb9, 0x43, 0xf8, 0xb7, 0x1a, 0x32, 0xa1, 0x3b, 0x12, 0xa3, 0xa4, 0x43, 0x32, 0x98, 0xe5,
-	0x6d, 0x1a, 0xc9, 0x11, 0x62, 0xa7, 0x11, 0xbb, 0x7f, 0xac, 0x4a, 0xa2, 0x43, 0xf8, 0x37, 0xfe,
-	0xc6, 0x82, 0xcd, 0xea, 0x7a, 0xfb, 0xa7, 0xd5, 0xc3, 0x5f, 0xe6, 0xc5, 0x94, 0xf7, 0x8c, 0xfa,
-	0x31, 0xe7, 0xa3, 0xf9, 0x9e, 0x2f, 0xcb, 0x88, 0x7f, 0xb3, 0xdb, 0x72, 0x0c, 0x1e, 0xc6, 0x84,
-	0x9e, 0x46, 0x9f, 0x53, 0x39, 0x89, 0x8b, 0x68, 0x7c, 0x13, 0x2e, 0x10, 0xfa, 0x4c, 0x4b, 0xba,
-	0x04, 0x6d, 0x40, 0x2b, 0x49, 0xdd, 0x38, 0xe5, 0x02, 0x1b, 0x44, 0x00, 0x68, 0x0d, 0x1a, 0x34,
-	0xf4, 0xa5, 0xe9, 0xec, 0x13, 0xbf, 0x05, 0xeb, 0x84, 0x8e, 0x87, 0x33, 0xe3, 0xb2, 0x03, 0x8b,
-	0xae, 0xef, 0xc7, 0x34, 0x11, 0x5d, 0xa0, 0x43, 0x14, 0x88, 0x3f, 0x04, 0x64, 0x4a, 0xba, 0x17,
-	0x1e, 0x47, 0xf5, 0xed, 0xc4, 0x7f, 0x5a, 0xb0, 0x51, 0x94, 0xc7, 0x59, 0xfc, 0xe7, 0x77, 0xba,
-	0x1f, 0x2d, 0x58, 0xd3, 0x5c, 0x37, 0x98, 0x06, 0x7e, 0x52, 0xb2, 0xca, 0xaa, 0xb0, 0x6a, 0x0b,
-	0x96, 0x58, 0xb2, 0x0f, 0xf2, 0xf4, 0xc8, 0x60, 0xbe, 0x89, 0x45, 0xfc, 0xa4, 0x21, 0x37, 0x31,
-	0x0e, 0x31, 0x75, 0xc7, 0x34, 0xf4, 0x83, 0x50, 0xd5, 0xb5, 0x02, 0x8d, 0xbd, 0xae, 0x65, 0xee,
-	0x75, 0xf8, 0x01, 0x0b, 0xae, 0x16, 0x9b, 0xfa, 0x3a, 0x6e, 0x40, 0x8b, 0x6d, 0x93, 0x89, 0x63,
-	0x6f, 0x37, 0x7a, 0x4d, 0x22, 0x00, 0x7c, 0x9f, 0xe5, 0x96, 0x66, 0x31, 0x0f, 0x74, 0x1d, 0x76,
-	0x15, 0xd5, 0x80, 0x1f, 0xc2, 0xff, 0x0a, 0xca, 0x71, 0x76, 0x37, 0xe5, 0x0f, 0x44, 0x86, 0x91,
-	0x7b, 0xcc, 0x7a, 0x61, 0x66, 0x0e, 0xa6, 0xa4, 0x40, 0x88, 0xc7, 0xb0, 0x65, 0xe6, 0xf2, 0xe3,
-	0xf0, 0x51, 0xbe, 0xb4, 0xd5, 0xd1, 0x73, 0xde, 0x4a, 0x96, 0x37, 0x9f, 0x86, 0xde, 0x7c, 0xf0,
-	0x43, 0xe9, 0x60, 0x29, 0xa8, 0x9f, 0x24, 0x34, 0x4d, 0xd0, 0xfb, 0xb0, 0x3a, 0xd1, 0x11, 0x32,
-	0x7b, 0x37, 0xa4, 0x05, 0x06, 0x31, 0x31, 0x49, 0xf1, 0x03, 0x58, 0x35, 0x99, 0xbd, 0x01, 0x6d,
-	0x57, 0x70, 0x11, 0x7e, 0x58, 0x95, 0x5c, 0xe4, 0x75, 0x79, 0x58, 0x68, 0x83, 0x4d, 0xd5, 0x06,
-	0xf1, 0x3b, 0xac, 0x93, 0x78, 0x34, 0x18, 0xa7, 0xd9, 0xdf, 0x56, 0x0d, 0x47, 0xe0, 0x2f, 0x58,
-	0x55, 0xf3, 0x6b, 0x87, 0x72, 0x19, 0x3f, 0x8c, 0xf7, 0xe8, 0xb0, 0x96, 0x13, 0x31, 0xb4, 0xa2,
-	0x6c, 0x48, 0x17, 0x8b, 0x56, 0x1c, 0xb1, 0xac, 0x75, 0x25, 0x4f, 0xf5, 0x37, 0xa2, 0x60, 0xfc,
-	0x93, 0x65, 0x0a, 0x3f, 0x88, 0x7c, 0xd6, 0x18, 0xc7, 0xb5, 0x84, 0x5f, 0x85, 0xce, 0x38, 0xa6,
-	0xa7, 0x87, 0x73, 0x15, 0xc8, 0x8f, 0xd1, 0xdb, 0xb0, 0xe2, 0x4d, 0xe2, 0x98, 0x86, 0x69, 0xbe,
-	0x38, 0x14, 0xc9, 0x0d, 0x0a, 0xa6, 0xf6, 0x48, 0x6a, 0x23, 0xeb, 0x30, 0x83, 0xf1, 0x73, 0x96,
-	0xcf, 0x5c, 0x6b, 0xd1, 0x20, 0x0e, 0x22, 0x3f, 0x38, 0xae, 0x97, 0x76, 0x97, 0x00, 0x98, 0x56,
-	0xc6, 0xe6, 0xa5, 0x61, 0xd0, 0x65, 0x58, 0x95, 0x6a, 0x18, 0x3b, 0x80, 0x89, 0xc4, 0xbf, 0x59,
-	0xe0, 0x48, 0x0d, 0xf2, 0xae, 0xa7, 0xb6, 0x95, 0x3a, 0x6a, 0xdc, 0x84, 0x2e, 0x13, 0xba, 0x57,
-	0xdc, 0x55, 0x2a, 0x7a, 0x69, 0x81, 0x10, 0xdd, 0xe0, 0x1a, 0xee, 0x15, 0x57, 0xc3, 0x8a, 0x9b,
-	0x26, 0x1d, 0x5b, 0x5a, 0x78, 0xe0, 0x85, 0xb7, 0xd4, 0xd2, 0xa2, 0xa1, 0xf0, 0xd7, 0xbc, 0xcf,
-	0x72, 0xb3, 0xf2, 0x41, 0x7c, 0x2b, 0x1f, 0x50, 0x83, 0xa9, 0xfa, 0x7f, 0x66, 0x12, 0x37, 0x4b,
-	0x6d, 0x42, 0xc4, 0xb1, 0x48, 0x8e, 0xae, 0xc2, 0x9a, 0xfa, 0x27, 0xcd, 0xa6, 0xb1, 0xcd, 0xa5,
-	0x97, 0xf0, 0x2c, 0x23, 0xb7, 0xa4, 0x0a, 0x7d, 0xcf, 0xcb, 0xb5, 0x7f, 0x3c, 0xf6, 0xff, 0xc5,
-	0xbe, 0xc5, 0x7f, 0x58, 0xac, 0x5f, 0x1b, 0xc5, 0xff, 0x52, 0x5c, 0x87, 0x61, 0x85, 0xa9, 0x78,
-	0x47, 0x8d, 0x1d, 0xe1, 0x36, 0x03, 0xc7, 0xe2, 0xea, 0x4d, 0xe2, 0x3b, 0xe6, 0x8b, 0x83, 0x8e,
-	0x62, 0x3b, 0x46, 0x32, 0x39, 0x62, 0x29, 0x1a, 0xcb, 0xb8, 0xca, 0xe8, 0x17, 0xd1, 0xda, 0x93,
-	0x46, 0xcb, 0x78, 0xd2, 0xc8, 0x9f, 0x2d, 0xda, 0xfa, 0xb3, 0x05, 0xfe, 0x34, 0xeb, 0x1f, 0x03,
-	0x31, 0xd3, 0x5f, 0x20, 0x4e, 0x6c, 0x6d, 0x99, 0xc4, 0xf2, 0x9e, 0x2a, 0xc5, 0x1c, 0x83, 0x9f,
-	0xc3, 0x85, 0x83, 0xb2, 0x3b, 0xea, 0x0d, 0xc0, 0x40, 0x1b, 0x80, 0x81, 0x5f, 0xf1, 0x72, 0x52,
-	0xd5, 0x80, 0x8a, 0x2f, 0x27, 0x17, 0xa1, 0xfd, 0x38, 0x08, 0xd3, 0x77, 0xaf, 0x33, 0x9e, 0xbe,
-	0x9b, 0xba, 0xea, 0xf5, 0x87, 0x7d, 0xe3, 0x18, 0x56, 0xfb, 0xe2, 0x9d, 0x4d, 0x8e, 0x8f, 0x3a,
-	0xca, 0xe5, 0x23, 0xc6, 0xae, 0x37, 0x62, 0x1a, 0xfa, 0xa6, 0x8d, 0x23, 0x58, 0x21, 0xf4, 0x19,
-	0x5b, 0x08, 0x5f, 0xba, 0xc8, 0x0d, 0x68, 0x05, 0x49, 0x7f, 0xa8, 0x66, 0x84, 0x00, 0xf0, 0x2d,
-	0xe8, 0xf2, 0xa9, 0x9b, 0x8b, 0xdc, 0x81, 0x8e, 0xab, 0x00, 0xf9, 0x9f, 0xbb, 0xa6, 0x38, 0x2a,
-	0x3c, 0xc9, 0x49, 0xf0, 0x57, 0xd0, 0xc9, 0x2f, 0xd7, 0x9c, 0xb0, 0x97, 0x00, 0x62, 0xea, 0x9d,
-	0xf6, 0xf5, 0x9f, 0x0d, 0x0d, 0x83, 0x7a, 0xb0, 0x28, 0x9f, 0x38, 0x65, 0x1c, 0xbb, 0xb9, 0x06,
-	0x0c, 0x4b, 0xd4, 0x31, 0x7e, 0x0f, 0xda, 0xfd, 0xcc, 0xa5, 0x72, 0xdf, 0xb0, 0xe6, 0xec, 0x1b,
-	0xb6, 0xb1, 0x6f, 0x5c, 0x01, 0x90, 0x8b, 0x37, 0x4d, 0xce, 0xdb, 0xea, 0x29, 0x74, 0xc4, 0xdc,
-	0x4e, 0xd3, 0x7a, 0xf9, 0x69, 0x3c, 0xc3, 0xd9, 0xf3, 0x9f, 0xe1, 0x1a, 0xc6, 0x33, 0xdc, 0x75,
-	0x80, 0x4c, 0x4c, 0x82, 0xae, 0x40, 0x2b, 0x48, 0xe9, 0xa8, 0x18, 0x80, 0x8c, 0x82, 0x88, 0xe3,
-	0xa3, 0x36, 0x7f, 0x01, 0xbe, 0xf6, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x1b, 0x43, 0x0b,
-	0x29, 0x16, 0x00, 0x00,
+func init() { proto.RegisterFile("multisig.proto", fileDescriptor_62b8b91adf3febfa) }
+
+var fileDescriptor_62b8b91adf3febfa = []byte{
+	// 1856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x5f, 0x6f, 0xe4, 0x48,
+	0x11, 0x8f, 0xed, 0x99, 0xc9, 0x4c, 0x25, 0x99, 0x9d, 0xe9, 0x1b, 0xf6, 0x4c, 0x38, 0x96, 0xa8,
+	0xb5, 0x9c, 0x46, 0x2b, 0x88, 0x50, 0x6e, 0xe1, 0x58, 0x24, 0xd0, 0x0d, 0x9b, 0xac, 0xe6, 0x74,
+	0xe4, 0xb2, 0xdb, 0xeb, 0xd5, 0x49, 0x48, 0x3c, 0x78, 0xed, 0xce, 0x9e, 0x75, 0x33, 0xb6, 0xd7,
+	0xf6, 0x24, 0x33, 0x80, 0x74, 0x08, 0x5e, 0x78, 0xe2, 0x91, 0x17, 0x24, 0x1e, 0xf9, 0x04, 0x48,
+	0xf0, 0x39, 0x10, 0x9f, 0x81, 0x57, 0x1e, 0x79, 0x45, 0xfd, 0xcf, 0x76, 0xdb, 0x9e, 0xe0, 0xc0,
+	0x82, 0xd0, 0xbd, 0xb9, 0x7e, 0x5d, 0x5d, 0x5d, 0x5d, 0x55, 0x5d, 0x55, 0xdd, 0x86, 0xe1, 0x72,
+	0xb5, 0xc8, 0x82, 0x34, 0x78, 0x75, 0x1c, 0x27, 0x51, 0x16, 0xa1, 0x6e, 0xb6, 0x89, 0x69, 0x7a,
+	0x78, 0xe0, 0x7a, 0x5e, 0xb4, 0x0a, 0x33, 0x81, 0xe2, 0x3f, 0x99, 0xd0, 0x3f, 0x67, 0x8c, 0xcf,
+	0x83, 0x57, 0xe8, 0x1e, 0x80, 0x97, 0x50, 0x37, 0xa3, 0x33, 0xdf, 0x4f, 0x6c, 0xe3, 0xc8, 0x98,
+	0x0e, 0x48, 0x09, 0x41, 0x18, 0xf6, 0x97, 0x92, 0x97, 0x73, 0x98, 0x9c, 0x43, 0xc3, 0xd0, 0x7d,
+	0xe8, 0x45, 0xd7, 0x21, 0x4d, 0x52, 0xdb, 0x3a, 0xb2, 0xa6, 0x7b, 0x27, 0xfb, 0xc7, 0x7c, 0xdd,
+	0xe3, 0x0b, 0x06, 0x12, 0x39, 0x86, 0xde, 0x83, 0x3d, 0xdf, 0x0d, 0x16, 0x9b, 0x1f, 0x05, 0xcb,
+	0x20, 0x4b, 0xed, 0x0e, 0x67, 0x1d, 0x4b, 0xd6, 0xd3, 0x7c, 0x84, 0x94, 0xb9, 0x90, 0x0d, 0xbb,
+	0xd9, 0xfa, 0x31, 0x53, 0xde, 0xee, 0x1e, 0x19, 0xd3, 0x0e, 0x51, 0x24, 0x7a, 0x17, 0x86, 0x09,
+	0x7d, 0xbd, 0x0a, 0x12, 0xea, 0x7f, 0x42, 0x83, 0x57, 0x9f, 0x66, 0x76, 0x8f, 0x33, 0x54, 0x50,
+	0xb6, 0x01, 0xba, 0xa6, 0xde, 0x2a, 0xa3, 0xa7, 0x74, 0xe1, 0x6e, 0xec, 0xdd, 0x23, 0x63, 0x6a,
+	0x11, 0x0d, 0x63, 0xb2, 0xe2, 0x24, 0x8a, 0xa3, 0xd4, 0x5d, 0x9c, 0xad, 0xe3, 0x20, 0xd9, 0xd8,
+	0x7d, 0xce, 0x55, 0x41, 0xf1, 0x13, 0x18, 0x3e, 0x8e, 0xc2, 0xcb, 0x20, 0x59, 0x52, 0x9f, 0x6f,
+	0x0e, 0x3d, 0x84, 0xa1, 0xa7, 0x21, 0xb6, 0xd1, 0x60, 0x82, 0x0a, 0x0f, 0xfe, 0x8d, 0x09, 0xa0,
+	0x3c, 0xe0, 0xac, 0x11, 0x82, 0x4e, 0xb6, 0x0e, 0x7c, 0x6e, 0xfd, 0x0e, 0xe1, 0xdf, 0xe8, 0x2e,
+	0xf4, 0xb2, 0xf5, 0xdc, 0x4d, 0x3f, 0x95, 0x16, 0x97, 0x14, 0x3a, 0x84, 0xbe, 0x54, 0xdd, 0xb7,
+	0xad, 0x23, 0x63, 0xda, 0x27, 0x39, 0x2d, 0xe6, 0x38, 0x9b, 0x98, 0xda, 0x1d, 0x2e, 0x49, 0x52,
+	0x35, 0x1f, 0x76, 0x1b, 0x7c, 0x58, 0xdf, 0x48, 0xef, 0x5f, 0x6f, 0x44, 0x18, 0x37, 0x0e, 0x12,
+	0x3a, 0x17, 0x2e, 0xc8, 0x8d, 0x5b, 0x60, 0x8c, 0xe7, 0xf5, 0x8a, 0xae, 0xa8, 0x2f, 0x79, 0x84,
+	0x69, 0x35, 0x0c, 0x7f, 0x1f, 0xba, 0x42, 0xe0, 0x3b, 0x30, 0xe0, 0xe1, 0x52, 0x8a, 0xc6, 0x02,
+	0x60, 0x1b, 0xbc, 0x16, 0x42, 0x4c, 0xb1, 0x41, 0x41, 0xe1, 0xdf, 0x1a, 0x00, 0x45, 0x04, 0x31,
+	0xb6, 0x74, 0xb3, 0x7c, 0x19, 0x2d, 0xa4, 0x04, 0x49, 0x31, 0x9c, 0xd9, 0x8a, 0xaa, 0x28, 0x96,
+	0x14, 0x3b, 0x03, 0x45, 0xcc, 0x71, 0xab, 0x76, 0x48, 0x09, 0x61, 0xe3, 0x69, 0x4c, 0xc3, 0xcc,
+	0x89, 0x7c, 0x77, 0x23, 0x6d, 0x5b, 0x42, 0x58, 0x90, 0x2e, 0xdc, 0x34, 0x3b, 0x75, 0x37, 0xdc,
+	0xb4, 0x16, 0x51, 0x24, 0x7e, 0x09, 0xa3, 0xe7, 0x7c, 0xed, 0xff, 0x9e, 0x76, 0xf8, 0x0f, 0x5d,
+	0x18, 0xaa, 0x60, 0x9a, 0x79, 0x59, 0x10, 0x85, 0x68, 0x0e, 0xe3, 0xdc, 0xb9, 0x9e, 0xf7, 0x98,
+	0x9f, 0x66, 0xbe, 0xda, 0xde, 0x89, 0x2d, 0xfd, 0x79, 0x5e, 0x1d, 0x9f, 0xef, 0x90, 0xfa, 0x24,
+	0xf4, 0x0c, 0x26, 0x0a, 0xe4, 0x0e, 0xba, 0x88, 0x69, 0xc2, 0x84, 0x99, 0x5c, 0xd8, 0x57, 0x2a,
+	0xc2, 0xca, 0x2c, 0xf3, 0x1d, 0xd2, 0x38, 0x15, 0x7d, 0x04, 0xa8, 0xb4, 0x8e, 0x12, 0x68, 0x71,
+	0x81, 0x5f, 0xae, 0x6b, 0x57, 0x88, 0x6b, 0x98, 0x56, 0xde, 0xa9, 0x3c, 0x99, 0xce, 0xda, 0xee,
+	0x34, 0xee, 0x34, 0x1f, 0x2f, 0xef, 0x34, 0x07, 0xd1, 0x27, 0x70, 0x57, 0x81, 0x67, 0x6b, 0xea,
+	0x39, 0x89, 0x1b, 0xa6, 0x97, 0x34, 0x71, 0x22, 0xee, 0xd3, 0xbd, 0x93, 0xaf, 0x56, 0xc4, 0xe9,
+	0x4c, 0xf3, 0x1d, 0xb2, 0x65, 0x3a, 0xfa, 0x09, 0xd8, 0x4d, 0x23, 0x4f, 0x92, 0x68, 0xc9, 0x53,
+	0xd6, 0xde, 0xc9, 0xd7, 0x6e, 0x10, 0xcd, 0xd8, 0xe6, 0x3b, 0x64, 0xab, 0x08, 0x74, 0x06, 0x23,
+	0x35, 0xf6, 0x7c, 0xf5, 0x72, 0x19, 0x64, 0xce, 0x9a, 0x1f, 0xb1, 0xbd, 0x93, 0xb7, 0x2b, 0x62,
+	0xd5, 0xf0, 0x7c, 0x87, 0xd4, 0xa6, 0x94, 0x0d, 0x79, 0x26, 0xf2, 0x89, 0xb3, 0xb6, 0x07, 0x8d,
+	0x86, 0xcc, 0xc7, 0xcb, 0x86, 0xcc, 0x41, 0x34, 0x04, 0xd3, 0x11, 0x69, 0xb6, 0x4b, 0x4c, 0x67,
+	0xf3, 0xc3, 0x5d, 0xe8, 0x5e, 0xb9, 0x8b, 0x15, 0xc5, 0x7f, 0x33, 0x60, 0x5c, 0x0b, 0xbb, 0x52,
+	0xf1, 0x30, 0x6e, 0x28, 0x1e, 0xf5, 0x6c, 0x6f, 0x36, 0x66, 0xfb, 0xf7, 0x6b, 0x87, 0xa5, 0xb0,
+	0x43, 0xf5, 0x24, 0x6a, 0x67, 0xbc, 0x5a, 0x26, 0x3a, 0xad, 0xca, 0x44, 0xb7, 0xb1, 0x4c, 0xfc,
+	0xd9, 0x80, 0x49, 0xd3, 0x91, 0x40, 0x53, 0xb8, 0x53, 0x8a, 0xe1, 0x52, 0x8e, 0xab, 0xc2, 0x2c,
+	0xcd, 0x47, 0x0b, 0x99, 0x88, 0x45, 0x3a, 0xc8, 0x69, 0x36, 0x16, 0xd2, 0x6b, 0x31, 0x66, 0x89,
+	0x31, 0x45, 0xb3, 0xfc, 0x19, 0xd2, 0x6b, 0x69, 0x22, 0x91, 0xa9, 0x0a, 0x00, 0x1d, 0xc1, 0x5e,
+	0x24, 0x54, 0x79, 0xb2, 0x70, 0x5f, 0xc9, 0x8a, 0x5a, 0x86, 0x58, 0x6f, 0x80, 0xea, 0x87, 0xef,
+	0x16, 0x8a, 0xeb, 0x0e, 0x30, 0xdb, 0x3b, 0xe0, 0x1b, 0x30, 0x0e, 0xe9, 0x35, 0xd1, 0x9d, 0x2c,
+	0xb2, 0x5d, 0x7d, 0xa0, 0xba, 0x93, 0x0e, 0xaf, 0x84, 0x65, 0x88, 0x39, 0x34, 0x0b, 0x96, 0x74,
+	0x11, 0x79, 0x9f, 0xe5, 0x9b, 0xed, 0x13, 0x0d, 0xab, 0x39, 0xbd, 0xd7, 0xca, 0xe9, 0xbb, 0x8d,
+	0x4e, 0xff, 0xbd, 0x01, 0xf6, 0xb6, 0x03, 0x7c, 0x53, 0xce, 0x77, 0x97, 0xbc, 0xbb, 0x31, 0xb9,
+	0x50, 0x49, 0xb1, 0x8e, 0x20, 0x8c, 0x64, 0x56, 0x1c, 0x10, 0xfe, 0xad, 0x2a, 0x7f, 0xe8, 0x2e,
+	0x45, 0x7d, 0x1f, 0x90, 0x9c, 0x66, 0x67, 0x2e, 0x8b, 0x64, 0x5d, 0x37, 0xb3, 0x88, 0xcd, 0xbf,
+	0x54, 0xf9, 0x65, 0x40, 0xf8, 0x37, 0xfe, 0xb5, 0x01, 0x77, 0x9b, 0x93, 0xd7, 0xff, 0x5a, 0x3d,
+	0xfc, 0x4b, 0x03, 0x46, 0xd5, 0xac, 0x74, 0x8b, 0x18, 0xdb, 0x56, 0x29, 0x6d, 0xd8, 0x8d, 0xdd,
+	0xcd, 0x22, 0x72, 0x45, 0x6b, 0xb4, 0x4f, 0x14, 0x99, 0x2b, 0xdc, 0x29, 0x14, 0xc6, 0xcf, 0x60,
+	0x5c, 0x36, 0x87, 0x48, 0x5e, 0xed, 0x95, 0xe0, 0x4d, 0xdb, 0x87, 0xbe, 0xcc, 0x43, 0xfc, 0x1b,
+	0xff, 0xac, 0x10, 0x59, 0x14, 0x96, 0xff, 0x48, 0x24, 0x9b, 0x2d, 0x7b, 0xae, 0x8b, 0x84, 0xd0,
+	0xab, 0xe8, 0x33, 0x2a, 0xdb, 0xbe, 0x2a, 0x8c, 0x1f, 0xc1, 0x1d, 0x42, 0x5f, 0x97, 0x0e, 0x6f,
+	0x8a, 0x26, 0xd0, 0x4d, 0x33, 0x37, 0xc9, 0xf8, 0x82, 0x16, 0x11, 0x04, 0x1a, 0x81, 0x45, 0x43,
+	0x5f, 0xba, 0x94, 0x7d, 0xe2, 0x6f, 0xc2, 0x98, 0xd0, 0x78, 0xb1, 0xd1, 0x26, 0xdb, 0xb0, 0xeb,
+	0xfa, 0x7e, 0x42, 0x53, 0x91, 0x99, 0x07, 0x44, 0x91, 0xf8, 0x07, 0x80, 0xf4, 0x95, 0x3e, 0x0c,
+	0x2f, 0xa3, 0xf6, 0xfb, 0xc4, 0xff, 0x30, 0x60, 0x52, 0x5d, 0x8f, 0x8b, 0xf8, 0xa2, 0x5f, 0x46,
+	0xf0, 0x5f, 0x0c, 0x18, 0x95, 0x4c, 0xe7, 0xac, 0x03, 0x3f, 0xad, 0xed, 0xca, 0x68, 0xd8, 0xd5,
+	0x21, 0xf4, 0xd9, 0x21, 0x76, 0x8a, 0xf0, 0xc8, 0x69, 0xde, 0xf6, 0x47, 0x7c, 0xc4, 0x92, 0x6d,
+	0x3f, 0xa7, 0xf8, 0x71, 0xa0, 0xa1, 0x1f, 0x84, 0x2a, 0x3f, 0x2a, 0x52, 0xbb, 0x44, 0x74, 0xeb,
+	0x97, 0x08, 0xd1, 0x9a, 0xf3, 0x2d, 0xf4, 0x89, 0xa4, 0x98, 0x34, 0xd1, 0xd6, 0xfb, 0x3c, 0x01,
+	0xf6, 0x89, 0x22, 0xf1, 0xc7, 0x80, 0x34, 0x6f, 0xb6, 0xdf, 0xd5, 0x04, 0xba, 0xec, 0xb2, 0x93,
+	0xda, 0xe6, 0x91, 0x35, 0xed, 0x10, 0x41, 0xe0, 0x8f, 0x60, 0xac, 0xd9, 0x88, 0x87, 0x46, 0x1b,
+	0x71, 0x4d, 0x47, 0xf2, 0x29, 0xbc, 0x55, 0x51, 0x8e, 0x8b, 0x7b, 0x24, 0xef, 0xca, 0x39, 0x22,
+	0xdb, 0xe3, 0x71, 0xa5, 0xd7, 0x71, 0xd6, 0xa4, 0xc2, 0x88, 0x63, 0x38, 0xd4, 0xa3, 0xff, 0x45,
+	0xf8, 0xbc, 0xb8, 0x0b, 0xb4, 0xd1, 0x73, 0x5b, 0xfe, 0x2a, 0xd2, 0xb0, 0x55, 0x4e, 0xc3, 0xf8,
+	0xa9, 0x34, 0xb0, 0x5c, 0x68, 0x96, 0xa6, 0x34, 0x4b, 0xd1, 0xf7, 0xe0, 0x60, 0x55, 0x06, 0x64,
+	0xbc, 0x4f, 0xe4, 0x0e, 0x34, 0x66, 0xa2, 0xb3, 0xe2, 0x8f, 0xe1, 0x40, 0x17, 0xf6, 0x75, 0xe8,
+	0xb9, 0x42, 0x8a, 0xb0, 0xc3, 0x81, 0x94, 0x22, 0xa7, 0xcb, 0xc1, 0x4a, 0x41, 0xe8, 0xa8, 0x82,
+	0x80, 0xbf, 0xcd, 0x72, 0x8f, 0x47, 0x83, 0x38, 0xcb, 0x1f, 0x16, 0x5a, 0x18, 0x02, 0xff, 0x14,
+	0x26, 0x72, 0xda, 0x85, 0xbc, 0xe3, 0x5d, 0x24, 0xa7, 0x74, 0xd1, 0xca, 0x88, 0x18, 0xba, 0x51,
+	0xde, 0x1e, 0x55, 0x8f, 0xb9, 0x18, 0x62, 0x71, 0xee, 0x4a, 0x99, 0xea, 0xb2, 0xac, 0x68, 0xfc,
+	0x47, 0x43, 0x5f, 0xfc, 0x3c, 0xf2, 0x59, 0x2a, 0x8d, 0x5b, 0x2d, 0xfe, 0x00, 0x06, 0x71, 0x42,
+	0xaf, 0x2e, 0xb6, 0x2a, 0x50, 0x0c, 0xa3, 0x6f, 0xc1, 0xbe, 0xb7, 0x4a, 0x12, 0x1a, 0x66, 0x45,
+	0xcb, 0x56, 0x65, 0xd7, 0x38, 0x98, 0xda, 0x4b, 0xa9, 0x8d, 0x3c, 0xb9, 0x39, 0x8d, 0x3f, 0x87,
+	0xb7, 0xa4, 0xd6, 0x22, 0xa5, 0x9c, 0x47, 0x7e, 0x70, 0xd9, 0x2e, 0xec, 0xee, 0x01, 0x30, 0xad,
+	0xb4, 0xfe, 0xb9, 0x84, 0xa0, 0xfb, 0x70, 0x20, 0xd5, 0xd0, 0xba, 0x2f, 0x1d, 0xc4, 0x7f, 0x35,
+	0xc0, 0x96, 0x1a, 0x14, 0x79, 0x52, 0xf5, 0x89, 0x6d, 0xd4, 0x78, 0xc4, 0x1a, 0x2a, 0x7a, 0x75,
+	0x5a, 0xed, 0x12, 0x1b, 0xb2, 0x6f, 0x85, 0x11, 0xbd, 0xcf, 0x35, 0x3c, 0xad, 0x36, 0xf8, 0x0d,
+	0x33, 0x75, 0x3e, 0xd6, 0x2e, 0x72, 0xc7, 0x0b, 0x6b, 0xa9, 0x76, 0xb1, 0x04, 0xe1, 0x5f, 0xf0,
+	0xcc, 0xcc, 0xb7, 0x55, 0x94, 0xee, 0x0f, 0x8a, 0x92, 0xe6, 0xac, 0xd5, 0xf3, 0x0e, 0x5b, 0xf1,
+	0x6e, 0x2d, 0x4d, 0x08, 0x3f, 0x56, 0xd9, 0xd1, 0x03, 0x18, 0xa9, 0x27, 0x93, 0xbc, 0x7e, 0x9b,
+	0x7c, 0xf5, 0x1a, 0xce, 0x22, 0xf2, 0x50, 0xaa, 0x30, 0xf3, 0xbc, 0x42, 0xfb, 0x17, 0xb1, 0xff,
+	0x7f, 0x6c, 0x5b, 0xfc, 0x3b, 0x13, 0xc6, 0x52, 0xed, 0xc2, 0x1c, 0x6f, 0xc0, 0x74, 0x18, 0xf6,
+	0x99, 0x8a, 0x67, 0xaa, 0x50, 0x09, 0xb3, 0x69, 0x18, 0xf3, 0xab, 0xb7, 0x4a, 0xce, 0xf4, 0x07,
+	0xb1, 0x32, 0xc4, 0xba, 0x92, 0x94, 0x77, 0x98, 0x17, 0x89, 0xf4, 0xab, 0xf4, 0x7e, 0x15, 0x2e,
+	0xbd, 0xb8, 0x75, 0xb5, 0x17, 0xb7, 0xe2, 0x55, 0xad, 0xa7, 0xbd, 0xaa, 0xdd, 0x87, 0x03, 0x59,
+	0x34, 0x9f, 0x89, 0x7a, 0x29, 0xca, 0xa2, 0x0e, 0xe2, 0xbf, 0x1b, 0xf0, 0x25, 0x69, 0x1d, 0x47,
+	0x5e, 0x3d, 0x6e, 0x71, 0x64, 0x1f, 0xc0, 0xa8, 0xb4, 0x5f, 0x71, 0x49, 0x11, 0x7d, 0x5b, 0x0d,
+	0x47, 0xc7, 0x80, 0x18, 0xf6, 0x54, 0xbf, 0xac, 0x58, 0x9c, 0xbb, 0x61, 0x84, 0x77, 0x96, 0xab,
+	0xa4, 0x2c, 0x42, 0x5e, 0x7a, 0xab, 0x30, 0xbb, 0x9a, 0x79, 0xab, 0xe4, 0x69, 0xd3, 0xd5, 0xb7,
+	0x3e, 0x80, 0x7f, 0x65, 0xc0, 0xdb, 0x95, 0x78, 0xc8, 0x7b, 0xfc, 0xd6, 0x55, 0x3c, 0x28, 0x55,
+	0x71, 0xf1, 0x1a, 0x2a, 0x2b, 0xa6, 0xb5, 0xad, 0xe3, 0xef, 0x68, 0x1d, 0x3f, 0xfe, 0x71, 0x9e,
+	0xdd, 0x1d, 0xd1, 0xa3, 0xdd, 0xe2, 0x14, 0xb1, 0x36, 0x74, 0x95, 0xc8, 0x79, 0x2a, 0x51, 0x16,
+	0x08, 0xfe, 0x1c, 0xee, 0x9c, 0xd7, 0x83, 0xf5, 0xdf, 0xda, 0x58, 0xfd, 0xd9, 0xb5, 0xa9, 0x3c,
+	0x54, 0x78, 0xf0, 0x3b, 0xd0, 0x7b, 0x11, 0x84, 0xd9, 0x77, 0x1e, 0x32, 0x99, 0xbe, 0x9b, 0xb9,
+	0xea, 0xe9, 0x98, 0x7d, 0xe3, 0x04, 0x0e, 0x66, 0xe2, 0xc1, 0x5f, 0x16, 0xf7, 0x36, 0xca, 0x15,
+	0x0d, 0x80, 0xd9, 0xae, 0x01, 0xb0, 0xca, 0x37, 0x42, 0x1c, 0xc1, 0x3e, 0xa1, 0xaf, 0x59, 0x83,
+	0xff, 0xc6, 0x97, 0x9c, 0x40, 0x37, 0x48, 0x67, 0x0b, 0x55, 0xc1, 0x05, 0x81, 0x3f, 0x80, 0x21,
+	0xef, 0x89, 0x8a, 0x25, 0x8f, 0x61, 0xe0, 0x2a, 0x42, 0xbe, 0x25, 0x8d, 0x94, 0x44, 0x85, 0x93,
+	0x82, 0x05, 0xff, 0x1c, 0x06, 0xc5, 0xe4, 0x96, 0xfd, 0xcf, 0x3d, 0x80, 0x84, 0x7a, 0x57, 0xb3,
+	0xf2, 0xa5, 0xb8, 0x84, 0xa0, 0x29, 0xec, 0xca, 0x7f, 0x2d, 0xd2, 0x8f, 0xc3, 0x42, 0x03, 0x86,
+	0x12, 0x35, 0x8c, 0xbf, 0x0b, 0xbd, 0x59, 0x6e, 0x52, 0x19, 0xdb, 0xc6, 0x96, 0x6e, 0xd0, 0xd4,
+	0xba, 0xc1, 0x77, 0x01, 0xe4, 0x45, 0x8a, 0xa6, 0x37, 0xdd, 0xd2, 0x28, 0x0c, 0x44, 0x57, 0x95,
+	0x65, 0xed, 0xe2, 0x53, 0x7b, 0x7b, 0x37, 0xb7, 0xbf, 0xbd, 0x5b, 0xda, 0xdb, 0xfb, 0x43, 0x80,
+	0x7c, 0x19, 0xf6, 0x4e, 0xd7, 0x0d, 0x32, 0xba, 0xac, 0x3a, 0x20, 0xe7, 0x20, 0x62, 0xf8, 0x65,
+	0x8f, 0xff, 0x8a, 0x7a, 0xef, 0x9f, 0x03, 0x00, 0x3a, 0xcd, 0xb6, 0x0f, 0xb2, 0x1a, 0x00, 0x00,
 }
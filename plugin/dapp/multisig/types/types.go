@@ -14,6 +14,8 @@ func init() {
 	types.AllowUserExec = append(types.AllowUserExec, []byte(MultiSigX))
 	types.RegistorExecutor(MultiSigX, NewType())
 	types.RegisterDappFork(MultiSigX, "Enable", 0)
+	types.RegisterDappFork(MultiSigX, ForkMultiSigSubmitTxX, 3800000)
+	types.RegisterDappFork(MultiSigX, ForkMultiSigTimelockX, 3800000)
 }
 
 // MultiSigType multisig合约结构体
@@ -47,6 +49,8 @@ func (m *MultiSigType) GetTypeMap() map[string]int32 {
 		"MultiSigConfirmTx":        ActionMultiSigConfirmTx,
 		"MultiSigExecTransferTo":   ActionMultiSigExecTransferTo,
 		"MultiSigExecTransferFrom": ActionMultiSigExecTransferFrom,
+		"MultiSigSubmitTx":         ActionMultiSigSubmitTx,
+		"MultiSigExecuteTx":        ActionMultiSigExecuteTx,
 	}
 }
 
@@ -70,6 +74,9 @@ func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogDailyLimitUpdate: {Ty: reflect.TypeOf(ReceiptAccDailyLimitUpdate{}), Name: "LogAccDailyLimitUpdate"},
 		TyLogMultiSigTx:       {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigAccTx"},
 		TyLogTxCountUpdate:    {Ty: reflect.TypeOf(ReceiptTxCountUpdate{}), Name: "LogTxCountUpdate"},
+
+		TyLogMultiSigSubmitTxApproved:  {Ty: reflect.TypeOf(ReceiptMultiSigSubmitTx{}), Name: "LogMultiSigSubmitTxApproved"},
+		TyLogMultiSigAccTimelockModify: {Ty: reflect.TypeOf(ReceiptTimelockModify{}), Name: "LogMultiSigAccTimelockModify"},
 	}
 }
 
@@ -102,6 +109,10 @@ func (m MultiSigType) ActionName(tx *types.Transaction) string {
 		return "MultiSigExecTransfer"
 	} else if g.Ty == ActionMultiSigExecTransferFrom && g.GetMultiSigExecTransferFrom() != nil {
 		return "MultiSigAccExecTransfer"
+	} else if g.Ty == ActionMultiSigSubmitTx && g.GetMultiSigSubmitTx() != nil {
+		return "MultiSigSubmitTx"
+	} else if g.Ty == ActionMultiSigExecuteTx && g.GetMultiSigExecuteTx() != nil {
+		return "MultiSigExecuteTx"
 	}
 	return "unknown"
 }
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/system/dapp/commands"
@@ -32,6 +34,10 @@ func ParcCmd() *cobra.Command {
 		CreateRawTransferCmd(),
 		CreateRawWithdrawCmd(),
 		CreateRawTransferToExecCmd(),
+		CreateRawParaAssetTransferCmd(),
+		CreateRawParaAssetWithdrawCmd(),
+		CreateRawCrossTransferCmd(),
+		GetCrossTransferCmd(),
 		superNodeCmd(),
 		nodeGroupCmd(),
 		GetParaInfoCmd(),
@@ -40,6 +46,7 @@ func ParcCmd() *cobra.Command {
 		GetHeightCmd(),
 		GetBlockInfoCmd(),
 		GetLocalBlockInfoCmd(),
+		GetCrossAssetListCmd(),
 	)
 	return cmd
 }
@@ -67,7 +74,7 @@ func addCreateAssetTransferFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("title", "", "", "the title of para chain, like `user.p.guodun.`")
 	cmd.MarkFlagRequired("title")
 
-	cmd.Flags().StringP("symbol", "s", "", "default for bty, symbol for token")
+	cmd.Flags().StringP("symbol", "s", "", "default for bty, symbol for token, exec.symbol for other registered assets")
 }
 
 func createAssetTransfer(cmd *cobra.Command, args []string) {
@@ -102,7 +109,7 @@ func addCreateAssetWithdrawFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("to", "t", "", "receiver account address")
 	cmd.MarkFlagRequired("to")
 
-	cmd.Flags().StringP("symbol", "s", "", "default for bty, symbol for token")
+	cmd.Flags().StringP("symbol", "s", "", "default for bty, symbol for token, exec.symbol for other registered assets")
 }
 
 func createAssetWithdraw(cmd *cobra.Command, args []string) {
@@ -115,9 +122,23 @@ func createAssetWithdraw(cmd *cobra.Command, args []string) {
 }
 
 func createAssetTx(cmd *cobra.Command, isWithdraw bool) (string, error) {
+	param, err := createAssetTxParam(cmd, isWithdraw)
+	if err != nil {
+		return "", err
+	}
+	tx, err := pt.CreateRawAssetTransferTx(param)
+	if err != nil {
+		return "", err
+	}
+
+	txHex := types.Encode(tx)
+	return hex.EncodeToString(txHex), nil
+}
+
+func createAssetTxParam(cmd *cobra.Command, isWithdraw bool) (*types.CreateTx, error) {
 	amount, _ := cmd.Flags().GetFloat64("amount")
 	if amount < 0 {
-		return "", types.ErrAmount
+		return nil, types.ErrAmount
 	}
 	amountInt64 := int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4
 
@@ -128,11 +149,11 @@ func createAssetTx(cmd *cobra.Command, isWithdraw bool) (string, error) {
 	title, _ := cmd.Flags().GetString("title")
 	if !strings.HasPrefix(title, "user.p") {
 		fmt.Fprintln(os.Stderr, "title is not right, title format like `user.p.guodun.`")
-		return "", types.ErrInvalidParam
+		return nil, types.ErrInvalidParam
 	}
 	execName := title + pt.ParaX
 
-	param := types.CreateTx{
+	param := &types.CreateTx{
 		To:          toAddr,
 		Amount:      amountInt64,
 		Fee:         0,
@@ -142,13 +163,205 @@ func createAssetTx(cmd *cobra.Command, isWithdraw bool) (string, error) {
 		TokenSymbol: symbol,
 		ExecName:    execName,
 	}
-	tx, err := pt.CreateRawAssetTransferTx(&param)
+	return param, nil
+}
+
+// CreateRawParaAssetTransferCmd create raw para chain asset transfer to main chain tx
+func CreateRawParaAssetTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "para_asset_transfer",
+		Short: "Create a para-chain asset transfer to main-chain transaction",
+		Run:   createParaAssetTransfer,
+	}
+	addCreateParaAssetFlags(cmd)
+	return cmd
+}
+
+// CreateRawParaAssetWithdrawCmd create raw para chain asset withdraw from main chain tx
+func CreateRawParaAssetWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "para_asset_withdraw",
+		Short: "Create a para-chain asset withdraw from main-chain transaction",
+		Run:   createParaAssetWithdraw,
+	}
+	addCreateParaAssetFlags(cmd)
+	return cmd
+}
+
+func addCreateParaAssetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+
+	cmd.Flags().StringP("title", "", "", "the title of para chain, like `user.p.guodun.`")
+	cmd.MarkFlagRequired("title")
+
+	cmd.Flags().StringP("symbol", "s", "", "para chain asset, symbol for token, exec.symbol for others, like coins.guodun")
+	cmd.MarkFlagRequired("symbol")
+}
+
+func createParaAssetTransfer(cmd *cobra.Command, args []string) {
+	createParaAssetTx(cmd, false)
+}
+
+func createParaAssetWithdraw(cmd *cobra.Command, args []string) {
+	createParaAssetTx(cmd, true)
+}
+
+func createParaAssetTx(cmd *cobra.Command, isWithdraw bool) {
+	param, err := createAssetTxParam(cmd, isWithdraw)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	tx, err := pt.CreateRawParaAssetTransferTx(param)
 	if err != nil {
-		return "", err
+		fmt.Fprintln(os.Stderr, err)
+		return
 	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
 
-	txHex := types.Encode(tx)
-	return hex.EncodeToString(txHex), nil
+// CreateRawCrossTransferCmd create raw asset transfer from one para chain to another tx
+func CreateRawCrossTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross_transfer",
+		Short: "Create a asset transfer from para-chain to another para-chain transaction",
+		Run:   createCrossTransfer,
+	}
+	addCreateCrossTransferFlags(cmd)
+	return cmd
+}
+
+func addCreateCrossTransferFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+
+	cmd.Flags().StringP("title", "", "", "the title of source para chain, like `user.p.guodun.`")
+	cmd.MarkFlagRequired("title")
+
+	cmd.Flags().StringP("to_title", "d", "", "the title of target para chain, like `user.p.fzm.`")
+	cmd.MarkFlagRequired("to_title")
+
+	cmd.Flags().StringP("symbol", "s", "", "default for bty, symbol for token, exec.symbol for other registered assets")
+}
+
+func createCrossTransfer(cmd *cobra.Command, args []string) {
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	if amount < 0 {
+		fmt.Fprintln(os.Stderr, types.ErrAmount)
+		return
+	}
+	amountInt64 := int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4
+	toAddr, _ := cmd.Flags().GetString("to")
+	note, _ := cmd.Flags().GetString("note")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	title, _ := cmd.Flags().GetString("title")
+	toTitle, _ := cmd.Flags().GetString("to_title")
+	if !strings.HasPrefix(title, "user.p") || !strings.HasPrefix(toTitle, "user.p") {
+		fmt.Fprintln(os.Stderr, "title is not right, title format like `user.p.guodun.`")
+		return
+	}
+
+	transfer := &pt.CrossAssetTransfer{
+		ToTitle:   toTitle,
+		Cointoken: symbol,
+		Amount:    amountInt64,
+		Note:      note,
+		To:        toAddr,
+	}
+	tx, err := pt.CreateRawCrossTransferTx(title+pt.ParaX, transfer)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+func addCrossTransferHashFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "", "", "cross transfer tx hash on source para chain")
+	cmd.MarkFlagRequired("hash")
+}
+
+func getCrossTransferHash(cmd *cobra.Command) ([]byte, error) {
+	hash, _ := cmd.Flags().GetString("hash")
+	return common.FromHex(hash)
+}
+
+// GetCrossTransferCmd query para chain to para chain transfer status
+func GetCrossTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross_transfer_status",
+		Short: "Query para-chain to para-chain transfer status by source tx hash",
+		Run:   crossTransferStatus,
+	}
+	addCrossTransferHashFlags(cmd)
+	return cmd
+}
+
+type crossTransferResult struct {
+	TxHash        string `json:"txHash"`
+	FromTitle     string `json:"fromTitle"`
+	ToTitle       string `json:"toTitle"`
+	From          string `json:"from"`
+	To            string `json:"to"`
+	Exec          string `json:"exec"`
+	Symbol        string `json:"symbol"`
+	Amount        string `json:"amount"`
+	Status        string `json:"status"`
+	Height        int64  `json:"height"`
+	SettleHeight  int64  `json:"settleHeight,omitempty"`
+	DeliverTxHash string `json:"deliverTxHash,omitempty"`
+	DeliverHeight int64  `json:"deliverHeight,omitempty"`
+	DoneHeight    int64  `json:"doneHeight,omitempty"`
+}
+
+func crossTransferStatus(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, err := getCrossTransferHash(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	var res pt.ParaCrossTransferStatus
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "paracross.GetCrossTransfer", &types.ReqHash{Hash: hash}, &res)
+	ctx.SetResultCb(parseCrossTransferStatus)
+	ctx.Run()
+}
+
+func parseCrossTransferStatus(arg interface{}) (interface{}, error) {
+	res := arg.(*pt.ParaCrossTransferStatus)
+	result := &crossTransferResult{
+		TxHash:        common.ToHex(res.TxHash),
+		FromTitle:     res.FromTitle,
+		ToTitle:       res.ToTitle,
+		From:          res.From,
+		To:            res.To,
+		Exec:          res.Exec,
+		Symbol:        res.Symbol,
+		Amount:        strconv.FormatFloat(float64(res.Amount)/float64(types.Coin), 'f', 4, 64),
+		Height:        res.Height,
+		SettleHeight:  res.SettleHeight,
+		DeliverHeight: res.DeliverHeight,
+		DoneHeight:    res.DoneHeight,
+	}
+	if res.Status >= 0 && int(res.Status) < len(pt.ParaCrossTransferStatusStr) {
+		result.Status = pt.ParaCrossTransferStatusStr[res.Status]
+	}
+	if len(res.DeliverTxHash) > 0 {
+		result.DeliverTxHash = common.ToHex(res.DeliverTxHash)
+	}
+	return result, nil
 }
 
 //CreateRawTransferCmd  create raw transfer tx
@@ -244,10 +457,12 @@ func superNodeCmd() *cobra.Command {
 	cmd.AddCommand(nodeVoteCmd())
 	cmd.AddCommand(nodeQuitCmd())
 	cmd.AddCommand(nodeCancelCmd())
+	cmd.AddCommand(nodeSlashCmd())
 
 	cmd.AddCommand(getNodeInfoCmd())
 	cmd.AddCommand(getNodeIDInfoCmd())
 	cmd.AddCommand(getNodeListCmd())
+	cmd.AddCommand(getNodeMissCommitsCmd())
 	return cmd
 }
 
@@ -382,6 +597,81 @@ func nodeCancelCmd() *cobra.Command {
 	return cmd
 }
 
+func addNodeSlashFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("title", "t", "", "parallel chain's title")
+	cmd.MarkFlagRequired("title")
+
+	cmd.Flags().StringP("addr", "a", "", "super node addr to be slashed")
+	cmd.MarkFlagRequired("addr")
+
+	cmd.Flags().Uint32P("reason", "r", 1, "slash reason, 1:conflict commits, 2:miss commits")
+
+	cmd.Flags().StringP("txs", "x", "", "two conflict raw commit txs in hex, separated by comma, only for reason 1")
+}
+
+func createNodeSlashTx(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	addr, _ := cmd.Flags().GetString("addr")
+	reason, _ := cmd.Flags().GetUint32("reason")
+	txs, _ := cmd.Flags().GetString("txs")
+
+	slash := &pt.ParaNodeSlash{Title: title, Addr: addr, Reason: int32(reason)}
+	if reason == pt.ParaNodeSlashConflict {
+		for _, txHex := range strings.Split(txs, ",") {
+			data, err := common.FromHex(strings.TrimSpace(txHex))
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return
+			}
+			var tx types.Transaction
+			err = types.Decode(data, &tx)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return
+			}
+			slash.CommitTxs = append(slash.CommitTxs, &tx)
+		}
+	}
+	tx, err := pt.CreateRawNodeSlashTx(slash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+func nodeSlashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash",
+		Short: "slash super node with conflict commits or miss commits evidence",
+		Run:   createNodeSlashTx,
+	}
+	addNodeSlashFlags(cmd)
+	return cmd
+}
+
+// getNodeMissCommitsCmd get continuous miss commit count of super nodes
+func getNodeMissCommitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "miss_commits",
+		Short: "Get continuous miss commit count of super nodes",
+		Run:   nodeMissCommits,
+	}
+	cmd.Flags().StringP("title", "t", "", "parallel chain's title")
+	cmd.MarkFlagRequired("title")
+	return cmd
+}
+
+func nodeMissCommits(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	title, _ := cmd.Flags().GetString("title")
+
+	params := types.ReqString{Data: title}
+	var res pt.ParaNodeMissCommits
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "paracross.GetNodeMissCommits", params, &res)
+	ctx.Run()
+}
+
 // getNodeInfoCmd get node current status
 func getNodeInfoCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -791,6 +1081,26 @@ func paraList(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+// GetCrossAssetListCmd get cross assets registered for title
+func GetCrossAssetListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset_list",
+		Short: "Query cross assets registered by manage for title, coins and token are always allowed",
+		Run:   crossAssetList,
+	}
+	addTitleFlags(cmd)
+	return cmd
+}
+
+func crossAssetList(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	title, _ := cmd.Flags().GetString("title")
+
+	var res pt.RespParacrossAssets
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "paracross.ListCrossAssets", &types.ReqString{Data: title}, &res)
+	ctx.Run()
+}
+
 // getNodeGroupCmd get node group addr
 func getNodeGroupAddrsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -76,6 +76,12 @@ func getConfigManageNodes(db dbm.KV, title string) (map[string]struct{}, []strin
 	return getNodes(db, key)
 }
 
+//manage 配置的资产格式为 exec.symbol
+func getConfigManageAssets(db dbm.KV, title string) (map[string]struct{}, []string, error) {
+	key := calcManageConfigAssetsKey(title)
+	return getNodes(db, key)
+}
+
 func getParacrossNodes(db dbm.KV, title string) (map[string]struct{}, []string, error) {
 	key := calcParaNodeGroupAddrsKey(title)
 	return getNodes(db, key)
@@ -333,7 +339,11 @@ func (a *action) Commit(commit *pt.ParacrossCommitAction) (*types.Receipt, error
 	if !validNode(a.fromaddr, nodes) {
 		return nil, errors.Wrapf(pt.ErrNodeNotForTheTitle, "not validNode:%s", a.fromaddr)
 	}
+	return a.commitByAddrs(commit, nodes, []string{a.fromaddr})
+}
 
+//addrs 为对commit投票的授权节点, 普通commit只有发送者自己, 聚合commit为所有签名的节点
+func (a *action) commitByAddrs(commit *pt.ParacrossCommitAction, nodes map[string]struct{}, addrs []string) (*types.Receipt, error) {
 	titleStatus, err := getTitle(a.db, calcTitleKey(commit.Status.Title))
 	if err != nil {
 		return nil, errors.Wrapf(err, "getTitle:%s", a.fromaddr)
@@ -384,7 +394,19 @@ func (a *action) Commit(commit *pt.ParacrossCommitAction) (*types.Receipt, error
 	// 在完成共识之后来的， 增加 record log， 只记录不修改已经达成的共识
 	if commit.Status.Height <= titleStatus.Height {
 		clog.Debug("paracross.Commit record", "node", a.fromaddr, "titile", commit.Status.Title, "height", commit.Status.Height)
-		return makeRecordReceipt(a.fromaddr, commit), nil
+		receipt := &types.Receipt{Ty: types.ExecOk}
+		for _, addr := range addrs {
+			receipt = mergeReceipt(receipt, makeRecordReceipt(addr, commit))
+		}
+		//主链，迟到但正确的commit同样清除节点未参与共识的统计
+		if !types.IsPara() && types.IsDappFork(a.height, pt.ParaX, pt.ForkParaNodeSlash) {
+			r, err := a.updateLateCommitMiss(commit.Status, addrs)
+			if err != nil {
+				return nil, err
+			}
+			receipt = mergeReceipt(receipt, r)
+		}
+		return receipt, nil
 	}
 
 	// 未共识处理， 接受当前高度以及后续高度
@@ -394,16 +416,13 @@ func (a *action) Commit(commit *pt.ParacrossCommitAction) (*types.Receipt, error
 		return nil, err
 	}
 
-	var receipt *types.Receipt
+	var prev *pt.ParacrossHeightStatus
 	if isNotFound(err) {
 		stat = &pt.ParacrossHeightStatus{
-			Status: pt.ParacrossStatusCommiting,
-			Title:  commit.Status.Title,
-			Height: commit.Status.Height,
-			Details: &pt.ParacrossStatusDetails{
-				Addrs:     []string{a.fromaddr},
-				BlockHash: [][]byte{commit.Status.BlockHash},
-			},
+			Status:  pt.ParacrossStatusCommiting,
+			Title:   commit.Status.Title,
+			Height:  commit.Status.Height,
+			Details: &pt.ParacrossStatusDetails{},
 		}
 		if pt.IsParaForkHeight(a.exec.GetMainHeight(), pt.ForkCommitTx) {
 			stat.MainHeight = commit.Status.MainBlockHeight
@@ -413,10 +432,7 @@ func (a *action) Commit(commit *pt.ParacrossCommitAction) (*types.Receipt, error
 		// 后面loopCommitTxDone时候也是用当前共识高度大于分叉高度判断
 		if pt.IsParaForkHeight(commit.Status.MainBlockHeight, pt.ForkLoopCheckCommitTxDone) {
 			stat.BlockDetails = &pt.ParacrossStatusBlockDetails{}
-			updateCommitBlockHashs(stat, commit.Status)
 		}
-
-		receipt = makeCommitReceipt(a.fromaddr, commit, nil, stat)
 	} else {
 		var copyStat pt.ParacrossHeightStatus
 		err = deepCopy(&copyStat, stat)
@@ -424,22 +440,26 @@ func (a *action) Commit(commit *pt.ParacrossCommitAction) (*types.Receipt, error
 			clog.Error("paracross.Commit deep copy fail", "copy", copyStat, "stat", stat)
 			return nil, err
 		}
+		prev = &copyStat
+	}
+
+	for _, addr := range addrs {
 		// 如有分叉， 同一个节点可能再次提交commit交易
-		found, index := hasCommited(stat.Details.Addrs, a.fromaddr)
+		found, index := hasCommited(stat.Details.Addrs, addr)
 		if found {
 			stat.Details.BlockHash[index] = commit.Status.BlockHash
-			if pt.IsParaForkHeight(commit.Status.MainBlockHeight, pt.ForkLoopCheckCommitTxDone) {
-				updateCommitBlockHashs(stat, commit.Status)
-			}
 		} else {
-			stat.Details.Addrs = append(stat.Details.Addrs, a.fromaddr)
+			stat.Details.Addrs = append(stat.Details.Addrs, addr)
 			stat.Details.BlockHash = append(stat.Details.BlockHash, commit.Status.BlockHash)
-			if pt.IsParaForkHeight(commit.Status.MainBlockHeight, pt.ForkLoopCheckCommitTxDone) {
-				updateCommitBlockHashs(stat, commit.Status)
-			}
 		}
+	}
+	if pt.IsParaForkHeight(commit.Status.MainBlockHeight, pt.ForkLoopCheckCommitTxDone) {
+		updateCommitBlockHashs(stat, commit.Status)
+	}
 
-		receipt = makeCommitReceipt(a.fromaddr, commit, &copyStat, stat)
+	receipt := makeCommitReceipt(addrs[0], commit, prev, stat)
+	for _, addr := range addrs[1:] {
+		receipt.Logs = append(receipt.Logs, makeCommitReceipt(addr, commit, prev, stat).Logs...)
 	}
 	//平行链fork pt.ForkCommitTx=0,主链在ForkCommitTx后支持nodegroup，这里平行链dappFork一定为true
 	if types.IsDappFork(commit.Status.MainBlockHeight, pt.ParaX, pt.ForkCommitTx) {
@@ -549,6 +569,15 @@ func (a *action) commitTxDoneStep2(nodeStatus *pt.ParacrossNodeStatus, stat *pt.
 		return receipt, nil
 	}
 
+	//主链，统计授权节点连续未参与共识的次数
+	if types.IsDappFork(a.height, pt.ParaX, pt.ForkParaNodeSlash) {
+		r, err := a.updateNodeMissCommits(stat)
+		if err != nil {
+			return nil, err
+		}
+		receipt = mergeReceipt(receipt, r)
+	}
+
 	//主链，处理跨链交易
 	r, err := a.procCrossTxs(nodeStatus)
 	if err != nil {
@@ -751,10 +780,59 @@ func (a *action) execCrossTx(tx *types.TransactionDetail, crossTxHash []byte) (*
 		clog.Debug("paracross.Commit WithdrawCoins", "txHash", hex.EncodeToString(crossTxHash))
 		return receiptWithdraw, nil
 	}
+
+	if payload.Ty == pt.ParacrossActionParaAssetTransfer {
+		receipt, err := a.paraAssetTransfer(payload.GetParaAssetTransfer(), tx.Tx)
+		if err != nil {
+			clog.Crit("paracross.Commit paraAssetTransfer failed", "error", err, "txHash", hex.EncodeToString(crossTxHash))
+			return nil, errors.Cause(err)
+		}
+		return receipt, nil
+	}
+
+	if payload.Ty == pt.ParacrossActionCrossTransfer {
+		return a.crossTransferDone(tx.Tx, crossTxHash, true)
+	}
 	return nil, nil
 
 }
 
+//平行链执行失败的跨链交易，主链已经执行的部分需要回滚
+func (a *action) rollbackCrossTx(tx *types.TransactionDetail, crossTxHash []byte) (*types.Receipt, error) {
+	if !bytes.HasSuffix(tx.Tx.Execer, []byte(pt.ParaX)) {
+		return nil, nil
+	}
+	var payload pt.ParacrossAction
+	err := types.Decode(tx.Tx.Payload, &payload)
+	if err != nil {
+		clog.Crit("paracross.Commit Decode Tx failed", "error", err, "txHash", hex.EncodeToString(crossTxHash))
+		return nil, err
+	}
+
+	if payload.Ty == pt.ParacrossActionParaAssetWithdraw {
+		receipt, err := a.paraAssetWithdrawRollback(payload.GetParaAssetWithdraw(), tx.Tx)
+		if err != nil {
+			clog.Crit("paracross.Commit paraAssetWithdrawRollback failed", "error", err, "txHash", hex.EncodeToString(crossTxHash))
+			return nil, errors.Cause(err)
+		}
+		return receipt, nil
+	}
+
+	if payload.Ty == pt.ParacrossActionCrossTransfer {
+		return a.crossTransferDone(tx.Tx, crossTxHash, false)
+	}
+	return nil, nil
+}
+
+func (a *action) crossTransferDone(tx *types.Transaction, crossTxHash []byte, success bool) (*types.Receipt, error) {
+	receipt, err := a.crossTransferSettle(tx, success)
+	if err != nil {
+		clog.Crit("paracross.Commit crossTransferDone failed", "error", err, "txHash", hex.EncodeToString(crossTxHash), "success", success)
+		return nil, errors.Cause(err)
+	}
+	return receipt, nil
+}
+
 func getCrossTxHashsByRst(api client.QueueProtocolAPI, status *pt.ParacrossNodeStatus) ([][]byte, []byte, error) {
 	//只获取跨链tx
 	rst, err := hex.DecodeString(string(status.TxResult))
@@ -853,6 +931,18 @@ func (a *action) execCrossTxs(status *pt.ParacrossNodeStatus) (*types.Receipt, e
 	for i := 0; i < len(crossTxHashs); i++ {
 		clog.Debug("paracross.Commit commitDone", "do cross number", i, "hash", hex.EncodeToString(crossTxHashs[i]),
 			"res", util.BitMapBit(crossTxResult, uint32(i)))
+		//平行链生成的跨链转账投递交易，主链上没有这个交易
+		receiptCross, isDeliver, err := a.crossDeliverDone(crossTxHashs[i], util.BitMapBit(crossTxResult, uint32(i)))
+		if err != nil {
+			clog.Crit("paracross.Commit crossDeliverDone failed", "para title", title, "para height", status.Height,
+				"para tx index", i, "error", err, "txHash", hex.EncodeToString(crossTxHashs[i]))
+			return nil, errors.Cause(err)
+		}
+		if isDeliver {
+			receipt.KV = append(receipt.KV, receiptCross.KV...)
+			receipt.Logs = append(receipt.Logs, receiptCross.Logs...)
+			continue
+		}
 		if util.BitMapBit(crossTxResult, uint32(i)) {
 			tx, err := GetTx(a.api, crossTxHashs[i])
 			if err != nil {
@@ -880,6 +970,26 @@ func (a *action) execCrossTxs(status *pt.ParacrossNodeStatus) (*types.Receipt, e
 			clog.Error("paracross.Commit commitDone", "do cross number", i, "hash",
 				hex.EncodeToString(crossTxHashs[i]),
 				"para res", util.BitMapBit(crossTxResult, uint32(i)))
+			if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaAssetBridge) {
+				continue
+			}
+			tx, err := GetTx(a.api, crossTxHashs[i])
+			if err != nil || tx == nil {
+				clog.Crit("paracross.Commit Load Tx failed", "para title", title, "para height", status.Height,
+					"para tx index", i, "error", err, "txHash", hex.EncodeToString(crossTxHashs[i]))
+				return nil, types.ErrHashNotExist
+			}
+			receiptCross, err := a.rollbackCrossTx(tx, crossTxHashs[i])
+			if err != nil {
+				clog.Error("paracross.Commit rollbackCrossTx", "para title", title, "para height", status.Height,
+					"para tx index", i, "error", err)
+				return nil, errors.Cause(err)
+			}
+			if receiptCross == nil {
+				continue
+			}
+			receipt.KV = append(receipt.KV, receiptCross.KV...)
+			receipt.Logs = append(receipt.Logs, receiptCross.Logs...)
 		}
 	}
 
@@ -919,6 +1029,41 @@ func (a *action) AssetWithdraw(withdraw *types.AssetsWithdraw) (*types.Receipt,
 	return receipt, nil
 }
 
+func (a *action) ParaAssetTransfer(transfer *types.AssetsTransfer) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaAssetBridge) {
+		return nil, types.ErrNotSupport
+	}
+	//平行链资产必须指定执行器和symbol, 主链和平行链的coins symbol不同
+	if transfer.Cointoken == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if !types.IsPara() {
+		// 需要平行链先锁定资产， 达成共识时，主链继续执行
+		return nil, nil
+	}
+	receipt, err := a.paraAssetTransfer(transfer, a.tx)
+	if err != nil {
+		clog.Error("ParaAssetTransfer failed", "err", err)
+		return nil, errors.Cause(err)
+	}
+	return receipt, nil
+}
+
+func (a *action) ParaAssetWithdraw(withdraw *types.AssetsWithdraw) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaAssetBridge) {
+		return nil, types.ErrNotSupport
+	}
+	if withdraw.Cointoken == "" {
+		return nil, types.ErrInvalidParam
+	}
+	receipt, err := a.paraAssetWithdraw(withdraw, a.tx)
+	if err != nil {
+		clog.Error("ParaAssetWithdraw failed", "err", err)
+		return nil, errors.Cause(err)
+	}
+	return receipt, nil
+}
+
 //当前miner tx不需要校验上一个区块的衔接性，因为tx就是本节点发出，高度，preHash等都在本区块里面的blockchain做了校验
 func (a *action) Miner(miner *pt.ParacrossMinerAction) (*types.Receipt, error) {
 	if miner.Status.Title != types.GetTitle() || miner.Status.MainBlockHash == nil {
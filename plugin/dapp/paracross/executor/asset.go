@@ -19,7 +19,18 @@ func (a *action) assetTransfer(transfer *types.AssetsTransfer) (*types.Receipt,
 	isPara := types.IsPara()
 	//主链处理分支
 	if !isPara {
-		accDB, err := createAccount(a.db, transfer.Cointoken)
+		exec, symbol := a.mainCrossAsset(transfer.Cointoken)
+		if types.IsDappFork(a.height, pt.ParaX, pt.ForkParaAssetRegistry) {
+			paraTitle, err := getTitleFrom(a.tx.Execer)
+			if err != nil {
+				return nil, errors.Wrap(err, "assetTransfer call getTitleFrom failed")
+			}
+			err = checkCrossAsset(a.db, string(paraTitle), exec, symbol)
+			if err != nil {
+				return nil, err
+			}
+		}
+		accDB, err := createAccount(a.db, exec, symbol)
 		if err != nil {
 			return nil, errors.Wrap(err, "assetTransferToken call account.NewAccountDB failed")
 		}
@@ -38,12 +49,8 @@ func (a *action) assetTransfer(transfer *types.AssetsTransfer) (*types.Receipt,
 	if err != nil {
 		return nil, errors.Wrap(err, "assetTransferCoins call getTitleFrom failed")
 	}
-	var paraAcc *account.DB
-	if transfer.Cointoken == "" {
-		paraAcc, err = NewParaAccount(string(paraTitle), "coins", "bty", a.db)
-	} else {
-		paraAcc, err = NewParaAccount(string(paraTitle), "token", transfer.Cointoken, a.db)
-	}
+	exec, symbol := a.paraCrossAsset(transfer.Cointoken)
+	paraAcc, err := NewParaAccount(string(paraTitle), exec, symbol, a.db)
 	if err != nil {
 		return nil, errors.Wrap(err, "assetTransferCoins call NewParaAccount failed")
 	}
@@ -56,7 +63,8 @@ func (a *action) assetWithdraw(withdraw *types.AssetsWithdraw, withdrawTx *types
 	isPara := types.IsPara()
 	//主链处理分支
 	if !isPara {
-		accDB, err := createAccount(a.db, withdraw.Cointoken)
+		exec, symbol := a.mainCrossAsset(withdraw.Cointoken)
+		accDB, err := createAccount(a.db, exec, symbol)
 		if err != nil {
 			return nil, errors.Wrap(err, "assetWithdrawCoins call account.NewAccountDB failed")
 		}
@@ -71,12 +79,8 @@ func (a *action) assetWithdraw(withdraw *types.AssetsWithdraw, withdrawTx *types
 	if err != nil {
 		return nil, errors.Wrap(err, "assetWithdrawCoins call getTitleFrom failed")
 	}
-	var paraAcc *account.DB
-	if withdraw.Cointoken == "" {
-		paraAcc, err = NewParaAccount(string(paraTitle), "coins", "bty", a.db)
-	} else {
-		paraAcc, err = NewParaAccount(string(paraTitle), "token", withdraw.Cointoken, a.db)
-	}
+	exec, symbol := a.paraCrossAsset(withdraw.Cointoken)
+	paraAcc, err := NewParaAccount(string(paraTitle), exec, symbol, a.db)
 	if err != nil {
 		return nil, errors.Wrap(err, "assetWithdrawCoins call NewParaAccount failed")
 	}
@@ -85,14 +89,123 @@ func (a *action) assetWithdraw(withdraw *types.AssetsWithdraw, withdrawTx *types
 	return assetWithdrawBalance(paraAcc, a.fromaddr, withdraw.Amount)
 }
 
-func createAccount(db db.KV, symbol string) (*account.DB, error) {
+//平行链原生资产跨链到主链，平行链先锁定资产，主链在共识完成时给paracross镜像帐号充值
+func (a *action) paraAssetTransfer(transfer *types.AssetsTransfer, transferTx *types.Transaction) (*types.Receipt, error) {
+	exec, symbol := crossAsset(transfer.Cointoken, a.height)
+	paraTitle, err := getTitleFrom(transferTx.Execer)
+	if err != nil {
+		return nil, errors.Wrap(err, "paraAssetTransfer call getTitleFrom failed")
+	}
+	//主链处理分支
+	if !types.IsPara() {
+		mainAcc, err := NewMainAccount(string(paraTitle), exec, symbol, a.db)
+		if err != nil {
+			return nil, errors.Wrap(err, "paraAssetTransfer call NewMainAccount failed")
+		}
+		clog.Debug("paracross.paraAssetTransfer not isPara", "execer", string(transferTx.Execer),
+			"txHash", hex.EncodeToString(transferTx.Hash()), "to", transfer.To, "amount", transfer.Amount)
+		return assetDepositBalance(mainAcc, transfer.To, transfer.Amount)
+	}
+	//平行链处理分支
+	accDB, err := createAccount(a.db, exec, symbol)
+	if err != nil {
+		return nil, errors.Wrap(err, "paraAssetTransfer call account.NewAccountDB failed")
+	}
+	lockAddr := address.ExecAddress(pt.ParaX)
+	clog.Debug("paracross.paraAssetTransfer isPara", "execer", string(transferTx.Execer),
+		"txHash", hex.EncodeToString(transferTx.Hash()), "from", a.fromaddr, "amount", transfer.Amount)
+	return accDB.ExecTransfer(a.fromaddr, lockAddr, a.execaddr, transfer.Amount)
+}
+
+//主链镜像资产提回平行链，主链先扣除镜像帐号，平行链解锁资产，平行链执行失败时主链在共识完成时退回
+func (a *action) paraAssetWithdraw(withdraw *types.AssetsWithdraw, withdrawTx *types.Transaction) (*types.Receipt, error) {
+	exec, symbol := crossAsset(withdraw.Cointoken, a.height)
+	paraTitle, err := getTitleFrom(withdrawTx.Execer)
+	if err != nil {
+		return nil, errors.Wrap(err, "paraAssetWithdraw call getTitleFrom failed")
+	}
+	//主链处理分支
+	if !types.IsPara() {
+		mainAcc, err := NewMainAccount(string(paraTitle), exec, symbol, a.db)
+		if err != nil {
+			return nil, errors.Wrap(err, "paraAssetWithdraw call NewMainAccount failed")
+		}
+		clog.Debug("paracross.paraAssetWithdraw not isPara", "execer", string(withdrawTx.Execer),
+			"txHash", hex.EncodeToString(withdrawTx.Hash()), "from", a.fromaddr, "amount", withdraw.Amount)
+		return assetWithdrawBalance(mainAcc, a.fromaddr, withdraw.Amount)
+	}
+	//平行链处理分支
+	accDB, err := createAccount(a.db, exec, symbol)
+	if err != nil {
+		return nil, errors.Wrap(err, "paraAssetWithdraw call account.NewAccountDB failed")
+	}
+	lockAddr := address.ExecAddress(pt.ParaX)
+	clog.Debug("paracross.paraAssetWithdraw isPara", "execer", string(withdrawTx.Execer),
+		"txHash", hex.EncodeToString(withdrawTx.Hash()), "to", withdraw.To, "amount", withdraw.Amount)
+	return accDB.ExecTransfer(lockAddr, withdraw.To, a.execaddr, withdraw.Amount)
+}
+
+//平行链执行提回交易失败，退回主链上已经扣除的镜像资产
+func (a *action) paraAssetWithdrawRollback(withdraw *types.AssetsWithdraw, withdrawTx *types.Transaction) (*types.Receipt, error) {
+	exec, symbol := crossAsset(withdraw.Cointoken, a.height)
+	paraTitle, err := getTitleFrom(withdrawTx.Execer)
+	if err != nil {
+		return nil, errors.Wrap(err, "paraAssetWithdrawRollback call getTitleFrom failed")
+	}
+	mainAcc, err := NewMainAccount(string(paraTitle), exec, symbol, a.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "paraAssetWithdrawRollback call NewMainAccount failed")
+	}
+	clog.Debug("paracross.paraAssetWithdrawRollback", "txHash", hex.EncodeToString(withdrawTx.Hash()),
+		"from", withdrawTx.From(), "amount", withdraw.Amount)
+	return assetDepositBalance(mainAcc, withdrawTx.From(), withdraw.Amount)
+}
+
+//跨链资产对应的执行器和symbol，fork之前只支持coins和token，不解析exec.symbol
+func crossAsset(cointoken string, height int64) (string, string) {
+	if cointoken != "" && !types.IsDappFork(height, pt.ParaX, pt.ForkParaAssetRegistry) {
+		return "token", cointoken
+	}
+	return pt.GetCrossAsset(cointoken)
+}
+
+//主链上跨链资产对应的执行器和symbol
+func (a *action) mainCrossAsset(cointoken string) (string, string) {
+	return crossAsset(cointoken, a.height)
+}
+
+//平行链上主链coins资产的symbol固定为bty
+func (a *action) paraCrossAsset(cointoken string) (string, string) {
+	if cointoken == "" {
+		return "coins", "bty"
+	}
+	return crossAsset(cointoken, a.height)
+}
+
+//coins和token资产默认允许跨链，其他执行器的资产需要通过manage为title配置
+func checkCrossAsset(db db.KV, title, exec, symbol string) error {
+	if exec == "token" || (exec == "coins" && symbol == types.GetCoinSymbol()) {
+		return nil
+	}
+	assets, _, err := getConfigManageAssets(db, title)
+	if err != nil && errors.Cause(err) != pt.ErrTitleNotExist {
+		return err
+	}
+	if _, ok := assets[exec+"."+symbol]; !ok {
+		clog.Error("paracross.checkCrossAsset not registered", "title", title, "exec", exec, "symbol", symbol)
+		return errors.Wrapf(pt.ErrParaAssetNotRegistered, "asset:%s.%s", exec, symbol)
+	}
+	return nil
+}
+
+func createAccount(db db.KV, exec, symbol string) (*account.DB, error) {
 	var accDB *account.DB
 	var err error
-	if symbol == "" {
+	if exec == "coins" && symbol == types.GetCoinSymbol() {
 		accDB = account.NewCoinsAccount()
 		accDB.SetDB(db)
 	} else {
-		accDB, err = account.NewAccountDB("token", symbol, db)
+		accDB, err = account.NewAccountDB(exec, symbol, db)
 	}
 	return accDB, err
 }
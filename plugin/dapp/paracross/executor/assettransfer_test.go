@@ -19,6 +19,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/plugin/plugin/dapp/paracross/testnode"
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
 )
 
 // para-exec addr on main 1HPkPopVe3ERfvaAgedDtJQ792taZFEHCe
@@ -320,3 +321,129 @@ func createAssetTransferTokenTx(s suite.Suite, privFrom string, to []byte) (*typ
 
 	return tx, nil
 }
+
+const (
+	TestAssetExec   = "evm"
+	TestAssetSymbol = "xyz"
+)
+
+func (suite *AssetTransferTestSuite) TestExecTransferRegisteredAsset() {
+	types.Init("test", nil)
+	suite.exec.SetEnv(types.GetDappFork(pt.ParaX, pt.ForkParaAssetRegistry), 0, 0)
+	toB := Nodes[1]
+
+	total := 1000 * types.Coin
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+	acc, _ := account.NewAccountDB(TestAssetExec, TestAssetSymbol, suite.stateDB)
+	addrMain := address.ExecAddress(pt.ParaX)
+	addrPara := address.ExecAddress(Title + pt.ParaX)
+
+	acc.SaveExecAccount(addrMain, &accountA)
+
+	tx, err := createAssetTransferSymbolTx(suite.Suite, PrivKeyA, toB, TestAssetExec+"."+TestAssetSymbol)
+	if err != nil {
+		suite.T().Error("TestExecTransfer", "createTxGroup", err)
+		return
+	}
+	//没有登记的资产不能跨链
+	_, err = suite.exec.Exec(tx, 1)
+	assert.Equal(suite.T(), pt.ErrParaAssetNotRegistered, errors.Cause(err))
+
+	item := &types.ConfigItem{
+		Key:   managerConfigAssets + Title,
+		Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{TestAssetExec + "." + TestAssetSymbol}}},
+	}
+	suite.stateDB.Set(calcManageConfigAssetsKey(Title), types.Encode(item))
+
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec Transfer", err)
+		return
+	}
+	accTest := acc.LoadExecAccount(addrPara, addrMain)
+	assert.Equal(suite.T(), Amount, accTest.Balance)
+
+	resultA := acc.LoadExecAccount(string(Nodes[0]), addrMain)
+	assert.Equal(suite.T(), total-Amount, resultA.Balance)
+
+	msg, err := suite.exec.Query_ListCrossAssets(&types.ReqString{Data: Title})
+	assert.Nil(suite.T(), err)
+	assets := msg.(*pt.RespParacrossAssets)
+	assert.Equal(suite.T(), 1, len(assets.Assets))
+	assert.Equal(suite.T(), TestAssetExec, assets.Assets[0].Exec)
+	assert.Equal(suite.T(), TestAssetSymbol, assets.Assets[0].Symbol)
+}
+
+func (suite *AssetTransferTestSuite) TestExecTransferRegisteredAssetInPara() {
+	para_init(Title)
+	toB := Nodes[1]
+
+	tx, err := createAssetTransferSymbolTx(suite.Suite, PrivKeyA, toB, TestAssetExec+"."+TestAssetSymbol)
+	if err != nil {
+		suite.T().Error("TestExecTransfer", "createTxGroup", err)
+		return
+	}
+
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec Transfer", err)
+		return
+	}
+
+	acc, _ := NewParaAccount(Title, TestAssetExec, TestAssetSymbol, suite.stateDB)
+	resultB := acc.LoadAccount(string(toB))
+	assert.Equal(suite.T(), Amount, resultB.Balance)
+}
+
+func createAssetTransferSymbolTx(s suite.Suite, privFrom string, to []byte, symbol string) (*types.Transaction, error) {
+	param := types.CreateTx{
+		To:          string(to),
+		Amount:      Amount,
+		Fee:         0,
+		Note:        []byte("test asset transfer"),
+		IsWithdraw:  false,
+		IsToken:     false,
+		TokenSymbol: symbol,
+		ExecName:    Title + pt.ParaX,
+	}
+	tx, err := pt.CreateRawAssetTransferTx(&param)
+	assert.Nil(s.T(), err, "create asset transfer failed")
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = signTx(s, tx, privFrom)
+	assert.Nil(s.T(), err, "sign asset transfer failed")
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func TestCrossAsset(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	forkHeight := types.GetDappFork(pt.ParaX, pt.ForkParaAssetRegistry)
+
+	exec, symbol := crossAsset("", forkHeight)
+	assert.Equal(t, "coins", exec)
+	assert.Equal(t, types.GetCoinSymbol(), symbol)
+
+	//fork之前只支持token
+	exec, symbol = crossAsset("user.p.fzm.token.TEST", forkHeight-1)
+	assert.Equal(t, "token", exec)
+	assert.Equal(t, "user.p.fzm.token.TEST", symbol)
+
+	exec, symbol = crossAsset("TEST", forkHeight)
+	assert.Equal(t, "token", exec)
+	assert.Equal(t, "TEST", symbol)
+
+	//执行器名称带"."，按最后一个"."分割
+	exec, symbol = crossAsset("user.p.fzm.token.TEST", forkHeight)
+	assert.Equal(t, "user.p.fzm.token", exec)
+	assert.Equal(t, "TEST", symbol)
+}
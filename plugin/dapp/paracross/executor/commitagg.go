@@ -0,0 +1,81 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+)
+
+//聚合commit
+// 1. 授权节点在链下对ParacrossNodeStatus签名，发给负责聚合的节点
+// 2. 聚合节点把所有签名放到一个commit交易里提交，只需要一笔交易和手续费
+// 3. 执行器校验每个签名，签名节点必须属于nodegroup，每个签名节点都算作一次投票
+
+//校验签名并返回签名节点，签名节点不能重复
+func getCommitAggAddrs(agg *pt.ParacrossCommitAggAction, nodes map[string]struct{}) ([]string, error) {
+	var addrs []string
+	signed := make(map[string]bool)
+	for _, sign := range agg.Signs {
+		addr, err := pt.CheckCommitSign(agg.Status, sign)
+		if err != nil {
+			return nil, errors.Wrap(pt.ErrParaCommitAggSigns, "check sign")
+		}
+		if !validNode(addr, nodes) {
+			return nil, errors.Wrapf(pt.ErrNodeNotForTheTitle, "not validNode:%s", addr)
+		}
+		if signed[addr] {
+			return nil, errors.Wrapf(pt.ErrParaCommitAggSigns, "duplicate sign:%s", addr)
+		}
+		signed[addr] = true
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+func (a *action) CommitAgg(agg *pt.ParacrossCommitAggAction) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaCommitAgg) {
+		return nil, types.ErrNotSupport
+	}
+	commit := &pt.ParacrossCommitAction{Status: agg.Status}
+	err := checkCommitInfo(commit)
+	if err != nil {
+		return nil, err
+	}
+	if len(agg.Signs) == 0 {
+		return nil, errors.Wrap(pt.ErrParaCommitAggSigns, "no signs")
+	}
+
+	if !validTitle(commit.Status.Title) {
+		return nil, pt.ErrInvalidTitle
+	}
+
+	//只有nodegroup审批通过的平行链支持聚合commit
+	groupStatus, err := getNodeGroupStatus(a.db, commit.Status.Title)
+	if err != nil && !isNotFound(err) {
+		return nil, errors.Wrapf(err, "getNodeGroupStatus:%s", commit.Status.Title)
+	}
+	if isNotFound(err) || groupStatus.Status != pt.ParacrossNodeGroupApprove {
+		return nil, errors.Wrapf(pt.ErrParaNodeGroupNotSet, "title:%s", commit.Status.Title)
+	}
+
+	nodes, err := a.getNodesGroup(commit.Status.Title)
+	if err != nil {
+		return nil, err
+	}
+	//提交者也必须是授权节点，避免任何人都可以用收集到的签名提交
+	if !validNode(a.fromaddr, nodes) {
+		return nil, errors.Wrapf(pt.ErrNodeNotForTheTitle, "not validNode:%s", a.fromaddr)
+	}
+
+	addrs, err := getCommitAggAddrs(agg, nodes)
+	if err != nil {
+		return nil, err
+	}
+	clog.Debug("paracross.CommitAgg", "title", commit.Status.Title, "height", commit.Status.Height,
+		"from", a.fromaddr, "signs", len(addrs))
+	return a.commitByAddrs(commit, nodes, addrs)
+}
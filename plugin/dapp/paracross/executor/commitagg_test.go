@@ -0,0 +1,205 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// 聚合commit
+//   Nodes 中的节点对status签名, 由 Nodes[3] 一次提交, 每个签名节点都算作投票
+
+type CommitAggTestSuite struct {
+	suite.Suite
+	stateDB dbm.KV
+	localDB *dbmock.KVDB
+	api     *apimock.QueueProtocolAPI
+
+	exec *Paracross
+}
+
+func TestCommitAggSuite(t *testing.T) {
+	suite.Run(t, new(CommitAggTestSuite))
+}
+
+func (suite *CommitAggTestSuite) SetupTest() {
+	suite.stateDB, _ = dbm.NewGoMemDB("state", "state", 1024)
+	suite.localDB = new(dbmock.KVDB)
+	suite.api = new(apimock.QueueProtocolAPI)
+
+	suite.exec = newParacross().(*Paracross)
+	suite.exec.SetLocalDB(suite.localDB)
+	suite.exec.SetStateDB(suite.stateDB)
+	suite.exec.SetAPI(suite.api)
+	enableParacrossTransfer = false
+
+	key := calcParaNodeGroupAddrsKey(Title)
+	suite.stateDB.Set(key, types.Encode(makeNodeInfo(string(key), Account14K, len(Nodes))))
+	suite.stateDB.Set(calcParaNodeGroupStatusKey(Title), types.Encode(&pt.ParaNodeGroupStatus{
+		Title:  Title,
+		Status: pt.ParacrossNodeGroupApprove,
+	}))
+
+	titleStatus := &pt.ParacrossStatus{Title: Title, Height: CurHeight - 1, BlockHash: PerBlock}
+	saveTitle(suite.stateDB, calcTitleKey(Title), titleStatus)
+
+	suite.api.On("GetBlockHash", &types.ReqInt{Height: MainBlockHeight}).Return(
+		&types.ReplyHash{Hash: MainBlockHash10}, nil)
+}
+
+func (suite *CommitAggTestSuite) setForkHeight() {
+	suite.exec.SetEnv(types.GetDappFork(pt.ParaX, pt.ForkParaCommitAgg), 0, 0)
+}
+
+func createCommitAggStatus() *pt.ParacrossNodeStatus {
+	return &pt.ParacrossNodeStatus{
+		MainBlockHash:   MainBlockHash10,
+		MainBlockHeight: MainBlockHeight,
+		Title:           Title,
+		Height:          TitleHeight,
+		PreBlockHash:    PerBlock,
+		BlockHash:       CurBlock,
+		PreStateHash:    []byte("state-hash-9"),
+		StateHash:       []byte("state-hash-10"),
+		TxResult:        []byte("abc"),
+	}
+}
+
+func (suite *CommitAggTestSuite) signStatus(status *pt.ParacrossNodeStatus, privKeys ...string) []*types.Signature {
+	var signs []*types.Signature
+	for _, key := range privKeys {
+		priv, err := getPrivKey(suite.Suite, key)
+		assert.Nil(suite.T(), err)
+		signs = append(signs, pt.SignCommitStatus(status, types.SECP256K1, priv))
+	}
+	return signs
+}
+
+func (suite *CommitAggTestSuite) execCommitAgg(status *pt.ParacrossNodeStatus, signs []*types.Signature) (*types.Receipt, error) {
+	tx, err := pt.CreateRawCommitAggTx4MainChain(status, signs, pt.GetExecName(), 0)
+	assert.Nil(suite.T(), err)
+	tx, err = signTx(suite.Suite, tx, PrivKeyD)
+	assert.Nil(suite.T(), err)
+	receipt, err := suite.exec.Exec(tx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range receipt.KV {
+		suite.stateDB.Set(kv.Key, kv.Value)
+	}
+	return receipt, nil
+}
+
+func (suite *CommitAggTestSuite) TestCommitAggDone() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+	status := createCommitAggStatus()
+	receipt, err := suite.execCommitAgg(status, suite.signStatus(status, PrivKeyA, PrivKeyB, PrivKeyC))
+	if err != nil {
+		suite.T().Error("Exec CommitAgg", err)
+		return
+	}
+
+	var commits, dones int
+	for _, log := range receipt.Logs {
+		switch log.Ty {
+		case pt.TyLogParacrossCommit:
+			commits++
+		case pt.TyLogParacrossCommitDone:
+			dones++
+		}
+	}
+	assert.Equal(suite.T(), 3, commits)
+	assert.Equal(suite.T(), 1, dones)
+
+	stat, err := getTitleHeight(suite.stateDB, calcTitleHeightKey(Title, TitleHeight))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{string(Nodes[0]), string(Nodes[1]), string(Nodes[2])}, stat.Details.Addrs)
+
+	titleStatus, err := getTitle(suite.stateDB, calcTitleKey(Title))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), TitleHeight, titleStatus.Height)
+	assert.Equal(suite.T(), CurBlock, titleStatus.BlockHash)
+
+	//共识完成之后再提交只做记录
+	receipt, err = suite.execCommitAgg(status, suite.signStatus(status, PrivKeyD))
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), receipt.Logs, 1)
+	assert.Equal(suite.T(), int32(pt.TyLogParacrossCommitRecord), receipt.Logs[0].Ty)
+}
+
+func (suite *CommitAggTestSuite) TestCommitAggNotDone() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+	status := createCommitAggStatus()
+	_, err := suite.execCommitAgg(status, suite.signStatus(status, PrivKeyA, PrivKeyB))
+	assert.Nil(suite.T(), err)
+
+	stat, err := getTitleHeight(suite.stateDB, calcTitleHeightKey(Title, TitleHeight))
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), stat.Details.Addrs, 2)
+	titleStatus, err := getTitle(suite.stateDB, calcTitleKey(Title))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), CurHeight-1, titleStatus.Height)
+
+	//已经投票的节点和新节点再次聚合提交, 补足票数
+	_, err = suite.execCommitAgg(status, suite.signStatus(status, PrivKeyB, PrivKeyC))
+	assert.Nil(suite.T(), err)
+	stat, err = getTitleHeight(suite.stateDB, calcTitleHeightKey(Title, TitleHeight))
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), stat.Details.Addrs, 3)
+	titleStatus, err = getTitle(suite.stateDB, calcTitleKey(Title))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), TitleHeight, titleStatus.Height)
+}
+
+func (suite *CommitAggTestSuite) TestCommitAggInvalidSigns() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+	status := createCommitAggStatus()
+
+	//签名的不是提交的status
+	other := createCommitAggStatus()
+	other.BlockHash = []byte("other-hash-10")
+	signs := suite.signStatus(status, PrivKeyA, PrivKeyB)
+	signs = append(signs, suite.signStatus(other, PrivKeyC)...)
+	_, err := suite.execCommitAgg(status, signs)
+	assert.Equal(suite.T(), pt.ErrParaCommitAggSigns, err)
+
+	//重复签名
+	_, err = suite.execCommitAgg(status, suite.signStatus(status, PrivKeyA, PrivKeyB, PrivKeyA))
+	assert.Equal(suite.T(), pt.ErrParaCommitAggSigns, err)
+
+	//签名节点不在nodegroup
+	_, err = suite.execCommitAgg(status, suite.signStatus(status, PrivKeyA, PrivKeyB, PrivKey14K))
+	assert.Equal(suite.T(), pt.ErrNodeNotForTheTitle, err)
+
+	_, err = getTitleHeight(suite.stateDB, calcTitleHeightKey(Title, TitleHeight))
+	assert.True(suite.T(), isNotFound(err))
+}
+
+func (suite *CommitAggTestSuite) TestCommitAggNoNodeGroup() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+	suite.stateDB.Set(calcParaNodeGroupStatusKey(Title), types.Encode(&pt.ParaNodeGroupStatus{
+		Title:  Title,
+		Status: pt.ParacrossNodeGroupQuit,
+	}))
+	status := createCommitAggStatus()
+	_, err := suite.execCommitAgg(status, suite.signStatus(status, PrivKeyA, PrivKeyB, PrivKeyC))
+	assert.Equal(suite.T(), pt.ErrParaNodeGroupNotSet, err)
+
+	suite.exec.SetEnv(0, 0, 0)
+	_, err = suite.execCommitAgg(status, suite.signStatus(status, PrivKeyA, PrivKeyB, PrivKeyC))
+	assert.Equal(suite.T(), types.ErrNotSupport, err)
+}
@@ -0,0 +1,333 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+)
+
+//平行链之间跨链转账
+// 1. 源平行链发起CrossTransfer, 主链记录pending, 源平行链扣除跨链资产
+// 2. 源平行链共识完成时，主链把资产从源平行链的paracross帐号转到目标平行链的paracross帐号, 状态为settled, 加入目标平行链的待投递队列
+// 3. 主链执行目标平行链的commit交易时投递队列中的转账，状态为delivered，目标平行链在FilterTxsForPara中根据commit交易的回执生成CrossDeliver交易，给接收者充值
+// 4. 目标平行链共识完成时状态为done, 执行失败时回到settled并重新加入待投递队列
+
+func getCrossTransfer(db dbm.KV, hash []byte) (*pt.ParaCrossTransferStatus, error) {
+	val, err := db.Get(calcParaCrossTransferKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	var status pt.ParaCrossTransferStatus
+	err = types.Decode(val, &status)
+	return &status, err
+}
+
+func makeCrossTransferReceipt(prev, current *pt.ParaCrossTransferStatus) *types.Receipt {
+	key := calcParaCrossTransferKey(current.TxHash)
+	log := &pt.ReceiptParaCrossTransfer{
+		Prev:    prev,
+		Current: current,
+	}
+	return &types.Receipt{
+		Ty: types.ExecOk,
+		KV: []*types.KeyValue{
+			{Key: key, Value: types.Encode(current)},
+		},
+		Logs: []*types.ReceiptLog{
+			{
+				Ty:  pt.TyLogParaCrossTransfer,
+				Log: types.Encode(log),
+			},
+		},
+	}
+}
+
+//共识完成时一个区块内可能处理多个跨链交易，需要同时写入statedb
+func (a *action) saveCrossTransfer(prev, current *pt.ParaCrossTransferStatus) *types.Receipt {
+	a.db.Set(calcParaCrossTransferKey(current.TxHash), types.Encode(current))
+	return makeCrossTransferReceipt(prev, current)
+}
+
+//每个commit交易最多投递的跨链转账数
+const crossDeliverCount = 100
+
+func getCrossDeliverQueue(db dbm.KV, toTitle string) (*types.ReqHashes, error) {
+	var queue types.ReqHashes
+	val, err := db.Get(calcParaCrossDeliverKey(toTitle))
+	if err == types.ErrNotFound {
+		return &queue, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = types.Decode(val, &queue)
+	return &queue, err
+}
+
+func (a *action) saveCrossDeliverQueue(toTitle string, queue *types.ReqHashes) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcParaCrossDeliverKey(toTitle), Value: types.Encode(queue)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+//加入目标平行链的待投递队列
+func (a *action) pushCrossDeliver(status *pt.ParaCrossTransferStatus) (*types.KeyValue, error) {
+	queue, err := getCrossDeliverQueue(a.db, status.ToTitle)
+	if err != nil {
+		return nil, errors.Wrapf(err, "pushCrossDeliver get queue:%s", status.ToTitle)
+	}
+	queue.Hashes = append(queue.Hashes, status.TxHash)
+	return a.saveCrossDeliverQueue(status.ToTitle, queue), nil
+}
+
+//目标平行链根据主链投递的状态生成的CrossDeliver交易，没有签名，主链和平行链计算出相同的交易哈希
+//nonce为投递时的主链高度，投递失败后重新投递的交易哈希不同
+func newCrossDeliverTx(status *pt.ParaCrossTransferStatus) *types.Transaction {
+	execName := status.ToTitle + pt.ParaX
+	action := &pt.ParacrossAction{
+		Ty: pt.ParacrossActionCrossDeliver,
+		Value: &pt.ParacrossAction_CrossDeliver{CrossDeliver: &pt.CrossAssetDeliver{
+			TxHash:    status.TxHash,
+			FromTitle: status.FromTitle,
+			Cointoken: status.Cointoken,
+			Amount:    status.Amount,
+			To:        status.To,
+		}},
+	}
+	return &types.Transaction{
+		Execer:  []byte(execName),
+		Payload: types.Encode(action),
+		Nonce:   status.DeliverHeight,
+		To:      address.ExecAddress(execName),
+	}
+}
+
+//主链commit交易回执中投递到tx所在平行链的跨链转账，生成对应的CrossDeliver交易
+func getCrossDeliverTxs(tx *types.Transaction, receipt *types.ReceiptData) []*types.Transaction {
+	var txs []*types.Transaction
+	for _, log := range receipt.Logs {
+		if log.Ty != pt.TyLogParaCrossTransfer {
+			continue
+		}
+		var g pt.ReceiptParaCrossTransfer
+		err := types.Decode(log.Log, &g)
+		if err != nil || g.Prev == nil || g.Current == nil {
+			continue
+		}
+		if g.Prev.Status == pt.ParaCrossTransferSettled && g.Current.Status == pt.ParaCrossTransferDelivered &&
+			string(tx.Execer) == g.Current.ToTitle+pt.ParaX {
+			txs = append(txs, newCrossDeliverTx(g.Current))
+		}
+	}
+	return txs
+}
+
+func checkCrossTitle(fromTitle, toTitle string) bool {
+	return types.IsParaExecName(toTitle) && strings.HasSuffix(toTitle, ".") && toTitle != fromTitle
+}
+
+func (a *action) CrossTransfer(transfer *pt.CrossAssetTransfer) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaCrossTransfer) {
+		return nil, types.ErrNotSupport
+	}
+	fromTitle, err := getTitleFrom(a.tx.Execer)
+	if err != nil {
+		return nil, errors.Wrap(err, "CrossTransfer call getTitleFrom failed")
+	}
+	if !checkCrossTitle(string(fromTitle), transfer.ToTitle) {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "CrossTransfer toTitle:%s", transfer.ToTitle)
+	}
+	if !types.CheckAmount(transfer.Amount) {
+		return nil, types.ErrAmount
+	}
+
+	//平行链处理分支
+	if types.IsPara() {
+		exec, symbol := a.paraCrossAsset(transfer.Cointoken)
+		paraAcc, err := NewParaAccount(string(fromTitle), exec, symbol, a.db)
+		if err != nil {
+			return nil, errors.Wrap(err, "CrossTransfer call NewParaAccount failed")
+		}
+		clog.Debug("paracross.CrossTransfer isPara", "txHash", hex.EncodeToString(a.txhash),
+			"from", a.fromaddr, "toTitle", transfer.ToTitle, "amount", transfer.Amount)
+		return assetWithdrawBalance(paraAcc, a.fromaddr, transfer.Amount)
+	}
+
+	//主链处理分支, 记录转账状态，等源平行链共识完成
+	exec, symbol := a.mainCrossAsset(transfer.Cointoken)
+	if types.IsDappFork(a.height, pt.ParaX, pt.ForkParaAssetRegistry) {
+		err = checkCrossAsset(a.db, transfer.ToTitle, exec, symbol)
+		if err != nil {
+			return nil, err
+		}
+	}
+	current := &pt.ParaCrossTransferStatus{
+		TxHash:    a.txhash,
+		FromTitle: string(fromTitle),
+		ToTitle:   transfer.ToTitle,
+		From:      a.fromaddr,
+		To:        transfer.To,
+		Cointoken: transfer.Cointoken,
+		Exec:      exec,
+		Symbol:    symbol,
+		Amount:    transfer.Amount,
+		Status:    pt.ParaCrossTransferPending,
+		Height:    a.height,
+	}
+	return a.saveCrossTransfer(nil, current), nil
+}
+
+func (a *action) CrossDeliver(deliver *pt.CrossAssetDeliver) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaCrossTransfer) {
+		return nil, types.ErrNotSupport
+	}
+	//投递交易只能由平行链从主链的commit交易生成，用户签名的投递交易即使被主链打包也不执行
+	if !types.IsPara() || a.tx.Signature != nil {
+		return nil, errors.Wrap(types.ErrNotAllow, "CrossDeliver only generated from main chain commit tx")
+	}
+	toTitle, err := getTitleFrom(a.tx.Execer)
+	if err != nil {
+		return nil, errors.Wrap(err, "CrossDeliver call getTitleFrom failed")
+	}
+	exec, symbol := a.paraCrossAsset(deliver.Cointoken)
+	paraAcc, err := NewParaAccount(string(toTitle), exec, symbol, a.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "CrossDeliver call NewParaAccount failed")
+	}
+	clog.Debug("paracross.CrossDeliver isPara", "txHash", hex.EncodeToString(a.txhash),
+		"crossTx", hex.EncodeToString(deliver.TxHash), "to", deliver.To, "amount", deliver.Amount)
+	return assetDepositBalance(paraAcc, deliver.To, deliver.Amount)
+}
+
+//主链执行目标平行链的commit交易时投递已结算的跨链转账，平行链从commit交易的回执中生成投递交易
+func (a *action) deliverCrossTransfers() (*types.Receipt, error) {
+	if types.IsPara() || !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaCrossTransfer) {
+		return nil, nil
+	}
+	//只有user.p.xx.paracross的commit交易会被过滤到目标平行链
+	title, err := getTitleFrom(a.tx.Execer)
+	if err != nil {
+		return nil, nil
+	}
+	toTitle := string(title)
+	queue, err := getCrossDeliverQueue(a.db, toTitle)
+	if err != nil {
+		return nil, errors.Wrapf(err, "deliverCrossTransfers get queue:%s", toTitle)
+	}
+	if len(queue.Hashes) == 0 {
+		return nil, nil
+	}
+	count := len(queue.Hashes)
+	if count > crossDeliverCount {
+		count = crossDeliverCount
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	for _, hash := range queue.Hashes[:count] {
+		prev, err := getCrossTransfer(a.db, hash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "deliverCrossTransfers get cross transfer:%s", hex.EncodeToString(hash))
+		}
+		if prev.Status != pt.ParaCrossTransferSettled {
+			return nil, errors.Wrapf(pt.ErrParaCrossTransferStatus, "deliverCrossTransfers status:%s", pt.ParaCrossTransferStatusStr[prev.Status])
+		}
+		current := *prev
+		current.Status = pt.ParaCrossTransferDelivered
+		current.DeliverHeight = a.height
+		current.DeliverTxHash = newCrossDeliverTx(&current).Hash()
+		receipt = mergeReceipt(receipt, a.saveCrossTransfer(prev, &current))
+
+		key := calcParaCrossDeliverTxKey(current.DeliverTxHash)
+		a.db.Set(key, hash)
+		receipt.KV = append(receipt.KV, &types.KeyValue{Key: key, Value: hash})
+	}
+	queue.Hashes = queue.Hashes[count:]
+	receipt.KV = append(receipt.KV, a.saveCrossDeliverQueue(toTitle, queue))
+	return receipt, nil
+}
+
+//源平行链共识完成，执行成功则把主链资产转到目标平行链
+func (a *action) crossTransferSettle(tx *types.Transaction, success bool) (*types.Receipt, error) {
+	prev, err := getCrossTransfer(a.db, tx.Hash())
+	if err != nil {
+		return nil, errors.Wrapf(err, "crossTransferSettle get cross transfer:%s", hex.EncodeToString(tx.Hash()))
+	}
+	if prev.Status != pt.ParaCrossTransferPending {
+		return nil, errors.Wrapf(pt.ErrParaCrossTransferStatus, "crossTransferSettle status:%s", pt.ParaCrossTransferStatusStr[prev.Status])
+	}
+	current := *prev
+	current.SettleHeight = a.height
+	if !success {
+		current.Status = pt.ParaCrossTransferFailed
+		return a.saveCrossTransfer(prev, &current), nil
+	}
+
+	accDB, err := createAccount(a.db, prev.Exec, prev.Symbol)
+	if err != nil {
+		return nil, errors.Wrap(err, "crossTransferSettle call account.NewAccountDB failed")
+	}
+	fromAddr := address.ExecAddress(prev.FromTitle + pt.ParaX)
+	toAddr := address.ExecAddress(prev.ToTitle + pt.ParaX)
+	execAddr := address.ExecAddress(pt.ParaX)
+	receipt, err := accDB.ExecTransfer(fromAddr, toAddr, execAddr, prev.Amount)
+	if err != nil {
+		clog.Crit("paracross.crossTransferSettle failed", "txHash", hex.EncodeToString(tx.Hash()),
+			"fromTitle", prev.FromTitle, "toTitle", prev.ToTitle, "err", err)
+		return nil, err
+	}
+	current.Status = pt.ParaCrossTransferSettled
+	receipt = mergeReceipt(receipt, a.saveCrossTransfer(prev, &current))
+	kv, err := a.pushCrossDeliver(&current)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, kv)
+	return receipt, nil
+}
+
+//目标平行链共识完成，投递交易执行失败则回到settled，重新加入待投递队列
+//不是投递交易时返回false
+func (a *action) crossDeliverDone(deliverTxHash []byte, success bool) (*types.Receipt, bool, error) {
+	if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaCrossTransfer) {
+		return nil, false, nil
+	}
+	hash, err := a.db.Get(calcParaCrossDeliverTxKey(deliverTxHash))
+	if err == types.ErrNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	prev, err := getCrossTransfer(a.db, hash)
+	if err != nil {
+		return nil, true, errors.Wrapf(err, "crossDeliverDone get cross transfer:%s", hex.EncodeToString(hash))
+	}
+	if prev.Status != pt.ParaCrossTransferDelivered || !bytes.Equal(prev.DeliverTxHash, deliverTxHash) {
+		return nil, true, errors.Wrapf(pt.ErrParaCrossTransferStatus, "crossDeliverDone status:%s", pt.ParaCrossTransferStatusStr[prev.Status])
+	}
+	current := *prev
+	if success {
+		current.Status = pt.ParaCrossTransferDone
+		current.DoneHeight = a.height
+		return a.saveCrossTransfer(prev, &current), true, nil
+	}
+	current.Status = pt.ParaCrossTransferSettled
+	current.DeliverTxHash = nil
+	current.DeliverHeight = 0
+	receipt := a.saveCrossTransfer(prev, &current)
+	kv, err := a.pushCrossDeliver(&current)
+	if err != nil {
+		return nil, true, err
+	}
+	receipt.KV = append(receipt.KV, kv)
+	return receipt, true, nil
+}
@@ -0,0 +1,304 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// 平行链之间跨链转账
+//   Title 发起转账到 TargetTitle, 主链在 Title 共识完成时结算
+//   主链在 TargetTitle 的commit交易中投递, TargetTitle 过滤时生成投递交易, 主链在 TargetTitle 共识完成时结束
+
+const TargetTitle = "user.p.fzm."
+
+type CrossTransferTestSuite struct {
+	suite.Suite
+	stateDB dbm.KV
+	localDB *dbmock.KVDB
+	api     *apimock.QueueProtocolAPI
+
+	exec *Paracross
+}
+
+func TestCrossTransferSuite(t *testing.T) {
+	suite.Run(t, new(CrossTransferTestSuite))
+}
+
+func (suite *CrossTransferTestSuite) SetupTest() {
+	suite.stateDB, _ = dbm.NewGoMemDB("state", "state", 1024)
+	suite.localDB = new(dbmock.KVDB)
+	suite.api = new(apimock.QueueProtocolAPI)
+
+	suite.exec = newParacross().(*Paracross)
+	suite.exec.SetLocalDB(suite.localDB)
+	suite.exec.SetStateDB(suite.stateDB)
+	suite.exec.SetAPI(suite.api)
+	enableParacrossTransfer = true
+}
+
+func (suite *CrossTransferTestSuite) setForkHeight() {
+	suite.exec.SetEnv(types.GetDappFork(pt.ParaX, pt.ForkParaCrossTransfer), 0, 0)
+}
+
+func (suite *CrossTransferTestSuite) getStatus(hash []byte) *pt.ParaCrossTransferStatus {
+	msg, err := suite.exec.Query_GetCrossTransfer(&types.ReqHash{Hash: hash})
+	assert.Nil(suite.T(), err)
+	return msg.(*pt.ParaCrossTransferStatus)
+}
+
+func (suite *CrossTransferTestSuite) TestInvalidTitle() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+
+	tx, err := createCrossTransferTx(suite.Suite, PrivKeyA, Nodes[1], Title)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	assert.Equal(suite.T(), types.ErrInvalidParam, err)
+}
+
+// 主链从发起到目标平行链共识完成
+func (suite *CrossTransferTestSuite) TestCrossTransferOnMain() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+
+	total := 10 * types.Coin
+	addrMain := address.ExecAddress(pt.ParaX)
+	addrFrom := address.ExecAddress(Title + pt.ParaX)
+	addrTo := address.ExecAddress(TargetTitle + pt.ParaX)
+	acc := account.NewCoinsAccount()
+	acc.SetDB(suite.stateDB)
+	acc.SaveExecAccount(addrMain, &types.Account{Balance: total, Addr: addrFrom})
+
+	tx, err := createCrossTransferTx(suite.Suite, PrivKeyA, Nodes[1], TargetTitle)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec CrossTransfer", err)
+		return
+	}
+	status := suite.getStatus(tx.Hash())
+	assert.Equal(suite.T(), int32(pt.ParaCrossTransferPending), status.Status)
+	assert.Equal(suite.T(), Title, status.FromTitle)
+	assert.Equal(suite.T(), "coins", status.Exec)
+
+	//源平行链共识完成
+	a := newAction(suite.exec, tx)
+	_, err = a.execCrossTx(&types.TransactionDetail{Tx: tx}, tx.Hash())
+	if err != nil {
+		suite.T().Error("execCrossTx", err)
+		return
+	}
+	status = suite.getStatus(tx.Hash())
+	assert.Equal(suite.T(), int32(pt.ParaCrossTransferSettled), status.Status)
+	assert.Equal(suite.T(), total-Amount, acc.LoadExecAccount(addrFrom, addrMain).Balance)
+	assert.Equal(suite.T(), Amount, acc.LoadExecAccount(addrTo, addrMain).Balance)
+
+	queue, err := getCrossDeliverQueue(suite.stateDB, TargetTitle)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), [][]byte{tx.Hash()}, queue.Hashes)
+
+	//主链上的投递交易不执行
+	_, err = suite.exec.Exec(newCrossDeliverTx(status), 1)
+	assert.Equal(suite.T(), types.ErrNotAllow, errors.Cause(err))
+
+	//目标平行链的commit交易投递
+	commitTx, err := createCommitTx(suite.Suite, TargetTitle)
+	if err != nil {
+		return
+	}
+	receipt := suite.deliver(commitTx)
+	status = suite.getStatus(tx.Hash())
+	assert.Equal(suite.T(), int32(pt.ParaCrossTransferDelivered), status.Status)
+	deliverTx := newCrossDeliverTx(status)
+	assert.Equal(suite.T(), deliverTx.Hash(), status.DeliverTxHash)
+	queue, err = getCrossDeliverQueue(suite.stateDB, TargetTitle)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 0, len(queue.Hashes))
+
+	//只有目标平行链过滤到commit交易时生成投递交易
+	txs := FilterTxsForPara(makeParaTxDetail(suite.exec.GetHeight(), commitTx, receipt))
+	assert.Equal(suite.T(), []*types.Transaction{commitTx, deliverTx}, txs)
+	otherTx, err := createCommitTx(suite.Suite, Title)
+	if err != nil {
+		return
+	}
+	txs = FilterTxsForPara(makeParaTxDetail(suite.exec.GetHeight(), otherTx, receipt))
+	assert.Equal(suite.T(), []*types.Transaction{otherTx}, txs)
+
+	//目标平行链执行失败，重新加入待投递队列
+	a = newAction(suite.exec, commitTx)
+	_, isDeliver, err := a.crossDeliverDone(deliverTx.Hash(), false)
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), isDeliver)
+	status = suite.getStatus(tx.Hash())
+	assert.Equal(suite.T(), int32(pt.ParaCrossTransferSettled), status.Status)
+	queue, err = getCrossDeliverQueue(suite.stateDB, TargetTitle)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), [][]byte{tx.Hash()}, queue.Hashes)
+
+	//下一个commit交易重新投递，投递交易哈希不同
+	suite.exec.SetEnv(suite.exec.GetHeight()+1, 0, 0)
+	suite.deliver(commitTx)
+	status = suite.getStatus(tx.Hash())
+	assert.Equal(suite.T(), int32(pt.ParaCrossTransferDelivered), status.Status)
+	assert.NotEqual(suite.T(), deliverTx.Hash(), status.DeliverTxHash)
+
+	a = newAction(suite.exec, commitTx)
+	_, _, err = a.crossDeliverDone(deliverTx.Hash(), true)
+	assert.Equal(suite.T(), pt.ErrParaCrossTransferStatus, errors.Cause(err))
+	_, isDeliver, err = a.crossDeliverDone(status.DeliverTxHash, true)
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), isDeliver)
+	status = suite.getStatus(tx.Hash())
+	assert.Equal(suite.T(), int32(pt.ParaCrossTransferDone), status.Status)
+
+	//不是投递交易
+	_, isDeliver, err = a.crossDeliverDone(tx.Hash(), true)
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), isDeliver)
+}
+
+func (suite *CrossTransferTestSuite) deliver(commitTx *types.Transaction) *types.Receipt {
+	a := newAction(suite.exec, commitTx)
+	receipt, err := a.deliverCrossTransfers()
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), receipt)
+	return receipt
+}
+
+// 源平行链执行失败
+func (suite *CrossTransferTestSuite) TestCrossTransferFailedOnPara() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+
+	tx, err := createCrossTransferTx(suite.Suite, PrivKeyA, Nodes[1], TargetTitle)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec CrossTransfer", err)
+		return
+	}
+	a := newAction(suite.exec, tx)
+	_, err = a.rollbackCrossTx(&types.TransactionDetail{Tx: tx}, tx.Hash())
+	if err != nil {
+		suite.T().Error("rollbackCrossTx", err)
+		return
+	}
+	status := suite.getStatus(tx.Hash())
+	assert.Equal(suite.T(), int32(pt.ParaCrossTransferFailed), status.Status)
+
+	//失败的转账不加入待投递队列
+	queue, err := getCrossDeliverQueue(suite.stateDB, TargetTitle)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 0, len(queue.Hashes))
+}
+
+// 源平行链扣除跨链资产
+func (suite *CrossTransferTestSuite) TestCrossTransferOnPara() {
+	para_init(Title)
+	suite.setForkHeight()
+
+	total := 10 * types.Coin
+	paraAcc, _ := NewParaAccount(Title, "coins", "bty", suite.stateDB)
+	paraAcc.SaveAccount(&types.Account{Balance: total, Addr: string(Nodes[0])})
+
+	tx, err := createCrossTransferTx(suite.Suite, PrivKeyA, Nodes[1], TargetTitle)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec CrossTransfer", err)
+		return
+	}
+	assert.Equal(suite.T(), total-Amount, paraAcc.LoadAccount(string(Nodes[0])).Balance)
+}
+
+// 目标平行链给接收者充值
+func (suite *CrossTransferTestSuite) TestCrossDeliverOnPara() {
+	para_init(Title)
+	suite.setForkHeight()
+
+	status := &pt.ParaCrossTransferStatus{
+		TxHash:    []byte("crosstx"),
+		FromTitle: TargetTitle,
+		ToTitle:   Title,
+		To:        string(Nodes[1]),
+		Amount:    Amount,
+		Status:    pt.ParaCrossTransferDelivered,
+	}
+	//用户签名的投递交易不执行
+	signed, err := signTx(suite.Suite, newCrossDeliverTx(status), PrivKeyB)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(signed, 1)
+	assert.Equal(suite.T(), types.ErrNotAllow, errors.Cause(err))
+
+	_, err = suite.exec.Exec(newCrossDeliverTx(status), 1)
+	if err != nil {
+		suite.T().Error("Exec CrossDeliver", err)
+		return
+	}
+	paraAcc, _ := NewParaAccount(Title, "coins", "bty", suite.stateDB)
+	assert.Equal(suite.T(), Amount, paraAcc.LoadAccount(string(Nodes[1])).Balance)
+}
+
+func createCrossTransferTx(s suite.Suite, privFrom string, to []byte, toTitle string) (*types.Transaction, error) {
+	transfer := &pt.CrossAssetTransfer{
+		ToTitle: toTitle,
+		Amount:  Amount,
+		Note:    "test cross transfer",
+		To:      string(to),
+	}
+	tx, err := pt.CreateRawCrossTransferTx(Title+pt.ParaX, transfer)
+	assert.Nil(s.T(), err, "create cross transfer failed")
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = signTx(s, tx, privFrom)
+	assert.Nil(s.T(), err, "sign cross transfer failed")
+	return tx, err
+}
+
+func createCommitTx(s suite.Suite, title string) (*types.Transaction, error) {
+	tx, err := pt.CreateRawCommitTx4MainChain(&pt.ParacrossNodeStatus{Title: title, Height: 1}, title+pt.ParaX, 0)
+	assert.Nil(s.T(), err, "create commit failed")
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = signTx(s, tx, PrivKeyB)
+	assert.Nil(s.T(), err, "sign commit failed")
+	return tx, err
+}
+
+func makeParaTxDetail(height int64, tx *types.Transaction, receipt *types.Receipt) *types.ParaTxDetail {
+	return &types.ParaTxDetail{
+		Header: &types.Header{Height: height},
+		TxDetails: []*types.TxDetail{
+			{Tx: tx, Receipt: &types.ReceiptData{Ty: types.ExecOk, Logs: receipt.Logs}},
+		},
+	}
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-//Exec_Commit consensus commit tx exec process
+// Exec_Commit consensus commit tx exec process
 func (e *Paracross) Exec_Commit(payload *pt.ParacrossCommitAction, tx *types.Transaction, index int) (*types.Receipt, error) {
 	a := newAction(e, tx)
 	receipt, err := a.Commit(payload)
@@ -20,10 +20,16 @@ func (e *Paracross) Exec_Commit(payload *pt.ParacrossCommitAction, tx *types.Tra
 		clog.Error("Paracross commit failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
 		return nil, errors.Cause(err)
 	}
-	return receipt, nil
+	//目标平行链的commit交易投递已结算的跨链转账
+	deliver, err := a.deliverCrossTransfers()
+	if err != nil {
+		clog.Error("Paracross deliverCrossTransfers failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, errors.Cause(err)
+	}
+	return mergeReceipt(receipt, deliver), nil
 }
 
-//Exec_AssetTransfer asset transfer exec process
+// Exec_AssetTransfer asset transfer exec process
 func (e *Paracross) Exec_AssetTransfer(payload *types.AssetsTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
 	clog.Debug("Paracross.Exec", "transfer", "")
 	_, err := e.checkTxGroup(tx, index)
@@ -40,7 +46,7 @@ func (e *Paracross) Exec_AssetTransfer(payload *types.AssetsTransfer, tx *types.
 	return receipt, nil
 }
 
-//Exec_AssetWithdraw asset withdraw exec process
+// Exec_AssetWithdraw asset withdraw exec process
 func (e *Paracross) Exec_AssetWithdraw(payload *types.AssetsWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
 	clog.Debug("Paracross.Exec", "withdraw", "")
 	_, err := e.checkTxGroup(tx, index)
@@ -57,7 +63,71 @@ func (e *Paracross) Exec_AssetWithdraw(payload *types.AssetsWithdraw, tx *types.
 	return receipt, nil
 }
 
-//Exec_Miner miner tx exec process
+// Exec_ParaAssetTransfer para chain asset transfer to main chain exec process
+func (e *Paracross) Exec_ParaAssetTransfer(payload *types.AssetsTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	_, err := e.checkTxGroup(tx, index)
+	if err != nil {
+		clog.Error("ParacrossActionParaAssetTransfer", "get tx group failed", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, err
+	}
+	a := newAction(e, tx)
+	receipt, err := a.ParaAssetTransfer(payload)
+	if err != nil {
+		clog.Error("ParacrossActionParaAssetTransfer failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, errors.Cause(err)
+	}
+	return receipt, nil
+}
+
+// Exec_ParaAssetWithdraw para chain asset withdraw from main chain exec process
+func (e *Paracross) Exec_ParaAssetWithdraw(payload *types.AssetsWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	_, err := e.checkTxGroup(tx, index)
+	if err != nil {
+		clog.Error("ParacrossActionParaAssetWithdraw", "get tx group failed", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, err
+	}
+	a := newAction(e, tx)
+	receipt, err := a.ParaAssetWithdraw(payload)
+	if err != nil {
+		clog.Error("ParacrossActionParaAssetWithdraw failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, errors.Cause(err)
+	}
+	return receipt, nil
+}
+
+// Exec_CrossTransfer para chain to para chain asset transfer exec process
+func (e *Paracross) Exec_CrossTransfer(payload *pt.CrossAssetTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	_, err := e.checkTxGroup(tx, index)
+	if err != nil {
+		clog.Error("ParacrossActionCrossTransfer", "get tx group failed", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, err
+	}
+	a := newAction(e, tx)
+	receipt, err := a.CrossTransfer(payload)
+	if err != nil {
+		clog.Error("ParacrossActionCrossTransfer failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, errors.Cause(err)
+	}
+	return receipt, nil
+}
+
+// Exec_CrossDeliver cross transfer deliver to target para chain exec process
+func (e *Paracross) Exec_CrossDeliver(payload *pt.CrossAssetDeliver, tx *types.Transaction, index int) (*types.Receipt, error) {
+	_, err := e.checkTxGroup(tx, index)
+	if err != nil {
+		clog.Error("ParacrossActionCrossDeliver", "get tx group failed", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, err
+	}
+	a := newAction(e, tx)
+	receipt, err := a.CrossDeliver(payload)
+	if err != nil {
+		clog.Error("ParacrossActionCrossDeliver failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, errors.Cause(err)
+	}
+	return receipt, nil
+}
+
+// Exec_Miner miner tx exec process
 func (e *Paracross) Exec_Miner(payload *pt.ParacrossMinerAction, tx *types.Transaction, index int) (*types.Receipt, error) {
 	if index != 0 {
 		return nil, pt.ErrParaMinerBaseIndex
@@ -69,32 +139,55 @@ func (e *Paracross) Exec_Miner(payload *pt.ParacrossMinerAction, tx *types.Trans
 	return a.Miner(payload)
 }
 
-//Exec_Transfer exec asset transfer process
+// Exec_Transfer exec asset transfer process
 func (e *Paracross) Exec_Transfer(payload *types.AssetsTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
 	a := newAction(e, tx)
 	return a.Transfer(payload, tx, index)
 }
 
-//Exec_Withdraw exec asset withdraw
+// Exec_Withdraw exec asset withdraw
 func (e *Paracross) Exec_Withdraw(payload *types.AssetsWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
 	a := newAction(e, tx)
 	return a.Withdraw(payload, tx, index)
 }
 
-//Exec_TransferToExec exec transfer asset
+// Exec_TransferToExec exec transfer asset
 func (e *Paracross) Exec_TransferToExec(payload *types.AssetsTransferToExec, tx *types.Transaction, index int) (*types.Receipt, error) {
 	a := newAction(e, tx)
 	return a.TransferToExec(payload, tx, index)
 }
 
-//Exec_NodeConfig exec super node config
+// Exec_NodeConfig exec super node config
 func (e *Paracross) Exec_NodeConfig(payload *pt.ParaNodeAddrConfig, tx *types.Transaction, index int) (*types.Receipt, error) {
 	a := newAction(e, tx)
 	return a.NodeConfig(payload)
 }
 
-//Exec_NodeGroupConfig node group config process
+// Exec_NodeGroupConfig node group config process
 func (e *Paracross) Exec_NodeGroupConfig(payload *pt.ParaNodeGroupConfig, tx *types.Transaction, index int) (*types.Receipt, error) {
 	a := newAction(e, tx)
 	return a.NodeGroupConfig(payload)
 }
+
+// Exec_NodeSlash slash super node with evidence
+func (e *Paracross) Exec_NodeSlash(payload *pt.ParaNodeSlash, tx *types.Transaction, index int) (*types.Receipt, error) {
+	a := newAction(e, tx)
+	return a.NodeSlash(payload)
+}
+
+// Exec_CommitAgg consensus commit tx with aggregated node signatures process
+func (e *Paracross) Exec_CommitAgg(payload *pt.ParacrossCommitAggAction, tx *types.Transaction, index int) (*types.Receipt, error) {
+	a := newAction(e, tx)
+	receipt, err := a.CommitAgg(payload)
+	if err != nil {
+		clog.Error("Paracross commit agg failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, errors.Cause(err)
+	}
+	//目标平行链的commit交易投递已结算的跨链转账
+	deliver, err := a.deliverCrossTransfers()
+	if err != nil {
+		clog.Error("Paracross deliverCrossTransfers failed", "error", err, "hash", hex.EncodeToString(tx.Hash()))
+		return nil, errors.Cause(err)
+	}
+	return mergeReceipt(receipt, deliver), nil
+}
@@ -20,7 +20,7 @@ func (e *Paracross) ExecDelLocal_Commit(payload *pt.ParacrossCommitAction, tx *t
 
 			var r pt.ParacrossTx
 			r.TxHash = common.ToHex(tx.Hash())
-			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, tx.From()), Value: nil})
+			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, g.Addr), Value: nil})
 		} else if log.Ty == pt.TyLogParacrossCommitDone {
 			var g pt.ReceiptParacrossDone
 			types.Decode(log.Log, &g)
@@ -46,7 +46,7 @@ func (e *Paracross) ExecDelLocal_Commit(payload *pt.ParacrossCommitAction, tx *t
 
 			var r pt.ParacrossTx
 			r.TxHash = common.ToHex(tx.Hash())
-			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, tx.From()), Value: nil})
+			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, g.Addr), Value: nil})
 		}
 	}
 	return &set, nil
@@ -101,6 +101,16 @@ func (e *Paracross) ExecDelLocal_NodeConfig(payload *pt.ParaNodeAddrConfig, tx *
 	return &set, nil
 }
 
+// ExecDelLocal_NodeSlash node slash tx delete process
+func (e *Paracross) ExecDelLocal_NodeSlash(payload *pt.ParaNodeSlash, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.ExecDelLocal_NodeConfig(nil, tx, receiptData, index)
+}
+
+// ExecDelLocal_CommitAgg aggregated commit tx delete process
+func (e *Paracross) ExecDelLocal_CommitAgg(payload *pt.ParacrossCommitAggAction, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.ExecDelLocal_Commit(nil, tx, receiptData, index)
+}
+
 // ExecDelLocal_NodeGroupConfig node group config tx delete process
 func (e *Paracross) ExecDelLocal_NodeGroupConfig(payload *pt.ParaNodeGroupConfig, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
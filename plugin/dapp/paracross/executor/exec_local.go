@@ -25,7 +25,7 @@ func (e *Paracross) ExecLocal_Commit(payload *pt.ParacrossCommitAction, tx *type
 
 			var r pt.ParacrossTx
 			r.TxHash = common.ToHex(tx.Hash())
-			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, tx.From()), Value: types.Encode(&r)})
+			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, g.Addr), Value: types.Encode(&r)})
 		} else if log.Ty == pt.TyLogParacrossCommitDone {
 			var g pt.ReceiptParacrossDone
 			types.Decode(log.Log, &g)
@@ -49,7 +49,7 @@ func (e *Paracross) ExecLocal_Commit(payload *pt.ParacrossCommitAction, tx *type
 
 			var r pt.ParacrossTx
 			r.TxHash = common.ToHex(tx.Hash())
-			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, tx.From()), Value: types.Encode(&r)})
+			set.KV = append(set.KV, &types.KeyValue{Key: calcLocalTxKey(g.Status.Title, g.Status.Height, g.Addr), Value: types.Encode(&r)})
 		}
 	}
 	return &set, nil
@@ -103,6 +103,16 @@ func (e *Paracross) ExecLocal_NodeConfig(payload *pt.ParaNodeAddrConfig, tx *typ
 	return &set, nil
 }
 
+//ExecLocal_NodeSlash node slash add process, 日志和node config相同
+func (e *Paracross) ExecLocal_NodeSlash(payload *pt.ParaNodeSlash, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.ExecLocal_NodeConfig(nil, tx, receiptData, index)
+}
+
+//ExecLocal_CommitAgg aggregated commit tx local db process, 日志和commit相同
+func (e *Paracross) ExecLocal_CommitAgg(payload *pt.ParacrossCommitAggAction, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.ExecLocal_Commit(nil, tx, receiptData, index)
+}
+
 //ExecLocal_NodeGroupConfig node group config add process
 func (e *Paracross) ExecLocal_NodeGroupConfig(payload *pt.ParaNodeGroupConfig, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
@@ -77,6 +77,7 @@ func filterParaTxGroup(tx *types.Transaction, allTxs []*types.TxDetail, index in
 }
 
 //FilterTxsForPara include some main tx in tx group before ForkParacrossCommitTx
+//ForkParaCrossTransfer之后，主链commit交易投递到本平行链的跨链转账生成CrossDeliver交易
 func FilterTxsForPara(main *types.ParaTxDetail) []*types.Transaction {
 	var txs []*types.Transaction
 	forkHeight := pt.GetDappForkHeight(pt.ForkCommitTx)
@@ -94,6 +95,10 @@ func FilterTxsForPara(main *types.ParaTxDetail) []*types.Transaction {
 		}
 
 		txs = append(txs, tx)
+		//目标平行链的commit交易在主链投递了跨链转账，紧跟着commit交易生成投递交易
+		if pt.IsParaForkHeight(main.Header.Height, pt.ForkParaCrossTransfer) && bytes.HasSuffix(tx.Execer, []byte(pt.ParaX)) {
+			txs = append(txs, getCrossDeliverTxs(tx, main.TxDetails[i].Receipt)...)
+		}
 	}
 	return txs
 }
@@ -9,6 +9,8 @@ import (
 
 	"strings"
 
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/types"
 )
 
@@ -20,11 +22,16 @@ var (
 	title                     string
 	titleHeight               string
 	managerConfigNodes        string //manager 合约配置的nodes
+	managerConfigAssets       string //manager 合约配置的允许跨链的资产
 	paraConfigNodes           string //平行链自组织配置的nodes，最初是从manager同步过来
 	paraConfigNodeAddr        string //平行链配置节点账户
 	paraNodeGroupStatusAddrs  string //正在申请的addrs
 	paraNodeIDPrefix          string
 	paraNodeGroupIDPrefix     string
+	paraCrossTransfer         string //平行链之间跨链转账状态
+	paraCrossDeliver          string //已结算等待投递到目标平行链的跨链转账
+	paraCrossDeliverTx        string //投递交易对应的跨链转账
+	paraNodeMissCommits       string //授权节点连续未参与共识的次数
 	localTx                   string
 	localTitle                string
 	localTitleHeight          string
@@ -38,11 +45,16 @@ func setPrefix() {
 	title = "mavl-paracross-title-"
 	titleHeight = "mavl-paracross-titleHeight-"
 	managerConfigNodes = "paracross-nodes-"
+	managerConfigAssets = "paracross-assets-"
 	paraConfigNodes = "mavl-paracross-nodes-title-"
 	paraConfigNodeAddr = "mavl-paracross-nodes-titleAddr-"
 	paraNodeGroupStatusAddrs = "mavl-paracross-nodegroup-apply-title-"
 	paraNodeIDPrefix = "mavl-paracross-title-nodeid-"
 	paraNodeGroupIDPrefix = "mavl-paracross-title-nodegroupid-"
+	paraCrossTransfer = "mavl-paracross-crosstransfer-"
+	paraCrossDeliver = "mavl-paracross-crossdeliver-"
+	paraCrossDeliverTx = "mavl-paracross-crossdelivertx-"
+	paraNodeMissCommits = "mavl-paracross-nodemiss-"
 	localTx = "LODB-paracross-titleHeightAddr-"
 	localTitle = "LODB-paracross-title-"
 	localTitleHeight = "LODB-paracross-titleHeight-"
@@ -72,6 +84,27 @@ func calcManageConfigNodesKey(title string) []byte {
 	return []byte(types.ManageKey(key))
 }
 
+func calcManageConfigAssetsKey(title string) []byte {
+	key := managerConfigAssets + title
+	return []byte(types.ManageKey(key))
+}
+
+func calcParaCrossTransferKey(hash []byte) []byte {
+	return []byte(paraCrossTransfer + common.ToHex(hash))
+}
+
+func calcParaCrossDeliverKey(toTitle string) []byte {
+	return []byte(paraCrossDeliver + toTitle)
+}
+
+func calcParaCrossDeliverTxKey(hash []byte) []byte {
+	return []byte(paraCrossDeliverTx + common.ToHex(hash))
+}
+
+func calcParaNodeMissCommitsKey(title string) []byte {
+	return []byte(fmt.Sprintf(paraNodeMissCommits+"%s", title))
+}
+
 func calcParaNodeGroupAddrsKey(title string) []byte {
 	return []byte(fmt.Sprintf(paraConfigNodes+"%s", title))
 }
@@ -155,3 +188,24 @@ func calcLocalNodeGroupStatusPrefix(status int32) []byte {
 func calcLocalNodeGroupAllPrefix() []byte {
 	return []byte(fmt.Sprintf(localNodeGroupStatusTitle))
 }
+
+//跨链资产账户的key: mavl-paracross-{symbol}-{addr} 或 mavl-paracross-{symbol}-exec-{execaddr}:{addr}
+func isParaAccountKey(key []byte) bool {
+	prefix := "mavl-paracross-"
+	if !strings.HasPrefix(string(key), prefix) {
+		return false
+	}
+	item := string(key[len(prefix):])
+	if i := strings.Index(item, "-exec-"); i > 0 {
+		addrs := strings.Split(item[i+len("-exec-"):], ":")
+		return !strings.Contains(item[:i], "-") && len(addrs) == 2 &&
+			isAddress(addrs[0]) && isAddress(addrs[1])
+	}
+	i := strings.LastIndex(item, "-")
+	return i > 0 && !strings.Contains(item[:i], "-") && isAddress(item[i+1:])
+}
+
+//普通地址或者多重签名地址
+func isAddress(addr string) bool {
+	return address.CheckAddress(addr) == nil || address.CheckMultiSignAddress(addr) == nil
+}
@@ -0,0 +1,196 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// 平行链原生资产跨链到主链
+//   平行链 para_asset_transfer 锁定资产， 主链在共识完成时给镜像帐号充值
+//   主链 para_asset_withdraw 扣除镜像帐号， 平行链解锁资产， 平行链执行失败时主链退回
+
+type ParaAssetTestSuite struct {
+	suite.Suite
+	stateDB dbm.KV
+	localDB *dbmock.KVDB
+	api     *apimock.QueueProtocolAPI
+
+	exec *Paracross
+}
+
+func TestParaAssetSuite(t *testing.T) {
+	suite.Run(t, new(ParaAssetTestSuite))
+}
+
+func (suite *ParaAssetTestSuite) SetupTest() {
+	suite.stateDB, _ = dbm.NewGoMemDB("state", "state", 1024)
+	suite.localDB = new(dbmock.KVDB)
+	suite.api = new(apimock.QueueProtocolAPI)
+
+	suite.exec = newParacross().(*Paracross)
+	suite.exec.SetLocalDB(suite.localDB)
+	suite.exec.SetStateDB(suite.stateDB)
+	suite.exec.SetAPI(suite.api)
+	enableParacrossTransfer = true
+}
+
+func (suite *ParaAssetTestSuite) setForkHeight() {
+	suite.exec.SetEnv(types.GetDappFork(pt.ParaX, pt.ForkParaAssetBridge), 0, 0)
+}
+
+func (suite *ParaAssetTestSuite) TestTransferBeforeFork() {
+	types.Init("test", nil)
+	suite.exec.SetEnv(0, 0, 0)
+	tx, err := createParaAssetTx(suite.Suite, PrivKeyA, Nodes[1], false)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	assert.Equal(suite.T(), types.ErrNotSupport, err)
+}
+
+// 平行链锁定资产
+func (suite *ParaAssetTestSuite) TestTransferOnPara() {
+	para_init(Title)
+	suite.setForkHeight()
+
+	total := 1000 * types.Coin
+	execAddr := address.ExecAddress(Title + pt.ParaX)
+	lockAddr := address.ExecAddress(pt.ParaX)
+	acc, _ := account.NewAccountDB("token", TestSymbol, suite.stateDB)
+	acc.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: string(Nodes[0])})
+
+	tx, err := createParaAssetTx(suite.Suite, PrivKeyA, Nodes[1], false)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec ParaAssetTransfer", err)
+		return
+	}
+	assert.Equal(suite.T(), total-Amount, acc.LoadExecAccount(string(Nodes[0]), execAddr).Balance)
+	assert.Equal(suite.T(), Amount, acc.LoadExecAccount(lockAddr, execAddr).Balance)
+}
+
+// 主链等平行链执行， 共识完成时充值镜像帐号
+func (suite *ParaAssetTestSuite) TestTransferOnMain() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+
+	tx, err := createParaAssetTx(suite.Suite, PrivKeyA, Nodes[1], false)
+	if err != nil {
+		return
+	}
+	receipt, err := suite.exec.Exec(tx, 1)
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), receipt, "mainChain not exec, wait for paraChain")
+
+	a := newAction(suite.exec, tx)
+	_, err = a.execCrossTx(&types.TransactionDetail{Tx: tx}, tx.Hash())
+	if err != nil {
+		suite.T().Error("execCrossTx", err)
+		return
+	}
+	mainAcc, _ := NewMainAccount(Title, "token", TestSymbol, suite.stateDB)
+	assert.Equal(suite.T(), Amount, mainAcc.LoadAccount(string(Nodes[1])).Balance)
+}
+
+// 主链先扣除镜像帐号， 平行链执行失败时退回
+func (suite *ParaAssetTestSuite) TestWithdrawOnMain() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+
+	total := 10 * types.Coin
+	mainAcc, _ := NewMainAccount(Title, "token", TestSymbol, suite.stateDB)
+	mainAcc.SaveAccount(&types.Account{Balance: total, Addr: string(Nodes[0])})
+
+	tx, err := createParaAssetTx(suite.Suite, PrivKeyA, Nodes[1], true)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec ParaAssetWithdraw", err)
+		return
+	}
+	assert.Equal(suite.T(), total-Amount, mainAcc.LoadAccount(string(Nodes[0])).Balance)
+
+	a := newAction(suite.exec, tx)
+	_, err = a.rollbackCrossTx(&types.TransactionDetail{Tx: tx}, tx.Hash())
+	if err != nil {
+		suite.T().Error("rollbackCrossTx", err)
+		return
+	}
+	assert.Equal(suite.T(), total, mainAcc.LoadAccount(string(Nodes[0])).Balance)
+}
+
+func (suite *ParaAssetTestSuite) TestWithdrawNoBalanceOnMain() {
+	types.Init("test", nil)
+	suite.setForkHeight()
+
+	tx, err := createParaAssetTx(suite.Suite, PrivKeyA, Nodes[1], true)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	assert.Equal(suite.T(), types.ErrNoBalance, err)
+}
+
+// 平行链解锁资产
+func (suite *ParaAssetTestSuite) TestWithdrawOnPara() {
+	para_init(Title)
+	suite.setForkHeight()
+
+	total := 10 * types.Coin
+	execAddr := address.ExecAddress(Title + pt.ParaX)
+	lockAddr := address.ExecAddress(pt.ParaX)
+	acc, _ := account.NewAccountDB("token", TestSymbol, suite.stateDB)
+	acc.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: lockAddr})
+
+	tx, err := createParaAssetTx(suite.Suite, PrivKeyA, Nodes[1], true)
+	if err != nil {
+		return
+	}
+	_, err = suite.exec.Exec(tx, 1)
+	if err != nil {
+		suite.T().Error("Exec ParaAssetWithdraw", err)
+		return
+	}
+	assert.Equal(suite.T(), total-Amount, acc.LoadExecAccount(lockAddr, execAddr).Balance)
+	assert.Equal(suite.T(), Amount, acc.LoadExecAccount(string(Nodes[1]), execAddr).Balance)
+}
+
+func createParaAssetTx(s suite.Suite, privFrom string, to []byte, isWithdraw bool) (*types.Transaction, error) {
+	param := types.CreateTx{
+		To:          string(to),
+		Amount:      Amount,
+		Fee:         0,
+		Note:        []byte("test para asset"),
+		IsWithdraw:  isWithdraw,
+		TokenSymbol: TestSymbol,
+		ExecName:    Title + pt.ParaX,
+	}
+	tx, err := pt.CreateRawParaAssetTransferTx(&param)
+	assert.Nil(s.T(), err, "create para asset tx failed")
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = signTx(s, tx, privFrom)
+	assert.Nil(s.T(), err, "sign para asset tx failed")
+	return tx, err
+}
@@ -22,6 +22,9 @@ var (
 	driverName              = pt.ParaX
 )
 
+//多重签名执行器名, 不引入multisig的包
+const multiSigX = "multisig"
+
 // Paracross exec
 type Paracross struct {
 	drivers.DriverBase
@@ -55,6 +58,49 @@ func (c *Paracross) GetDriverName() string {
 	return pt.ParaX
 }
 
+// ExecFrom 多重签名账户批准的超级节点和节点组配置交易，以多重签名账户作为发起人执行
+func (c *Paracross) ExecFrom(from string, tx *types.Transaction, index int) (*types.Receipt, error) {
+	txs := c.GetTxs()
+	if from == "" || index < 0 || index >= len(txs) || multiSigAccAddr(txs[index]) != from {
+		return nil, errors.Wrapf(types.ErrNotAllow, "exec from:%s", from)
+	}
+	var payload pt.ParacrossAction
+	err := types.Decode(tx.Payload, &payload)
+	if err != nil {
+		return nil, err
+	}
+	a := newAction(c, tx)
+	a.fromaddr = from
+	//内层交易没有签名，配置的id使用多重签名交易的hash
+	a.txhash = txs[index].Hash()
+	if payload.Ty == pt.ParacrossActionNodeConfig && payload.GetNodeConfig() != nil {
+		return a.NodeConfig(payload.GetNodeConfig())
+	}
+	if payload.Ty == pt.ParacrossActionNodeGroupApply && payload.GetNodeGroupConfig() != nil {
+		return a.NodeGroupConfig(payload.GetNodeGroupConfig())
+	}
+	return nil, errors.Wrapf(types.ErrActionNotSupport, "exec from action:%d", payload.Ty)
+}
+
+//多重签名交易操作的多重签名账户地址
+func multiSigAccAddr(tx *types.Transaction) string {
+	if string(types.GetRealExecName(tx.Execer)) != multiSigX {
+		return ""
+	}
+	ety := types.LoadExecutorType(multiSigX)
+	if ety == nil {
+		return ""
+	}
+	_, v, err := ety.DecodePayloadValue(tx)
+	if err != nil {
+		return ""
+	}
+	if action, ok := v.Interface().(interface{ GetMultiSigAccAddr() string }); ok {
+		return action.GetMultiSigAccAddr()
+	}
+	return ""
+}
+
 func (c *Paracross) checkTxGroup(tx *types.Transaction, index int) ([]*types.Transaction, error) {
 	if tx.GroupCount >= 2 {
 		txs, err := c.GetTxGroup(index)
@@ -74,17 +120,21 @@ func (c *Paracross) saveLocalParaTxs(tx *types.Transaction, isDel bool) (*types.
 	if err != nil {
 		return nil, err
 	}
-	if payload.Ty != pt.ParacrossActionCommit || payload.GetCommit() == nil {
+	var status *pt.ParacrossNodeStatus
+	if payload.Ty == pt.ParacrossActionCommit && payload.GetCommit() != nil {
+		status = payload.GetCommit().Status
+	} else if payload.Ty == pt.ParacrossActionCommitAgg && payload.GetCommitAgg() != nil {
+		status = payload.GetCommitAgg().Status
+	} else {
 		return nil, nil
 	}
 
-	commit := payload.GetCommit()
-	crossTxHashs, crossTxResult, err := getCrossTxHashs(c.GetAPI(), commit.Status)
+	crossTxHashs, crossTxResult, err := getCrossTxHashs(c.GetAPI(), status)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.udpateLocalParaTxs(commit.Status.Title, commit.Status.Height, crossTxHashs, crossTxResult, isDel)
+	return c.udpateLocalParaTxs(status.Title, status.Height, crossTxHashs, crossTxResult, isDel)
 
 }
 
@@ -146,6 +196,12 @@ func (c *Paracross) udpateLocalParaTxs(paraTitle string, paraHeight int64, cross
 				return nil, err
 			}
 			set.KV = append(set.KV, kv)
+		} else if payload.Ty == pt.ParacrossActionParaAssetTransfer || payload.Ty == pt.ParacrossActionParaAssetWithdraw {
+			kv, err := c.initLocalParaAsset(paraHeight, paraTx.Tx, &payload, success, isDel)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kv)
 		}
 	}
 
@@ -171,8 +227,7 @@ func (c *Paracross) initLocalAssetTransfer(tx *types.Transaction, success, isDel
 	exec := "coins"
 	symbol := types.BTY
 	if payload.GetAssetTransfer().Cointoken != "" {
-		exec = "token"
-		symbol = payload.GetAssetTransfer().Cointoken
+		exec, symbol = crossAsset(payload.GetAssetTransfer().Cointoken, c.GetHeight())
 	}
 
 	var asset pt.ParacrossAsset
@@ -225,8 +280,7 @@ func (c *Paracross) initLocalAssetWithdraw(paraHeight int64, tx *types.Transacti
 	exec := "coins"
 	symbol := types.BTY
 	if payload.GetAssetWithdraw().Cointoken != "" {
-		exec = "token"
-		symbol = payload.GetAssetWithdraw().Cointoken
+		exec, symbol = crossAsset(payload.GetAssetWithdraw().Cointoken, c.GetHeight())
 	}
 
 	asset = pt.ParacrossAsset{
@@ -250,6 +304,47 @@ func (c *Paracross) initLocalAssetWithdraw(paraHeight int64, tx *types.Transacti
 	return &types.KeyValue{Key: key, Value: types.Encode(&asset)}, nil
 }
 
+//平行链原生资产的跨链记录，在主链共识完成时记录
+func (c *Paracross) initLocalParaAsset(paraHeight int64, tx *types.Transaction, payload *pt.ParacrossAction, success, isDel bool) (*types.KeyValue, error) {
+	key := calcLocalAssetKey(tx.Hash())
+	if isDel {
+		c.GetLocalDB().Set(key, nil)
+		return &types.KeyValue{Key: key, Value: nil}, nil
+	}
+
+	var cointoken, to string
+	var amount int64
+	isWithdraw := payload.Ty == pt.ParacrossActionParaAssetWithdraw
+	if isWithdraw {
+		withdraw := payload.GetParaAssetWithdraw()
+		cointoken, to, amount = withdraw.GetCointoken(), withdraw.GetTo(), withdraw.GetAmount()
+	} else {
+		transfer := payload.GetParaAssetTransfer()
+		cointoken, to, amount = transfer.GetCointoken(), transfer.GetTo(), transfer.GetAmount()
+	}
+	exec, symbol := crossAsset(cointoken, c.GetHeight())
+
+	asset := pt.ParacrossAsset{
+		From:             tx.From(),
+		To:               to,
+		Amount:           amount,
+		IsWithdraw:       isWithdraw,
+		TxHash:           tx.Hash(),
+		Height:           c.GetHeight(),
+		Exec:             exec,
+		Symbol:           symbol,
+		ParaHeight:       paraHeight,
+		CommitDoneHeight: c.GetHeight(),
+		Success:          success,
+	}
+
+	err := c.GetLocalDB().Set(key, types.Encode(&asset))
+	if err != nil {
+		clog.Error("para execLocal", "set", hex.EncodeToString(tx.Hash()), "failed", err)
+	}
+	return &types.KeyValue{Key: key, Value: types.Encode(&asset)}, nil
+}
+
 func (c *Paracross) updateLocalAssetTransfer(paraHeight int64, tx *types.Transaction, success, isDel bool) (*types.KeyValue, error) {
 	clog.Debug("para execLocal", "tx hash", hex.EncodeToString(tx.Hash()))
 	key := calcLocalAssetKey(tx.Hash())
@@ -279,6 +374,14 @@ func (c *Paracross) updateLocalAssetTransfer(paraHeight int64, tx *types.Transac
 
 //IsFriend call exec is same seariase exec
 func (c *Paracross) IsFriend(myexec, writekey []byte, tx *types.Transaction) bool {
+	//evm合约通过资产预编译合约操作跨链资产，只允许修改资产账户的余额
+	if string(types.GetRealExecName(tx.Execer)) == "evm" {
+		return string(myexec) == c.GetDriverName() && isParaAccountKey(writekey)
+	}
+	//多重签名账户只能执行节点配置交易，不允许修改跨链资产账户
+	if string(types.GetRealExecName(tx.Execer)) == multiSigX {
+		return string(myexec) == c.GetDriverName() && bytes.HasPrefix(writekey, []byte("mavl-paracross-")) && !isParaAccountKey(writekey)
+	}
 	//不允许平行链
 	if types.IsPara() {
 		return false
@@ -314,6 +417,26 @@ func (c *Paracross) allow(tx *types.Transaction, index int) error {
 				return nil
 			}
 		}
+		if types.IsDappFork(c.GetHeight(), pt.ParaX, pt.ForkParaAssetBridge) {
+			if payload.Ty == pt.ParacrossActionParaAssetTransfer || payload.Ty == pt.ParacrossActionParaAssetWithdraw {
+				return nil
+			}
+		}
+		if types.IsDappFork(c.GetHeight(), pt.ParaX, pt.ForkParaCrossTransfer) {
+			if payload.Ty == pt.ParacrossActionCrossTransfer {
+				return nil
+			}
+		}
+		if types.IsDappFork(c.GetHeight(), pt.ParaX, pt.ForkParaNodeSlash) {
+			if payload.Ty == pt.ParacrossActionNodeSlash {
+				return nil
+			}
+		}
+		if types.IsDappFork(c.GetHeight(), pt.ParaX, pt.ForkParaCommitAgg) {
+			if payload.Ty == pt.ParacrossActionCommitAgg {
+				return nil
+			}
+		}
 	}
 	return types.ErrNotAllow
 }
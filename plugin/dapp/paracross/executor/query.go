@@ -81,6 +81,43 @@ func (p *Paracross) Query_GetNodeGroupAddrs(in *pt.ReqParacrossNodeInfo) (types.
 	return &reply, nil
 }
 
+//Query_ListCrossAssets list cross assets registered by manage for title, coins and token are always allowed
+func (p *Paracross) Query_ListCrossAssets(in *types.ReqString) (types.Message, error) {
+	if in == nil || in.GetData() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	_, assets, err := getConfigManageAssets(p.GetStateDB(), in.GetData())
+	if err != nil {
+		return nil, errors.Cause(err)
+	}
+	reply := &pt.RespParacrossAssets{Title: in.GetData()}
+	for _, asset := range assets {
+		exec, symbol := pt.GetCrossAsset(asset)
+		reply.Assets = append(reply.Assets, &pt.ParacrossAssetInfo{Exec: exec, Symbol: symbol})
+	}
+	return reply, nil
+}
+
+//Query_GetCrossTransfer get para chain to para chain transfer status by source tx hash
+func (p *Paracross) Query_GetCrossTransfer(in *types.ReqHash) (types.Message, error) {
+	if in == nil || len(in.Hash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	status, err := getCrossTransfer(p.GetStateDB(), in.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+//Query_GetNodeMissCommits get the continuous miss commit count of super nodes
+func (p *Paracross) Query_GetNodeMissCommits(in *types.ReqString) (types.Message, error) {
+	if in == nil || in.GetData() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return getNodeMissCommits(p.GetStateDB(), in.GetData())
+}
+
 //Query_GetNodeAddrInfo get specific node addr info
 func (p *Paracross) Query_GetNodeAddrInfo(in *pt.ReqParacrossNodeInfo) (types.Message, error) {
 	if in == nil || in.Title == "" || in.Addr == "" {
@@ -0,0 +1,352 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+)
+
+//授权节点惩罚
+// 1. 冲突: 同一节点对相同title和高度，在相同主链hash下提交了不同的blockHash, 两个签名的commit交易就是证据
+//    聚合commit交易中该节点对status的签名同样可以作为证据
+// 2. 缺席: 主链在共识完成时统计每个授权节点连续未参与共识的次数，达到nodeSlashMissCommits后可以被惩罚
+//    共识完成后才到达、且和共识结果一致的commit也算参与，该节点的次数从这个高度之后重新统计
+// 3. 主链校验证据后按nodeSlashPercent罚没冻结币给nodeSlashAddr，为空则销毁，剩余冻结币解冻，节点被剔除出nodegroup
+// 4. 平行链不校验证据，主链执行失败的交易不会过滤到平行链
+
+//无私钥的地址，罚没的币转入即销毁
+var nodeSlashBurnAddr = address.ExecAddress(pt.ParaX + "-slash-burn")
+
+func getNodeMissCommits(db dbm.KV, title string) (*pt.ParaNodeMissCommits, error) {
+	val, err := db.Get(calcParaNodeMissCommitsKey(title))
+	if err != nil {
+		return nil, err
+	}
+	var miss pt.ParaNodeMissCommits
+	err = types.Decode(val, &miss)
+	return &miss, err
+}
+
+func getMissCommitCount(miss *pt.ParaNodeMissCommits, addr string) int64 {
+	for i, a := range miss.Addrs {
+		if a == addr {
+			return miss.Counts[i]
+		}
+	}
+	return 0
+}
+
+//共识完成时更新授权节点连续未参与共识的次数，已退出的节点不再统计
+func (a *action) updateNodeMissCommits(stat *pt.ParacrossHeightStatus) (*types.Receipt, error) {
+	_, nodes, err := getParacrossNodes(a.db, stat.Title)
+	if err != nil {
+		//manage 配置的节点没有冻结币，不统计
+		if errors.Cause(err) == pt.ErrTitleNotExist {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	prev, err := getNodeMissCommits(a.db, stat.Title)
+	if err != nil {
+		if !isNotFound(err) {
+			return nil, errors.Wrapf(err, "updateNodeMissCommits title:%s", stat.Title)
+		}
+		prev = &pt.ParaNodeMissCommits{Title: stat.Title, Height: -1}
+	}
+	if stat.Height <= prev.Height {
+		return nil, nil
+	}
+
+	current := &pt.ParaNodeMissCommits{Title: stat.Title, Height: stat.Height}
+	for _, addr := range nodes {
+		count := getMissCommitCount(prev, addr) + 1
+		if found, _ := hasCommited(stat.Details.Addrs, addr); found {
+			count = 0
+		}
+		current.Addrs = append(current.Addrs, addr)
+		current.Counts = append(current.Counts, count)
+	}
+
+	return saveNodeMissCommits(a.db, current), nil
+}
+
+//共识完成后才收到的commit, 和共识结果一致时同样算作参与了该高度的共识, 连续未参与的次数从该高度之后重新计算
+func (a *action) updateLateCommitMiss(status *pt.ParacrossNodeStatus, addrs []string) (*types.Receipt, error) {
+	miss, err := getNodeMissCommits(a.db, status.Title)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "updateLateCommitMiss title:%s", status.Title)
+	}
+	if status.Height > miss.Height {
+		return nil, nil
+	}
+	stat, err := getTitleHeight(a.db, calcTitleHeightKey(status.Title, status.Height))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "updateLateCommitMiss title:%s,height:%d", status.Title, status.Height)
+	}
+	if _, mostHash := getMostCommit(stat); !bytes.Equal([]byte(mostHash), status.BlockHash) {
+		return nil, nil
+	}
+
+	count := miss.Height - status.Height
+	var changed bool
+	for i, addr := range miss.Addrs {
+		if found, _ := hasCommited(addrs, addr); found && miss.Counts[i] > count {
+			miss.Counts[i] = count
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, nil
+	}
+	return saveNodeMissCommits(a.db, miss), nil
+}
+
+func saveNodeMissCommits(db dbm.KV, miss *pt.ParaNodeMissCommits) *types.Receipt {
+	key := calcParaNodeMissCommitsKey(miss.Title)
+	value := types.Encode(miss)
+	db.Set(key, value)
+	return &types.Receipt{
+		Ty: types.ExecOk,
+		KV: []*types.KeyValue{
+			{Key: key, Value: value},
+		},
+	}
+}
+
+//节点被惩罚剔除后清除统计，重新加入时从0开始
+func (a *action) resetNodeMissCommits(title, addr string) *types.Receipt {
+	miss, err := getNodeMissCommits(a.db, title)
+	if err != nil {
+		return nil
+	}
+	current := &pt.ParaNodeMissCommits{Title: miss.Title, Height: miss.Height}
+	for i, v := range miss.Addrs {
+		if v != addr {
+			current.Addrs = append(current.Addrs, v)
+			current.Counts = append(current.Counts, miss.Counts[i])
+		}
+	}
+	return saveNodeMissCommits(a.db, current)
+}
+
+//普通commit交易需要由节点签名, 聚合commit交易中需要有节点对status的签名
+func decodeSlashCommit(tx *types.Transaction, addr string) (*pt.ParacrossNodeStatus, error) {
+	var payload pt.ParacrossAction
+	err := types.Decode(tx.Payload, &payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Ty == pt.ParacrossActionCommit && payload.GetCommit().GetStatus() != nil {
+		if !tx.CheckSign() {
+			return nil, errors.Wrapf(types.ErrSign, "commit tx:%s", common.ToHex(tx.Hash()))
+		}
+		if tx.From() != addr {
+			return nil, errors.Wrapf(pt.ErrParaNodeSlashEvidence, "commit tx from:%s,slash addr:%s", tx.From(), addr)
+		}
+		return payload.GetCommit().Status, nil
+	}
+	if payload.Ty == pt.ParacrossActionCommitAgg && payload.GetCommitAgg().GetStatus() != nil {
+		agg := payload.GetCommitAgg()
+		for _, sign := range agg.Signs {
+			signer, err := pt.CheckCommitSign(agg.Status, sign)
+			if err == nil && signer == addr {
+				return agg.Status, nil
+			}
+		}
+		return nil, errors.Wrapf(pt.ErrParaNodeSlashEvidence, "commit agg tx:%s no sign of:%s", common.ToHex(tx.Hash()), addr)
+	}
+	return nil, errors.Wrapf(pt.ErrParaNodeSlashEvidence, "not commit tx:%s", common.ToHex(tx.Hash()))
+}
+
+func checkConflictCommits(slash *pt.ParaNodeSlash) error {
+	if len(slash.CommitTxs) != 2 {
+		return errors.Wrapf(pt.ErrParaNodeSlashEvidence, "commit txs count:%d", len(slash.CommitTxs))
+	}
+	var status [2]*pt.ParacrossNodeStatus
+	for i, tx := range slash.CommitTxs {
+		s, err := decodeSlashCommit(tx, slash.Addr)
+		if err != nil {
+			return err
+		}
+		if s.Title != slash.Title {
+			return errors.Wrapf(pt.ErrParaNodeSlashEvidence, "commit title:%s,slash title:%s", s.Title, slash.Title)
+		}
+		status[i] = s
+	}
+	//主链回滚后节点可以对相同高度重新提交，只有主链hash相同时blockHash不同才是冲突
+	if status[0].Height != status[1].Height || !bytes.Equal(status[0].MainBlockHash, status[1].MainBlockHash) ||
+		bytes.Equal(status[0].BlockHash, status[1].BlockHash) {
+		return errors.Wrapf(pt.ErrParaNodeSlashEvidence, "commit not conflict,height:%d-%d", status[0].Height, status[1].Height)
+	}
+	return nil
+}
+
+func (a *action) checkMissCommits(slash *pt.ParaNodeSlash) error {
+	confMiss := conf.GInt("nodeSlashMissCommits")
+	if confMiss <= 0 {
+		return errors.Wrap(types.ErrNotSupport, "nodeSlashMissCommits not config")
+	}
+	miss, err := getNodeMissCommits(a.db, slash.Title)
+	if err != nil {
+		return errors.Wrapf(err, "getNodeMissCommits title:%s", slash.Title)
+	}
+	count := getMissCommitCount(miss, slash.Addr)
+	if count < confMiss {
+		return errors.Wrapf(pt.ErrParaNodeSlashEvidence, "miss commits:%d less than conf:%d", count, confMiss)
+	}
+	return nil
+}
+
+func (a *action) checkSlashEvidence(slash *pt.ParaNodeSlash) error {
+	if slash.Reason == pt.ParaNodeSlashConflict {
+		return checkConflictCommits(slash)
+	}
+	if slash.Reason == pt.ParaNodeSlashMissCommit {
+		return a.checkMissCommits(slash)
+	}
+	return errors.Wrapf(types.ErrInvalidParam, "slash reason:%d", slash.Reason)
+}
+
+//罚没冻结币，并把节点提案中的冻结币改为剩余的数量，节点退出时解冻剩余部分
+func (a *action) nodeSlashCoins(slash *pt.ParaNodeSlash, addrStat *pt.ParaNodeAddrIdStatus) (*types.Receipt, error) {
+	proposalStat, err := getNodeID(a.db, addrStat.ProposalId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "nodeAddr:%s wrong proposeid:%s", slash.Addr, addrStat.ProposalId)
+	}
+
+	percent := conf.GInt("nodeSlashPercent")
+	if percent < 0 {
+		percent = 0
+	} else if percent > 100 {
+		percent = 100
+	}
+	slashCoins := proposalStat.CoinsFrozen * percent / 100
+	receiver := conf.GStr("nodeSlashAddr")
+	if receiver == "" {
+		receiver = nodeSlashBurnAddr
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if slashCoins > 0 {
+		realExecAddr := dapp.ExecAddress(string(types.GetRealExecName(a.tx.Execer)))
+		r, err := a.coinsAccount.ExecTransferFrozen(proposalStat.FromAddr, receiver, realExecAddr, slashCoins)
+		if err != nil {
+			clog.Error("paracross.nodeSlashCoins", "addr", slash.Addr, "frozenAddr", proposalStat.FromAddr,
+				"receiver", receiver, "amount", slashCoins, "err", err)
+			return nil, err
+		}
+		receipt = mergeReceipt(receipt, r)
+
+		proposalStat.CoinsFrozen -= slashCoins
+		a.db.Set([]byte(proposalStat.Id), types.Encode(proposalStat))
+		receipt.KV = append(receipt.KV, &types.KeyValue{Key: []byte(proposalStat.Id), Value: types.Encode(proposalStat)})
+	}
+
+	log := &pt.ReceiptParaNodeSlash{
+		Title:       slash.Title,
+		Addr:        slash.Addr,
+		Reason:      slash.Reason,
+		FrozenAddr:  proposalStat.FromAddr,
+		SlashCoins:  slashCoins,
+		ActiveCoins: proposalStat.CoinsFrozen,
+		Receiver:    receiver,
+		Reporter:    a.fromaddr,
+		Height:      a.height,
+	}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: pt.TyLogParaNodeSlash, Log: types.Encode(log)})
+	return receipt, nil
+}
+
+//NodeSlash slash super node with evidence and eject it from node group
+func (a *action) NodeSlash(slash *pt.ParaNodeSlash) (*types.Receipt, error) {
+	if !types.IsDappFork(a.height, pt.ParaX, pt.ForkParaNodeSlash) {
+		return nil, types.ErrNotSupport
+	}
+	if !validTitle(slash.Title) {
+		return nil, pt.ErrInvalidTitle
+	}
+	title, err := getTitleFrom(a.tx.Execer)
+	if err != nil || string(title) != slash.Title {
+		return nil, errors.Wrapf(pt.ErrNodeNotForTheTitle, "slash title:%s,tx execer:%s", slash.Title, string(a.tx.Execer))
+	}
+
+	nodes, _, err := getParacrossNodes(a.db, slash.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getNodes for title:%s", slash.Title)
+	}
+	if !validNode(slash.Addr, nodes) {
+		return nil, errors.Wrapf(pt.ErrParaNodeAddrNotExisted, "nodeAddr not existed:%s", slash.Addr)
+	}
+	addrStat, err := getNodeAddr(a.db, slash.Title, slash.Addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "nodeAddr:%s get error", slash.Addr)
+	}
+	if addrStat.Status != pt.ParacrossNodeJoined {
+		return nil, errors.Wrapf(pt.ErrParaNodeAddrNotExisted, "nodeAddr:%s status:%d", slash.Addr, addrStat.Status)
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if !types.IsPara() {
+		err = a.checkSlashEvidence(slash)
+		if err != nil {
+			return nil, err
+		}
+		r, err := a.nodeSlashCoins(slash, addrStat)
+		if err != nil {
+			return nil, err
+		}
+		receipt = mergeReceipt(receipt, r)
+		receipt = mergeReceipt(receipt, a.resetNodeMissCommits(slash.Title, slash.Addr))
+	}
+
+	r, err := unpdateNodeGroup(a.db, slash.Title, slash.Addr, false)
+	if err != nil {
+		return nil, err
+	}
+	receipt = mergeReceipt(receipt, r)
+
+	stat := &pt.ParaNodeIdStatus{
+		Id:         calcParaNodeIDKey(slash.Title, common.ToHex(a.txhash)),
+		Status:     pt.ParacrossNodeQuiting,
+		Title:      slash.Title,
+		TargetAddr: slash.Addr,
+		FromAddr:   a.fromaddr,
+		Votes:      &pt.ParaNodeVoteDetail{},
+		Height:     a.height}
+	r, err = a.updateNodeAddrStatus(stat)
+	if err != nil {
+		return nil, err
+	}
+	receipt = mergeReceipt(receipt, r)
+
+	if a.exec.GetMainHeight() > pt.GetDappForkHeight(pt.ForkLoopCheckCommitTxDone) {
+		//剔除节点后，如果committx满足2/3目标，自动触发commitDone
+		r, err = a.loopCommitTxDone(slash.Title)
+		if err != nil {
+			clog.Error("NodeSlash.loopCommitTxDone", "title", slash.Title, "err", err.Error())
+		}
+		receipt = mergeReceipt(receipt, r)
+	}
+
+	stat.Status = pt.ParacrossNodeClosed
+	r = makeNodeConfigReceipt(a.fromaddr, nil, nil, stat)
+	receipt = mergeReceipt(receipt, r)
+	return receipt, nil
+}
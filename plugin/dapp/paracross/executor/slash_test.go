@@ -0,0 +1,358 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// 授权节点惩罚
+//   Nodes 都由 Account14K 冻结币加入, 被惩罚的节点冻结币按比例罚没, 剩余解冻, 并被剔除出nodegroup
+
+var slashFrozenCoins = 10 * types.Coin
+
+type NodeSlashTestSuite struct {
+	suite.Suite
+	stateDB dbm.KV
+	localDB *dbmock.KVDB
+	api     *apimock.QueueProtocolAPI
+
+	exec *Paracross
+}
+
+func TestNodeSlashSuite(t *testing.T) {
+	suite.Run(t, new(NodeSlashTestSuite))
+}
+
+func (suite *NodeSlashTestSuite) SetupTest() {
+	suite.stateDB, _ = dbm.NewGoMemDB("state", "state", 1024)
+	suite.localDB = new(dbmock.KVDB)
+	suite.api = new(apimock.QueueProtocolAPI)
+
+	suite.exec = newParacross().(*Paracross)
+	suite.exec.SetLocalDB(suite.localDB)
+	suite.exec.SetStateDB(suite.stateDB)
+	suite.exec.SetAPI(suite.api)
+
+	//只有测试平行链可以修改配置, 各测试用例会重新设置title
+	types.SetTitleOnlyForTest(Title)
+	types.S("config.exec.sub.paracross.nodeSlashPercent", int64(50))
+	types.S("config.exec.sub.paracross.nodeSlashMissCommits", int64(3))
+}
+
+func (suite *NodeSlashTestSuite) setupNodeGroup() {
+	suite.exec.SetEnv(types.GetDappFork(pt.ParaX, pt.ForkParaNodeSlash), 0, 0)
+
+	key := calcParaNodeGroupAddrsKey(Title)
+	suite.stateDB.Set(key, types.Encode(makeNodeInfo(string(key), Account14K, len(Nodes))))
+	for i, node := range Nodes {
+		id := calcParaNodeIDKey(Title, "0x01") + "-" + string('0'+rune(i))
+		stat := &pt.ParaNodeIdStatus{
+			Id:          id,
+			Status:      pt.ParacrossNodeClosed,
+			Title:       Title,
+			TargetAddr:  string(node),
+			FromAddr:    Account14K,
+			CoinsFrozen: slashFrozenCoins,
+		}
+		suite.stateDB.Set([]byte(id), types.Encode(stat))
+		addrStat := &pt.ParaNodeAddrIdStatus{
+			Title:      Title,
+			Addr:       string(node),
+			Status:     pt.ParacrossNodeJoined,
+			ProposalId: id,
+		}
+		suite.stateDB.Set(calcParaNodeAddrKey(Title, string(node)), types.Encode(addrStat))
+	}
+
+	acc := account.NewCoinsAccount()
+	acc.SetDB(suite.stateDB)
+	acc.SaveExecAccount(address.ExecAddress(pt.ParaX), &types.Account{
+		Addr:   Account14K,
+		Frozen: int64(len(Nodes)) * slashFrozenCoins,
+	})
+}
+
+func (suite *NodeSlashTestSuite) execSlash(slash *pt.ParaNodeSlash) (*types.Receipt, error) {
+	tx, err := pt.CreateRawNodeSlashTx(slash)
+	assert.Nil(suite.T(), err)
+	tx, err = signTx(suite.Suite, tx, PrivKeyD)
+	assert.Nil(suite.T(), err)
+	receipt, err := suite.exec.Exec(tx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range receipt.KV {
+		suite.stateDB.Set(kv.Key, kv.Value)
+	}
+	return receipt, nil
+}
+
+func (suite *NodeSlashTestSuite) checkEjected(addr string) {
+	nodes, _, err := getParacrossNodes(suite.stateDB, Title)
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), validNode(addr, nodes))
+	assert.Equal(suite.T(), len(Nodes)-1, len(nodes))
+
+	addrStat, err := getNodeAddr(suite.stateDB, Title, addr)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int32(pt.ParacrossNodeQuited), addrStat.Status)
+}
+
+func createSlashCommitTx(s suite.Suite, privKey string, blockHash []byte) *types.Transaction {
+	status := &pt.ParacrossNodeStatus{
+		MainBlockHash:   MainBlockHash10,
+		MainBlockHeight: MainBlockHeight,
+		Title:           Title,
+		Height:          TitleHeight,
+		BlockHash:       blockHash,
+	}
+	tx, err := pt.CreateRawCommitTx4MainChain(status, Title+pt.ParaX, 0)
+	assert.Nil(s.T(), err)
+	tx, err = signTx(s, tx, privKey)
+	assert.Nil(s.T(), err)
+	return tx
+}
+
+func (suite *NodeSlashTestSuite) TestSlashConflictCommits() {
+	types.Init("test", nil)
+	suite.setupNodeGroup()
+
+	slash := &pt.ParaNodeSlash{
+		Title:  Title,
+		Addr:   string(Nodes[0]),
+		Reason: pt.ParaNodeSlashConflict,
+		CommitTxs: []*types.Transaction{
+			createSlashCommitTx(suite.Suite, PrivKeyA, CurBlock),
+			createSlashCommitTx(suite.Suite, PrivKeyA, PerBlock),
+		},
+	}
+	receipt, err := suite.execSlash(slash)
+	if err != nil {
+		suite.T().Error("Exec NodeSlash", err)
+		return
+	}
+	suite.checkEjected(string(Nodes[0]))
+
+	var found bool
+	for _, log := range receipt.Logs {
+		if log.Ty == pt.TyLogParaNodeSlash {
+			var rlog pt.ReceiptParaNodeSlash
+			assert.Nil(suite.T(), types.Decode(log.Log, &rlog))
+			assert.Equal(suite.T(), slashFrozenCoins/2, rlog.SlashCoins)
+			assert.Equal(suite.T(), nodeSlashBurnAddr, rlog.Receiver)
+			found = true
+		}
+	}
+	assert.True(suite.T(), found)
+
+	acc := account.NewCoinsAccount()
+	acc.SetDB(suite.stateDB)
+	execAddr := address.ExecAddress(pt.ParaX)
+	frozenAcc := acc.LoadExecAccount(Account14K, execAddr)
+	assert.Equal(suite.T(), int64(len(Nodes)-1)*slashFrozenCoins, frozenAcc.Frozen)
+	assert.Equal(suite.T(), slashFrozenCoins/2, frozenAcc.Balance)
+	assert.Equal(suite.T(), slashFrozenCoins/2, acc.LoadExecAccount(nodeSlashBurnAddr, execAddr).Balance)
+
+	//已经被剔除的节点不能再被惩罚
+	_, err = suite.execSlash(slash)
+	assert.Equal(suite.T(), pt.ErrParaNodeAddrNotExisted, errors.Cause(err))
+}
+
+//聚合commit交易由聚合节点发送, 其中被惩罚节点对status的签名作为证据
+func (suite *NodeSlashTestSuite) TestSlashConflictCommitAgg() {
+	types.Init("test", nil)
+	suite.setupNodeGroup()
+
+	createAggTx := func(signKey string) *types.Transaction {
+		status := &pt.ParacrossNodeStatus{
+			MainBlockHash:   MainBlockHash10,
+			MainBlockHeight: MainBlockHeight,
+			Title:           Title,
+			Height:          TitleHeight,
+			BlockHash:       PerBlock,
+		}
+		priv, err := getPrivKey(suite.Suite, signKey)
+		assert.Nil(suite.T(), err)
+		sign := pt.SignCommitStatus(status, types.SECP256K1, priv)
+		tx, err := pt.CreateRawCommitAggTx4MainChain(status, []*types.Signature{sign}, Title+pt.ParaX, 0)
+		assert.Nil(suite.T(), err)
+		tx, err = signTx(suite.Suite, tx, PrivKeyB)
+		assert.Nil(suite.T(), err)
+		return tx
+	}
+
+	//聚合交易中没有被惩罚节点的签名
+	slash := &pt.ParaNodeSlash{
+		Title:  Title,
+		Addr:   string(Nodes[0]),
+		Reason: pt.ParaNodeSlashConflict,
+		CommitTxs: []*types.Transaction{
+			createSlashCommitTx(suite.Suite, PrivKeyA, CurBlock),
+			createAggTx(PrivKeyC),
+		},
+	}
+	_, err := suite.execSlash(slash)
+	assert.Equal(suite.T(), pt.ErrParaNodeSlashEvidence, errors.Cause(err))
+
+	slash.CommitTxs[1] = createAggTx(PrivKeyA)
+	_, err = suite.execSlash(slash)
+	if err != nil {
+		suite.T().Error("Exec NodeSlash", err)
+		return
+	}
+	suite.checkEjected(string(Nodes[0]))
+}
+
+func (suite *NodeSlashTestSuite) TestSlashInvalidEvidence() {
+	types.Init("test", nil)
+	suite.setupNodeGroup()
+
+	//相同的blockHash不是冲突
+	slash := &pt.ParaNodeSlash{
+		Title:  Title,
+		Addr:   string(Nodes[0]),
+		Reason: pt.ParaNodeSlashConflict,
+		CommitTxs: []*types.Transaction{
+			createSlashCommitTx(suite.Suite, PrivKeyA, CurBlock),
+			createSlashCommitTx(suite.Suite, PrivKeyA, CurBlock),
+		},
+	}
+	_, err := suite.execSlash(slash)
+	assert.Equal(suite.T(), pt.ErrParaNodeSlashEvidence, errors.Cause(err))
+
+	//commit 交易不是被惩罚节点签名的
+	slash.CommitTxs[1] = createSlashCommitTx(suite.Suite, PrivKeyB, PerBlock)
+	_, err = suite.execSlash(slash)
+	assert.Equal(suite.T(), pt.ErrParaNodeSlashEvidence, errors.Cause(err))
+}
+
+func (suite *NodeSlashTestSuite) TestSlashMissCommits() {
+	types.Init("test", nil)
+	suite.setupNodeGroup()
+
+	//Nodes[1] 连续未提交commit
+	tx, _ := pt.CreateRawNodeSlashTx(&pt.ParaNodeSlash{Title: Title, Addr: string(Nodes[1]), Reason: pt.ParaNodeSlashMissCommit})
+	a := newAction(suite.exec, tx)
+	for height := int64(1); height <= 2; height++ {
+		stat := &pt.ParacrossHeightStatus{
+			Title:  Title,
+			Height: height,
+			Details: &pt.ParacrossStatusDetails{
+				Addrs: []string{string(Nodes[0]), string(Nodes[2]), string(Nodes[3])},
+			},
+		}
+		_, err := a.updateNodeMissCommits(stat)
+		assert.Nil(suite.T(), err)
+	}
+	slash := &pt.ParaNodeSlash{Title: Title, Addr: string(Nodes[1]), Reason: pt.ParaNodeSlashMissCommit}
+	_, err := suite.execSlash(slash)
+	assert.Equal(suite.T(), pt.ErrParaNodeSlashEvidence, errors.Cause(err))
+
+	stat := &pt.ParacrossHeightStatus{
+		Title:   Title,
+		Height:  3,
+		Details: &pt.ParacrossStatusDetails{Addrs: []string{string(Nodes[0])}},
+	}
+	_, err = a.updateNodeMissCommits(stat)
+	assert.Nil(suite.T(), err)
+
+	msg, err := suite.exec.Query_GetNodeMissCommits(&types.ReqString{Data: Title})
+	assert.Nil(suite.T(), err)
+	miss := msg.(*pt.ParaNodeMissCommits)
+	assert.Equal(suite.T(), int64(3), miss.Height)
+	assert.Equal(suite.T(), int64(0), getMissCommitCount(miss, string(Nodes[0])))
+	assert.Equal(suite.T(), int64(3), getMissCommitCount(miss, string(Nodes[1])))
+	assert.Equal(suite.T(), int64(1), getMissCommitCount(miss, string(Nodes[2])))
+
+	_, err = suite.execSlash(slash)
+	if err != nil {
+		suite.T().Error("Exec NodeSlash", err)
+		return
+	}
+	suite.checkEjected(string(Nodes[1]))
+
+	miss, err = getNodeMissCommits(suite.stateDB, Title)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), len(Nodes)-1, len(miss.Addrs))
+}
+
+//共识完成后迟到的commit, 和共识结果一致时清除未参与共识的统计
+func (suite *NodeSlashTestSuite) TestLateCommitClearMiss() {
+	types.Init("test", nil)
+	suite.setupNodeGroup()
+
+	tx, _ := pt.CreateRawNodeSlashTx(&pt.ParaNodeSlash{Title: Title, Addr: string(Nodes[1]), Reason: pt.ParaNodeSlashMissCommit})
+	a := newAction(suite.exec, tx)
+	for height := int64(1); height <= 3; height++ {
+		stat := &pt.ParacrossHeightStatus{
+			Status: pt.ParacrossStatusCommitDone,
+			Title:  Title,
+			Height: height,
+			Details: &pt.ParacrossStatusDetails{
+				Addrs:     []string{string(Nodes[0]), string(Nodes[2]), string(Nodes[3])},
+				BlockHash: [][]byte{CurBlock, CurBlock, CurBlock},
+			},
+		}
+		saveTitleHeight(suite.stateDB, calcTitleHeightKey(Title, height), stat)
+		_, err := a.updateNodeMissCommits(stat)
+		assert.Nil(suite.T(), err)
+	}
+	getCount := func() int64 {
+		miss, err := getNodeMissCommits(suite.stateDB, Title)
+		assert.Nil(suite.T(), err)
+		return getMissCommitCount(miss, string(Nodes[1]))
+	}
+	assert.Equal(suite.T(), int64(3), getCount())
+
+	//和共识结果不一致的commit不算参与
+	status := &pt.ParacrossNodeStatus{Title: Title, Height: 2, BlockHash: PerBlock}
+	r, err := a.updateLateCommitMiss(status, []string{string(Nodes[1])})
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), r)
+	assert.Equal(suite.T(), int64(3), getCount())
+
+	//高度2迟到的正确commit, 之后只有高度3未参与
+	status.BlockHash = CurBlock
+	r, err = a.updateLateCommitMiss(status, []string{string(Nodes[1])})
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), r)
+	assert.Equal(suite.T(), int64(1), getCount())
+
+	slash := &pt.ParaNodeSlash{Title: Title, Addr: string(Nodes[1]), Reason: pt.ParaNodeSlashMissCommit}
+	_, err = suite.execSlash(slash)
+	assert.Equal(suite.T(), pt.ErrParaNodeSlashEvidence, errors.Cause(err))
+
+	//最新共识高度迟到的正确commit, 清零
+	status.Height = 3
+	_, err = a.updateLateCommitMiss(status, []string{string(Nodes[1])})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(0), getCount())
+}
+
+// 平行链不校验证据, 只剔除节点
+func (suite *NodeSlashTestSuite) TestSlashOnPara() {
+	para_init(Title)
+	suite.setupNodeGroup()
+
+	slash := &pt.ParaNodeSlash{Title: Title, Addr: string(Nodes[2]), Reason: pt.ParaNodeSlashMissCommit}
+	_, err := suite.execSlash(slash)
+	if err != nil {
+		suite.T().Error("Exec NodeSlash", err)
+		return
+	}
+	suite.checkEjected(string(Nodes[2]))
+}
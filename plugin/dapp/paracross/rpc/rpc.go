@@ -358,3 +358,78 @@ func (c *Jrpc) ListNodeGroupStatus(req *pt.ReqParacrossNodeInfo, result *interfa
 	*result = data
 	return err
 }
+
+//ListCrossAssets list cross assets registered for title
+func (c *channelClient) ListCrossAssets(ctx context.Context, req *types.ReqString) (*pt.RespParacrossAssets, error) {
+	data, err := c.Query(pt.GetExecName(), "ListCrossAssets", req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := data.(*pt.RespParacrossAssets); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
+//ListCrossAssets list cross assets registered for title
+func (c *Jrpc) ListCrossAssets(req *types.ReqString, result *interface{}) error {
+	if req == nil || req.Data == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := c.cli.ListCrossAssets(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*result = data
+	return err
+}
+
+//GetCrossTransfer get para chain to para chain transfer status
+func (c *channelClient) GetCrossTransfer(ctx context.Context, req *types.ReqHash) (*pt.ParaCrossTransferStatus, error) {
+	data, err := c.Query(pt.GetExecName(), "GetCrossTransfer", req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := data.(*pt.ParaCrossTransferStatus); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
+//GetCrossTransfer get para chain to para chain transfer status by source tx hash
+func (c *Jrpc) GetCrossTransfer(req *types.ReqHash, result *interface{}) error {
+	if req == nil || len(req.Hash) == 0 {
+		return types.ErrInvalidParam
+	}
+	data, err := c.cli.GetCrossTransfer(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*result = data
+	return err
+}
+
+//GetNodeMissCommits get the continuous miss commit count of super nodes
+func (c *channelClient) GetNodeMissCommits(ctx context.Context, req *types.ReqString) (*pt.ParaNodeMissCommits, error) {
+	data, err := c.Query(pt.GetExecName(), "GetNodeMissCommits", req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := data.(*pt.ParaNodeMissCommits); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
+//GetNodeMissCommits get the continuous miss commit count of super nodes by title
+func (c *Jrpc) GetNodeMissCommits(req *types.ReqString, result *interface{}) error {
+	if req == nil || req.Data == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := c.cli.GetNodeMissCommits(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*result = data
+	return err
+}
@@ -123,6 +123,7 @@ genesisAmount=100000000
 MainBlockHashForkHeight=1
 MainForkParacrossCommitTx=1
 MainLoopCheckCommitTxDoneForkHeight=11
+MainParaCrossTransferForkHeight=1
 [[consensus.sub.para.emptyBlockInterval]]
 blockHeight=0
 interval=2
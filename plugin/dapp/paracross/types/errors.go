@@ -51,4 +51,12 @@ var (
 	ErrParaConsensStopBlocksNotReach = errors.New("ErrParaConsensStopBlocksNotReach")
 	//ErrForkHeightNotReach fork height not reach
 	ErrForkHeightNotReach = errors.New("ErrForkHeightNotReach")
+	//ErrParaAssetNotRegistered cross asset not registered for the title
+	ErrParaAssetNotRegistered = errors.New("ErrParaAssetNotRegistered")
+	//ErrParaCrossTransferStatus cross transfer status not allowed for the operation
+	ErrParaCrossTransferStatus = errors.New("ErrParaCrossTransferStatus")
+	//ErrParaNodeSlashEvidence slash evidence not valid
+	ErrParaNodeSlashEvidence = errors.New("ErrParaNodeSlashEvidence")
+	//ErrParaCommitAggSigns aggregated commit signatures not valid
+	ErrParaCommitAggSigns = errors.New("ErrParaCommitAggSigns")
 )
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
 )
@@ -43,6 +44,10 @@ const (
 	TyLogParaNodeGroupConfig       = 660
 	TyLogParaNodeStatusUpdate      = 661
 	TyLogParaNodeGroupStatusUpdate = 664
+	// TyLogParaCrossTransfer para chain cross transfer status update log key
+	TyLogParaCrossTransfer = 665
+	// TyLogParaNodeSlash para super node slashed log key
+	TyLogParaNodeSlash = 666
 )
 
 type paracrossCommitTx struct {
@@ -78,8 +83,37 @@ const (
 	ParacrossActionNodeConfig
 	//ParacrossActionNodeGroupApply apply for node group initially
 	ParacrossActionNodeGroupApply
+	//ParacrossActionParaAssetTransfer para chain asset transfer to main chain
+	ParacrossActionParaAssetTransfer
+	//ParacrossActionParaAssetWithdraw para chain asset withdraw from main chain
+	ParacrossActionParaAssetWithdraw
+	//ParacrossActionCrossTransfer asset transfer from one para chain to another
+	ParacrossActionCrossTransfer
+	//ParacrossActionCrossDeliver deliver cross transfer to target para chain
+	ParacrossActionCrossDeliver
+	//ParacrossActionNodeSlash slash super node with evidence
+	ParacrossActionNodeSlash
+	//ParacrossActionCommitAgg commit with signatures of multi super nodes
+	ParacrossActionCommitAgg
 )
 
+//para chain cross transfer status
+const (
+	// ParaCrossTransferPending 源平行链发起，等待共识
+	ParaCrossTransferPending = iota + 1
+	// ParaCrossTransferSettled 源平行链共识完成，主链资产已转到目标平行链
+	ParaCrossTransferSettled
+	// ParaCrossTransferFailed 源平行链执行失败
+	ParaCrossTransferFailed
+	// ParaCrossTransferDelivered 已投递到目标平行链，等待共识
+	ParaCrossTransferDelivered
+	// ParaCrossTransferDone 目标平行链共识完成
+	ParaCrossTransferDone
+)
+
+// ParaCrossTransferStatusStr ...
+var ParaCrossTransferStatusStr = []string{"invalid", "pending", "settled", "failed", "delivered", "done"}
+
 // status
 const (
 	// ParacrossStatusCommiting commit status
@@ -107,6 +141,17 @@ const (
 // ParaNodeVoteStr ...
 var ParaNodeVoteStr = []string{"invalid", "yes", "no"}
 
+// node slash reason
+const (
+	// ParaNodeSlashConflict 同一高度提交了不同的blockHash
+	ParaNodeSlashConflict = iota + 1
+	// ParaNodeSlashMissCommit 连续多个高度没有提交commit
+	ParaNodeSlashMissCommit
+)
+
+// ParaNodeSlashReasonStr ...
+var ParaNodeSlashReasonStr = []string{"invalid", "conflict", "miss"}
+
 const (
 	// ParacrossNodeJoined pass to add by votes
 	ParacrossNodeJoined = iota + 10
@@ -164,6 +209,55 @@ func CreateRawCommitTx4MainChain(status *ParacrossNodeStatus, name string, fee i
 	return createRawCommitTx(status, name, fee)
 }
 
+// CreateRawCommitAggTx4MainChain create commit tx with aggregated node signatures to main chain
+func CreateRawCommitAggTx4MainChain(status *ParacrossNodeStatus, signs []*types.Signature, name string, feeRate int64) (*types.Transaction, error) {
+	if status == nil || len(signs) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	v := &ParacrossCommitAggAction{
+		Status: status,
+		Signs:  signs,
+	}
+	action := &ParacrossAction{
+		Ty:    ParacrossActionCommitAgg,
+		Value: &ParacrossAction_CommitAgg{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(name),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(name),
+		Expire:  types.Now().Unix() + int64(120), //120s
+	}
+	tx, err := types.FormatTx(name, tx)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate != 0 {
+		tx.Fee, err = tx.GetRealFee(feeRate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// SignCommitStatus 授权节点对共识status签名, 用于聚合commit
+func SignCommitStatus(status *ParacrossNodeStatus, ty int32, priv crypto.PrivKey) *types.Signature {
+	return &types.Signature{
+		Ty:        ty,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(types.Encode(status)).Bytes(),
+	}
+}
+
+// CheckCommitSign 校验对status的签名, 返回签名地址
+func CheckCommitSign(status *ParacrossNodeStatus, sign *types.Signature) (string, error) {
+	if sign == nil || !types.CheckSign(types.Encode(status), "", sign) {
+		return "", types.ErrSign
+	}
+	return address.PubKeyToAddress(sign.Pubkey).String(), nil
+}
+
 func createRawParacrossCommitTx(parm *paracrossCommitTx) (*types.Transaction, error) {
 	if parm == nil {
 		tlog.Error("createRawParacrossCommitTx", "parm", parm)
@@ -267,6 +361,86 @@ func CreateRawAssetTransferTx(param *types.CreateTx) (*types.Transaction, error)
 	return tx, nil
 }
 
+// CreateRawParaAssetTransferTx create para chain asset transfer tx, IsWithdraw for withdraw from main chain
+func CreateRawParaAssetTransferTx(param *types.CreateTx) (*types.Transaction, error) {
+	if !types.IsParaExecName(param.GetExecName()) || param.TokenSymbol == "" {
+		tlog.Error("CreateRawParaAssetTransferTx", "exec", param.GetExecName(), "symbol", param.TokenSymbol)
+		return nil, types.ErrInvalidParam
+	}
+
+	transfer := &ParacrossAction{}
+	if !param.IsWithdraw {
+		v := &ParacrossAction_ParaAssetTransfer{ParaAssetTransfer: &types.AssetsTransfer{
+			Amount: param.Amount, Note: param.GetNote(), To: param.GetTo(), Cointoken: param.TokenSymbol}}
+		transfer.Value = v
+		transfer.Ty = ParacrossActionParaAssetTransfer
+	} else {
+		v := &ParacrossAction_ParaAssetWithdraw{ParaAssetWithdraw: &types.AssetsWithdraw{
+			Amount: param.Amount, Note: param.GetNote(), To: param.GetTo(), Cointoken: param.TokenSymbol, ExecName: param.ExecName}}
+		transfer.Value = v
+		transfer.Ty = ParacrossActionParaAssetWithdraw
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(param.GetExecName()),
+		Payload: types.Encode(transfer),
+		To:      address.ExecAddress(param.GetExecName()),
+		Fee:     param.Fee,
+	}
+	return types.FormatTx(param.GetExecName(), tx)
+}
+
+// CreateRawCrossTransferTx create asset transfer tx from para chain execName to another para chain
+func CreateRawCrossTransferTx(execName string, transfer *CrossAssetTransfer) (*types.Transaction, error) {
+	if !types.IsParaExecName(execName) || !types.IsParaExecName(transfer.ToTitle) {
+		tlog.Error("CreateRawCrossTransferTx", "exec", execName, "toTitle", transfer.ToTitle)
+		return nil, types.ErrInvalidParam
+	}
+	action := &ParacrossAction{
+		Ty:    ParacrossActionCrossTransfer,
+		Value: &ParacrossAction_CrossTransfer{CrossTransfer: transfer},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(execName),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(execName),
+	}
+	return types.FormatTx(execName, tx)
+}
+
+// CreateRawNodeSlashTx create raw tx to slash super node of the title
+func CreateRawNodeSlashTx(slash *ParaNodeSlash) (*types.Transaction, error) {
+	if !types.IsParaExecName(slash.Title) || slash.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if slash.Reason != ParaNodeSlashConflict && slash.Reason != ParaNodeSlashMissCommit {
+		return nil, types.ErrInvalidParam
+	}
+	execName := slash.Title + ParaX
+	action := &ParacrossAction{
+		Ty:    ParacrossActionNodeSlash,
+		Value: &ParacrossAction_NodeSlash{NodeSlash: slash},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(execName),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(execName),
+	}
+	return types.FormatTx(execName, tx)
+}
+
+// GetCrossAsset 解析跨链资产，空为主链coins，不带执行器名称的为token，其他资产格式为 exec.symbol
+// 执行器名称可能带"."，比如 user.p.xx.token，按最后一个"."分割
+func GetCrossAsset(cointoken string) (string, string) {
+	if cointoken == "" {
+		return "coins", types.GetCoinSymbol()
+	}
+	idx := strings.LastIndex(cointoken, ".")
+	if idx < 0 {
+		return "token", cointoken
+	}
+	return cointoken[:idx], cointoken[idx+1:]
+}
+
 // CreateRawMinerTx create miner tx
 func CreateRawMinerTx(value *ParacrossMinerAction) (*types.Transaction, error) {
 
@@ -324,6 +498,8 @@ func GetDappForkHeight(forkKey string) int64 {
 			key = MainForkParacrossCommitTx
 		case ForkLoopCheckCommitTxDone:
 			key = MainLoopCheckCommitTxDoneForkHeight
+		case ForkParaCrossTransfer:
+			key = MainParaCrossTransferForkHeight
 		}
 
 		forkHeight = types.Conf("config.consensus.sub.para").GInt(key)
@@ -4,17 +4,14 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
-
-	proto "github.com/golang/protobuf/proto"
-
-	math "math"
-
 	types "github.com/33cn/chain33/types"
-
-	context "golang.org/x/net/context"
-
+	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -26,7 +23,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // stateDB
 type ParacrossStatusDetails struct {
@@ -41,16 +38,17 @@ func (m *ParacrossStatusDetails) Reset()         { *m = ParacrossStatusDetails{}
 func (m *ParacrossStatusDetails) String() string { return proto.CompactTextString(m) }
 func (*ParacrossStatusDetails) ProtoMessage()    {}
 func (*ParacrossStatusDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{0}
+	return fileDescriptor_6a397e38c9ea6747, []int{0}
 }
+
 func (m *ParacrossStatusDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossStatusDetails.Unmarshal(m, b)
 }
 func (m *ParacrossStatusDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossStatusDetails.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossStatusDetails) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossStatusDetails.Merge(dst, src)
+func (m *ParacrossStatusDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossStatusDetails.Merge(m, src)
 }
 func (m *ParacrossStatusDetails) XXX_Size() int {
 	return xxx_messageInfo_ParacrossStatusDetails.Size(m)
@@ -88,16 +86,17 @@ func (m *ParacrossStatusBlockDetails) Reset()         { *m = ParacrossStatusBloc
 func (m *ParacrossStatusBlockDetails) String() string { return proto.CompactTextString(m) }
 func (*ParacrossStatusBlockDetails) ProtoMessage()    {}
 func (*ParacrossStatusBlockDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{1}
+	return fileDescriptor_6a397e38c9ea6747, []int{1}
 }
+
 func (m *ParacrossStatusBlockDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossStatusBlockDetails.Unmarshal(m, b)
 }
 func (m *ParacrossStatusBlockDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossStatusBlockDetails.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossStatusBlockDetails) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossStatusBlockDetails.Merge(dst, src)
+func (m *ParacrossStatusBlockDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossStatusBlockDetails.Merge(m, src)
 }
 func (m *ParacrossStatusBlockDetails) XXX_Size() int {
 	return xxx_messageInfo_ParacrossStatusBlockDetails.Size(m)
@@ -140,16 +139,17 @@ func (m *ParacrossHeightStatus) Reset()         { *m = ParacrossHeightStatus{} }
 func (m *ParacrossHeightStatus) String() string { return proto.CompactTextString(m) }
 func (*ParacrossHeightStatus) ProtoMessage()    {}
 func (*ParacrossHeightStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{2}
+	return fileDescriptor_6a397e38c9ea6747, []int{2}
 }
+
 func (m *ParacrossHeightStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossHeightStatus.Unmarshal(m, b)
 }
 func (m *ParacrossHeightStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossHeightStatus.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossHeightStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossHeightStatus.Merge(dst, src)
+func (m *ParacrossHeightStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossHeightStatus.Merge(m, src)
 }
 func (m *ParacrossHeightStatus) XXX_Size() int {
 	return xxx_messageInfo_ParacrossHeightStatus.Size(m)
@@ -226,16 +226,17 @@ func (m *ParacrossHeightStatusRsp) Reset()         { *m = ParacrossHeightStatusR
 func (m *ParacrossHeightStatusRsp) String() string { return proto.CompactTextString(m) }
 func (*ParacrossHeightStatusRsp) ProtoMessage()    {}
 func (*ParacrossHeightStatusRsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{3}
+	return fileDescriptor_6a397e38c9ea6747, []int{3}
 }
+
 func (m *ParacrossHeightStatusRsp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossHeightStatusRsp.Unmarshal(m, b)
 }
 func (m *ParacrossHeightStatusRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossHeightStatusRsp.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossHeightStatusRsp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossHeightStatusRsp.Merge(dst, src)
+func (m *ParacrossHeightStatusRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossHeightStatusRsp.Merge(m, src)
 }
 func (m *ParacrossHeightStatusRsp) XXX_Size() int {
 	return xxx_messageInfo_ParacrossHeightStatusRsp.Size(m)
@@ -310,16 +311,17 @@ func (m *ParacrossStatus) Reset()         { *m = ParacrossStatus{} }
 func (m *ParacrossStatus) String() string { return proto.CompactTextString(m) }
 func (*ParacrossStatus) ProtoMessage()    {}
 func (*ParacrossStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{4}
+	return fileDescriptor_6a397e38c9ea6747, []int{4}
 }
+
 func (m *ParacrossStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossStatus.Unmarshal(m, b)
 }
 func (m *ParacrossStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossStatus.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossStatus.Merge(dst, src)
+func (m *ParacrossStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossStatus.Merge(m, src)
 }
 func (m *ParacrossStatus) XXX_Size() int {
 	return xxx_messageInfo_ParacrossStatus.Size(m)
@@ -379,16 +381,17 @@ func (m *ParacrossConsensusStatus) Reset()         { *m = ParacrossConsensusStat
 func (m *ParacrossConsensusStatus) String() string { return proto.CompactTextString(m) }
 func (*ParacrossConsensusStatus) ProtoMessage()    {}
 func (*ParacrossConsensusStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{5}
+	return fileDescriptor_6a397e38c9ea6747, []int{5}
 }
+
 func (m *ParacrossConsensusStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossConsensusStatus.Unmarshal(m, b)
 }
 func (m *ParacrossConsensusStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossConsensusStatus.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossConsensusStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossConsensusStatus.Merge(dst, src)
+func (m *ParacrossConsensusStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossConsensusStatus.Merge(m, src)
 }
 func (m *ParacrossConsensusStatus) XXX_Size() int {
 	return xxx_messageInfo_ParacrossConsensusStatus.Size(m)
@@ -443,16 +446,17 @@ func (m *ParaNodeAddrConfig) Reset()         { *m = ParaNodeAddrConfig{} }
 func (m *ParaNodeAddrConfig) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeAddrConfig) ProtoMessage()    {}
 func (*ParaNodeAddrConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{6}
+	return fileDescriptor_6a397e38c9ea6747, []int{6}
 }
+
 func (m *ParaNodeAddrConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaNodeAddrConfig.Unmarshal(m, b)
 }
 func (m *ParaNodeAddrConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaNodeAddrConfig.Marshal(b, m, deterministic)
 }
-func (dst *ParaNodeAddrConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaNodeAddrConfig.Merge(dst, src)
+func (m *ParaNodeAddrConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeAddrConfig.Merge(m, src)
 }
 func (m *ParaNodeAddrConfig) XXX_Size() int {
 	return xxx_messageInfo_ParaNodeAddrConfig.Size(m)
@@ -517,16 +521,17 @@ func (m *ParaNodeVoteDetail) Reset()         { *m = ParaNodeVoteDetail{} }
 func (m *ParaNodeVoteDetail) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeVoteDetail) ProtoMessage()    {}
 func (*ParaNodeVoteDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{7}
+	return fileDescriptor_6a397e38c9ea6747, []int{7}
 }
+
 func (m *ParaNodeVoteDetail) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaNodeVoteDetail.Unmarshal(m, b)
 }
 func (m *ParaNodeVoteDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaNodeVoteDetail.Marshal(b, m, deterministic)
 }
-func (dst *ParaNodeVoteDetail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaNodeVoteDetail.Merge(dst, src)
+func (m *ParaNodeVoteDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeVoteDetail.Merge(m, src)
 }
 func (m *ParaNodeVoteDetail) XXX_Size() int {
 	return xxx_messageInfo_ParaNodeVoteDetail.Size(m)
@@ -566,16 +571,17 @@ func (m *ParaNodeAddrIdStatus) Reset()         { *m = ParaNodeAddrIdStatus{} }
 func (m *ParaNodeAddrIdStatus) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeAddrIdStatus) ProtoMessage()    {}
 func (*ParaNodeAddrIdStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{8}
+	return fileDescriptor_6a397e38c9ea6747, []int{8}
 }
+
 func (m *ParaNodeAddrIdStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaNodeAddrIdStatus.Unmarshal(m, b)
 }
 func (m *ParaNodeAddrIdStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaNodeAddrIdStatus.Marshal(b, m, deterministic)
 }
-func (dst *ParaNodeAddrIdStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaNodeAddrIdStatus.Merge(dst, src)
+func (m *ParaNodeAddrIdStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeAddrIdStatus.Merge(m, src)
 }
 func (m *ParaNodeAddrIdStatus) XXX_Size() int {
 	return xxx_messageInfo_ParaNodeAddrIdStatus.Size(m)
@@ -639,16 +645,17 @@ func (m *ParaNodeIdStatus) Reset()         { *m = ParaNodeIdStatus{} }
 func (m *ParaNodeIdStatus) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeIdStatus) ProtoMessage()    {}
 func (*ParaNodeIdStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{9}
+	return fileDescriptor_6a397e38c9ea6747, []int{9}
 }
+
 func (m *ParaNodeIdStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaNodeIdStatus.Unmarshal(m, b)
 }
 func (m *ParaNodeIdStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaNodeIdStatus.Marshal(b, m, deterministic)
 }
-func (dst *ParaNodeIdStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaNodeIdStatus.Merge(dst, src)
+func (m *ParaNodeIdStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeIdStatus.Merge(m, src)
 }
 func (m *ParaNodeIdStatus) XXX_Size() int {
 	return xxx_messageInfo_ParaNodeIdStatus.Size(m)
@@ -729,16 +736,17 @@ func (m *ReceiptParaNodeConfig) Reset()         { *m = ReceiptParaNodeConfig{} }
 func (m *ReceiptParaNodeConfig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParaNodeConfig) ProtoMessage()    {}
 func (*ReceiptParaNodeConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{10}
+	return fileDescriptor_6a397e38c9ea6747, []int{10}
 }
+
 func (m *ReceiptParaNodeConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParaNodeConfig.Unmarshal(m, b)
 }
 func (m *ReceiptParaNodeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParaNodeConfig.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParaNodeConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParaNodeConfig.Merge(dst, src)
+func (m *ReceiptParaNodeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaNodeConfig.Merge(m, src)
 }
 func (m *ReceiptParaNodeConfig) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParaNodeConfig.Size(m)
@@ -790,16 +798,17 @@ func (m *ReceiptParaNodeAddrStatUpdate) Reset()         { *m = ReceiptParaNodeAd
 func (m *ReceiptParaNodeAddrStatUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParaNodeAddrStatUpdate) ProtoMessage()    {}
 func (*ReceiptParaNodeAddrStatUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{11}
+	return fileDescriptor_6a397e38c9ea6747, []int{11}
 }
+
 func (m *ReceiptParaNodeAddrStatUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParaNodeAddrStatUpdate.Unmarshal(m, b)
 }
 func (m *ReceiptParaNodeAddrStatUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParaNodeAddrStatUpdate.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParaNodeAddrStatUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParaNodeAddrStatUpdate.Merge(dst, src)
+func (m *ReceiptParaNodeAddrStatUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaNodeAddrStatUpdate.Merge(m, src)
 }
 func (m *ReceiptParaNodeAddrStatUpdate) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParaNodeAddrStatUpdate.Size(m)
@@ -849,16 +858,17 @@ func (m *ReceiptParaNodeVoteDone) Reset()         { *m = ReceiptParaNodeVoteDone
 func (m *ReceiptParaNodeVoteDone) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParaNodeVoteDone) ProtoMessage()    {}
 func (*ReceiptParaNodeVoteDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{12}
+	return fileDescriptor_6a397e38c9ea6747, []int{12}
 }
+
 func (m *ReceiptParaNodeVoteDone) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParaNodeVoteDone.Unmarshal(m, b)
 }
 func (m *ReceiptParaNodeVoteDone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParaNodeVoteDone.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParaNodeVoteDone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParaNodeVoteDone.Merge(dst, src)
+func (m *ReceiptParaNodeVoteDone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaNodeVoteDone.Merge(m, src)
 }
 func (m *ReceiptParaNodeVoteDone) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParaNodeVoteDone.Size(m)
@@ -940,16 +950,17 @@ func (m *ParaNodeGroupConfig) Reset()         { *m = ParaNodeGroupConfig{} }
 func (m *ParaNodeGroupConfig) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeGroupConfig) ProtoMessage()    {}
 func (*ParaNodeGroupConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{13}
+	return fileDescriptor_6a397e38c9ea6747, []int{13}
 }
+
 func (m *ParaNodeGroupConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaNodeGroupConfig.Unmarshal(m, b)
 }
 func (m *ParaNodeGroupConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaNodeGroupConfig.Marshal(b, m, deterministic)
 }
-func (dst *ParaNodeGroupConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaNodeGroupConfig.Merge(dst, src)
+func (m *ParaNodeGroupConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeGroupConfig.Merge(m, src)
 }
 func (m *ParaNodeGroupConfig) XXX_Size() int {
 	return xxx_messageInfo_ParaNodeGroupConfig.Size(m)
@@ -1012,16 +1023,17 @@ func (m *ParaNodeGroupStatus) Reset()         { *m = ParaNodeGroupStatus{} }
 func (m *ParaNodeGroupStatus) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeGroupStatus) ProtoMessage()    {}
 func (*ParaNodeGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{14}
+	return fileDescriptor_6a397e38c9ea6747, []int{14}
 }
+
 func (m *ParaNodeGroupStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaNodeGroupStatus.Unmarshal(m, b)
 }
 func (m *ParaNodeGroupStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaNodeGroupStatus.Marshal(b, m, deterministic)
 }
-func (dst *ParaNodeGroupStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaNodeGroupStatus.Merge(dst, src)
+func (m *ParaNodeGroupStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeGroupStatus.Merge(m, src)
 }
 func (m *ParaNodeGroupStatus) XXX_Size() int {
 	return xxx_messageInfo_ParaNodeGroupStatus.Size(m)
@@ -1095,16 +1107,17 @@ func (m *ReceiptParaNodeGroupConfig) Reset()         { *m = ReceiptParaNodeGroup
 func (m *ReceiptParaNodeGroupConfig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParaNodeGroupConfig) ProtoMessage()    {}
 func (*ReceiptParaNodeGroupConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{15}
+	return fileDescriptor_6a397e38c9ea6747, []int{15}
 }
+
 func (m *ReceiptParaNodeGroupConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParaNodeGroupConfig.Unmarshal(m, b)
 }
 func (m *ReceiptParaNodeGroupConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParaNodeGroupConfig.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParaNodeGroupConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParaNodeGroupConfig.Merge(dst, src)
+func (m *ReceiptParaNodeGroupConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaNodeGroupConfig.Merge(m, src)
 }
 func (m *ReceiptParaNodeGroupConfig) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParaNodeGroupConfig.Size(m)
@@ -1158,16 +1171,17 @@ func (m *ReqParacrossNodeInfo) Reset()         { *m = ReqParacrossNodeInfo{} }
 func (m *ReqParacrossNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossNodeInfo) ProtoMessage()    {}
 func (*ReqParacrossNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{16}
+	return fileDescriptor_6a397e38c9ea6747, []int{16}
 }
+
 func (m *ReqParacrossNodeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqParacrossNodeInfo.Unmarshal(m, b)
 }
 func (m *ReqParacrossNodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqParacrossNodeInfo.Marshal(b, m, deterministic)
 }
-func (dst *ReqParacrossNodeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqParacrossNodeInfo.Merge(dst, src)
+func (m *ReqParacrossNodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqParacrossNodeInfo.Merge(m, src)
 }
 func (m *ReqParacrossNodeInfo) XXX_Size() int {
 	return xxx_messageInfo_ReqParacrossNodeInfo.Size(m)
@@ -1217,16 +1231,17 @@ func (m *RespParacrossNodeAddrs) Reset()         { *m = RespParacrossNodeAddrs{}
 func (m *RespParacrossNodeAddrs) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossNodeAddrs) ProtoMessage()    {}
 func (*RespParacrossNodeAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{17}
+	return fileDescriptor_6a397e38c9ea6747, []int{17}
 }
+
 func (m *RespParacrossNodeAddrs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RespParacrossNodeAddrs.Unmarshal(m, b)
 }
 func (m *RespParacrossNodeAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_RespParacrossNodeAddrs.Marshal(b, m, deterministic)
 }
-func (dst *RespParacrossNodeAddrs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespParacrossNodeAddrs.Merge(dst, src)
+func (m *RespParacrossNodeAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespParacrossNodeAddrs.Merge(m, src)
 }
 func (m *RespParacrossNodeAddrs) XXX_Size() int {
 	return xxx_messageInfo_RespParacrossNodeAddrs.Size(m)
@@ -1255,16 +1270,17 @@ func (m *RespParacrossNodeGroups) Reset()         { *m = RespParacrossNodeGroups
 func (m *RespParacrossNodeGroups) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossNodeGroups) ProtoMessage()    {}
 func (*RespParacrossNodeGroups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{18}
+	return fileDescriptor_6a397e38c9ea6747, []int{18}
 }
+
 func (m *RespParacrossNodeGroups) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RespParacrossNodeGroups.Unmarshal(m, b)
 }
 func (m *RespParacrossNodeGroups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_RespParacrossNodeGroups.Marshal(b, m, deterministic)
 }
-func (dst *RespParacrossNodeGroups) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespParacrossNodeGroups.Merge(dst, src)
+func (m *RespParacrossNodeGroups) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespParacrossNodeGroups.Merge(m, src)
 }
 func (m *RespParacrossNodeGroups) XXX_Size() int {
 	return xxx_messageInfo_RespParacrossNodeGroups.Size(m)
@@ -1296,16 +1312,17 @@ func (m *ParaBlock2MainMap) Reset()         { *m = ParaBlock2MainMap{} }
 func (m *ParaBlock2MainMap) String() string { return proto.CompactTextString(m) }
 func (*ParaBlock2MainMap) ProtoMessage()    {}
 func (*ParaBlock2MainMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{19}
+	return fileDescriptor_6a397e38c9ea6747, []int{19}
 }
+
 func (m *ParaBlock2MainMap) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaBlock2MainMap.Unmarshal(m, b)
 }
 func (m *ParaBlock2MainMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaBlock2MainMap.Marshal(b, m, deterministic)
 }
-func (dst *ParaBlock2MainMap) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaBlock2MainMap.Merge(dst, src)
+func (m *ParaBlock2MainMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaBlock2MainMap.Merge(m, src)
 }
 func (m *ParaBlock2MainMap) XXX_Size() int {
 	return xxx_messageInfo_ParaBlock2MainMap.Size(m)
@@ -1355,16 +1372,17 @@ func (m *ParaBlock2MainInfo) Reset()         { *m = ParaBlock2MainInfo{} }
 func (m *ParaBlock2MainInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaBlock2MainInfo) ProtoMessage()    {}
 func (*ParaBlock2MainInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{20}
+	return fileDescriptor_6a397e38c9ea6747, []int{20}
 }
+
 func (m *ParaBlock2MainInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaBlock2MainInfo.Unmarshal(m, b)
 }
 func (m *ParaBlock2MainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaBlock2MainInfo.Marshal(b, m, deterministic)
 }
-func (dst *ParaBlock2MainInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaBlock2MainInfo.Merge(dst, src)
+func (m *ParaBlock2MainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaBlock2MainInfo.Merge(m, src)
 }
 func (m *ParaBlock2MainInfo) XXX_Size() int {
 	return xxx_messageInfo_ParaBlock2MainInfo.Size(m)
@@ -1407,16 +1425,17 @@ func (m *ParacrossNodeStatus) Reset()         { *m = ParacrossNodeStatus{} }
 func (m *ParacrossNodeStatus) String() string { return proto.CompactTextString(m) }
 func (*ParacrossNodeStatus) ProtoMessage()    {}
 func (*ParacrossNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{21}
+	return fileDescriptor_6a397e38c9ea6747, []int{21}
 }
+
 func (m *ParacrossNodeStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossNodeStatus.Unmarshal(m, b)
 }
 func (m *ParacrossNodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossNodeStatus.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossNodeStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossNodeStatus.Merge(dst, src)
+func (m *ParacrossNodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossNodeStatus.Merge(m, src)
 }
 func (m *ParacrossNodeStatus) XXX_Size() int {
 	return xxx_messageInfo_ParacrossNodeStatus.Size(m)
@@ -1536,16 +1555,17 @@ func (m *ParacrossCommitAction) Reset()         { *m = ParacrossCommitAction{} }
 func (m *ParacrossCommitAction) String() string { return proto.CompactTextString(m) }
 func (*ParacrossCommitAction) ProtoMessage()    {}
 func (*ParacrossCommitAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{22}
+	return fileDescriptor_6a397e38c9ea6747, []int{22}
 }
+
 func (m *ParacrossCommitAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossCommitAction.Unmarshal(m, b)
 }
 func (m *ParacrossCommitAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossCommitAction.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossCommitAction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossCommitAction.Merge(dst, src)
+func (m *ParacrossCommitAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossCommitAction.Merge(m, src)
 }
 func (m *ParacrossCommitAction) XXX_Size() int {
 	return xxx_messageInfo_ParacrossCommitAction.Size(m)
@@ -1563,6 +1583,94 @@ func (m *ParacrossCommitAction) GetStatus() *ParacrossNodeStatus {
 	return nil
 }
 
+// 多个授权节点对同一个status签名，由一个节点聚合后提交
+type ParacrossCommitAggAction struct {
+	Status               *ParacrossNodeStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Signs                []*types.Signature   `protobuf:"bytes,2,rep,name=signs,proto3" json:"signs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ParacrossCommitAggAction) Reset()         { *m = ParacrossCommitAggAction{} }
+func (m *ParacrossCommitAggAction) String() string { return proto.CompactTextString(m) }
+func (*ParacrossCommitAggAction) ProtoMessage()    {}
+func (*ParacrossCommitAggAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{23}
+}
+
+func (m *ParacrossCommitAggAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParacrossCommitAggAction.Unmarshal(m, b)
+}
+func (m *ParacrossCommitAggAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParacrossCommitAggAction.Marshal(b, m, deterministic)
+}
+func (m *ParacrossCommitAggAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossCommitAggAction.Merge(m, src)
+}
+func (m *ParacrossCommitAggAction) XXX_Size() int {
+	return xxx_messageInfo_ParacrossCommitAggAction.Size(m)
+}
+func (m *ParacrossCommitAggAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParacrossCommitAggAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParacrossCommitAggAction proto.InternalMessageInfo
+
+func (m *ParacrossCommitAggAction) GetStatus() *ParacrossNodeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ParacrossCommitAggAction) GetSigns() []*types.Signature {
+	if m != nil {
+		return m.Signs
+	}
+	return nil
+}
+
+// 授权节点之间交换的commit签名
+type ParaCommitSignMsgs struct {
+	Msgs                 []*ParacrossCommitAggAction `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ParaCommitSignMsgs) Reset()         { *m = ParaCommitSignMsgs{} }
+func (m *ParaCommitSignMsgs) String() string { return proto.CompactTextString(m) }
+func (*ParaCommitSignMsgs) ProtoMessage()    {}
+func (*ParaCommitSignMsgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{24}
+}
+
+func (m *ParaCommitSignMsgs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaCommitSignMsgs.Unmarshal(m, b)
+}
+func (m *ParaCommitSignMsgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaCommitSignMsgs.Marshal(b, m, deterministic)
+}
+func (m *ParaCommitSignMsgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaCommitSignMsgs.Merge(m, src)
+}
+func (m *ParaCommitSignMsgs) XXX_Size() int {
+	return xxx_messageInfo_ParaCommitSignMsgs.Size(m)
+}
+func (m *ParaCommitSignMsgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaCommitSignMsgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaCommitSignMsgs proto.InternalMessageInfo
+
+func (m *ParaCommitSignMsgs) GetMsgs() []*ParacrossCommitAggAction {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
 type ParacrossMinerAction struct {
 	Status               *ParacrossNodeStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IsSelfConsensus      bool                 `protobuf:"varint,2,opt,name=isSelfConsensus,proto3" json:"isSelfConsensus,omitempty"`
@@ -1575,16 +1683,17 @@ func (m *ParacrossMinerAction) Reset()         { *m = ParacrossMinerAction{} }
 func (m *ParacrossMinerAction) String() string { return proto.CompactTextString(m) }
 func (*ParacrossMinerAction) ProtoMessage()    {}
 func (*ParacrossMinerAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{23}
+	return fileDescriptor_6a397e38c9ea6747, []int{25}
 }
+
 func (m *ParacrossMinerAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossMinerAction.Unmarshal(m, b)
 }
 func (m *ParacrossMinerAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossMinerAction.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossMinerAction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossMinerAction.Merge(dst, src)
+func (m *ParacrossMinerAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossMinerAction.Merge(m, src)
 }
 func (m *ParacrossMinerAction) XXX_Size() int {
 	return xxx_messageInfo_ParacrossMinerAction.Size(m)
@@ -1620,6 +1729,12 @@ type ParacrossAction struct {
 	//	*ParacrossAction_TransferToExec
 	//	*ParacrossAction_NodeConfig
 	//	*ParacrossAction_NodeGroupConfig
+	//	*ParacrossAction_ParaAssetTransfer
+	//	*ParacrossAction_ParaAssetWithdraw
+	//	*ParacrossAction_CrossTransfer
+	//	*ParacrossAction_CrossDeliver
+	//	*ParacrossAction_NodeSlash
+	//	*ParacrossAction_CommitAgg
 	Value                isParacrossAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                   `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
@@ -1631,16 +1746,17 @@ func (m *ParacrossAction) Reset()         { *m = ParacrossAction{} }
 func (m *ParacrossAction) String() string { return proto.CompactTextString(m) }
 func (*ParacrossAction) ProtoMessage()    {}
 func (*ParacrossAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{24}
+	return fileDescriptor_6a397e38c9ea6747, []int{26}
 }
+
 func (m *ParacrossAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossAction.Unmarshal(m, b)
 }
 func (m *ParacrossAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossAction.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossAction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossAction.Merge(dst, src)
+func (m *ParacrossAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossAction.Merge(m, src)
 }
 func (m *ParacrossAction) XXX_Size() int {
 	return xxx_messageInfo_ParacrossAction.Size(m)
@@ -1691,6 +1807,30 @@ type ParacrossAction_NodeGroupConfig struct {
 	NodeGroupConfig *ParaNodeGroupConfig `protobuf:"bytes,10,opt,name=nodeGroupConfig,proto3,oneof"`
 }
 
+type ParacrossAction_ParaAssetTransfer struct {
+	ParaAssetTransfer *types.AssetsTransfer `protobuf:"bytes,11,opt,name=paraAssetTransfer,proto3,oneof"`
+}
+
+type ParacrossAction_ParaAssetWithdraw struct {
+	ParaAssetWithdraw *types.AssetsWithdraw `protobuf:"bytes,12,opt,name=paraAssetWithdraw,proto3,oneof"`
+}
+
+type ParacrossAction_CrossTransfer struct {
+	CrossTransfer *CrossAssetTransfer `protobuf:"bytes,13,opt,name=crossTransfer,proto3,oneof"`
+}
+
+type ParacrossAction_CrossDeliver struct {
+	CrossDeliver *CrossAssetDeliver `protobuf:"bytes,14,opt,name=crossDeliver,proto3,oneof"`
+}
+
+type ParacrossAction_NodeSlash struct {
+	NodeSlash *ParaNodeSlash `protobuf:"bytes,15,opt,name=nodeSlash,proto3,oneof"`
+}
+
+type ParacrossAction_CommitAgg struct {
+	CommitAgg *ParacrossCommitAggAction `protobuf:"bytes,16,opt,name=commitAgg,proto3,oneof"`
+}
+
 func (*ParacrossAction_Commit) isParacrossAction_Value() {}
 
 func (*ParacrossAction_Miner) isParacrossAction_Value() {}
@@ -1709,6 +1849,18 @@ func (*ParacrossAction_NodeConfig) isParacrossAction_Value() {}
 
 func (*ParacrossAction_NodeGroupConfig) isParacrossAction_Value() {}
 
+func (*ParacrossAction_ParaAssetTransfer) isParacrossAction_Value() {}
+
+func (*ParacrossAction_ParaAssetWithdraw) isParacrossAction_Value() {}
+
+func (*ParacrossAction_CrossTransfer) isParacrossAction_Value() {}
+
+func (*ParacrossAction_CrossDeliver) isParacrossAction_Value() {}
+
+func (*ParacrossAction_NodeSlash) isParacrossAction_Value() {}
+
+func (*ParacrossAction_CommitAgg) isParacrossAction_Value() {}
+
 func (m *ParacrossAction) GetValue() isParacrossAction_Value {
 	if m != nil {
 		return m.Value
@@ -1779,6 +1931,48 @@ func (m *ParacrossAction) GetNodeGroupConfig() *ParaNodeGroupConfig {
 	return nil
 }
 
+func (m *ParacrossAction) GetParaAssetTransfer() *types.AssetsTransfer {
+	if x, ok := m.GetValue().(*ParacrossAction_ParaAssetTransfer); ok {
+		return x.ParaAssetTransfer
+	}
+	return nil
+}
+
+func (m *ParacrossAction) GetParaAssetWithdraw() *types.AssetsWithdraw {
+	if x, ok := m.GetValue().(*ParacrossAction_ParaAssetWithdraw); ok {
+		return x.ParaAssetWithdraw
+	}
+	return nil
+}
+
+func (m *ParacrossAction) GetCrossTransfer() *CrossAssetTransfer {
+	if x, ok := m.GetValue().(*ParacrossAction_CrossTransfer); ok {
+		return x.CrossTransfer
+	}
+	return nil
+}
+
+func (m *ParacrossAction) GetCrossDeliver() *CrossAssetDeliver {
+	if x, ok := m.GetValue().(*ParacrossAction_CrossDeliver); ok {
+		return x.CrossDeliver
+	}
+	return nil
+}
+
+func (m *ParacrossAction) GetNodeSlash() *ParaNodeSlash {
+	if x, ok := m.GetValue().(*ParacrossAction_NodeSlash); ok {
+		return x.NodeSlash
+	}
+	return nil
+}
+
+func (m *ParacrossAction) GetCommitAgg() *ParacrossCommitAggAction {
+	if x, ok := m.GetValue().(*ParacrossAction_CommitAgg); ok {
+		return x.CommitAgg
+	}
+	return nil
+}
+
 func (m *ParacrossAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -1798,6 +1992,12 @@ func (*ParacrossAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffe
 		(*ParacrossAction_TransferToExec)(nil),
 		(*ParacrossAction_NodeConfig)(nil),
 		(*ParacrossAction_NodeGroupConfig)(nil),
+		(*ParacrossAction_ParaAssetTransfer)(nil),
+		(*ParacrossAction_ParaAssetWithdraw)(nil),
+		(*ParacrossAction_CrossTransfer)(nil),
+		(*ParacrossAction_CrossDeliver)(nil),
+		(*ParacrossAction_NodeSlash)(nil),
+		(*ParacrossAction_CommitAgg)(nil),
 	}
 }
 
@@ -1850,6 +2050,36 @@ func _ParacrossAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.NodeGroupConfig); err != nil {
 			return err
 		}
+	case *ParacrossAction_ParaAssetTransfer:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ParaAssetTransfer); err != nil {
+			return err
+		}
+	case *ParacrossAction_ParaAssetWithdraw:
+		b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ParaAssetWithdraw); err != nil {
+			return err
+		}
+	case *ParacrossAction_CrossTransfer:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CrossTransfer); err != nil {
+			return err
+		}
+	case *ParacrossAction_CrossDeliver:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CrossDeliver); err != nil {
+			return err
+		}
+	case *ParacrossAction_NodeSlash:
+		b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.NodeSlash); err != nil {
+			return err
+		}
+	case *ParacrossAction_CommitAgg:
+		b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CommitAgg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ParacrossAction.Value has unexpected type %T", x)
@@ -1932,6 +2162,54 @@ func _ParacrossAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Value = &ParacrossAction_NodeGroupConfig{msg}
 		return true, err
+	case 11: // value.paraAssetTransfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.AssetsTransfer)
+		err := b.DecodeMessage(msg)
+		m.Value = &ParacrossAction_ParaAssetTransfer{msg}
+		return true, err
+	case 12: // value.paraAssetWithdraw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.AssetsWithdraw)
+		err := b.DecodeMessage(msg)
+		m.Value = &ParacrossAction_ParaAssetWithdraw{msg}
+		return true, err
+	case 13: // value.crossTransfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CrossAssetTransfer)
+		err := b.DecodeMessage(msg)
+		m.Value = &ParacrossAction_CrossTransfer{msg}
+		return true, err
+	case 14: // value.crossDeliver
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CrossAssetDeliver)
+		err := b.DecodeMessage(msg)
+		m.Value = &ParacrossAction_CrossDeliver{msg}
+		return true, err
+	case 15: // value.nodeSlash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ParaNodeSlash)
+		err := b.DecodeMessage(msg)
+		m.Value = &ParacrossAction_NodeSlash{msg}
+		return true, err
+	case 16: // value.commitAgg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ParacrossCommitAggAction)
+		err := b.DecodeMessage(msg)
+		m.Value = &ParacrossAction_CommitAgg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1986,6 +2264,36 @@ func _ParacrossAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ParacrossAction_ParaAssetTransfer:
+		s := proto.Size(x.ParaAssetTransfer)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ParacrossAction_ParaAssetWithdraw:
+		s := proto.Size(x.ParaAssetWithdraw)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ParacrossAction_CrossTransfer:
+		s := proto.Size(x.CrossTransfer)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ParacrossAction_CrossDeliver:
+		s := proto.Size(x.CrossDeliver)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ParacrossAction_NodeSlash:
+		s := proto.Size(x.NodeSlash)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ParacrossAction_CommitAgg:
+		s := proto.Size(x.CommitAgg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2008,16 +2316,17 @@ func (m *ReceiptParacrossCommit) Reset()         { *m = ReceiptParacrossCommit{}
 func (m *ReceiptParacrossCommit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossCommit) ProtoMessage()    {}
 func (*ReceiptParacrossCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{25}
+	return fileDescriptor_6a397e38c9ea6747, []int{27}
 }
+
 func (m *ReceiptParacrossCommit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParacrossCommit.Unmarshal(m, b)
 }
 func (m *ReceiptParacrossCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParacrossCommit.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParacrossCommit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParacrossCommit.Merge(dst, src)
+func (m *ReceiptParacrossCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParacrossCommit.Merge(m, src)
 }
 func (m *ReceiptParacrossCommit) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParacrossCommit.Size(m)
@@ -2067,16 +2376,17 @@ func (m *ReceiptParacrossMiner) Reset()         { *m = ReceiptParacrossMiner{} }
 func (m *ReceiptParacrossMiner) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossMiner) ProtoMessage()    {}
 func (*ReceiptParacrossMiner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{26}
+	return fileDescriptor_6a397e38c9ea6747, []int{28}
 }
+
 func (m *ReceiptParacrossMiner) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParacrossMiner.Unmarshal(m, b)
 }
 func (m *ReceiptParacrossMiner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParacrossMiner.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParacrossMiner) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParacrossMiner.Merge(dst, src)
+func (m *ReceiptParacrossMiner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParacrossMiner.Merge(m, src)
 }
 func (m *ReceiptParacrossMiner) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParacrossMiner.Size(m)
@@ -2118,16 +2428,17 @@ func (m *ReceiptParacrossDone) Reset()         { *m = ReceiptParacrossDone{} }
 func (m *ReceiptParacrossDone) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossDone) ProtoMessage()    {}
 func (*ReceiptParacrossDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{27}
+	return fileDescriptor_6a397e38c9ea6747, []int{29}
 }
+
 func (m *ReceiptParacrossDone) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParacrossDone.Unmarshal(m, b)
 }
 func (m *ReceiptParacrossDone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParacrossDone.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParacrossDone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParacrossDone.Merge(dst, src)
+func (m *ReceiptParacrossDone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParacrossDone.Merge(m, src)
 }
 func (m *ReceiptParacrossDone) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParacrossDone.Size(m)
@@ -2248,16 +2559,17 @@ func (m *ReceiptParacrossRecord) Reset()         { *m = ReceiptParacrossRecord{}
 func (m *ReceiptParacrossRecord) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossRecord) ProtoMessage()    {}
 func (*ReceiptParacrossRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{28}
+	return fileDescriptor_6a397e38c9ea6747, []int{30}
 }
+
 func (m *ReceiptParacrossRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptParacrossRecord.Unmarshal(m, b)
 }
 func (m *ReceiptParacrossRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptParacrossRecord.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptParacrossRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptParacrossRecord.Merge(dst, src)
+func (m *ReceiptParacrossRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParacrossRecord.Merge(m, src)
 }
 func (m *ReceiptParacrossRecord) XXX_Size() int {
 	return xxx_messageInfo_ReceiptParacrossRecord.Size(m)
@@ -2295,16 +2607,17 @@ func (m *ParacrossTx) Reset()         { *m = ParacrossTx{} }
 func (m *ParacrossTx) String() string { return proto.CompactTextString(m) }
 func (*ParacrossTx) ProtoMessage()    {}
 func (*ParacrossTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{29}
+	return fileDescriptor_6a397e38c9ea6747, []int{31}
 }
+
 func (m *ParacrossTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossTx.Unmarshal(m, b)
 }
 func (m *ParacrossTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossTx.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossTx.Merge(dst, src)
+func (m *ParacrossTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossTx.Merge(m, src)
 }
 func (m *ParacrossTx) XXX_Size() int {
 	return xxx_messageInfo_ParacrossTx.Size(m)
@@ -2335,16 +2648,17 @@ func (m *ReqParacrossTitleHeight) Reset()         { *m = ReqParacrossTitleHeight
 func (m *ReqParacrossTitleHeight) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossTitleHeight) ProtoMessage()    {}
 func (*ReqParacrossTitleHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{30}
+	return fileDescriptor_6a397e38c9ea6747, []int{32}
 }
+
 func (m *ReqParacrossTitleHeight) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqParacrossTitleHeight.Unmarshal(m, b)
 }
 func (m *ReqParacrossTitleHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqParacrossTitleHeight.Marshal(b, m, deterministic)
 }
-func (dst *ReqParacrossTitleHeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqParacrossTitleHeight.Merge(dst, src)
+func (m *ReqParacrossTitleHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqParacrossTitleHeight.Merge(m, src)
 }
 func (m *ReqParacrossTitleHeight) XXX_Size() int {
 	return xxx_messageInfo_ReqParacrossTitleHeight.Size(m)
@@ -2387,16 +2701,17 @@ func (m *RespParacrossDone) Reset()         { *m = RespParacrossDone{} }
 func (m *RespParacrossDone) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossDone) ProtoMessage()    {}
 func (*RespParacrossDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{31}
+	return fileDescriptor_6a397e38c9ea6747, []int{33}
 }
+
 func (m *RespParacrossDone) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RespParacrossDone.Unmarshal(m, b)
 }
 func (m *RespParacrossDone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_RespParacrossDone.Marshal(b, m, deterministic)
 }
-func (dst *RespParacrossDone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespParacrossDone.Merge(dst, src)
+func (m *RespParacrossDone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespParacrossDone.Merge(m, src)
 }
 func (m *RespParacrossDone) XXX_Size() int {
 	return xxx_messageInfo_RespParacrossDone.Size(m)
@@ -2474,16 +2789,17 @@ func (m *RespParacrossTitles) Reset()         { *m = RespParacrossTitles{} }
 func (m *RespParacrossTitles) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossTitles) ProtoMessage()    {}
 func (*RespParacrossTitles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{32}
+	return fileDescriptor_6a397e38c9ea6747, []int{34}
 }
+
 func (m *RespParacrossTitles) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RespParacrossTitles.Unmarshal(m, b)
 }
 func (m *RespParacrossTitles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_RespParacrossTitles.Marshal(b, m, deterministic)
 }
-func (dst *RespParacrossTitles) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RespParacrossTitles.Merge(dst, src)
+func (m *RespParacrossTitles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespParacrossTitles.Merge(m, src)
 }
 func (m *RespParacrossTitles) XXX_Size() int {
 	return xxx_messageInfo_RespParacrossTitles.Size(m)
@@ -2513,16 +2829,17 @@ func (m *ReqParacrossTitleHash) Reset()         { *m = ReqParacrossTitleHash{} }
 func (m *ReqParacrossTitleHash) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossTitleHash) ProtoMessage()    {}
 func (*ReqParacrossTitleHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{33}
+	return fileDescriptor_6a397e38c9ea6747, []int{35}
 }
+
 func (m *ReqParacrossTitleHash) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqParacrossTitleHash.Unmarshal(m, b)
 }
 func (m *ReqParacrossTitleHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqParacrossTitleHash.Marshal(b, m, deterministic)
 }
-func (dst *ReqParacrossTitleHash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqParacrossTitleHash.Merge(dst, src)
+func (m *ReqParacrossTitleHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqParacrossTitleHash.Merge(m, src)
 }
 func (m *ReqParacrossTitleHash) XXX_Size() int {
 	return xxx_messageInfo_ReqParacrossTitleHash.Size(m)
@@ -2572,16 +2889,17 @@ func (m *ParacrossAsset) Reset()         { *m = ParacrossAsset{} }
 func (m *ParacrossAsset) String() string { return proto.CompactTextString(m) }
 func (*ParacrossAsset) ProtoMessage()    {}
 func (*ParacrossAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{34}
+	return fileDescriptor_6a397e38c9ea6747, []int{36}
 }
+
 func (m *ParacrossAsset) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParacrossAsset.Unmarshal(m, b)
 }
 func (m *ParacrossAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParacrossAsset.Marshal(b, m, deterministic)
 }
-func (dst *ParacrossAsset) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParacrossAsset.Merge(dst, src)
+func (m *ParacrossAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossAsset.Merge(m, src)
 }
 func (m *ParacrossAsset) XXX_Size() int {
 	return xxx_messageInfo_ParacrossAsset.Size(m)
@@ -2669,87 +2987,758 @@ func (m *ParacrossAsset) GetSuccess() bool {
 	return false
 }
 
-type ParaLocalDbBlock struct {
-	Height               int64                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	MainHash             []byte               `protobuf:"bytes,2,opt,name=mainHash,proto3" json:"mainHash,omitempty"`
-	MainHeight           int64                `protobuf:"varint,3,opt,name=mainHeight,proto3" json:"mainHeight,omitempty"`
-	ParentMainHash       []byte               `protobuf:"bytes,4,opt,name=parentMainHash,proto3" json:"parentMainHash,omitempty"`
-	BlockTime            int64                `protobuf:"varint,5,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
-	Txs                  []*types.Transaction `protobuf:"bytes,6,rep,name=txs,proto3" json:"txs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+// 允许跨链的资产
+type ParacrossAssetInfo struct {
+	Exec                 string   `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
+	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ParaLocalDbBlock) Reset()         { *m = ParaLocalDbBlock{} }
-func (m *ParaLocalDbBlock) String() string { return proto.CompactTextString(m) }
-func (*ParaLocalDbBlock) ProtoMessage()    {}
-func (*ParaLocalDbBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{35}
+func (m *ParacrossAssetInfo) Reset()         { *m = ParacrossAssetInfo{} }
+func (m *ParacrossAssetInfo) String() string { return proto.CompactTextString(m) }
+func (*ParacrossAssetInfo) ProtoMessage()    {}
+func (*ParacrossAssetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{37}
 }
-func (m *ParaLocalDbBlock) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ParaLocalDbBlock.Unmarshal(m, b)
+
+func (m *ParacrossAssetInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParacrossAssetInfo.Unmarshal(m, b)
 }
-func (m *ParaLocalDbBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ParaLocalDbBlock.Marshal(b, m, deterministic)
+func (m *ParacrossAssetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParacrossAssetInfo.Marshal(b, m, deterministic)
 }
-func (dst *ParaLocalDbBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaLocalDbBlock.Merge(dst, src)
+func (m *ParacrossAssetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParacrossAssetInfo.Merge(m, src)
 }
-func (m *ParaLocalDbBlock) XXX_Size() int {
-	return xxx_messageInfo_ParaLocalDbBlock.Size(m)
+func (m *ParacrossAssetInfo) XXX_Size() int {
+	return xxx_messageInfo_ParacrossAssetInfo.Size(m)
 }
-func (m *ParaLocalDbBlock) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParaLocalDbBlock.DiscardUnknown(m)
+func (m *ParacrossAssetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParacrossAssetInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParaLocalDbBlock proto.InternalMessageInfo
+var xxx_messageInfo_ParacrossAssetInfo proto.InternalMessageInfo
 
-func (m *ParaLocalDbBlock) GetHeight() int64 {
+func (m *ParacrossAssetInfo) GetExec() string {
 	if m != nil {
-		return m.Height
+		return m.Exec
 	}
-	return 0
+	return ""
 }
 
-func (m *ParaLocalDbBlock) GetMainHash() []byte {
+func (m *ParacrossAssetInfo) GetSymbol() string {
 	if m != nil {
-		return m.MainHash
+		return m.Symbol
 	}
-	return nil
+	return ""
 }
 
-func (m *ParaLocalDbBlock) GetMainHeight() int64 {
-	if m != nil {
-		return m.MainHeight
-	}
-	return 0
+type RespParacrossAssets struct {
+	Title                string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Assets               []*ParacrossAssetInfo `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ParaLocalDbBlock) GetParentMainHash() []byte {
-	if m != nil {
-		return m.ParentMainHash
-	}
-	return nil
+func (m *RespParacrossAssets) Reset()         { *m = RespParacrossAssets{} }
+func (m *RespParacrossAssets) String() string { return proto.CompactTextString(m) }
+func (*RespParacrossAssets) ProtoMessage()    {}
+func (*RespParacrossAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{38}
 }
 
-func (m *ParaLocalDbBlock) GetBlockTime() int64 {
+func (m *RespParacrossAssets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespParacrossAssets.Unmarshal(m, b)
+}
+func (m *RespParacrossAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespParacrossAssets.Marshal(b, m, deterministic)
+}
+func (m *RespParacrossAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespParacrossAssets.Merge(m, src)
+}
+func (m *RespParacrossAssets) XXX_Size() int {
+	return xxx_messageInfo_RespParacrossAssets.Size(m)
+}
+func (m *RespParacrossAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespParacrossAssets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespParacrossAssets proto.InternalMessageInfo
+
+func (m *RespParacrossAssets) GetTitle() string {
 	if m != nil {
-		return m.BlockTime
+		return m.Title
 	}
-	return 0
+	return ""
 }
 
-func (m *ParaLocalDbBlock) GetTxs() []*types.Transaction {
+func (m *RespParacrossAssets) GetAssets() []*ParacrossAssetInfo {
 	if m != nil {
-		return m.Txs
+		return m.Assets
 	}
 	return nil
 }
 
-type ParaLocalDbBlockInfo struct {
-	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	MainHash             string   `protobuf:"bytes,2,opt,name=mainHash,proto3" json:"mainHash,omitempty"`
+// 平行链之间跨链转账，在源平行链发起
+type CrossAssetTransfer struct {
+	ToTitle              string   `protobuf:"bytes,1,opt,name=toTitle,proto3" json:"toTitle,omitempty"`
+	Cointoken            string   `protobuf:"bytes,2,opt,name=cointoken,proto3" json:"cointoken,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CrossAssetTransfer) Reset()         { *m = CrossAssetTransfer{} }
+func (m *CrossAssetTransfer) String() string { return proto.CompactTextString(m) }
+func (*CrossAssetTransfer) ProtoMessage()    {}
+func (*CrossAssetTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{39}
+}
+
+func (m *CrossAssetTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrossAssetTransfer.Unmarshal(m, b)
+}
+func (m *CrossAssetTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrossAssetTransfer.Marshal(b, m, deterministic)
+}
+func (m *CrossAssetTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossAssetTransfer.Merge(m, src)
+}
+func (m *CrossAssetTransfer) XXX_Size() int {
+	return xxx_messageInfo_CrossAssetTransfer.Size(m)
+}
+func (m *CrossAssetTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossAssetTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossAssetTransfer proto.InternalMessageInfo
+
+func (m *CrossAssetTransfer) GetToTitle() string {
+	if m != nil {
+		return m.ToTitle
+	}
+	return ""
+}
+
+func (m *CrossAssetTransfer) GetCointoken() string {
+	if m != nil {
+		return m.Cointoken
+	}
+	return ""
+}
+
+func (m *CrossAssetTransfer) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CrossAssetTransfer) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *CrossAssetTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+// 源平行链共识完成后，主链在目标平行链的commit交易中投递，目标平行链过滤主链交易时生成投递交易
+type CrossAssetDeliver struct {
+	TxHash               []byte   `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	FromTitle            string   `protobuf:"bytes,2,opt,name=fromTitle,proto3" json:"fromTitle,omitempty"`
+	Cointoken            string   `protobuf:"bytes,3,opt,name=cointoken,proto3" json:"cointoken,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CrossAssetDeliver) Reset()         { *m = CrossAssetDeliver{} }
+func (m *CrossAssetDeliver) String() string { return proto.CompactTextString(m) }
+func (*CrossAssetDeliver) ProtoMessage()    {}
+func (*CrossAssetDeliver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{40}
+}
+
+func (m *CrossAssetDeliver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrossAssetDeliver.Unmarshal(m, b)
+}
+func (m *CrossAssetDeliver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrossAssetDeliver.Marshal(b, m, deterministic)
+}
+func (m *CrossAssetDeliver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossAssetDeliver.Merge(m, src)
+}
+func (m *CrossAssetDeliver) XXX_Size() int {
+	return xxx_messageInfo_CrossAssetDeliver.Size(m)
+}
+func (m *CrossAssetDeliver) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossAssetDeliver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossAssetDeliver proto.InternalMessageInfo
+
+func (m *CrossAssetDeliver) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *CrossAssetDeliver) GetFromTitle() string {
+	if m != nil {
+		return m.FromTitle
+	}
+	return ""
+}
+
+func (m *CrossAssetDeliver) GetCointoken() string {
+	if m != nil {
+		return m.Cointoken
+	}
+	return ""
+}
+
+func (m *CrossAssetDeliver) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CrossAssetDeliver) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type ParaCrossTransferStatus struct {
+	TxHash    []byte `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	FromTitle string `protobuf:"bytes,2,opt,name=fromTitle,proto3" json:"fromTitle,omitempty"`
+	ToTitle   string `protobuf:"bytes,3,opt,name=toTitle,proto3" json:"toTitle,omitempty"`
+	From      string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Cointoken string `protobuf:"bytes,6,opt,name=cointoken,proto3" json:"cointoken,omitempty"`
+	Exec      string `protobuf:"bytes,7,opt,name=exec,proto3" json:"exec,omitempty"`
+	Symbol    string `protobuf:"bytes,8,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount    int64  `protobuf:"varint,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status    int32  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	// 主链高度
+	Height               int64    `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	SettleHeight         int64    `protobuf:"varint,12,opt,name=settleHeight,proto3" json:"settleHeight,omitempty"`
+	DeliverTxHash        []byte   `protobuf:"bytes,13,opt,name=deliverTxHash,proto3" json:"deliverTxHash,omitempty"`
+	DeliverHeight        int64    `protobuf:"varint,14,opt,name=deliverHeight,proto3" json:"deliverHeight,omitempty"`
+	DoneHeight           int64    `protobuf:"varint,15,opt,name=doneHeight,proto3" json:"doneHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaCrossTransferStatus) Reset()         { *m = ParaCrossTransferStatus{} }
+func (m *ParaCrossTransferStatus) String() string { return proto.CompactTextString(m) }
+func (*ParaCrossTransferStatus) ProtoMessage()    {}
+func (*ParaCrossTransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{41}
+}
+
+func (m *ParaCrossTransferStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaCrossTransferStatus.Unmarshal(m, b)
+}
+func (m *ParaCrossTransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaCrossTransferStatus.Marshal(b, m, deterministic)
+}
+func (m *ParaCrossTransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaCrossTransferStatus.Merge(m, src)
+}
+func (m *ParaCrossTransferStatus) XXX_Size() int {
+	return xxx_messageInfo_ParaCrossTransferStatus.Size(m)
+}
+func (m *ParaCrossTransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaCrossTransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaCrossTransferStatus proto.InternalMessageInfo
+
+func (m *ParaCrossTransferStatus) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ParaCrossTransferStatus) GetFromTitle() string {
+	if m != nil {
+		return m.FromTitle
+	}
+	return ""
+}
+
+func (m *ParaCrossTransferStatus) GetToTitle() string {
+	if m != nil {
+		return m.ToTitle
+	}
+	return ""
+}
+
+func (m *ParaCrossTransferStatus) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ParaCrossTransferStatus) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ParaCrossTransferStatus) GetCointoken() string {
+	if m != nil {
+		return m.Cointoken
+	}
+	return ""
+}
+
+func (m *ParaCrossTransferStatus) GetExec() string {
+	if m != nil {
+		return m.Exec
+	}
+	return ""
+}
+
+func (m *ParaCrossTransferStatus) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ParaCrossTransferStatus) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ParaCrossTransferStatus) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ParaCrossTransferStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParaCrossTransferStatus) GetSettleHeight() int64 {
+	if m != nil {
+		return m.SettleHeight
+	}
+	return 0
+}
+
+func (m *ParaCrossTransferStatus) GetDeliverTxHash() []byte {
+	if m != nil {
+		return m.DeliverTxHash
+	}
+	return nil
+}
+
+func (m *ParaCrossTransferStatus) GetDeliverHeight() int64 {
+	if m != nil {
+		return m.DeliverHeight
+	}
+	return 0
+}
+
+func (m *ParaCrossTransferStatus) GetDoneHeight() int64 {
+	if m != nil {
+		return m.DoneHeight
+	}
+	return 0
+}
+
+type ReceiptParaCrossTransfer struct {
+	Prev                 *ParaCrossTransferStatus `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ParaCrossTransferStatus `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReceiptParaCrossTransfer) Reset()         { *m = ReceiptParaCrossTransfer{} }
+func (m *ReceiptParaCrossTransfer) String() string { return proto.CompactTextString(m) }
+func (*ReceiptParaCrossTransfer) ProtoMessage()    {}
+func (*ReceiptParaCrossTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{42}
+}
+
+func (m *ReceiptParaCrossTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptParaCrossTransfer.Unmarshal(m, b)
+}
+func (m *ReceiptParaCrossTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptParaCrossTransfer.Marshal(b, m, deterministic)
+}
+func (m *ReceiptParaCrossTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaCrossTransfer.Merge(m, src)
+}
+func (m *ReceiptParaCrossTransfer) XXX_Size() int {
+	return xxx_messageInfo_ReceiptParaCrossTransfer.Size(m)
+}
+func (m *ReceiptParaCrossTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptParaCrossTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptParaCrossTransfer proto.InternalMessageInfo
+
+func (m *ReceiptParaCrossTransfer) GetPrev() *ParaCrossTransferStatus {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptParaCrossTransfer) GetCurrent() *ParaCrossTransferStatus {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+// reason=1 时commitTxs为同一节点对相同title和高度提交的两个不同blockHash的commit交易
+type ParaNodeSlash struct {
+	Title                string               `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Addr                 string               `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Reason               int32                `protobuf:"varint,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CommitTxs            []*types.Transaction `protobuf:"bytes,4,rep,name=commitTxs,proto3" json:"commitTxs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ParaNodeSlash) Reset()         { *m = ParaNodeSlash{} }
+func (m *ParaNodeSlash) String() string { return proto.CompactTextString(m) }
+func (*ParaNodeSlash) ProtoMessage()    {}
+func (*ParaNodeSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{43}
+}
+
+func (m *ParaNodeSlash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaNodeSlash.Unmarshal(m, b)
+}
+func (m *ParaNodeSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaNodeSlash.Marshal(b, m, deterministic)
+}
+func (m *ParaNodeSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeSlash.Merge(m, src)
+}
+func (m *ParaNodeSlash) XXX_Size() int {
+	return xxx_messageInfo_ParaNodeSlash.Size(m)
+}
+func (m *ParaNodeSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaNodeSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaNodeSlash proto.InternalMessageInfo
+
+func (m *ParaNodeSlash) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ParaNodeSlash) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ParaNodeSlash) GetReason() int32 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+func (m *ParaNodeSlash) GetCommitTxs() []*types.Transaction {
+	if m != nil {
+		return m.CommitTxs
+	}
+	return nil
+}
+
+// 授权节点连续未参与共识的次数
+type ParaNodeMissCommits struct {
+	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Addrs                []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	Counts               []int64  `protobuf:"varint,4,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaNodeMissCommits) Reset()         { *m = ParaNodeMissCommits{} }
+func (m *ParaNodeMissCommits) String() string { return proto.CompactTextString(m) }
+func (*ParaNodeMissCommits) ProtoMessage()    {}
+func (*ParaNodeMissCommits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{44}
+}
+
+func (m *ParaNodeMissCommits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaNodeMissCommits.Unmarshal(m, b)
+}
+func (m *ParaNodeMissCommits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaNodeMissCommits.Marshal(b, m, deterministic)
+}
+func (m *ParaNodeMissCommits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaNodeMissCommits.Merge(m, src)
+}
+func (m *ParaNodeMissCommits) XXX_Size() int {
+	return xxx_messageInfo_ParaNodeMissCommits.Size(m)
+}
+func (m *ParaNodeMissCommits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaNodeMissCommits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaNodeMissCommits proto.InternalMessageInfo
+
+func (m *ParaNodeMissCommits) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ParaNodeMissCommits) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParaNodeMissCommits) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *ParaNodeMissCommits) GetCounts() []int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+type ReceiptParaNodeSlash struct {
+	Title  string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Addr   string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Reason int32  `protobuf:"varint,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 冻结币的地址
+	FrozenAddr           string   `protobuf:"bytes,4,opt,name=frozenAddr,proto3" json:"frozenAddr,omitempty"`
+	SlashCoins           int64    `protobuf:"varint,5,opt,name=slashCoins,proto3" json:"slashCoins,omitempty"`
+	ActiveCoins          int64    `protobuf:"varint,6,opt,name=activeCoins,proto3" json:"activeCoins,omitempty"`
+	Receiver             string   `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Reporter             string   `protobuf:"bytes,8,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Height               int64    `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptParaNodeSlash) Reset()         { *m = ReceiptParaNodeSlash{} }
+func (m *ReceiptParaNodeSlash) String() string { return proto.CompactTextString(m) }
+func (*ReceiptParaNodeSlash) ProtoMessage()    {}
+func (*ReceiptParaNodeSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{45}
+}
+
+func (m *ReceiptParaNodeSlash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptParaNodeSlash.Unmarshal(m, b)
+}
+func (m *ReceiptParaNodeSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptParaNodeSlash.Marshal(b, m, deterministic)
+}
+func (m *ReceiptParaNodeSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaNodeSlash.Merge(m, src)
+}
+func (m *ReceiptParaNodeSlash) XXX_Size() int {
+	return xxx_messageInfo_ReceiptParaNodeSlash.Size(m)
+}
+func (m *ReceiptParaNodeSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptParaNodeSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptParaNodeSlash proto.InternalMessageInfo
+
+func (m *ReceiptParaNodeSlash) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ReceiptParaNodeSlash) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReceiptParaNodeSlash) GetReason() int32 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+func (m *ReceiptParaNodeSlash) GetFrozenAddr() string {
+	if m != nil {
+		return m.FrozenAddr
+	}
+	return ""
+}
+
+func (m *ReceiptParaNodeSlash) GetSlashCoins() int64 {
+	if m != nil {
+		return m.SlashCoins
+	}
+	return 0
+}
+
+func (m *ReceiptParaNodeSlash) GetActiveCoins() int64 {
+	if m != nil {
+		return m.ActiveCoins
+	}
+	return 0
+}
+
+func (m *ReceiptParaNodeSlash) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ReceiptParaNodeSlash) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *ReceiptParaNodeSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ParaLocalDbBlock struct {
+	Height               int64                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	MainHash             []byte               `protobuf:"bytes,2,opt,name=mainHash,proto3" json:"mainHash,omitempty"`
+	MainHeight           int64                `protobuf:"varint,3,opt,name=mainHeight,proto3" json:"mainHeight,omitempty"`
+	ParentMainHash       []byte               `protobuf:"bytes,4,opt,name=parentMainHash,proto3" json:"parentMainHash,omitempty"`
+	BlockTime            int64                `protobuf:"varint,5,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	Txs                  []*types.Transaction `protobuf:"bytes,6,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ParaLocalDbBlock) Reset()         { *m = ParaLocalDbBlock{} }
+func (m *ParaLocalDbBlock) String() string { return proto.CompactTextString(m) }
+func (*ParaLocalDbBlock) ProtoMessage()    {}
+func (*ParaLocalDbBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{46}
+}
+
+func (m *ParaLocalDbBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaLocalDbBlock.Unmarshal(m, b)
+}
+func (m *ParaLocalDbBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaLocalDbBlock.Marshal(b, m, deterministic)
+}
+func (m *ParaLocalDbBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaLocalDbBlock.Merge(m, src)
+}
+func (m *ParaLocalDbBlock) XXX_Size() int {
+	return xxx_messageInfo_ParaLocalDbBlock.Size(m)
+}
+func (m *ParaLocalDbBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaLocalDbBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaLocalDbBlock proto.InternalMessageInfo
+
+func (m *ParaLocalDbBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParaLocalDbBlock) GetMainHash() []byte {
+	if m != nil {
+		return m.MainHash
+	}
+	return nil
+}
+
+func (m *ParaLocalDbBlock) GetMainHeight() int64 {
+	if m != nil {
+		return m.MainHeight
+	}
+	return 0
+}
+
+func (m *ParaLocalDbBlock) GetParentMainHash() []byte {
+	if m != nil {
+		return m.ParentMainHash
+	}
+	return nil
+}
+
+func (m *ParaLocalDbBlock) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *ParaLocalDbBlock) GetTxs() []*types.Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type ParaLocalDbBlockInfo struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	MainHash             string   `protobuf:"bytes,2,opt,name=mainHash,proto3" json:"mainHash,omitempty"`
 	MainHeight           int64    `protobuf:"varint,3,opt,name=mainHeight,proto3" json:"mainHeight,omitempty"`
 	ParentMainHash       string   `protobuf:"bytes,4,opt,name=parentMainHash,proto3" json:"parentMainHash,omitempty"`
 	BlockTime            int64    `protobuf:"varint,5,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
@@ -2763,16 +3752,17 @@ func (m *ParaLocalDbBlockInfo) Reset()         { *m = ParaLocalDbBlockInfo{} }
 func (m *ParaLocalDbBlockInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaLocalDbBlockInfo) ProtoMessage()    {}
 func (*ParaLocalDbBlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_paracross_dcd1b923b8f04760, []int{36}
+	return fileDescriptor_6a397e38c9ea6747, []int{47}
 }
+
 func (m *ParaLocalDbBlockInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParaLocalDbBlockInfo.Unmarshal(m, b)
 }
 func (m *ParaLocalDbBlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ParaLocalDbBlockInfo.Marshal(b, m, deterministic)
 }
-func (dst *ParaLocalDbBlockInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParaLocalDbBlockInfo.Merge(dst, src)
+func (m *ParaLocalDbBlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaLocalDbBlockInfo.Merge(m, src)
 }
 func (m *ParaLocalDbBlockInfo) XXX_Size() int {
 	return xxx_messageInfo_ParaLocalDbBlockInfo.Size(m)
@@ -2849,6 +3839,8 @@ func init() {
 	proto.RegisterType((*ParaBlock2MainInfo)(nil), "types.ParaBlock2MainInfo")
 	proto.RegisterType((*ParacrossNodeStatus)(nil), "types.ParacrossNodeStatus")
 	proto.RegisterType((*ParacrossCommitAction)(nil), "types.ParacrossCommitAction")
+	proto.RegisterType((*ParacrossCommitAggAction)(nil), "types.ParacrossCommitAggAction")
+	proto.RegisterType((*ParaCommitSignMsgs)(nil), "types.ParaCommitSignMsgs")
 	proto.RegisterType((*ParacrossMinerAction)(nil), "types.ParacrossMinerAction")
 	proto.RegisterType((*ParacrossAction)(nil), "types.ParacrossAction")
 	proto.RegisterType((*ReceiptParacrossCommit)(nil), "types.ReceiptParacrossCommit")
@@ -2861,10 +3853,183 @@ func init() {
 	proto.RegisterType((*RespParacrossTitles)(nil), "types.RespParacrossTitles")
 	proto.RegisterType((*ReqParacrossTitleHash)(nil), "types.ReqParacrossTitleHash")
 	proto.RegisterType((*ParacrossAsset)(nil), "types.ParacrossAsset")
+	proto.RegisterType((*ParacrossAssetInfo)(nil), "types.ParacrossAssetInfo")
+	proto.RegisterType((*RespParacrossAssets)(nil), "types.RespParacrossAssets")
+	proto.RegisterType((*CrossAssetTransfer)(nil), "types.CrossAssetTransfer")
+	proto.RegisterType((*CrossAssetDeliver)(nil), "types.CrossAssetDeliver")
+	proto.RegisterType((*ParaCrossTransferStatus)(nil), "types.ParaCrossTransferStatus")
+	proto.RegisterType((*ReceiptParaCrossTransfer)(nil), "types.ReceiptParaCrossTransfer")
+	proto.RegisterType((*ParaNodeSlash)(nil), "types.ParaNodeSlash")
+	proto.RegisterType((*ParaNodeMissCommits)(nil), "types.ParaNodeMissCommits")
+	proto.RegisterType((*ReceiptParaNodeSlash)(nil), "types.ReceiptParaNodeSlash")
 	proto.RegisterType((*ParaLocalDbBlock)(nil), "types.ParaLocalDbBlock")
 	proto.RegisterType((*ParaLocalDbBlockInfo)(nil), "types.ParaLocalDbBlockInfo")
 }
 
+func init() { proto.RegisterFile("paracross.proto", fileDescriptor_6a397e38c9ea6747) }
+
+var fileDescriptor_6a397e38c9ea6747 = []byte{
+	// 2521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x9f, 0x9e, 0x9e, 0x0f, 0xcf, 0xf3, 0x77, 0xad, 0xe3, 0xf4, 0x7a, 0xb3, 0x5e, 0xab, 0x15,
+	0x22, 0x03, 0x51, 0x76, 0xe3, 0x2c, 0x0b, 0x68, 0x05, 0x4b, 0xe2, 0x24, 0xb6, 0xb5, 0xf1, 0x6a,
+	0xd5, 0x9e, 0x85, 0x03, 0x12, 0xa2, 0x33, 0x53, 0xb6, 0x5b, 0x3b, 0xee, 0x9e, 0x74, 0x95, 0xb3,
+	0x0e, 0x37, 0xb4, 0x42, 0x42, 0x5c, 0xe0, 0x84, 0xb4, 0x5c, 0xe1, 0x0c, 0xff, 0x01, 0x07, 0x8e,
+	0x70, 0x03, 0x89, 0x3b, 0x37, 0xee, 0x1c, 0xb8, 0xa2, 0x57, 0x1f, 0x5d, 0x1f, 0xd3, 0x33, 0x71,
+	0x3e, 0x2e, 0xdc, 0xe6, 0xbd, 0x7a, 0xf5, 0xea, 0xbd, 0x57, 0xef, 0xbd, 0xfa, 0x55, 0xf5, 0xc0,
+	0xf2, 0x38, 0x2d, 0xd3, 0x41, 0x59, 0x30, 0x76, 0x6b, 0x5c, 0x16, 0xbc, 0x20, 0x6d, 0xfe, 0x6c,
+	0x4c, 0xd9, 0xc6, 0x2a, 0x2f, 0xd3, 0x9c, 0xa5, 0x03, 0x9e, 0x15, 0xb9, 0x1c, 0xd9, 0x58, 0x18,
+	0x14, 0x67, 0x67, 0x15, 0xb5, 0xf2, 0x78, 0x54, 0x0c, 0x3e, 0x1f, 0x9c, 0xa6, 0x99, 0xe2, 0xc4,
+	0x8f, 0x60, 0xfd, 0x53, 0xad, 0xec, 0x88, 0xa7, 0xfc, 0x9c, 0xdd, 0xa7, 0x3c, 0xcd, 0x46, 0x8c,
+	0xac, 0x41, 0x3b, 0x1d, 0x0e, 0x4b, 0x16, 0x05, 0x5b, 0xe1, 0x76, 0x2f, 0x91, 0x04, 0xb9, 0x06,
+	0x3d, 0xa1, 0x63, 0x3f, 0x65, 0xa7, 0x51, 0x73, 0x2b, 0xdc, 0x5e, 0x48, 0x0c, 0x23, 0xfe, 0x31,
+	0xbc, 0xe5, 0x69, 0xbb, 0x87, 0x63, 0x5a, 0xe5, 0x26, 0x40, 0x25, 0x2b, 0xf5, 0x2e, 0x24, 0x16,
+	0x07, 0x95, 0xf3, 0x8b, 0x84, 0xb2, 0xf3, 0x11, 0x67, 0x5a, 0x79, 0xc5, 0x88, 0x7f, 0xd7, 0x84,
+	0x2b, 0x95, 0xf6, 0x7d, 0x9a, 0x9d, 0x9c, 0x72, 0xb9, 0x06, 0x59, 0x87, 0x0e, 0x13, 0xbf, 0xa2,
+	0x60, 0x2b, 0xd8, 0x6e, 0x27, 0x8a, 0x42, 0x17, 0x78, 0xc6, 0x47, 0x34, 0x6a, 0x6e, 0x05, 0xe8,
+	0x82, 0x20, 0x50, 0xfa, 0x54, 0xcc, 0x8e, 0xc2, 0xad, 0x60, 0x3b, 0x4c, 0x14, 0x45, 0xbe, 0x0d,
+	0xdd, 0xa1, 0x34, 0x34, 0x6a, 0x6d, 0x05, 0xdb, 0xf3, 0x3b, 0x6f, 0xdf, 0x12, 0x61, 0xbd, 0x55,
+	0x1f, 0xa0, 0xa4, 0x3b, 0x34, 0x6e, 0x9d, 0xa5, 0x59, 0x2e, 0x4d, 0x8a, 0xda, 0x42, 0xa9, 0xc5,
+	0x21, 0x1b, 0x30, 0x27, 0x28, 0x0c, 0x59, 0x67, 0x2b, 0xd8, 0x5e, 0x48, 0x2a, 0x9a, 0x3c, 0x84,
+	0x85, 0xc7, 0x56, 0x88, 0xa2, 0xae, 0x58, 0x39, 0xae, 0x5f, 0xd9, 0x0e, 0x66, 0xe2, 0xcc, 0x8b,
+	0xff, 0x1d, 0x40, 0x54, 0x1b, 0x9c, 0x84, 0x8d, 0x5f, 0x53, 0x7c, 0x5c, 0x37, 0x5b, 0x33, 0xdd,
+	0x6c, 0x0b, 0x85, 0xc6, 0xcd, 0x2d, 0x98, 0xc7, 0x44, 0xcc, 0xf8, 0x5d, 0x91, 0x52, 0x1d, 0x91,
+	0x52, 0x36, 0x8b, 0x6c, 0xc3, 0xb2, 0x24, 0xef, 0x55, 0xe9, 0xd5, 0x15, 0x52, 0x3e, 0x3b, 0xfe,
+	0x2a, 0x80, 0x65, 0x2f, 0x30, 0xc6, 0x93, 0xa0, 0xde, 0x93, 0xa6, 0xe3, 0x89, 0x93, 0xc4, 0xa1,
+	0xd8, 0x11, 0xc3, 0x78, 0x61, 0x3f, 0xad, 0xed, 0x8c, 0xff, 0x60, 0x6f, 0xc3, 0x6e, 0x91, 0x33,
+	0x9a, 0xb3, 0xf3, 0xd9, 0x46, 0x62, 0x68, 0x4e, 0xcd, 0x7a, 0xd2, 0x52, 0x9b, 0x45, 0xae, 0xc3,
+	0xe2, 0x40, 0xaa, 0xda, 0xb7, 0xf7, 0xc5, 0x65, 0x92, 0x6f, 0xc0, 0x8a, 0x62, 0x98, 0x08, 0xb6,
+	0xc4, 0x42, 0x13, 0xfc, 0xf8, 0xb7, 0x01, 0x10, 0x34, 0xf3, 0x93, 0x62, 0x48, 0x31, 0xfc, 0xbb,
+	0x45, 0x7e, 0x9c, 0x9d, 0x4c, 0x31, 0x70, 0x09, 0x9a, 0xc5, 0x58, 0xd8, 0xb5, 0x98, 0x34, 0x8b,
+	0x31, 0xd2, 0xd9, 0x50, 0xd8, 0xd0, 0x4b, 0x9a, 0xd9, 0x90, 0x10, 0x68, 0x61, 0x6f, 0x50, 0x8b,
+	0x89, 0xdf, 0xa8, 0xe9, 0x69, 0x3a, 0x3a, 0xa7, 0x22, 0x40, 0x8b, 0x89, 0x24, 0x64, 0x16, 0x64,
+	0x39, 0x7b, 0x58, 0x16, 0x3f, 0xa3, 0x79, 0xd4, 0x51, 0xae, 0x1a, 0x56, 0xfc, 0x03, 0x63, 0xd7,
+	0x0f, 0x0b, 0x4e, 0x65, 0x76, 0x4f, 0x69, 0x45, 0xb8, 0x46, 0xc1, 0xa9, 0xec, 0x14, 0xbd, 0x44,
+	0x12, 0xf1, 0x6f, 0x02, 0x58, 0xb3, 0x5d, 0x3b, 0x18, 0xaa, 0xe8, 0x6b, 0x33, 0x03, 0xcb, 0xcc,
+	0x4d, 0x80, 0x71, 0x59, 0x8c, 0x0b, 0x96, 0x8e, 0x0e, 0x86, 0xaa, 0x0a, 0x2c, 0x0e, 0x26, 0xd0,
+	0x93, 0xf3, 0x8c, 0x1f, 0x68, 0x77, 0x15, 0x65, 0x15, 0x54, 0xab, 0xbe, 0xa0, 0xda, 0x56, 0x00,
+	0xe3, 0xff, 0x06, 0xb0, 0xa2, 0x4d, 0xaa, 0xcc, 0x91, 0x51, 0x0c, 0xaa, 0x28, 0x1a, 0x95, 0xcd,
+	0x7a, 0x95, 0xa1, 0xbd, 0x27, 0x9b, 0x00, 0x3c, 0x2d, 0x4f, 0xa8, 0x28, 0x1e, 0x15, 0x79, 0x8b,
+	0xe3, 0x47, 0xba, 0x3d, 0x11, 0x69, 0xf2, 0xae, 0x8e, 0x5e, 0x47, 0x74, 0x9c, 0x37, 0xad, 0x8e,
+	0xe3, 0x46, 0x5f, 0x05, 0x16, 0xd3, 0xfe, 0xb8, 0x2c, 0xce, 0xc4, 0x82, 0x5d, 0x59, 0xde, 0x9a,
+	0xb6, 0x0a, 0x6d, 0xce, 0x2e, 0xb4, 0xf8, 0xcf, 0x01, 0x5c, 0x49, 0xe8, 0x80, 0x66, 0x63, 0xae,
+	0x15, 0xab, 0x54, 0xab, 0xdb, 0x8d, 0xdb, 0xd0, 0x19, 0x88, 0xd1, 0xa8, 0x59, 0x6b, 0x93, 0xc9,
+	0xd4, 0x44, 0x09, 0x92, 0x6f, 0x42, 0x6b, 0x5c, 0xd2, 0xa7, 0x22, 0x38, 0xf3, 0x3b, 0x57, 0xbd,
+	0x09, 0x3a, 0xd8, 0x89, 0x10, 0x22, 0xb7, 0xa1, 0x3b, 0x38, 0x2f, 0x4b, 0x9a, 0xf3, 0xa8, 0x35,
+	0x5b, 0x5e, 0xcb, 0xc5, 0xbf, 0x0f, 0xe0, 0x6d, 0xcf, 0x01, 0xb4, 0x02, 0xc5, 0x3e, 0x1b, 0x0f,
+	0x53, 0x4e, 0x9d, 0xb0, 0x04, 0x5e, 0x58, 0xde, 0x55, 0xd6, 0x49, 0x77, 0xde, 0xaa, 0x71, 0xc7,
+	0xb3, 0xf0, 0x5b, 0xc6, 0xc2, 0xf0, 0xf9, 0x73, 0x2a, 0x2b, 0xff, 0x13, 0xc0, 0x55, 0xcf, 0x4a,
+	0xb1, 0x7f, 0x45, 0x4e, 0x27, 0xf2, 0xac, 0xbe, 0xe7, 0xbb, 0xf9, 0x14, 0x4e, 0xe4, 0x13, 0x8e,
+	0x17, 0x3c, 0x1d, 0xa1, 0x6a, 0x9d, 0xf4, 0x16, 0x47, 0x9c, 0xdc, 0x48, 0xe1, 0xb2, 0x22, 0xdb,
+	0xda, 0x89, 0x61, 0x88, 0x8e, 0x59, 0x30, 0x2e, 0x06, 0x3b, 0x62, 0xb0, 0xa2, 0x49, 0x04, 0x5d,
+	0xcc, 0xaf, 0x84, 0x71, 0x95, 0x55, 0x9a, 0xc4, 0x35, 0x87, 0x45, 0x4e, 0xa5, 0xb3, 0x22, 0xb1,
+	0xda, 0x89, 0xc5, 0x89, 0xbf, 0x0c, 0xe0, 0x0d, 0xed, 0xee, 0x5e, 0x59, 0x9c, 0x8f, 0x5f, 0xa9,
+	0x8b, 0x55, 0x3d, 0x46, 0x16, 0x93, 0x24, 0x9e, 0x5f, 0x47, 0xf1, 0xdf, 0x7c, 0x2b, 0x5e, 0x4b,
+	0x7d, 0x6f, 0xc1, 0xbc, 0x89, 0xbe, 0xb6, 0xc9, 0x66, 0x5d, 0xa2, 0xc2, 0xed, 0xcc, 0xec, 0x4c,
+	0x2d, 0xd8, 0xae, 0x53, 0xb0, 0x7f, 0x0d, 0x60, 0xc3, 0xcb, 0x24, 0x3b, 0xb4, 0x75, 0x55, 0xbb,
+	0xe3, 0x55, 0xed, 0x86, 0x97, 0xb2, 0xd6, 0xfc, 0xaa, 0x6c, 0x6f, 0x39, 0x65, 0x5b, 0x3b, 0xc3,
+	0xa9, 0x8b, 0xf7, 0xfd, 0xca, 0x9d, 0x35, 0xa5, 0x2a, 0x8b, 0x53, 0x58, 0x4b, 0xe8, 0x93, 0xea,
+	0x38, 0x16, 0x15, 0x9e, 0x1f, 0x17, 0xd3, 0x13, 0x24, 0xd3, 0x67, 0x80, 0x7d, 0xac, 0x85, 0x96,
+	0xaf, 0x53, 0xfa, 0x7e, 0xbc, 0x0b, 0xeb, 0x09, 0x65, 0x63, 0x67, 0x29, 0xb9, 0x4d, 0x5f, 0x87,
+	0x30, 0x1b, 0xca, 0x83, 0x6b, 0x46, 0xbf, 0x41, 0x99, 0x78, 0x0f, 0xae, 0x4e, 0x28, 0x11, 0x7e,
+	0x31, 0x72, 0xd3, 0xd6, 0x32, 0xcb, 0x77, 0xa1, 0xe8, 0x17, 0x01, 0xac, 0xe2, 0xa0, 0x38, 0xef,
+	0x77, 0x0e, 0xd3, 0x2c, 0x3f, 0x4c, 0xc7, 0xd6, 0x96, 0x07, 0xd3, 0xc1, 0x90, 0x74, 0x7f, 0x2a,
+	0x18, 0x0a, 0x67, 0x82, 0xa1, 0x96, 0x0b, 0xfa, 0xe2, 0xfb, 0x40, 0x5c, 0x33, 0x44, 0xf4, 0x6f,
+	0x41, 0x3b, 0xe3, 0xf4, 0x4c, 0x7b, 0x13, 0x59, 0xde, 0x38, 0x06, 0x27, 0x52, 0x2c, 0xfe, 0x57,
+	0x08, 0x6f, 0x38, 0x31, 0x51, 0x05, 0x76, 0x1d, 0x16, 0x71, 0x25, 0x03, 0x76, 0x02, 0x81, 0xc5,
+	0x5c, 0x26, 0xc2, 0x4a, 0xc3, 0xb0, 0x11, 0x96, 0xcf, 0x9e, 0x52, 0x88, 0x26, 0x6a, 0x2d, 0x27,
+	0x6a, 0x31, 0x2c, 0x8c, 0x4b, 0x6a, 0x16, 0x97, 0x40, 0xd0, 0xe1, 0xb9, 0x91, 0xed, 0xf8, 0x30,
+	0x53, 0x6a, 0x40, 0x67, 0xa8, 0x42, 0xbb, 0x5a, 0x43, 0xc5, 0x43, 0x0d, 0xac, 0x12, 0x98, 0x93,
+	0x1a, 0x2a, 0x06, 0xc6, 0x9e, 0x5f, 0xec, 0x16, 0xe7, 0x39, 0x67, 0x51, 0x4f, 0x34, 0xb6, 0x8a,
+	0x96, 0x63, 0xf2, 0xe6, 0x14, 0x81, 0x04, 0xa9, 0x9a, 0xc6, 0x96, 0xcb, 0x2f, 0xe4, 0x1d, 0x6c,
+	0x5e, 0x5c, 0xb2, 0x34, 0x29, 0x90, 0x26, 0x86, 0xb9, 0xaf, 0xa7, 0x2e, 0xc8, 0x98, 0x3a, 0x4c,
+	0xb4, 0x5c, 0x31, 0xa4, 0x92, 0x45, 0xa1, 0xc4, 0xe1, 0x91, 0x9b, 0xb0, 0x9a, 0x17, 0xf9, 0xae,
+	0x80, 0xee, 0x7d, 0x6d, 0xe4, 0x92, 0x30, 0x72, 0x72, 0x20, 0xfe, 0xd8, 0xba, 0xd9, 0xc9, 0xa1,
+	0xbb, 0xe2, 0x12, 0x8b, 0xcd, 0xc5, 0xba, 0xb9, 0xb8, 0xb9, 0xef, 0x25, 0x44, 0x55, 0x8c, 0x4f,
+	0x21, 0xf2, 0x95, 0x9d, 0x9c, 0xbc, 0xbc, 0x3e, 0x72, 0x03, 0xda, 0x2c, 0x3b, 0xc9, 0x25, 0xce,
+	0x9c, 0xdf, 0x59, 0x51, 0x53, 0x8e, 0xb2, 0x93, 0x3c, 0xe5, 0xe7, 0x25, 0x4d, 0xe4, 0x70, 0x7c,
+	0x20, 0xd3, 0x5d, 0x2e, 0x89, 0xa3, 0x87, 0xec, 0x84, 0x91, 0x3b, 0xd0, 0x3a, 0x63, 0x27, 0x3a,
+	0xdb, 0xdf, 0xf1, 0xd7, 0xf3, 0x0c, 0x4c, 0x84, 0x70, 0xcc, 0x61, 0xad, 0x92, 0x38, 0xcc, 0x72,
+	0x5a, 0xbe, 0x82, 0xf9, 0xdb, 0xb0, 0x9c, 0xb1, 0x23, 0x3a, 0x3a, 0xae, 0xae, 0x23, 0xa2, 0x02,
+	0xe6, 0x12, 0x9f, 0x1d, 0xff, 0xa3, 0x6b, 0x5d, 0xac, 0xd4, 0x8a, 0x1f, 0x60, 0x77, 0x47, 0x13,
+	0xd5, 0x8a, 0xd7, 0xa6, 0x38, 0x20, 0xa4, 0xf7, 0x1b, 0x89, 0x92, 0x26, 0x77, 0xa0, 0x7d, 0x86,
+	0x86, 0xd7, 0xe0, 0x18, 0xdf, 0xab, 0xfd, 0x46, 0x22, 0x65, 0xc9, 0xf7, 0x60, 0x31, 0x65, 0x8c,
+	0xf2, 0x7e, 0x99, 0xe6, 0xec, 0x98, 0x96, 0xaa, 0xd9, 0x5f, 0x51, 0x93, 0xef, 0xe2, 0x18, 0xd3,
+	0x83, 0xfb, 0x8d, 0xc4, 0x95, 0xae, 0xa6, 0xff, 0x28, 0xe3, 0xa7, 0xc3, 0x32, 0xfd, 0x22, 0x6a,
+	0xd7, 0x4c, 0xd7, 0x83, 0xd5, 0x74, 0xcd, 0x20, 0x77, 0x60, 0x8e, 0xeb, 0x85, 0x3b, 0xb3, 0x17,
+	0xae, 0x04, 0x71, 0xd2, 0x17, 0x7a, 0xb9, 0xee, 0xec, 0xe5, 0x2a, 0x41, 0xf2, 0x00, 0x96, 0xb4,
+	0x82, 0x7e, 0xf1, 0xe0, 0x82, 0x0e, 0xa2, 0x39, 0x27, 0x4a, 0xee, 0x7a, 0x52, 0x64, 0xbf, 0x91,
+	0x78, 0x93, 0xc8, 0x87, 0x00, 0x79, 0x85, 0xa8, 0xa3, 0xde, 0x73, 0x30, 0xf3, 0x7e, 0x23, 0xb1,
+	0xc4, 0xc9, 0x43, 0x58, 0xce, 0xdd, 0xd3, 0x39, 0x82, 0x89, 0x9c, 0xf2, 0xce, 0xef, 0xfd, 0x46,
+	0xe2, 0x4f, 0x22, 0x0f, 0x60, 0x15, 0x5f, 0xa3, 0xee, 0x3a, 0xfb, 0x36, 0x3f, 0x3b, 0x7c, 0x93,
+	0x33, 0x1c, 0x35, 0xd5, 0xfe, 0x2d, 0xcc, 0x0e, 0xe8, 0xe4, 0x0c, 0x72, 0x57, 0x37, 0x30, 0x6d,
+	0xc9, 0xa2, 0x13, 0x95, 0x5d, 0x91, 0xd9, 0xf6, 0xc2, 0x98, 0x06, 0xce, 0x0c, 0xf2, 0x7d, 0xd5,
+	0xdd, 0xee, 0xd3, 0x51, 0xf6, 0x94, 0x96, 0xa2, 0x69, 0x99, 0x63, 0xca, 0x68, 0x50, 0xe3, 0xfb,
+	0x8d, 0xc4, 0x91, 0x27, 0xef, 0x43, 0x0f, 0x63, 0x74, 0x34, 0xc2, 0x9e, 0xbd, 0x2c, 0x26, 0xaf,
+	0x79, 0x21, 0x15, 0x63, 0xfb, 0x8d, 0xc4, 0x08, 0x92, 0x8f, 0xa0, 0x37, 0xd0, 0xad, 0x20, 0x5a,
+	0xd9, 0x0a, 0x2e, 0xd1, 0x2b, 0x50, 0x41, 0x35, 0x07, 0xe1, 0x0b, 0x7f, 0xa6, 0xb0, 0x65, 0x93,
+	0x3f, 0xbb, 0xd7, 0x55, 0x37, 0x70, 0x84, 0x74, 0xeb, 0x16, 0xa4, 0xb3, 0x34, 0x4d, 0x83, 0x73,
+	0x16, 0x4e, 0xbd, 0x5c, 0x8b, 0x79, 0xcf, 0x81, 0x73, 0x13, 0x2d, 0xc2, 0x79, 0x8e, 0x12, 0x92,
+	0xe4, 0x03, 0x1f, 0xd0, 0xcd, 0x9e, 0x54, 0x41, 0xba, 0x8f, 0x9d, 0xfb, 0xa4, 0xe9, 0x24, 0x2f,
+	0x75, 0x50, 0xfc, 0x33, 0x84, 0x35, 0x5f, 0x9b, 0xb8, 0x33, 0xb9, 0xb7, 0x9d, 0x60, 0xe2, 0xb6,
+	0x83, 0xe8, 0x1c, 0x29, 0x19, 0x46, 0x15, 0x74, 0x9b, 0x45, 0x6e, 0xc0, 0x12, 0xde, 0x70, 0x8e,
+	0xd2, 0x33, 0xaa, 0x84, 0x42, 0x21, 0xe4, 0x71, 0x0d, 0xe8, 0x68, 0xd5, 0x83, 0x8e, 0xb6, 0x0f,
+	0xd5, 0x0c, 0x1c, 0xe8, 0xcc, 0x82, 0x03, 0xdd, 0x19, 0x70, 0x60, 0xce, 0x83, 0x03, 0x0e, 0x4c,
+	0xe9, 0xf9, 0x30, 0xc5, 0x02, 0x0b, 0xf0, 0x1c, 0xb0, 0x30, 0x7f, 0x19, 0xb0, 0xb0, 0x50, 0x03,
+	0x16, 0x26, 0xa0, 0xdc, 0xe2, 0x25, 0xa1, 0xdc, 0x52, 0x2d, 0x94, 0x8b, 0x7f, 0x3a, 0x99, 0xf1,
+	0x09, 0x1d, 0x14, 0xe5, 0xf0, 0x75, 0x65, 0x7c, 0xfc, 0x35, 0x98, 0xaf, 0x86, 0xfb, 0x17, 0xb8,
+	0x61, 0x32, 0x2a, 0x4a, 0xb1, 0xa2, 0x24, 0xa4, 0x37, 0x37, 0x90, 0x3e, 0xee, 0xae, 0x0f, 0x37,
+	0x2f, 0xf3, 0x62, 0x19, 0xff, 0xbc, 0x09, 0xab, 0xce, 0xe5, 0xe0, 0xff, 0x2b, 0x4f, 0x7b, 0x2f,
+	0x9b, 0xa7, 0x3d, 0x93, 0xa7, 0xf1, 0x1e, 0xbc, 0xe1, 0x84, 0x40, 0x44, 0x13, 0x9b, 0x4f, 0x47,
+	0x58, 0xe3, 0x5f, 0x28, 0x26, 0xc2, 0x95, 0x28, 0x39, 0xd9, 0x44, 0xfc, 0x5d, 0x41, 0xcb, 0xea,
+	0xf7, 0x64, 0xe2, 0x82, 0xe4, 0x7c, 0xf2, 0xf8, 0x63, 0x13, 0x96, 0x0c, 0x68, 0x62, 0x8c, 0x8a,
+	0xb6, 0x8a, 0x17, 0x6d, 0x9d, 0x64, 0xf8, 0x5b, 0xb4, 0xe7, 0x42, 0xdf, 0x2e, 0x79, 0x81, 0x5b,
+	0x97, 0x55, 0x67, 0x99, 0x08, 0xfa, 0x5c, 0x62, 0x71, 0xac, 0x8c, 0x6a, 0x89, 0x15, 0x15, 0x85,
+	0xfc, 0xf4, 0x0c, 0x63, 0xa5, 0x43, 0x2e, 0x29, 0x5c, 0x93, 0x22, 0x90, 0x90, 0xd1, 0x16, 0xbf,
+	0x51, 0x96, 0x3d, 0x3b, 0x7b, 0x5c, 0x8c, 0xd4, 0xcb, 0x8a, 0xa2, 0xac, 0x6d, 0x03, 0x67, 0xdb,
+	0xc4, 0x0b, 0x32, 0x6e, 0x37, 0x46, 0x4b, 0x55, 0xd8, 0x15, 0x21, 0x31, 0xc1, 0x17, 0x2f, 0xa7,
+	0x69, 0x99, 0x2a, 0xa9, 0x75, 0x21, 0x65, 0x71, 0xb0, 0x6d, 0xb0, 0xf3, 0xc1, 0x80, 0x32, 0x16,
+	0x5d, 0x15, 0xce, 0x69, 0x52, 0x3f, 0xf1, 0x9a, 0x78, 0x89, 0x5b, 0xa1, 0xb6, 0x3f, 0xa8, 0xb5,
+	0xbf, 0x69, 0xdb, 0x1f, 0xff, 0xc4, 0x4b, 0x04, 0xa1, 0x65, 0xda, 0xf3, 0xfa, 0x6d, 0xe8, 0x08,
+	0x98, 0xa7, 0xe1, 0xfb, 0x9b, 0x7e, 0x75, 0x57, 0x36, 0x24, 0x4a, 0x30, 0xfe, 0x65, 0x00, 0x64,
+	0x12, 0x29, 0x88, 0x4e, 0x58, 0xf4, 0xad, 0x15, 0x34, 0x89, 0x19, 0x82, 0x0f, 0x2f, 0xbc, 0xf8,
+	0x9c, 0xe6, 0xfa, 0x0a, 0x5d, 0x31, 0xac, 0x2d, 0x0b, 0xfd, 0x2d, 0xcb, 0x0b, 0xae, 0x4b, 0x4a,
+	0xfc, 0x56, 0x69, 0xd2, 0xd6, 0x69, 0x12, 0xff, 0x3a, 0x80, 0xd5, 0x09, 0xc8, 0xe1, 0xb5, 0x1b,
+	0x93, 0x1c, 0xd7, 0xa0, 0x87, 0xc9, 0xd6, 0xb7, 0xde, 0xf7, 0x0c, 0xc3, 0xb5, 0x32, 0x9c, 0x6e,
+	0x65, 0xcb, 0xb1, 0xd2, 0xb7, 0xe8, 0x4f, 0x21, 0x5c, 0x15, 0xd7, 0x1c, 0x1b, 0x34, 0x99, 0xef,
+	0x70, 0x2f, 0x61, 0x97, 0x15, 0xd7, 0xd0, 0x8d, 0xab, 0x2e, 0xa4, 0xd6, 0x44, 0x21, 0x55, 0xf6,
+	0xb8, 0x5e, 0x75, 0x7c, 0xaf, 0x74, 0x5a, 0x75, 0x6b, 0xd3, 0x6a, 0xce, 0x2f, 0x0b, 0x15, 0x81,
+	0x9e, 0x13, 0x01, 0xf3, 0xe8, 0x03, 0xce, 0xcb, 0x9d, 0x29, 0xa3, 0x79, 0xff, 0x69, 0x80, 0x51,
+	0x5e, 0x75, 0x7a, 0x81, 0x62, 0xc3, 0xc4, 0xe1, 0xe1, 0x89, 0x37, 0x94, 0x9b, 0xd9, 0xbf, 0xb0,
+	0x4f, 0x3c, 0x87, 0x69, 0x49, 0x39, 0xe7, 0x9d, 0xcb, 0xd4, 0xef, 0xa4, 0x4a, 0x64, 0x59, 0x96,
+	0xa2, 0xe1, 0x60, 0x3a, 0x47, 0xd6, 0x71, 0xe8, 0x6c, 0x1c, 0xd9, 0x51, 0xd0, 0x4d, 0xa2, 0xa6,
+	0x4d, 0xab, 0x38, 0x6a, 0x36, 0x58, 0x81, 0xb7, 0xef, 0x18, 0xf0, 0xd6, 0xbc, 0xd4, 0xb4, 0x0a,
+	0xbe, 0x7d, 0x19, 0xc0, 0xa2, 0x03, 0x82, 0xa7, 0x14, 0xad, 0x3e, 0xa6, 0x9b, 0xee, 0xdb, 0x5b,
+	0x49, 0x53, 0x56, 0xe4, 0xea, 0x88, 0x52, 0x14, 0x79, 0x4f, 0x23, 0xe7, 0xfe, 0x05, 0x3e, 0xcb,
+	0x61, 0x8d, 0x13, 0x65, 0x4f, 0xdf, 0x7c, 0x0e, 0x4f, 0x8c, 0x50, 0xfc, 0xc4, 0xbc, 0xd8, 0x1e,
+	0x66, 0x1a, 0x0c, 0xbf, 0xe8, 0x37, 0xc4, 0xea, 0xbd, 0x38, 0xb4, 0xbf, 0x49, 0xad, 0xe3, 0x75,
+	0x59, 0x9c, 0x6c, 0x68, 0x49, 0x98, 0x28, 0x2a, 0xfe, 0x55, 0xd3, 0x81, 0x9a, 0xaf, 0xd3, 0xff,
+	0x4d, 0x80, 0x63, 0xf1, 0xe0, 0x6b, 0x7f, 0x0a, 0x32, 0x1c, 0x1c, 0x67, 0xb8, 0xd4, 0x2e, 0x3e,
+	0x0d, 0xeb, 0xaf, 0xd3, 0x86, 0x83, 0x20, 0x01, 0x43, 0xf4, 0x94, 0x4a, 0x01, 0xf5, 0x51, 0xce,
+	0x62, 0xe1, 0xa1, 0x5c, 0xa2, 0xed, 0x78, 0x1b, 0x52, 0x5f, 0x7e, 0x34, 0x2d, 0xc7, 0xc6, 0x45,
+	0xc9, 0x69, 0xa9, 0x0f, 0x6c, 0x4d, 0x5b, 0xa1, 0xeb, 0x39, 0x60, 0xe6, 0xef, 0xea, 0x7b, 0xd8,
+	0xa3, 0x62, 0x90, 0x8e, 0xee, 0x3f, 0x16, 0xc8, 0x6d, 0xea, 0xf3, 0xa4, 0xfd, 0xc0, 0xd8, 0xf4,
+	0x3e, 0x9e, 0x3f, 0xef, 0x71, 0xf2, 0x06, 0x2c, 0x8d, 0x53, 0x4c, 0xbc, 0x43, 0xfb, 0x89, 0x72,
+	0x21, 0xf1, 0xb8, 0xd5, 0x09, 0xdf, 0xcf, 0xce, 0xa8, 0x8a, 0x90, 0x61, 0x90, 0xeb, 0x10, 0xf2,
+	0x0b, 0xf9, 0xcd, 0xba, 0x3e, 0xb5, 0x70, 0x38, 0xfe, 0x8b, 0xfa, 0xee, 0x68, 0x3b, 0x25, 0x4e,
+	0xb6, 0xcb, 0x3a, 0xd6, 0x7b, 0x65, 0xc7, 0x7a, 0x2f, 0xe8, 0xd8, 0x8a, 0x71, 0xac, 0x27, 0x9c,
+	0xd8, 0xf9, 0x2a, 0x84, 0x5e, 0xf5, 0xdf, 0x12, 0xf2, 0x11, 0xcc, 0xed, 0x51, 0x2e, 0x5b, 0xf1,
+	0x4a, 0x85, 0xaa, 0x9e, 0x1c, 0xf1, 0x32, 0xcb, 0x4f, 0x36, 0x6a, 0xae, 0xa7, 0xce, 0xe7, 0xee,
+	0xb8, 0x41, 0xbe, 0x0b, 0xf0, 0x28, 0x63, 0x5c, 0x01, 0xb5, 0x45, 0xa3, 0xe2, 0x93, 0x6c, 0xb4,
+	0xb1, 0x51, 0x87, 0xd3, 0xa4, 0x68, 0xdc, 0x20, 0x9f, 0x02, 0xd9, 0xa3, 0x02, 0x70, 0xd8, 0xa0,
+	0x79, 0xd3, 0xa8, 0xa8, 0x03, 0xd5, 0x1b, 0x53, 0xb1, 0x5f, 0xdc, 0x20, 0x47, 0xb0, 0xa4, 0xbd,
+	0xb9, 0xa4, 0xb6, 0x77, 0x66, 0xde, 0x49, 0xd9, 0x38, 0x6e, 0x90, 0x0f, 0x61, 0x65, 0x8f, 0x72,
+	0x89, 0x13, 0xf4, 0x8d, 0x67, 0xc9, 0xa8, 0xc5, 0x4d, 0xd8, 0xb8, 0x52, 0x8b, 0x38, 0xe2, 0x06,
+	0xb9, 0x09, 0x9d, 0x03, 0x76, 0xf4, 0x2c, 0x1f, 0xf8, 0xa1, 0x59, 0x55, 0xe4, 0x01, 0xdb, 0x4d,
+	0xcf, 0x4f, 0x4e, 0xf9, 0x67, 0xe3, 0xb8, 0xf1, 0xb8, 0x23, 0xfe, 0xb0, 0x73, 0xe7, 0x7f, 0x03,
+	0x00, 0xe5, 0xd9, 0x07, 0x90, 0xfd, 0x23, 0x00, 0x00,
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -2957,6 +4122,29 @@ type ParacrossServer interface {
 	IsSync(context.Context, *types.ReqNil) (*types.IsCaughtUp, error)
 }
 
+// UnimplementedParacrossServer can be embedded to have forward compatible implementations.
+type UnimplementedParacrossServer struct {
+}
+
+func (*UnimplementedParacrossServer) GetTitle(ctx context.Context, req *types.ReqString) (*ParacrossConsensusStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTitle not implemented")
+}
+func (*UnimplementedParacrossServer) ListTitles(ctx context.Context, req *types.ReqNil) (*RespParacrossTitles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTitles not implemented")
+}
+func (*UnimplementedParacrossServer) GetDoneTitleHeight(ctx context.Context, req *ReqParacrossTitleHeight) (*RespParacrossDone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoneTitleHeight not implemented")
+}
+func (*UnimplementedParacrossServer) GetTitleHeight(ctx context.Context, req *ReqParacrossTitleHeight) (*ParacrossHeightStatusRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTitleHeight not implemented")
+}
+func (*UnimplementedParacrossServer) GetAssetTxResult(ctx context.Context, req *types.ReqHash) (*ParacrossAsset, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetTxResult not implemented")
+}
+func (*UnimplementedParacrossServer) IsSync(ctx context.Context, req *types.ReqNil) (*types.IsCaughtUp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSync not implemented")
+}
+
 func RegisterParacrossServer(s *grpc.Server, srv ParacrossServer) {
 	s.RegisterService(&_Paracross_serviceDesc, srv)
 }
@@ -3101,134 +4289,3 @@ var _Paracross_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "paracross.proto",
 }
-
-func init() { proto.RegisterFile("paracross.proto", fileDescriptor_paracross_dcd1b923b8f04760) }
-
-var fileDescriptor_paracross_dcd1b923b8f04760 = []byte{
-	// 1988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xbd, 0x73, 0x24, 0x47,
-	0x15, 0xdf, 0xd9, 0x4f, 0xed, 0x93, 0xb4, 0x27, 0xb5, 0x4f, 0xba, 0x65, 0x6d, 0x9f, 0xb7, 0xa6,
-	0x0e, 0x97, 0x80, 0x2b, 0x19, 0xeb, 0xc0, 0x14, 0xe5, 0xa2, 0xe0, 0x4e, 0xe7, 0xd3, 0xaa, 0x7c,
-	0xe7, 0x72, 0xb5, 0x64, 0x08, 0x48, 0x18, 0xed, 0xb6, 0xa4, 0x29, 0x76, 0x67, 0xe6, 0x66, 0x7a,
-	0x6d, 0x89, 0x8c, 0xa2, 0xc8, 0x89, 0xa8, 0x32, 0x29, 0xc4, 0xfc, 0x09, 0x04, 0x84, 0x38, 0x23,
-	0x20, 0x27, 0x23, 0x27, 0x20, 0xa5, 0xde, 0xeb, 0x9e, 0x99, 0xee, 0xde, 0xd9, 0x3d, 0xf9, 0xee,
-	0x12, 0x67, 0xfb, 0x5e, 0xbf, 0x7e, 0xfd, 0xde, 0xaf, 0xdf, 0x57, 0xcf, 0xc2, 0xad, 0x24, 0x48,
-	0x83, 0x71, 0x1a, 0x67, 0xd9, 0x7e, 0x92, 0xc6, 0x32, 0x66, 0x2d, 0x79, 0x9d, 0x88, 0x6c, 0xb0,
-	0x2d, 0xd3, 0x20, 0xca, 0x82, 0xb1, 0x0c, 0xe3, 0x48, 0xad, 0x0c, 0x36, 0xc6, 0xf1, 0x6c, 0x56,
-	0x50, 0x5b, 0x67, 0xd3, 0x78, 0xfc, 0xeb, 0xf1, 0x65, 0x10, 0x6a, 0x8e, 0xff, 0x14, 0x76, 0x3f,
-	0xcd, 0x95, 0x9d, 0xc8, 0x40, 0xce, 0xb3, 0xc7, 0x42, 0x06, 0xe1, 0x34, 0x63, 0xb7, 0xa1, 0x15,
-	0x4c, 0x26, 0x69, 0xd6, 0xf7, 0x86, 0x8d, 0xbd, 0x2e, 0x57, 0x04, 0x7b, 0x0b, 0xba, 0xa4, 0x63,
-	0x14, 0x64, 0x97, 0xfd, 0xfa, 0xb0, 0xb1, 0xb7, 0xc1, 0x4b, 0x86, 0xff, 0x4b, 0x78, 0xd3, 0xd1,
-	0xf6, 0x08, 0xd7, 0x72, 0x95, 0x77, 0x01, 0x0a, 0x59, 0xa5, 0x77, 0x83, 0x1b, 0x1c, 0x54, 0x2e,
-	0xaf, 0xb8, 0xc8, 0xe6, 0x53, 0x99, 0xe5, 0xca, 0x0b, 0x86, 0xff, 0xa7, 0x3a, 0xec, 0x14, 0xda,
-	0x47, 0x22, 0xbc, 0xb8, 0x94, 0xea, 0x0c, 0xb6, 0x0b, 0xed, 0x8c, 0x7e, 0xf5, 0xbd, 0xa1, 0xb7,
-	0xd7, 0xe2, 0x9a, 0x42, 0x17, 0x64, 0x28, 0xa7, 0xa2, 0x5f, 0x1f, 0x7a, 0xe8, 0x02, 0x11, 0x28,
-	0x7d, 0x49, 0xbb, 0xfb, 0x8d, 0xa1, 0xb7, 0xd7, 0xe0, 0x9a, 0x62, 0x3f, 0x82, 0xce, 0x44, 0x19,
-	0xda, 0x6f, 0x0e, 0xbd, 0xbd, 0xf5, 0x83, 0xb7, 0xf7, 0x09, 0xd6, 0xfd, 0x6a, 0x80, 0x78, 0x2e,
-	0x8d, 0x6e, 0xcd, 0x82, 0x30, 0x52, 0x26, 0xf5, 0x5b, 0xa4, 0xd4, 0xe0, 0xb0, 0x01, 0xac, 0x11,
-	0x85, 0x90, 0xb5, 0x87, 0xde, 0xde, 0x06, 0x2f, 0x68, 0xf6, 0x04, 0x36, 0xce, 0x0c, 0x88, 0xfa,
-	0x1d, 0x3a, 0xd9, 0xaf, 0x3e, 0xd9, 0x04, 0x93, 0x5b, 0xfb, 0xfc, 0xff, 0x78, 0xd0, 0xaf, 0x04,
-	0x87, 0x67, 0xc9, 0x6b, 0xc2, 0xc7, 0x76, 0xb3, 0xb9, 0xd2, 0xcd, 0x16, 0x29, 0x2c, 0xdd, 0x1c,
-	0xc2, 0x3a, 0x06, 0x62, 0x28, 0x1f, 0x52, 0x48, 0xb5, 0x29, 0xa4, 0x4c, 0x16, 0xdb, 0x83, 0x5b,
-	0x8a, 0x7c, 0x54, 0x84, 0x57, 0x87, 0xa4, 0x5c, 0xb6, 0xff, 0xa5, 0x07, 0xb7, 0x1c, 0x60, 0x4a,
-	0x4f, 0xbc, 0x6a, 0x4f, 0xea, 0x96, 0x27, 0x56, 0x10, 0x37, 0xe8, 0x46, 0x4a, 0xc6, 0xd7, 0xf6,
-	0xd3, 0xb8, 0x4e, 0xff, 0x2f, 0xe6, 0x35, 0x1c, 0xc6, 0x51, 0x26, 0xa2, 0x6c, 0xbe, 0xda, 0x48,
-	0x84, 0xe6, 0xb2, 0x3c, 0x4f, 0x59, 0x6a, 0xb2, 0xd8, 0x3d, 0xd8, 0x1c, 0x2b, 0x55, 0x23, 0xf3,
-	0x5e, 0x6c, 0x26, 0xfb, 0x2e, 0x6c, 0x69, 0x46, 0x89, 0x60, 0x93, 0x0e, 0x5a, 0xe0, 0xfb, 0x7f,
-	0xf4, 0x80, 0xa1, 0x99, 0x9f, 0xc4, 0x13, 0x81, 0xf0, 0x1f, 0xc6, 0xd1, 0x79, 0x78, 0xb1, 0xc4,
-	0xc0, 0x1e, 0xd4, 0xe3, 0x84, 0xec, 0xda, 0xe4, 0xf5, 0x38, 0x41, 0x3a, 0x9c, 0x90, 0x0d, 0x5d,
-	0x5e, 0x0f, 0x27, 0x8c, 0x41, 0x13, 0x6b, 0x83, 0x3e, 0x8c, 0x7e, 0xa3, 0xa6, 0xcf, 0x83, 0xe9,
-	0x5c, 0x10, 0x40, 0x9b, 0x5c, 0x11, 0x2a, 0x0a, 0xc2, 0x28, 0x7b, 0x92, 0xc6, 0xbf, 0x11, 0x11,
-	0xe5, 0x02, 0xba, 0x5a, 0xb2, 0xfc, 0x9f, 0x95, 0x76, 0xfd, 0x3c, 0x96, 0x42, 0x45, 0xf7, 0x92,
-	0x52, 0x84, 0x67, 0xc4, 0x52, 0xa8, 0x4a, 0xd1, 0xe5, 0x8a, 0xf0, 0xff, 0xe0, 0xc1, 0x6d, 0xd3,
-	0xb5, 0xe3, 0x89, 0x46, 0x3f, 0x37, 0xd3, 0x33, 0xcc, 0xbc, 0x0b, 0x90, 0xa4, 0x71, 0x12, 0x67,
-	0xc1, 0xf4, 0x78, 0xa2, 0xb3, 0xc0, 0xe0, 0x60, 0x00, 0x3d, 0x9f, 0x87, 0xf2, 0x38, 0x77, 0x57,
-	0x53, 0x46, 0x42, 0x35, 0xab, 0x13, 0xaa, 0x65, 0x00, 0xe8, 0xff, 0xcf, 0x83, 0xad, 0xdc, 0xa4,
-	0xc2, 0x1c, 0x85, 0xa2, 0x57, 0xa0, 0x58, 0xaa, 0xac, 0x57, 0xab, 0x6c, 0x98, 0x77, 0x72, 0x17,
-	0x40, 0x06, 0xe9, 0x85, 0xa0, 0xe4, 0xd1, 0xc8, 0x1b, 0x1c, 0x17, 0xe9, 0xd6, 0x02, 0xd2, 0xec,
-	0xbd, 0x1c, 0xbd, 0x36, 0x55, 0x9c, 0x6f, 0x19, 0x15, 0xc7, 0x46, 0x5f, 0x03, 0x8b, 0x61, 0x7f,
-	0x9e, 0xc6, 0x33, 0x3a, 0xb0, 0xa3, 0xd2, 0x3b, 0xa7, 0x8d, 0x44, 0x5b, 0x33, 0x13, 0xcd, 0xff,
-	0x9b, 0x07, 0x3b, 0x5c, 0x8c, 0x45, 0x98, 0xc8, 0x5c, 0xb1, 0x0e, 0xb5, 0xaa, 0xdb, 0x78, 0x1f,
-	0xda, 0x63, 0x5a, 0x25, 0x08, 0x16, 0x6d, 0x2a, 0x23, 0x95, 0x6b, 0x41, 0xf6, 0x3d, 0x68, 0x26,
-	0xa9, 0xf8, 0x9c, 0xc0, 0x59, 0x3f, 0xb8, 0xe3, 0x6c, 0xc8, 0xc1, 0xe6, 0x24, 0xc4, 0xde, 0x87,
-	0xce, 0x78, 0x9e, 0xa6, 0x22, 0x92, 0xba, 0xc0, 0x2f, 0x95, 0xcf, 0xe5, 0xfc, 0x3f, 0x7b, 0xf0,
-	0xb6, 0xe3, 0x00, 0x5a, 0x81, 0x62, 0x9f, 0x25, 0x93, 0x40, 0x0a, 0x0b, 0x16, 0xcf, 0x81, 0xe5,
-	0x3d, 0x6d, 0x9d, 0x72, 0xe7, 0xcd, 0x0a, 0x77, 0x1c, 0x0b, 0x7f, 0x58, 0x5a, 0xd8, 0x78, 0xf1,
-	0x9e, 0xc2, 0xca, 0xff, 0x7a, 0x70, 0xc7, 0xb1, 0x92, 0xee, 0x2f, 0x8e, 0xc4, 0x42, 0x9c, 0x55,
-	0xd7, 0x7c, 0x3b, 0x9e, 0x1a, 0x0b, 0xf1, 0x84, 0xeb, 0xb1, 0x0c, 0xa6, 0xa8, 0x3a, 0x0f, 0x7a,
-	0x83, 0x43, 0x9d, 0x1b, 0x29, 0x3c, 0x96, 0xa2, 0xad, 0xc5, 0x4b, 0x06, 0x55, 0xcc, 0x38, 0x93,
-	0xb4, 0xd8, 0xa6, 0xc5, 0x82, 0x66, 0x7d, 0xe8, 0x60, 0x7c, 0xf1, 0x4c, 0xea, 0xa8, 0xca, 0x49,
-	0x3c, 0x73, 0x12, 0x47, 0x42, 0x39, 0x4b, 0x81, 0xd5, 0xe2, 0x06, 0xc7, 0xff, 0x9d, 0x07, 0x6f,
-	0xe4, 0xee, 0x1e, 0xa5, 0xf1, 0x3c, 0x79, 0xa5, 0x2a, 0x56, 0xd4, 0x18, 0x95, 0x4c, 0xba, 0xc6,
-	0xbc, 0x30, 0x8f, 0xfc, 0xaf, 0x5c, 0x2b, 0x5e, 0x4b, 0x7e, 0x0f, 0x61, 0xbd, 0x44, 0x3f, 0xb7,
-	0xc9, 0x64, 0xdd, 0x20, 0xc3, 0xcd, 0xc8, 0x6c, 0x2f, 0x4d, 0xd8, 0x8e, 0x95, 0xb0, 0xff, 0xf0,
-	0x60, 0xe0, 0x44, 0x92, 0x09, 0x6d, 0x55, 0xd6, 0x1e, 0x38, 0x59, 0x3b, 0x70, 0x42, 0xd6, 0xd8,
-	0x5f, 0xa4, 0xed, 0xbe, 0x95, 0xb6, 0x95, 0x3b, 0xac, 0xbc, 0xf8, 0x81, 0x9b, 0xb9, 0xab, 0xb6,
-	0x14, 0x69, 0x71, 0x09, 0xb7, 0xb9, 0x78, 0x5e, 0xb4, 0x63, 0xca, 0xf0, 0xe8, 0x3c, 0x5e, 0x1e,
-	0x20, 0x61, 0xde, 0x03, 0xcc, 0xb6, 0xd6, 0x30, 0x7c, 0x5d, 0x52, 0xf7, 0xfd, 0x43, 0xd8, 0xe5,
-	0x22, 0x4b, 0xac, 0xa3, 0xd4, 0x35, 0x7d, 0x07, 0x1a, 0xe1, 0x44, 0x35, 0xae, 0x15, 0xf5, 0x06,
-	0x65, 0xfc, 0x23, 0x4c, 0x62, 0x47, 0x09, 0xf9, 0x95, 0xb1, 0xfb, 0xa6, 0x96, 0x55, 0xbe, 0x93,
-	0xa2, 0xdf, 0x7b, 0xb0, 0x8d, 0x8b, 0xd4, 0xef, 0x0f, 0x9e, 0x05, 0x61, 0xf4, 0x2c, 0x48, 0x8c,
-	0x2b, 0xf7, 0x96, 0x0f, 0x43, 0xca, 0xfd, 0xa5, 0xc3, 0x50, 0x63, 0xe5, 0x30, 0xd4, 0xb4, 0x87,
-	0x3e, 0xff, 0xb1, 0x6a, 0xe6, 0xa5, 0x19, 0x84, 0xfe, 0x3e, 0xb4, 0x42, 0x29, 0x66, 0xb9, 0x37,
-	0x7d, 0xc3, 0x1b, 0xcb, 0x60, 0xae, 0xc4, 0xfc, 0x7f, 0x37, 0x54, 0x82, 0x15, 0x98, 0xe8, 0x04,
-	0xbb, 0x07, 0x9b, 0x78, 0x52, 0x39, 0xec, 0x78, 0x34, 0x8b, 0xd9, 0x4c, 0x1c, 0x2b, 0x4b, 0x86,
-	0x39, 0x61, 0xb9, 0xec, 0x25, 0x89, 0x58, 0xa2, 0xd6, 0xb4, 0x50, 0xf3, 0x61, 0x23, 0x49, 0x45,
-	0x79, 0xb8, 0x1a, 0x04, 0x2d, 0x9e, 0x8d, 0x6c, 0xdb, 0x1d, 0x33, 0x95, 0x06, 0x74, 0x46, 0xe8,
-	0x69, 0x37, 0xd7, 0x50, 0xf0, 0x50, 0x43, 0x56, 0x08, 0xac, 0x29, 0x0d, 0x05, 0x03, 0xb1, 0x97,
-	0x57, 0x87, 0xf1, 0x3c, 0x92, 0x59, 0xbf, 0x4b, 0x85, 0xad, 0xa0, 0xd5, 0x9a, 0x7a, 0x39, 0xf5,
-	0x41, 0x0d, 0xa9, 0x39, 0x8d, 0x25, 0x57, 0x5e, 0xa9, 0x37, 0xd8, 0x3a, 0x3d, 0xb2, 0x72, 0x92,
-	0x26, 0x4d, 0x84, 0xf9, 0x34, 0xdf, 0xba, 0xa1, 0x30, 0xb5, 0x98, 0x68, 0xb9, 0x66, 0x28, 0x25,
-	0x9b, 0xa4, 0xc4, 0xe2, 0xb1, 0xfb, 0xb0, 0x1d, 0xc5, 0xd1, 0x21, 0x8d, 0xee, 0xa7, 0xb9, 0x91,
-	0x3d, 0x32, 0x72, 0x71, 0xc1, 0xff, 0xd8, 0x78, 0xd9, 0xa9, 0xa5, 0x87, 0xf4, 0x88, 0xc5, 0xe2,
-	0x62, 0xbc, 0x5c, 0xec, 0xd8, 0x77, 0x02, 0xa2, 0x48, 0x46, 0xa9, 0x06, 0x40, 0x5a, 0x7e, 0x16,
-	0x46, 0x22, 0x7d, 0x79, 0x5d, 0x18, 0x3e, 0x61, 0x76, 0x22, 0xa6, 0xe7, 0xc5, 0x2c, 0x4f, 0xe1,
-	0xb3, 0xc6, 0x5d, 0xb6, 0xff, 0x55, 0xd3, 0x78, 0x95, 0xe8, 0x13, 0x3f, 0xc0, 0xd2, 0x88, 0xde,
-	0xe8, 0x13, 0xdf, 0x72, 0x4f, 0x34, 0x7d, 0x1d, 0xd5, 0xb8, 0x96, 0x66, 0x0f, 0xa0, 0x35, 0x43,
-	0xc3, 0x2b, 0x86, 0x00, 0xd7, 0xab, 0x51, 0x8d, 0x2b, 0x59, 0xf6, 0x13, 0xd8, 0x0c, 0xb2, 0x4c,
-	0xc8, 0xd3, 0x34, 0x88, 0xb2, 0x73, 0x91, 0xea, 0x4a, 0xb9, 0xa3, 0x37, 0x3f, 0xc4, 0xb5, 0x2c,
-	0x5f, 0x1c, 0xd5, 0xb8, 0x2d, 0x5d, 0x6c, 0xff, 0x45, 0x28, 0x2f, 0x27, 0x69, 0xf0, 0x05, 0x45,
-	0xb4, 0xbb, 0x3d, 0x5f, 0x2c, 0xb6, 0xe7, 0x0c, 0xf6, 0x00, 0xd6, 0x64, 0x7e, 0x70, 0x7b, 0xf5,
-	0xc1, 0x85, 0x20, 0x6e, 0xfa, 0x22, 0x3f, 0xae, 0xb3, 0xfa, 0xb8, 0x42, 0x90, 0x7d, 0x04, 0xbd,
-	0x5c, 0xc1, 0x69, 0xfc, 0xd1, 0x95, 0x18, 0x53, 0x62, 0x94, 0x28, 0xd9, 0xe7, 0x29, 0x91, 0x51,
-	0x8d, 0x3b, 0x9b, 0xd8, 0x87, 0x00, 0x51, 0x31, 0x8e, 0x52, 0xfa, 0xac, 0x1a, 0x38, 0x47, 0x35,
-	0x6e, 0x88, 0xb3, 0x27, 0x70, 0x2b, 0xb2, 0x5b, 0x1b, 0x25, 0xd9, 0xca, 0xe6, 0x37, 0xaa, 0x71,
-	0x77, 0x13, 0xf6, 0x1c, 0x79, 0xad, 0x07, 0x82, 0xba, 0xbc, 0x7e, 0xd4, 0xd1, 0xcf, 0x26, 0xec,
-	0xc3, 0xbb, 0x46, 0x1f, 0x36, 0x82, 0x65, 0x59, 0x0f, 0x36, 0x86, 0x8b, 0x9b, 0x85, 0xf6, 0xf7,
-	0xad, 0x1e, 0xbc, 0x10, 0x9a, 0xd6, 0x37, 0x04, 0xd5, 0x85, 0x3f, 0x70, 0xbb, 0xf0, 0xea, 0x4d,
-	0x45, 0x1f, 0xfe, 0xd8, 0x7a, 0x04, 0x94, 0x11, 0xfc, 0x52, 0xd9, 0xfd, 0xaf, 0x06, 0x76, 0x75,
-	0x5b, 0x1b, 0x0d, 0xba, 0xf6, 0x88, 0xea, 0x2d, 0x8c, 0xa8, 0x38, 0x52, 0x21, 0xa5, 0x60, 0xd4,
-	0xa0, 0x9b, 0x2c, 0xf6, 0x2e, 0xf4, 0x70, 0x2c, 0x3d, 0x09, 0x66, 0x42, 0x0b, 0x35, 0x48, 0xc8,
-	0xe1, 0x96, 0x9d, 0xa2, 0x59, 0xdd, 0x29, 0x5a, 0x6e, 0x7f, 0x2d, 0x6b, 0x78, 0x7b, 0x55, 0x0d,
-	0xef, 0xac, 0xa8, 0xe1, 0x6b, 0x4e, 0x0d, 0xb7, 0x7a, 0x4b, 0xd7, 0xed, 0x2d, 0x46, 0x85, 0x87,
-	0x17, 0x54, 0xf8, 0xf5, 0x9b, 0x54, 0xf8, 0x8d, 0x8a, 0x0a, 0xbf, 0xd0, 0x7f, 0x37, 0x6f, 0xd8,
-	0x7f, 0x7b, 0x95, 0xfd, 0xd7, 0xff, 0xd5, 0x62, 0xc4, 0x73, 0x31, 0x8e, 0xd3, 0xc9, 0xeb, 0x8a,
-	0x78, 0xff, 0xdb, 0xb0, 0x5e, 0x2c, 0x9f, 0x5e, 0xe1, 0x85, 0x29, 0x54, 0xb4, 0x62, 0x4d, 0xa9,
-	0x39, 0xac, 0x1c, 0x1b, 0x4f, 0xf1, 0x76, 0xdd, 0x19, 0xe1, 0x26, 0x9f, 0x99, 0xfc, 0xdf, 0xd6,
-	0x61, 0xdb, 0x9a, 0xe8, 0xbe, 0x59, 0x71, 0xda, 0x7d, 0xd9, 0x38, 0xed, 0x96, 0x71, 0xea, 0x1f,
-	0xc1, 0x1b, 0x16, 0x04, 0x84, 0x26, 0x16, 0x9f, 0x36, 0x59, 0xe3, 0x4e, 0x81, 0x0b, 0x70, 0x71,
-	0x2d, 0xa7, 0x8a, 0x88, 0x7b, 0x2b, 0x68, 0x59, 0xf5, 0x9d, 0x2c, 0x4c, 0xb5, 0xd6, 0x77, 0xea,
-	0xbf, 0xd6, 0xa1, 0x57, 0x36, 0x6b, 0x6c, 0x18, 0x18, 0x64, 0xf8, 0x3a, 0xca, 0x83, 0x0c, 0x7f,
-	0x53, 0x79, 0x8e, 0xf3, 0x27, 0x81, 0x8c, 0xf1, 0xea, 0xc2, 0xa2, 0x29, 0x11, 0xe8, 0x6b, 0xdc,
-	0xe0, 0x18, 0x11, 0xd5, 0xa4, 0x13, 0x35, 0x85, 0xfc, 0x60, 0x86, 0x58, 0xe5, 0x90, 0x2b, 0x0a,
-	0xcf, 0x14, 0xd8, 0xc0, 0x14, 0xda, 0xf4, 0x9b, 0x9e, 0x18, 0xd7, 0xb3, 0xb3, 0x78, 0xaa, 0x9f,
-	0xc3, 0x9a, 0x32, 0xae, 0x0d, 0xac, 0x6b, 0xa3, 0xcf, 0x7e, 0x78, 0xdd, 0x88, 0x96, 0xce, 0xb0,
-	0x1d, 0x92, 0x58, 0xe0, 0xd3, 0xe7, 0xae, 0x20, 0x0d, 0xb4, 0xd4, 0xae, 0x1a, 0xe6, 0x4b, 0x0e,
-	0x96, 0x8d, 0x6c, 0x3e, 0x1e, 0x8b, 0x2c, 0xeb, 0xdf, 0x21, 0xe7, 0x72, 0xd2, 0xff, 0xa7, 0xfe,
-	0x84, 0xf5, 0x34, 0x1e, 0x07, 0xd3, 0xc7, 0x67, 0x94, 0xb7, 0x4b, 0x5f, 0x14, 0xe6, 0x9b, 0xa0,
-	0xee, 0x7c, 0xef, 0x7e, 0xd1, 0x7b, 0xe2, 0x5d, 0xe8, 0x25, 0x01, 0x76, 0x8d, 0x67, 0xe6, 0xab,
-	0x62, 0x83, 0x3b, 0xdc, 0xe2, 0x7e, 0x4f, 0xc3, 0x99, 0xd0, 0xa8, 0x96, 0x0c, 0x76, 0x0f, 0x1a,
-	0xf2, 0x4a, 0x7d, 0x66, 0x5e, 0x3f, 0x60, 0x3a, 0xb6, 0x4e, 0xcb, 0x3f, 0x47, 0x38, 0x2e, 0xfb,
-	0x7f, 0xd7, 0x9f, 0x0a, 0x4d, 0xa7, 0xe8, 0x89, 0x72, 0x53, 0xc7, 0xba, 0xaf, 0xec, 0x58, 0xf7,
-	0x6b, 0x3a, 0xb6, 0x55, 0x3a, 0xd6, 0x25, 0x27, 0x0e, 0xbe, 0x6c, 0x40, 0xb7, 0xf8, 0x3b, 0x88,
-	0xfd, 0x14, 0xd6, 0x8e, 0x84, 0xa4, 0xe4, 0x60, 0x5b, 0x45, 0x4e, 0x3d, 0x3f, 0x91, 0x69, 0x18,
-	0x5d, 0x0c, 0xde, 0x59, 0x9c, 0x3f, 0xad, 0x2f, 0xd4, 0x7e, 0x8d, 0xfd, 0x18, 0xe0, 0x69, 0x98,
-	0x49, 0x9d, 0xa6, 0x9b, 0xa5, 0x8a, 0x4f, 0xc2, 0xe9, 0x60, 0x50, 0x95, 0xa5, 0x4a, 0xd4, 0xaf,
-	0xb1, 0x4f, 0x81, 0x1d, 0x09, 0x0a, 0x37, 0xb3, 0x64, 0xde, 0x2d, 0x55, 0x54, 0x95, 0xd4, 0xc1,
-	0xd2, 0xcc, 0xf7, 0x6b, 0xec, 0x04, 0x7a, 0xb9, 0x37, 0x37, 0xd4, 0xf6, 0xce, 0xca, 0x89, 0x24,
-	0x4b, 0xfc, 0x1a, 0xfb, 0x10, 0xb6, 0x8e, 0x84, 0xa4, 0xa4, 0x2f, 0xfa, 0x5d, 0xaf, 0x54, 0x8b,
-	0x97, 0x30, 0xd8, 0x71, 0xd5, 0x90, 0xb8, 0x5f, 0x63, 0xf7, 0xa1, 0x7d, 0x9c, 0x9d, 0x5c, 0x47,
-	0x63, 0x17, 0x9a, 0x6d, 0x4d, 0x1e, 0x67, 0x87, 0xc1, 0xfc, 0xe2, 0x52, 0x7e, 0x96, 0xf8, 0xb5,
-	0xb3, 0x36, 0xfd, 0xc7, 0xf6, 0xe0, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x00, 0xd6, 0x0d, 0xaf,
-	0xb0, 0x1b, 0x00, 0x00,
-}
@@ -26,6 +26,18 @@ var (
 	ForkLoopCheckCommitTxDone = "ForkLoopCheckCommitTxDone"
 	// MainLoopCheckCommitTxDoneForkHeight 平行链的配置项，对应主链的ForkLoopCheckCommitTxDone高度
 	MainLoopCheckCommitTxDoneForkHeight = "MainLoopCheckCommitTxDoneForkHeight"
+	// ForkParaAssetRegistry 支持跨链登记过的其他执行器资产的fork
+	ForkParaAssetRegistry = "ForkParaAssetRegistry"
+	// ForkParaAssetBridge 支持平行链原生资产跨链到主链的fork
+	ForkParaAssetBridge = "ForkParaAssetBridge"
+	// ForkParaCrossTransfer 支持平行链之间直接跨链转账的fork
+	ForkParaCrossTransfer = "ForkParaCrossTransfer"
+	// MainParaCrossTransferForkHeight 平行链的配置项，对应主链的ForkParaCrossTransfer高度
+	MainParaCrossTransferForkHeight = "MainParaCrossTransferForkHeight"
+	// ForkParaNodeSlash 支持惩罚授权节点的fork
+	ForkParaNodeSlash = "ForkParaNodeSlash"
+	// ForkParaCommitAgg 支持聚合多个授权节点签名commit的fork
+	ForkParaCommitAgg = "ForkParaCommitAgg"
 )
 
 func init() {
@@ -36,6 +48,11 @@ func init() {
 	types.RegisterDappFork(ParaX, "ForkParacrossWithdrawFromParachain", 1298600)
 	types.RegisterDappFork(ParaX, ForkCommitTx, 1850000)
 	types.RegisterDappFork(ParaX, ForkLoopCheckCommitTxDone, 3230000)
+	types.RegisterDappFork(ParaX, ForkParaAssetRegistry, 3800000)
+	types.RegisterDappFork(ParaX, ForkParaAssetBridge, 3800000)
+	types.RegisterDappFork(ParaX, ForkParaCrossTransfer, 3800000)
+	types.RegisterDappFork(ParaX, ForkParaNodeSlash, 3800000)
+	types.RegisterDappFork(ParaX, ForkParaCommitAgg, 3800000)
 }
 
 // GetExecName get para exec name
@@ -76,6 +93,8 @@ func (p *ParacrossType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogParaNodeVoteDone:          {Ty: reflect.TypeOf(ReceiptParaNodeVoteDone{}), Name: "LogParaNodeVoteDone"},
 		TyLogParaNodeGroupConfig:       {Ty: reflect.TypeOf(ReceiptParaNodeGroupConfig{}), Name: "LogParaNodeGroupConfig"},
 		TyLogParaNodeGroupStatusUpdate: {Ty: reflect.TypeOf(ReceiptParaNodeGroupConfig{}), Name: "LogParaNodeGroupStatusUpdate"},
+		TyLogParaCrossTransfer:         {Ty: reflect.TypeOf(ReceiptParaCrossTransfer{}), Name: "LogParaCrossTransfer"},
+		TyLogParaNodeSlash:             {Ty: reflect.TypeOf(ReceiptParaNodeSlash{}), Name: "LogParaNodeSlash"},
 	}
 }
 
@@ -91,6 +110,13 @@ func (p *ParacrossType) GetTypeMap() map[string]int32 {
 		"TransferToExec":  ParacrossActionTransferToExec,
 		"NodeConfig":      ParacrossActionNodeConfig,
 		"NodeGroupConfig": ParacrossActionNodeGroupApply,
+
+		"ParaAssetTransfer": ParacrossActionParaAssetTransfer,
+		"ParaAssetWithdraw": ParacrossActionParaAssetWithdraw,
+		"CrossTransfer":     ParacrossActionCrossTransfer,
+		"CrossDeliver":      ParacrossActionCrossDeliver,
+		"NodeSlash":         ParacrossActionNodeSlash,
+		"CommitAgg":         ParacrossActionCommitAgg,
 	}
 }
 
@@ -119,6 +145,15 @@ func (p ParacrossType) CreateTx(action string, message json.RawMessage) (*types.
 		}
 		return CreateRawAssetTransferTx(&param)
 
+	} else if action == "ParaAssetTransfer" || action == "ParaAssetWithdraw" {
+		var param types.CreateTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			glog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawParaAssetTransferTx(&param)
+
 	} else if action == "ParacrossTransfer" || action == "Transfer" ||
 		action == "ParacrossWithdraw" || action == "Withdraw" ||
 		action == "ParacrossTransferToExec" || action == "TransferToExec" {
@@ -44,6 +44,17 @@ func TokenCmd() *cobra.Command {
 		CreateTokenTransferExecCmd(),
 		CreateRawTokenMintTxCmd(),
 		CreateRawTokenBurnTxCmd(),
+		CreateRawTokenPauseTxCmd(),
+		CreateRawTokenFreezeAddrTxCmd(),
+		CreateRawTokenTransferOwnershipTxCmd(),
+		CreateRawTokenAcceptOwnershipTxCmd(),
+		CreateRawTokenUpdateMetadataTxCmd(),
+		CreateRawTokenApproveTxCmd(),
+		CreateRawTokenIncreaseAllowanceTxCmd(),
+		CreateRawTokenTransferFromTxCmd(),
+		GetTokenFreezeStatusCmd(),
+		GetTokenAllowanceCmd(),
+		GetTokenVestingCmd(),
 		GetTokenLogsCmd(),
 		GetTokenCmd(),
 		QueryTxCmd(),
@@ -375,9 +386,34 @@ func addTokenPrecreatedFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Int32P("category", "c", 0, "token category")
 
+	cmd.Flags().StringArrayP("allocation", "l", nil, "locked allocation, format addr:amount:cliff_height:period:periods, can be repeated")
+
 	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
 }
 
+// addr:amount:cliff_height:period:periods
+func parseTokenAllocation(s string) (*tokenty.TokenAllocation, error) {
+	items := strings.Split(s, ":")
+	if len(items) != 5 {
+		return nil, types.ErrInvalidParam
+	}
+	var nums [4]int64
+	for i, item := range items[1:] {
+		n, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = n
+	}
+	return &tokenty.TokenAllocation{
+		Addr:        items[0],
+		Amount:      nums[0] * types.TokenPrecision,
+		CliffHeight: nums[1],
+		Period:      nums[2],
+		Periods:     int32(nums[3]),
+	}, nil
+}
+
 func tokenPrecreated(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	name, _ := cmd.Flags().GetString("name")
@@ -387,6 +423,7 @@ func tokenPrecreated(cmd *cobra.Command, args []string) {
 	price, _ := cmd.Flags().GetFloat64("price")
 	total, _ := cmd.Flags().GetInt64("total")
 	category, _ := cmd.Flags().GetInt32("category")
+	allocations, _ := cmd.Flags().GetStringArray("allocation")
 
 	priceInt64 := int64((price + 0.000001) * 1e4)
 	params := &tokenty.TokenPreCreate{
@@ -398,6 +435,14 @@ func tokenPrecreated(cmd *cobra.Command, args []string) {
 		Total:        total * types.TokenPrecision,
 		Category:     category,
 	}
+	for _, a := range allocations {
+		alloc, err := parseTokenAllocation(a)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "allocation", a, err)
+			return
+		}
+		params.Allocations = append(params.Allocations, alloc)
+	}
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenPreCreateTx", params, nil)
 	ctx.RunWithoutMarshal()
 }
@@ -541,6 +586,416 @@ func tokenBurn(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateRawTokenPauseTxCmd create raw token pause transaction
+func CreateRawTokenPauseTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Create a pause/resume token transfer transaction",
+		Run:   tokenPause,
+	}
+	addTokenPauseFlags(cmd)
+	return cmd
+}
+
+func addTokenPauseFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().BoolP("resume", "r", false, "resume token transfer")
+
+	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
+}
+
+func tokenPause(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	resume, _ := cmd.Flags().GetBool("resume")
+
+	params := &tokenty.TokenPause{
+		Symbol: symbol,
+		Paused: !resume,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenPauseTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenFreezeAddrTxCmd create raw token freeze addr transaction
+func CreateRawTokenFreezeAddrTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze_addr",
+		Short: "Create a freeze/unfreeze address of token transaction",
+		Run:   tokenFreezeAddr,
+	}
+	addTokenFreezeAddrFlags(cmd)
+	return cmd
+}
+
+func addTokenFreezeAddrFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("addr", "a", "", "address to freeze")
+	cmd.MarkFlagRequired("addr")
+
+	cmd.Flags().BoolP("unfreeze", "u", false, "unfreeze the address")
+
+	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
+}
+
+func tokenFreezeAddr(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+	unfreeze, _ := cmd.Flags().GetBool("unfreeze")
+
+	params := &tokenty.TokenFreezeAddr{
+		Symbol: symbol,
+		Addr:   addr,
+		Frozen: !unfreeze,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenFreezeAddrTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenTransferOwnershipTxCmd create raw token transfer ownership transaction
+func CreateRawTokenTransferOwnershipTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_ownership",
+		Short: "Create a propose/cancel token ownership transfer transaction",
+		Run:   tokenTransferOwnership,
+	}
+	addTokenTransferOwnershipFlags(cmd)
+	return cmd
+}
+
+func addTokenTransferOwnershipFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("new_owner", "n", "", "new owner address, empty to cancel the pending transfer")
+
+	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
+}
+
+func tokenTransferOwnership(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	newOwner, _ := cmd.Flags().GetString("new_owner")
+
+	params := &tokenty.TokenTransferOwnership{
+		Symbol:   symbol,
+		NewOwner: newOwner,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenTransferOwnershipTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenAcceptOwnershipTxCmd create raw token accept ownership transaction
+func CreateRawTokenAcceptOwnershipTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept_ownership",
+		Short: "Create an accept token ownership transaction",
+		Run:   tokenAcceptOwnership,
+	}
+	addTokenAcceptOwnershipFlags(cmd)
+	return cmd
+}
+
+func addTokenAcceptOwnershipFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
+}
+
+func tokenAcceptOwnership(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	params := &tokenty.TokenAcceptOwnership{
+		Symbol: symbol,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenAcceptOwnershipTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenUpdateMetadataTxCmd create raw token update metadata transaction
+func CreateRawTokenUpdateMetadataTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update_metadata",
+		Short: "Create an update token name/introduction transaction",
+		Run:   tokenUpdateMetadata,
+	}
+	addTokenUpdateMetadataFlags(cmd)
+	return cmd
+}
+
+func addTokenUpdateMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("name", "n", "", "new token name, empty to keep")
+	cmd.Flags().StringP("introduction", "i", "", "new token introduction, empty to keep")
+
+	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
+}
+
+func tokenUpdateMetadata(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	name, _ := cmd.Flags().GetString("name")
+	introduction, _ := cmd.Flags().GetString("introduction")
+
+	params := &tokenty.TokenUpdateMetadata{
+		Symbol:       symbol,
+		Name:         name,
+		Introduction: introduction,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenUpdateMetadataTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenApproveTxCmd create raw token approve transaction
+func CreateRawTokenApproveTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Create a set allowance of spender transaction",
+		Run:   tokenApprove,
+	}
+	addTokenAllowanceFlags(cmd)
+	return cmd
+}
+
+func addTokenAllowanceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("spender", "p", "", "spender address")
+	cmd.MarkFlagRequired("spender")
+
+	cmd.Flags().Float64P("amount", "a", 0, "amount of allowance")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
+}
+
+func tokenApprove(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	spender, _ := cmd.Flags().GetString("spender")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+
+	params := &tokenty.TokenApprove{
+		Symbol:  symbol,
+		Spender: spender,
+		Amount:  int64((amount+0.000001)*1e4) * 1e4,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenApproveTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenIncreaseAllowanceTxCmd create raw token increase allowance transaction
+func CreateRawTokenIncreaseAllowanceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase_allowance",
+		Short: "Create an increase allowance of spender transaction",
+		Run:   tokenIncreaseAllowance,
+	}
+	addTokenAllowanceFlags(cmd)
+	return cmd
+}
+
+func tokenIncreaseAllowance(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	spender, _ := cmd.Flags().GetString("spender")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+
+	params := &tokenty.TokenIncreaseAllowance{
+		Symbol:  symbol,
+		Spender: spender,
+		Amount:  int64((amount+0.000001)*1e4) * 1e4,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenIncreaseAllowanceTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenTransferFromTxCmd create raw token transfer from transaction
+func CreateRawTokenTransferFromTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_from",
+		Short: "Create a transfer token from approved address transaction",
+		Run:   tokenTransferFrom,
+	}
+	addTokenTransferFromFlags(cmd)
+	return cmd
+}
+
+func addTokenTransferFromFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("from", "m", "", "address approved the allowance")
+	cmd.MarkFlagRequired("from")
+
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+
+	cmd.Flags().Float64P("fee", "f", 0, "token transaction fee")
+}
+
+func tokenTransferFrom(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+
+	params := &tokenty.TokenTransferFrom{
+		Symbol: symbol,
+		From:   from,
+		To:     to,
+		Amount: int64((amount+0.000001)*1e4) * 1e4,
+		Note:   note,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenTransferFromTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GetTokenAllowanceCmd get allowance of spender
+func GetTokenAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance",
+		Short: "Get token allowance of spender",
+		Run:   getTokenAllowance,
+	}
+	addGetTokenAllowanceFlags(cmd)
+	return cmd
+}
+
+func addGetTokenAllowanceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.MarkFlagRequired("owner")
+
+	cmd.Flags().StringP("spender", "p", "", "spender address")
+	cmd.MarkFlagRequired("spender")
+}
+
+func getTokenAllowance(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	owner, _ := cmd.Flags().GetString("owner")
+	spender, _ := cmd.Flags().GetString("spender")
+
+	req := &tokenty.ReqTokenAllowance{
+		Symbol:  symbol,
+		Owner:   owner,
+		Spender: spender,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenAllowance"
+	params.Payload = types.MustPBToJSON(req)
+
+	var res tokenty.TokenAllowance
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// GetTokenVestingCmd get locked, vested and released allocation of token
+func GetTokenVestingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting",
+		Short: "Get locked, vested and released allocation of token",
+		Run:   getTokenVesting,
+	}
+	addGetTokenVestingFlags(cmd)
+	return cmd
+}
+
+func addGetTokenVestingFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("addr", "a", "", "beneficiary address, empty for all")
+}
+
+func getTokenVesting(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	req := &tokenty.ReqTokenVesting{
+		Symbol: symbol,
+		Addr:   addr,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenVesting"
+	params.Payload = types.MustPBToJSON(req)
+
+	var res tokenty.ReplyTokenVesting
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// GetTokenFreezeStatusCmd get token pause and address freeze status
+func GetTokenFreezeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze_status",
+		Short: "Get token pause and address freeze status",
+		Run:   getTokenFreezeStatus,
+	}
+	addGetTokenFreezeStatusFlags(cmd)
+	return cmd
+}
+
+func addGetTokenFreezeStatusFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("addr", "a", "", "address")
+}
+
+func getTokenFreezeStatus(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	req := &tokenty.ReqTokenFreezeStatus{
+		Symbol: symbol,
+		Addr:   addr,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenFreezeStatus"
+	params.Payload = types.MustPBToJSON(req)
+
+	var res tokenty.ReplyTokenFreezeStatus
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
 // GetTokenLogsCmd get logs of token
 func GetTokenLogsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,140 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+// GetAllowance 获取 owner 授权给 spender 的 token 额度
+func GetAllowance(db dbm.KV, symbol, owner, spender string) int64 {
+	value, err := db.Get(calcTokenAllowanceKey(symbol, owner, spender))
+	if err != nil || len(value) == 0 {
+		return 0
+	}
+	var allowance pty.TokenAllowance
+	if err = types.Decode(value, &allowance); err != nil {
+		tokenlog.Error("GetAllowance decode", "symbol", symbol, "owner", owner, "spender", spender, "err", err)
+		return 0
+	}
+	return allowance.Amount
+}
+
+func setAllowance(db dbm.KV, symbol, owner, spender string, amount int64) *types.Receipt {
+	prev := GetAllowance(db, symbol, owner, spender)
+	value := &pty.TokenAllowance{Symbol: symbol, Owner: owner, Spender: spender, Amount: amount}
+	kv := &types.KeyValue{Key: calcTokenAllowanceKey(symbol, owner, spender), Value: types.Encode(value)}
+	db.Set(kv.Key, kv.Value)
+
+	log := &pty.ReceiptTokenAllowance{Symbol: symbol, Owner: owner, Spender: spender, Prev: prev, Current: amount}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{kv},
+		Logs: []*types.ReceiptLog{{Ty: pty.TyLogTokenAllowance, Log: types.Encode(log)}},
+	}
+}
+
+// TransferFrom spender 在授权额度内把 owner 的 token 转到 to 地址,
+// 其他执行器加载 token 的执行器驱动后调用, to 是执行器地址时转入 owner 在该执行器中的账户
+func (t *token) TransferFrom(symbol, owner, spender, to string, amount int64) (*types.Receipt, error) {
+	return transferFrom(t.GetStateDB(), t.GetHeight(), symbol, owner, spender, to, amount)
+}
+
+func transferFrom(db dbm.KV, height int64, symbol, owner, spender, to string, amount int64) (*types.Receipt, error) {
+	if !types.IsDappFork(height, pty.TokenX, pty.ForkTokenAllowanceX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if symbol == "" || amount <= 0 || amount > types.MaxTokenBalance {
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(to); err != nil {
+		return nil, err
+	}
+	if err := checkTokenTransfer(db, height, symbol, owner, to); err != nil {
+		return nil, err
+	}
+	allowance := GetAllowance(db, symbol, owner, spender)
+	if allowance < amount {
+		tokenlog.Error("TransferFrom", "symbol", symbol, "owner", owner, "spender", spender, "allowance", allowance, "amount", amount)
+		return nil, pty.ErrTokenAllowance
+	}
+
+	release, err := releaseVesting(db, height, symbol, owner)
+	if err != nil {
+		return nil, err
+	}
+	accDB, err := account.NewAccountDB(pty.TokenX, symbol, db)
+	if err != nil {
+		return nil, err
+	}
+	var receipt *types.Receipt
+	if drivers.IsDriverAddress(to, height) {
+		receipt, err = accDB.TransferToExec(owner, to, amount)
+	} else {
+		receipt, err = accDB.Transfer(owner, to, amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+	allowanceReceipt := setAllowance(db, symbol, owner, spender, allowance-amount)
+	receipt.KV = append(receipt.KV, allowanceReceipt.KV...)
+	receipt.Logs = append(receipt.Logs, allowanceReceipt.Logs...)
+	return mergeReceipt(release, receipt), nil
+}
+
+func (action *tokenAction) checkAllowanceParam(symbol, spender string, amount int64) error {
+	if !types.IsDappFork(action.height, pty.TokenX, pty.ForkTokenAllowanceX) {
+		return types.ErrActionNotSupport
+	}
+	if symbol == "" || amount < 0 || amount > types.MaxTokenBalance {
+		return types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(spender); err != nil {
+		return err
+	}
+	if spender == action.fromaddr {
+		return types.ErrInvalidParam
+	}
+	if !checkTokenExist(symbol, action.db) {
+		return pty.ErrTokenNotExist
+	}
+	return nil
+}
+
+func (action *tokenAction) approve(approve *pty.TokenApprove) (*types.Receipt, error) {
+	if approve == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := action.checkAllowanceParam(approve.Symbol, approve.Spender, approve.Amount); err != nil {
+		return nil, err
+	}
+	return setAllowance(action.db, approve.Symbol, action.fromaddr, approve.Spender, approve.Amount), nil
+}
+
+func (action *tokenAction) increaseAllowance(increase *pty.TokenIncreaseAllowance) (*types.Receipt, error) {
+	if increase == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := action.checkAllowanceParam(increase.Symbol, increase.Spender, increase.Amount); err != nil {
+		return nil, err
+	}
+	allowance := GetAllowance(action.db, increase.Symbol, action.fromaddr, increase.Spender)
+	if allowance+increase.Amount > types.MaxTokenBalance {
+		return nil, types.ErrAmount
+	}
+	return setAllowance(action.db, increase.Symbol, action.fromaddr, increase.Spender, allowance+increase.Amount), nil
+}
+
+func (action *tokenAction) transferFrom(transfer *pty.TokenTransferFrom) (*types.Receipt, error) {
+	if transfer == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return transferFrom(action.db, action.height, transfer.Symbol, transfer.From, action.fromaddr, transfer.To, transfer.Amount)
+}
@@ -0,0 +1,110 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenAllowance(t *testing.T) {
+	tokenTotal := int64(10000 * 1e8)
+	amount := int64(100 * 1e8)
+
+	e := newTokenTestEnv(t, pty.ForkTokenAllowanceX)
+	defer e.close()
+
+	cfg.SaveTokenTxList = true
+	defer func() {
+		cfg.SaveTokenTxList = false
+	}()
+
+	allowance := func(spender string) int64 {
+		out, err := e.exec.Query("GetTokenAllowance", types.Encode(&pty.ReqTokenAllowance{Symbol: Symbol, Owner: string(Nodes[0]), Spender: spender}))
+		assert.Nil(t, err)
+		return out.(*pty.TokenAllowance).Amount
+	}
+
+	_, _, _, err := e.run("TokenPreCreate", &pty.TokenPreCreate{
+		Name:   Symbol,
+		Symbol: Symbol,
+		Total:  tokenTotal,
+		Owner:  string(Nodes[0]),
+	}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, _, err = e.run("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+
+	// A 授权 B, B 在额度内把 A 的 token 转给 C
+	_, _, _, err = e.run("TokenApprove", &pty.TokenApprove{Symbol: Symbol, Spender: string(Nodes[1]), Amount: amount}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, _, err = e.run("TokenIncreaseAllowance", &pty.TokenIncreaseAllowance{Symbol: Symbol, Spender: string(Nodes[1]), Amount: amount}, PrivKeyA)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*amount, allowance(string(Nodes[1])))
+
+	transfer := &pty.TokenTransferFrom{Symbol: Symbol, From: string(Nodes[0]), To: string(Nodes[2]), Amount: amount}
+	_, _, _, err = e.run("TokenTransferFrom", transfer, PrivKeyC)
+	assert.Equal(t, pty.ErrTokenAllowance, err)
+	tx, receiptData, txIndex, err := e.run("TokenTransferFrom", transfer, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, amount, allowance(string(Nodes[1])))
+
+	// 交易列表按 owner 和 to 记录, 回滚时删除
+	ownerTxKey := calcTokenAddrTxKey(Symbol, string(Nodes[0]), e.env.blockHeight, int64(txIndex))
+	toTxKey := calcTokenAddrTxKey(Symbol, string(Nodes[2]), e.env.blockHeight, int64(txIndex))
+	_, err = e.kvdb.Get(ownerTxKey)
+	assert.Nil(t, err)
+	_, err = e.kvdb.Get(toTxKey)
+	assert.Nil(t, err)
+	set, err := e.exec.ExecDelLocal(tx, receiptData, txIndex)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(set.KV))
+	e.apply(set)
+	_, err = e.kvdb.Get(ownerTxKey)
+	assert.Equal(t, types.ErrNotFound, err)
+	_, err = e.kvdb.Get(toTxKey)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	// 其他执行器通过执行器驱动调用, 转到执行器地址时记入 owner 的执行账户
+	execAddr := address.ExecAddress(pty.TokenX)
+	if !drivers.IsDriverAddress(execAddr, -1) {
+		Init(pty.TokenX, nil)
+	}
+	receipt, err := e.exec.(*token).TransferFrom(Symbol, string(Nodes[0]), string(Nodes[1]), execAddr, 2*amount)
+	assert.Equal(t, pty.ErrTokenAllowance, err)
+	assert.Nil(t, receipt)
+	_, err = e.exec.(*token).TransferFrom(Symbol, string(Nodes[0]), string(Nodes[1]), execAddr, amount)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), allowance(string(Nodes[1])))
+	tradeDB, _ := account.NewAccountDB(pty.TokenX, Symbol, e.stateDB)
+	assert.Equal(t, amount, tradeDB.LoadExecAccount(string(Nodes[0]), execAddr).Balance)
+
+	// 重新设置额度会覆盖原额度
+	_, _, _, err = e.run("TokenApprove", &pty.TokenApprove{Symbol: Symbol, Spender: string(Nodes[1]), Amount: 0}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, _, err = e.run("TokenTransferFrom", transfer, PrivKeyB)
+	assert.Equal(t, pty.ErrTokenAllowance, err)
+
+	// 不在多重签名交易中时，ExecFrom 不接受指定的发起人
+	_, _, _, err = e.run("TokenApprove", &pty.TokenApprove{Symbol: Symbol, Spender: string(Nodes[1]), Amount: amount}, PrivKeyA)
+	assert.Nil(t, err)
+	tx = e.createTx("TokenTransferFrom", transfer, PrivKeyC)
+	_, err = e.exec.(*token).ExecFrom(string(Nodes[1]), tx, e.index+1)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, _, _, err = e.run("TokenTransferFrom", transfer, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), allowance(string(Nodes[1])))
+
+	accDB, _ := account.NewAccountDB(pty.TokenX, Symbol, e.stateDB)
+	assert.Equal(t, tokenTotal-3*amount, accDB.LoadAccount(string(Nodes[0])).Balance)
+	assert.Equal(t, 2*amount, accDB.LoadAccount(string(Nodes[2])).Balance)
+	assert.Equal(t, amount, accDB.LoadAccount(execAddr).Balance)
+}
@@ -12,32 +12,48 @@ import (
 
 func (t *token) Exec_Transfer(payload *types.AssetsTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
 	token := payload.GetCointoken()
+	if err := checkTokenTransfer(t.GetStateDB(), t.GetHeight(), token, t.txFrom(tx), tx.GetRealToAddr()); err != nil {
+		return nil, err
+	}
 	db, err := account.NewAccountDB(t.GetName(), token, t.GetStateDB())
 	if err != nil {
 		return nil, err
 	}
+	release, err := releaseVesting(t.GetStateDB(), t.GetHeight(), token, t.txFrom(tx))
+	if err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.ActionTransfer,
 		Value: &tokenty.TokenAction_Transfer{
 			Transfer: payload,
 		},
 	}
-	return t.ExecTransWithdraw(db, tx, &tokenAction, index)
+	receipt, err := t.ExecTransWithdraw(db, tx, &tokenAction, index)
+	return mergeReceipt(release, receipt), err
 }
 
 func (t *token) Exec_Withdraw(payload *types.AssetsWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
 	token := payload.GetCointoken()
+	if err := checkTokenTransfer(t.GetStateDB(), t.GetHeight(), token, t.txFrom(tx)); err != nil {
+		return nil, err
+	}
 	db, err := account.NewAccountDB(t.GetName(), token, t.GetStateDB())
 	if err != nil {
 		return nil, err
 	}
+	release, err := releaseVesting(t.GetStateDB(), t.GetHeight(), token, t.txFrom(tx))
+	if err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.ActionWithdraw,
 		Value: &tokenty.TokenAction_Withdraw{
 			Withdraw: payload,
 		},
 	}
-	return t.ExecTransWithdraw(db, tx, &tokenAction, index)
+	receipt, err := t.ExecTransWithdraw(db, tx, &tokenAction, index)
+	return mergeReceipt(release, receipt), err
 }
 
 func (t *token) Exec_TokenPreCreate(payload *tokenty.TokenPreCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
@@ -57,17 +73,25 @@ func (t *token) Exec_TokenRevokeCreate(payload *tokenty.TokenRevokeCreate, tx *t
 
 func (t *token) Exec_TransferToExec(payload *types.AssetsTransferToExec, tx *types.Transaction, index int) (*types.Receipt, error) {
 	token := payload.GetCointoken()
+	if err := checkTokenTransfer(t.GetStateDB(), t.GetHeight(), token, t.txFrom(tx)); err != nil {
+		return nil, err
+	}
 	db, err := account.NewAccountDB(t.GetName(), token, t.GetStateDB())
 	if err != nil {
 		return nil, err
 	}
+	release, err := releaseVesting(t.GetStateDB(), t.GetHeight(), token, t.txFrom(tx))
+	if err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.TokenActionTransferToExec,
 		Value: &tokenty.TokenAction_TransferToExec{
 			TransferToExec: payload,
 		},
 	}
-	return t.ExecTransWithdraw(db, tx, &tokenAction, index)
+	receipt, err := t.ExecTransWithdraw(db, tx, &tokenAction, index)
+	return mergeReceipt(release, receipt), err
 }
 
 func (t *token) Exec_TokenMint(payload *tokenty.TokenMint, tx *types.Transaction, index int) (*types.Receipt, error) {
@@ -79,3 +103,43 @@ func (t *token) Exec_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction
 	action := newTokenAction(t, "", tx)
 	return action.burn(payload)
 }
+
+func (t *token) Exec_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.pause(payload)
+}
+
+func (t *token) Exec_TokenFreezeAddr(payload *tokenty.TokenFreezeAddr, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.freezeAddr(payload)
+}
+
+func (t *token) Exec_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.transferOwnership(payload)
+}
+
+func (t *token) Exec_TokenAcceptOwnership(payload *tokenty.TokenAcceptOwnership, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.acceptOwnership(payload)
+}
+
+func (t *token) Exec_TokenUpdateMetadata(payload *tokenty.TokenUpdateMetadata, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.updateMetadata(payload)
+}
+
+func (t *token) Exec_TokenApprove(payload *tokenty.TokenApprove, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.approve(payload)
+}
+
+func (t *token) Exec_TokenIncreaseAllowance(payload *tokenty.TokenIncreaseAllowance, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.increaseAllowance(payload)
+}
+
+func (t *token) Exec_TokenTransferFrom(payload *tokenty.TokenTransferFrom, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.transferFrom(payload)
+}
@@ -187,3 +187,108 @@ func (t *token) ExecDelLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Tra
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecDelLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	receipt, err := getPauseReceipt(receiptData)
+	if err != nil {
+		return nil, err
+	}
+	localToken, err := loadLocalToken(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated, t.GetLocalDB())
+	if err != nil {
+		return nil, err
+	}
+	localToken.Paused = receipt.Prev
+	key := calcTokenStatusKeyLocal(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated)
+	var set []*types.KeyValue
+	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
+
+	table := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	err = table.Del([]byte(txIndex))
+	if err != nil {
+		return nil, err
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) ExecDelLocal_TokenFreezeAddr(payload *tokenty.TokenFreezeAddr, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	receipt, err := getFreezeAddrReceipt(receiptData)
+	if err != nil {
+		return nil, err
+	}
+	localToken, err := loadLocalToken(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated, t.GetLocalDB())
+	if err != nil {
+		return nil, err
+	}
+	localToken = resetFrozenAddr(localToken, receipt.Prev, receipt.Current)
+	key := calcTokenStatusKeyLocal(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated)
+	var set []*types.KeyValue
+	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
+	set = append(set, frozenAddrKV(receipt.Symbol, receipt.Addr, receipt.Prev))
+
+	table := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	err = table.Del([]byte(txIndex))
+	if err != nil {
+		return nil, err
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) execDelLocalTokenChange(receiptData *types.ReceiptData, index int, logTy int32) (*types.LocalDBSet, error) {
+	receipt, err := getTokenAmountReceipt(receiptData, logTy)
+	if err != nil {
+		return nil, err
+	}
+	set, err := changeLocalToken(t.GetLocalDB(), receipt.Current, receipt.Prev)
+	if err != nil {
+		return nil, err
+	}
+
+	table := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	err = table.Del([]byte(txIndex))
+	if err != nil {
+		return nil, err
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) ExecDelLocal_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalTokenChange(receiptData, index, tokenty.TyLogTokenTransferOwnership)
+}
+
+func (t *token) ExecDelLocal_TokenAcceptOwnership(payload *tokenty.TokenAcceptOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalTokenChange(receiptData, index, tokenty.TyLogTokenAcceptOwnership)
+}
+
+func (t *token) ExecDelLocal_TokenUpdateMetadata(payload *tokenty.TokenUpdateMetadata, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalTokenChange(receiptData, index, tokenty.TyLogTokenUpdateMetadata)
+}
+
+func (t *token) ExecDelLocal_TokenTransferFrom(payload *tokenty.TokenTransferFrom, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	// 个人资产列表和转账一样不做删除
+	set := &types.LocalDBSet{}
+	if cfg.SaveTokenTxList {
+		set.KV = append(set.KV, tokenTransferFromTxKvs(tx, payload, t.GetHeight(), int64(index), true)...)
+	}
+	return set, nil
+}
@@ -110,10 +110,16 @@ func (t *token) ExecLocal_TokenFinishCreate(payload *tokenty.TokenFinishCreate,
 	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
 	kv := AddTokenToAssets(payload.Owner, t.GetLocalDB(), payload.Symbol)
 	set = append(set, kv...)
+	//分配的受益地址也加入个人资产列表
+	if vestings, err := loadTokenVestings(t.GetStateDB(), calcTokenVestingKey(payload.Symbol)); err == nil {
+		for _, vesting := range vestings.Vestings {
+			set = append(set, AddTokenToAssets(vesting.Allocation.Addr, t.GetLocalDB(), payload.Symbol)...)
+		}
+	}
 
 	table := NewLogsTable(t.GetLocalDB())
 	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
-	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionFinishCreate, TxHash: "0x" + hex.EncodeToString(tx.Hash())})
+	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionFinishCreate, TxHash: "0x" + hex.EncodeToString(tx.Hash()), Owner: payload.Owner})
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +250,7 @@ func (t *token) ExecLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Transa
 
 	table := NewLogsTable(t.GetLocalDB())
 	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
-	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionMint, TxHash: "0x" + hex.EncodeToString(tx.Hash())})
+	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionMint, TxHash: "0x" + hex.EncodeToString(tx.Hash()), Owner: tx.From()})
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +275,178 @@ func (t *token) ExecLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transa
 
 	table := NewLogsTable(t.GetLocalDB())
 	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
-	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionBurn, TxHash: "0x" + hex.EncodeToString(tx.Hash())})
+	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionBurn, TxHash: "0x" + hex.EncodeToString(tx.Hash()), Owner: tx.From()})
+	if err != nil {
+		return nil, err
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func getPauseReceipt(receiptData *types.ReceiptData) (*tokenty.ReceiptTokenPause, error) {
+	for _, item := range receiptData.Logs {
+		if item.Ty != tokenty.TyLogTokenPause {
+			continue
+		}
+		var receipt tokenty.ReceiptTokenPause
+		err := types.Decode(item.Log, &receipt)
+		if err != nil {
+			return nil, err
+		}
+		return &receipt, nil
+	}
+	return nil, types.ErrNotFound
+}
+
+func getFreezeAddrReceipt(receiptData *types.ReceiptData) (*tokenty.ReceiptTokenFreezeAddr, error) {
+	for _, item := range receiptData.Logs {
+		if item.Ty != tokenty.TyLogTokenFreezeAddr {
+			continue
+		}
+		var receipt tokenty.ReceiptTokenFreezeAddr
+		err := types.Decode(item.Log, &receipt)
+		if err != nil {
+			return nil, err
+		}
+		return &receipt, nil
+	}
+	return nil, types.ErrNotFound
+}
+
+// 冻结地址的计数只在状态变化时更新
+func setFrozenAddr(t *tokenty.LocalToken, prev, current bool) *tokenty.LocalToken {
+	if !prev && current {
+		t.FrozenAddrCount++
+	} else if prev && !current {
+		t.FrozenAddrCount--
+	}
+	return t
+}
+
+func resetFrozenAddr(t *tokenty.LocalToken, prev, current bool) *tokenty.LocalToken {
+	return setFrozenAddr(t, current, prev)
+}
+
+func frozenAddrKV(symbol, addr string, frozen bool) *types.KeyValue {
+	key := calcTokenFrozenAddrKeyLocal(symbol, addr)
+	if !frozen {
+		return &types.KeyValue{Key: key, Value: nil}
+	}
+	return &types.KeyValue{Key: key, Value: types.Encode(&tokenty.TokenAddrFrozen{Symbol: symbol, Addr: addr, Frozen: true})}
+}
+
+func (t *token) ExecLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	receipt, err := getPauseReceipt(receiptData)
+	if err != nil {
+		return nil, err
+	}
+	localToken, err := loadLocalToken(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated, t.GetLocalDB())
+	if err != nil {
+		return nil, err
+	}
+	localToken.Paused = receipt.Current
+	var set []*types.KeyValue
+	key := calcTokenStatusKeyLocal(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated)
+	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
+
+	table := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionPause, TxHash: "0x" + hex.EncodeToString(tx.Hash()), Owner: receipt.Owner})
+	if err != nil {
+		return nil, err
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) ExecLocal_TokenFreezeAddr(payload *tokenty.TokenFreezeAddr, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	receipt, err := getFreezeAddrReceipt(receiptData)
+	if err != nil {
+		return nil, err
+	}
+	localToken, err := loadLocalToken(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated, t.GetLocalDB())
+	if err != nil {
+		return nil, err
+	}
+	localToken = setFrozenAddr(localToken, receipt.Prev, receipt.Current)
+	var set []*types.KeyValue
+	key := calcTokenStatusKeyLocal(receipt.Symbol, receipt.Owner, tokenty.TokenStatusCreated)
+	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
+	set = append(set, frozenAddrKV(receipt.Symbol, receipt.Addr, receipt.Current))
+
+	table := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	err = table.Add(&tokenty.LocalLogs{Symbol: payload.Symbol, TxIndex: txIndex, ActionType: tokenty.TokenActionFreezeAddr, TxHash: "0x" + hex.EncodeToString(tx.Hash()), Owner: receipt.Owner})
+	if err != nil {
+		return nil, err
+	}
+	kv, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func getTokenAmountReceipt(receiptData *types.ReceiptData, ty int32) (*tokenty.ReceiptTokenAmount, error) {
+	for _, item := range receiptData.Logs {
+		if item.Ty != ty {
+			continue
+		}
+		var receipt tokenty.ReceiptTokenAmount
+		err := types.Decode(item.Log, &receipt)
+		if err != nil {
+			return nil, err
+		}
+		return &receipt, nil
+	}
+	return nil, types.ErrNotFound
+}
+
+// 按 token 的变化更新本地记录, owner 变化时本地记录的 key 也随之变化
+func changeLocalToken(db db.KVDB, from, to *tokenty.Token) ([]*types.KeyValue, error) {
+	localToken, err := loadLocalToken(from.Symbol, from.Owner, tokenty.TokenStatusCreated, db)
+	if err != nil {
+		return nil, err
+	}
+	localToken.Owner = to.Owner
+	localToken.PendingOwner = to.PendingOwner
+	localToken.Name = to.Name
+	localToken.Introduction = to.Introduction
+
+	var set []*types.KeyValue
+	if from.Owner != to.Owner {
+		set = append(set, &types.KeyValue{Key: calcTokenStatusKeyLocal(from.Symbol, from.Owner, tokenty.TokenStatusCreated), Value: nil})
+	}
+	key := calcTokenStatusKeyLocal(to.Symbol, to.Owner, tokenty.TokenStatusCreated)
+	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
+	return set, nil
+}
+
+func (t *token) execLocalTokenChange(tx *types.Transaction, receiptData *types.ReceiptData, index int, logTy, actionTy int32) (*types.LocalDBSet, error) {
+	receipt, err := getTokenAmountReceipt(receiptData, logTy)
+	if err != nil {
+		return nil, err
+	}
+	set, err := changeLocalToken(t.GetLocalDB(), receipt.Prev, receipt.Current)
+	if err != nil {
+		return nil, err
+	}
+
+	table := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	err = table.Add(&tokenty.LocalLogs{Symbol: receipt.Current.Symbol, TxIndex: txIndex, ActionType: actionTy, TxHash: "0x" + hex.EncodeToString(tx.Hash()), Owner: receipt.Current.Owner})
 	if err != nil {
 		return nil, err
 	}
@@ -281,3 +458,28 @@ func (t *token) ExecLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transa
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecLocal_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTokenChange(tx, receiptData, index, tokenty.TyLogTokenTransferOwnership, tokenty.TokenActionTransferOwnership)
+}
+
+func (t *token) ExecLocal_TokenAcceptOwnership(payload *tokenty.TokenAcceptOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTokenChange(tx, receiptData, index, tokenty.TyLogTokenAcceptOwnership, tokenty.TokenActionAcceptOwnership)
+}
+
+func (t *token) ExecLocal_TokenUpdateMetadata(payload *tokenty.TokenUpdateMetadata, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTokenChange(tx, receiptData, index, tokenty.TyLogTokenUpdateMetadata, tokenty.TokenActionUpdateMetadata)
+}
+
+func (t *token) ExecLocal_TokenTransferFrom(payload *tokenty.TokenTransferFrom, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	// 添加个人资产列表
+	kv := AddTokenToAssets(payload.To, t.GetLocalDB(), payload.Symbol)
+	if kv != nil {
+		set.KV = append(set.KV, kv...)
+	}
+	if cfg.SaveTokenTxList {
+		set.KV = append(set.KV, tokenTransferFromTxKvs(tx, payload, t.GetHeight(), int64(index), false)...)
+	}
+	return set, nil
+}
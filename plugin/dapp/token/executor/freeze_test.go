@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenFreeze(t *testing.T) {
+	tokenTotal := int64(10000 * 1e8)
+	amount := int64(100 * 1e8)
+
+	e := newTokenTestEnv(t, pty.ForkTokenFreezeX)
+	defer e.close()
+
+	_, _, _, err := e.run("TokenPreCreate", &pty.TokenPreCreate{
+		Name:   Symbol,
+		Symbol: Symbol,
+		Total:  tokenTotal,
+		Owner:  string(Nodes[0]),
+	}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, _, err = e.run("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+	assert.Nil(t, e.transfer(string(Nodes[1]), amount, PrivKeyA))
+
+	// 不是 owner 也不是管理员, 不能冻结
+	freeze := &pty.TokenFreezeAddr{Symbol: Symbol, Addr: string(Nodes[1]), Frozen: true}
+	_, _, _, err = e.run("TokenFreezeAddr", freeze, PrivKeyC)
+	assert.Equal(t, pty.ErrTokenFreezeOperator, err)
+
+	// 管理员冻结 B, B 不能转出也不能转入
+	e.setConfig(adminKey, string(Nodes[2]))
+	freezeTx, freezeReceipt, freezeIndex, err := e.run("TokenFreezeAddr", freeze, PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, pty.ErrTokenAddrFrozen, e.transfer(string(Nodes[0]), amount, PrivKeyB))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, e.transfer(string(Nodes[1]), amount, PrivKeyA))
+	assert.Nil(t, e.transfer(string(Nodes[3]), amount, PrivKeyA))
+	assert.Equal(t, int64(1), e.localToken().FrozenAddrCount)
+
+	out, err := e.exec.Query("GetTokenFreezeStatus", types.Encode(&pty.ReqTokenFreezeStatus{Symbol: Symbol, Addr: string(Nodes[1])}))
+	assert.Nil(t, err)
+	assert.True(t, out.(*pty.ReplyTokenFreezeStatus).Frozen)
+	assert.False(t, out.(*pty.ReplyTokenFreezeStatus).Paused)
+	out, err = e.exec.Query("GetTokenFrozenAddrs", types.Encode(&pty.ReqTokenFrozenAddrs{Symbol: Symbol}))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{string(Nodes[1])}, out.(*pty.ReplyTokenFrozenAddrs).Addrs)
+
+	// owner 暂停后所有地址都不能转账
+	_, _, _, err = e.run("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyA)
+	assert.Nil(t, err)
+	assert.Equal(t, pty.ErrTokenPaused, e.transfer(string(Nodes[3]), amount, PrivKeyA))
+	assert.True(t, e.localToken().Paused)
+	_, _, _, err = e.run("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: false}, PrivKeyA)
+	assert.Nil(t, err)
+	assert.Nil(t, e.transfer(string(Nodes[3]), amount, PrivKeyA))
+	assert.False(t, e.localToken().Paused)
+
+	// 回滚冻结, 本地记录恢复
+	set, err := e.exec.ExecDelLocal(freezeTx, freezeReceipt, freezeIndex)
+	assert.Nil(t, err)
+	e.apply(set)
+	assert.Equal(t, int64(0), e.localToken().FrozenAddrCount)
+	out, err = e.exec.Query("GetTokenFrozenAddrs", types.Encode(&pty.ReqTokenFrozenAddrs{Symbol: Symbol}))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(out.(*pty.ReplyTokenFrozenAddrs).Addrs))
+
+	accDB, _ := account.NewAccountDB(pty.TokenX, Symbol, e.stateDB)
+	assert.Equal(t, amount, accDB.LoadAccount(string(Nodes[1])).Balance)
+	assert.Equal(t, 2*amount, accDB.LoadAccount(string(Nodes[3])).Balance)
+}
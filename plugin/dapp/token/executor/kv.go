@@ -6,6 +6,9 @@ package executor
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/33cn/chain33/common/address"
 )
 
 var (
@@ -14,8 +17,12 @@ var (
 	tokenPreCreatedSTO    = "mavl-create-token-sto-"
 	tokenPreCreatedOTNew  = "mavl-token-create-ot-"
 	tokenPreCreatedSTONew = "mavl-token-create-sto-"
+	tokenFrozenAddr       = "mavl-token-frozen-"
+	tokenAllowance        = "mavl-token-allowance-"
+	tokenVesting          = "mavl-token-vesting-"
 
 	tokenPreCreatedSTONewLocal = "LODB-token-create-sto-"
+	tokenFrozenAddrLocal       = "LODB-token-frozen-"
 )
 
 func calcTokenKey(token string) (key []byte) {
@@ -50,7 +57,64 @@ func calcTokenStatusTokenKeyPrefixLocal(status int32, token string) []byte {
 	return []byte(fmt.Sprintf(tokenPreCreatedSTONewLocal+"%d-%s-", status, token))
 }
 
+func calcTokenFrozenAddrKey(token string, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenFrozenAddr+"%s-%s", token, addr))
+}
+
+func calcTokenAllowanceKey(token, owner, spender string) []byte {
+	return []byte(fmt.Sprintf(tokenAllowance+"%s-%s-%s", token, owner, spender))
+}
+
+func calcTokenVestingKey(token string) []byte {
+	return []byte(fmt.Sprintf(tokenVesting+"%s", token))
+}
+
+// 预创建时的分配表, 同一个 symbol 可以被不同 owner 预创建
+func calcTokenVestingPreKey(token, owner string) []byte {
+	return []byte(fmt.Sprintf(tokenVesting+"pre-%s-%s", token, owner))
+}
+
+func calcTokenFrozenAddrKeyLocal(token string, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenFrozenAddrLocal+"%s-%s", token, addr))
+}
+
+func calcTokenFrozenAddrPrefixLocal(token string) []byte {
+	return []byte(fmt.Sprintf(tokenFrozenAddrLocal+"%s-", token))
+}
+
 //存储地址上收币的信息
 func calcAddrKey(token string, addr string) []byte {
 	return []byte(fmt.Sprintf("LODB-token-%s-Addr:%s", token, addr))
 }
+
+//token账户的key: mavl-token-{symbol}-{addr} 或 mavl-token-{symbol}-exec-{execaddr}:{addr}
+func isTokenAccountKey(key []byte) bool {
+	if !strings.HasPrefix(string(key), tokenCreated) {
+		return false
+	}
+	item := string(key[len(tokenCreated):])
+	if i := strings.Index(item, "-exec-"); i > 0 {
+		addrs := strings.Split(item[i+len("-exec-"):], ":")
+		return !strings.Contains(item[:i], "-") && len(addrs) == 2 &&
+			isAddress(addrs[0]) && isAddress(addrs[1])
+	}
+	i := strings.LastIndex(item, "-")
+	return i > 0 && !strings.Contains(item[:i], "-") && isAddress(item[i+1:])
+}
+
+//授权额度的key: mavl-token-allowance-{symbol}-{owner}-{spender}, 返回owner
+func allowanceOwner(key []byte) string {
+	if !strings.HasPrefix(string(key), tokenAllowance) {
+		return ""
+	}
+	items := strings.Split(string(key[len(tokenAllowance):]), "-")
+	if len(items) != 3 || !isAddress(items[1]) {
+		return ""
+	}
+	return items[1]
+}
+
+//普通地址或者多重签名地址
+func isAddress(addr string) bool {
+	return address.CheckAddress(addr) == nil || address.CheckMultiSignAddress(addr) == nil
+}
@@ -0,0 +1,86 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenOwnership(t *testing.T) {
+	tokenTotal := int64(10000 * 1e8)
+	amount := int64(100 * 1e8)
+
+	e := newTokenTestEnv(t, pty.ForkTokenOwnershipX)
+	defer e.close()
+
+	_, _, _, err := e.run("TokenPreCreate", &pty.TokenPreCreate{
+		Name:     Symbol,
+		Symbol:   Symbol,
+		Total:    tokenTotal,
+		Owner:    string(Nodes[0]),
+		Category: pty.CategoryMintBurnSupport,
+	}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, _, err = e.run("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+
+	// 未完成创建的 token 不能转移
+	preToken := &pty.Token{Symbol: "PRE", Owner: string(Nodes[0]), Status: pty.TokenStatusPreCreated}
+	e.stateDB.Set(calcTokenKey(preToken.Symbol), types.Encode(preToken))
+	_, _, _, err = e.run("TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: preToken.Symbol, NewOwner: string(Nodes[1])}, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenNotExist, err)
+
+	// 只有 owner 能发起转移, 只有被指定的地址能接受
+	propose := &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: string(Nodes[1])}
+	_, _, _, err = e.run("TokenTransferOwnership", propose, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, _, _, err = e.run("TokenTransferOwnership", propose, PrivKeyA)
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), e.localToken().PendingOwner)
+	_, _, _, err = e.run("TokenAcceptOwnership", &pty.TokenAcceptOwnership{Symbol: Symbol}, PrivKeyC)
+	assert.Equal(t, types.ErrNotAllow, err)
+	acceptTx, acceptReceipt, acceptIndex, err := e.run("TokenAcceptOwnership", &pty.TokenAcceptOwnership{Symbol: Symbol}, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), e.localToken().Owner)
+	assert.Equal(t, "", e.localToken().PendingOwner)
+	// 原 owner 名下的记录同步更新
+	prevOwnerToken, err := getTokenFromDB(e.stateDB, Symbol, string(Nodes[0]))
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), prevOwnerToken.Owner)
+
+	// 新 owner 可以增发和修改信息, 原 owner 不可以
+	mint := &pty.TokenMint{Symbol: Symbol, Amount: amount}
+	_, _, _, err = e.run("TokenMint", mint, PrivKeyA)
+	assert.NotNil(t, err)
+	_, _, _, err = e.run("TokenMint", mint, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, tokenTotal+amount, e.localToken().Total)
+
+	update := &pty.TokenUpdateMetadata{Symbol: Symbol, Introduction: "new introduction"}
+	_, _, _, err = e.run("TokenUpdateMetadata", update, PrivKeyA)
+	assert.NotNil(t, err)
+	_, _, _, err = e.run("TokenUpdateMetadata", update, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, Symbol, e.localToken().Name)
+	assert.Equal(t, "new introduction", e.localToken().Introduction)
+
+	out, err := e.exec.Query("GetTokenHistory", types.Encode(&types.ReqString{Data: Symbol}))
+	assert.Nil(t, err)
+	logs := out.(*pty.ReplyTokenLogs).Logs
+	assert.Equal(t, 5, len(logs))
+	assert.Equal(t, int32(pty.TokenActionUpdateMetadata), logs[0].ActionType)
+	assert.Equal(t, string(Nodes[1]), logs[0].Owner)
+
+	// 回滚接受操作, 本地记录回到原 owner
+	set, err := e.exec.ExecDelLocal(acceptTx, acceptReceipt, acceptIndex)
+	assert.Nil(t, err)
+	e.apply(set)
+	assert.Equal(t, string(Nodes[0]), e.localToken().Owner)
+	assert.Equal(t, string(Nodes[1]), e.localToken().PendingOwner)
+}
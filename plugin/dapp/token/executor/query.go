@@ -59,6 +59,27 @@ func (t *token) Query_GetAccountTokenAssets(in *tokenty.ReqAccountTokenAssets) (
 	return t.getAccountTokenAssets(in)
 }
 
+// Query_GetTokenAllowance 获取 owner 授权给 spender 的 token 额度
+func (t *token) Query_GetTokenAllowance(in *tokenty.ReqTokenAllowance) (types.Message, error) {
+	if in == nil || in.Symbol == "" || in.Owner == "" || in.Spender == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return &tokenty.TokenAllowance{
+		Symbol:  in.Symbol,
+		Owner:   in.Owner,
+		Spender: in.Spender,
+		Amount:  GetAllowance(t.GetStateDB(), in.Symbol, in.Owner, in.Spender),
+	}, nil
+}
+
+// Query_GetTokenVesting 获取受益地址锁定和已释放的额度
+func (t *token) Query_GetTokenVesting(in *tokenty.ReqTokenVesting) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return t.getTokenVesting(in)
+}
+
 // Query_GetTxByToken 获取token相关交易
 func (t *token) Query_GetTxByToken(in *tokenty.ReqTokenTx) (types.Message, error) {
 	if in == nil {
@@ -91,3 +112,30 @@ func (t *token) Query_GetTokenHistory(in *types.ReqString) (types.Message, error
 	}
 	return &replys, nil
 }
+
+// Query_GetTokenFreezeStatus 获取token是否暂停, 以及地址是否被冻结
+func (t *token) Query_GetTokenFreezeStatus(in *tokenty.ReqTokenFreezeStatus) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if !checkTokenExist(in.Symbol, t.GetStateDB()) {
+		return nil, tokenty.ErrTokenNotExist
+	}
+	reply := &tokenty.ReplyTokenFreezeStatus{
+		Symbol: in.Symbol,
+		Paused: isTokenPaused(t.GetStateDB(), in.Symbol),
+		Addr:   in.Addr,
+	}
+	if in.Addr != "" {
+		reply.Frozen = isTokenAddrFrozen(t.GetStateDB(), in.Symbol, in.Addr)
+	}
+	return reply, nil
+}
+
+// Query_GetTokenFrozenAddrs 获取token被冻结的地址列表
+func (t *token) Query_GetTokenFrozenAddrs(in *tokenty.ReqTokenFrozenAddrs) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return t.getTokenFrozenAddrs(in)
+}
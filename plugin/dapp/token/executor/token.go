@@ -14,6 +14,8 @@ token执行器支持token的创建，
 */
 
 import (
+	"strings"
+
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/common/address"
 	log "github.com/33cn/chain33/common/log/log15"
@@ -30,6 +32,10 @@ const (
 	finisherKey       = "token-finisher"
 	tokenAssetsPrefix = "LODB-token-assets:"
 	blacklist         = "token-blacklist"
+	adminKey          = "token-admin"
+	//evm和multisig执行器名, 不引入对应执行器的包
+	evmX      = "evm"
+	multiSigX = "multisig"
 )
 
 var driverName = "token"
@@ -61,6 +67,8 @@ func GetName() string {
 
 type token struct {
 	drivers.DriverBase
+	//ExecFrom执行期间的交易发起人，为空时使用交易签名地址
+	from string
 }
 
 func newToken() drivers.Driver {
@@ -80,6 +88,63 @@ func (t *token) CheckTx(tx *types.Transaction, index int) error {
 	return nil
 }
 
+// ExecFrom 以from作为交易发起人执行交易，只在多重签名交易中以被批准的多重签名账户作为发起人
+func (t *token) ExecFrom(from string, tx *types.Transaction, index int) (*types.Receipt, error) {
+	txs := t.GetTxs()
+	if from == "" || index < 0 || index >= len(txs) || multiSigAccAddr(txs[index]) != from {
+		return nil, types.ErrNotAllow
+	}
+	t.from = from
+	defer func() {
+		t.from = ""
+	}()
+	return t.Exec(tx, index)
+}
+
+// IsFriend 多重签名合约中执行被批准的token交易时，只允许修改token账户的余额和多重签名账户的授权额度
+// evm合约通过资产预编译合约操作token时，只允许修改token账户的余额
+func (t *token) IsFriend(myexec, writekey []byte, othertx *types.Transaction) bool {
+	key := string(writekey)
+	//转账时还会修改锁仓释放记录
+	vesting := strings.HasPrefix(key, tokenVesting) && !strings.HasPrefix(key, tokenVesting+"pre-")
+	switch string(types.GetRealExecName(othertx.Execer)) {
+	case multiSigX:
+		owner := allowanceOwner(writekey)
+		return isTokenAccountKey(writekey) || vesting || (owner != "" && owner == multiSigAccAddr(othertx))
+	case evmX:
+		//授权转账时还会修改授权额度
+		return isTokenAccountKey(writekey) || vesting || strings.HasPrefix(key, tokenAllowance)
+	}
+	return false
+}
+
+//多重签名交易操作的多重签名账户地址, 不是多重签名交易时返回空
+func multiSigAccAddr(tx *types.Transaction) string {
+	if string(types.GetRealExecName(tx.Execer)) != multiSigX {
+		return ""
+	}
+	ety := types.LoadExecutorType(multiSigX)
+	if ety == nil {
+		return ""
+	}
+	_, v, err := ety.DecodePayloadValue(tx)
+	if err != nil {
+		return ""
+	}
+	if action, ok := v.Interface().(interface{ GetMultiSigAccAddr() string }); ok {
+		return action.GetMultiSigAccAddr()
+	}
+	return ""
+}
+
+//交易发起人
+func (t *token) txFrom(tx *types.Transaction) string {
+	if t.from != "" {
+		return t.from
+	}
+	return tx.From()
+}
+
 func (t *token) queryTokenAssetsKey(addr string) (*types.ReplyStrings, error) {
 	key := calcTokenAssetsKey(addr)
 	value, err := t.GetLocalDB().Get(key)
@@ -110,6 +175,11 @@ func (t *token) getAccountTokenAssets(req *tokenty.ReqAccountTokenAssets) (types
 		var acc1 *types.Account
 		if req.Execer == t.GetName() {
 			acc1 = acc.LoadAccount(req.Address)
+			//已经到期还没有解冻的分配额度按可用余额返回
+			if amount := unreleasedVesting(t.GetStateDB(), t.GetHeight(), asset, req.Address); amount > 0 && acc1.Frozen >= amount {
+				acc1.Frozen -= amount
+				acc1.Balance += amount
+			}
 		} else if req.Execer != "" {
 			execAddress := address.ExecAddress(req.Execer)
 			acc1 = acc.LoadExecAccount(req.Address, execAddress)
@@ -343,3 +413,24 @@ func (t *token) getTxByToken(req *tokenty.ReqTokenTx) (types.Message, error) {
 func (t *token) CheckReceiptExecOk() bool {
 	return true
 }
+
+func (t *token) getTokenFrozenAddrs(req *tokenty.ReqTokenFrozenAddrs) (types.Message, error) {
+	var fromKey []byte
+	if req.FromAddr != "" {
+		fromKey = calcTokenFrozenAddrKeyLocal(req.Symbol, req.FromAddr)
+	}
+	values, err := t.GetLocalDB().List(calcTokenFrozenAddrPrefixLocal(req.Symbol), fromKey, req.Count, req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	var reply tokenty.ReplyTokenFrozenAddrs
+	for _, value := range values {
+		var frozen tokenty.TokenAddrFrozen
+		err = types.Decode(value, &frozen)
+		if err != nil {
+			return nil, err
+		}
+		reply.Addrs = append(reply.Addrs, frozen.Addr)
+	}
+	return &reply, nil
+}
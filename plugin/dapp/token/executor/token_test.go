@@ -11,6 +11,7 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
 	pty "github.com/33cn/plugin/plugin/dapp/token/types"
 	"github.com/stretchr/testify/assert"
 	//"github.com/33cn/chain33/types/jsonpb"
@@ -294,3 +295,116 @@ func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error
 	tx.Sign(int32(signType), privKey)
 	return tx, nil
 }
+
+// tokenTestEnv 直接调用执行器的测试环境, 每笔交易执行后立即写入状态数据库并执行 ExecLocal
+type tokenTestEnv struct {
+	t       *testing.T
+	env     execEnv
+	stateDB dbm.DB
+	ldb     dbm.DB
+	kvdb    dbm.KVDB
+	exec    drivers.Driver
+	index   int
+}
+
+func newTokenTestEnv(t *testing.T, fork string) *tokenTestEnv {
+	types.SetTitleOnlyForTest("chain33")
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, ldb, kvdb := util.CreateTestDB()
+	e := &tokenTestEnv{
+		t: t,
+		env: execEnv{
+			10,
+			types.GetDappFork(pty.TokenX, fork),
+			1539918074,
+		},
+		stateDB: stateDB,
+		ldb:     ldb,
+		kvdb:    kvdb,
+	}
+	e.setConfig(blacklist, "BTY")
+	e.setConfig(finisherKey, string(Nodes[0]))
+
+	e.exec = newToken()
+	e.exec.SetStateDB(stateDB)
+	e.exec.SetLocalDB(kvdb)
+	e.exec.SetEnv(e.env.blockHeight, e.env.blockTime, e.env.difficulty)
+	return e
+}
+
+func (e *tokenTestEnv) close() {
+	e.ldb.Close()
+}
+
+func (e *tokenTestEnv) setConfig(key string, values ...string) {
+	item := &types.ConfigItem{
+		Key: "mavl-manage-" + key,
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: values},
+		},
+	}
+	e.stateDB.Set([]byte(item.Key), types.Encode(item))
+}
+
+func (e *tokenTestEnv) setHeight(height int64) {
+	e.env.blockHeight = height
+	e.exec.SetEnv(e.env.blockHeight, e.env.blockTime, e.env.difficulty)
+}
+
+func (e *tokenTestEnv) apply(set *types.LocalDBSet) {
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			e.ldb.Delete(kv.Key)
+			continue
+		}
+		e.kvdb.Set(kv.Key, kv.Value)
+	}
+}
+
+func (e *tokenTestEnv) createTx(action string, param types.Message, priv string) *types.Transaction {
+	var tx *types.Transaction
+	var err error
+	if transfer, ok := param.(*types.AssetsTransfer); ok {
+		v := &pty.TokenAction_Transfer{Transfer: transfer}
+		payload := &pty.TokenAction{Value: v, Ty: pty.ActionTransfer}
+		tx = &types.Transaction{Execer: []byte(pty.TokenX), Payload: types.Encode(payload), To: transfer.To, Nonce: e.env.blockTime}
+		e.env.blockTime++
+	} else {
+		tx, err = types.CallCreateTransaction(pty.TokenX, action, param)
+		assert.Nil(e.t, err)
+		if to, ok := param.(interface{ GetTo() string }); ok && to.GetTo() != "" {
+			tx.To = to.GetTo()
+		}
+	}
+	tx, err = signTx(tx, priv)
+	assert.Nil(e.t, err)
+	return tx
+}
+
+func (e *tokenTestEnv) run(action string, param types.Message, priv string) (*types.Transaction, *types.ReceiptData, int, error) {
+	e.index++
+	tx := e.createTx(action, param, priv)
+	receipt, err := e.exec.Exec(tx, e.index)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	for _, kv := range receipt.KV {
+		e.stateDB.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := e.exec.ExecLocal(tx, receiptData, e.index)
+	assert.Nil(e.t, err)
+	e.apply(set)
+	return tx, receiptData, e.index, nil
+}
+
+func (e *tokenTestEnv) transfer(to string, amount int64, priv string) error {
+	_, _, _, err := e.run("Transfer", &types.AssetsTransfer{Cointoken: Symbol, Amount: amount, To: to}, priv)
+	return err
+}
+
+func (e *tokenTestEnv) localToken() *pty.LocalToken {
+	out, err := e.exec.(*token).Query_GetTokenInfo(&types.ReqString{Data: Symbol})
+	assert.Nil(e.t, err)
+	return out.(*pty.LocalToken)
+}
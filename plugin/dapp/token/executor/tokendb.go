@@ -138,7 +138,7 @@ type tokenAction struct {
 
 func newTokenAction(t *token, toaddr string, tx *types.Transaction) *tokenAction {
 	hash := tx.Hash()
-	fromaddr := tx.From()
+	fromaddr := t.txFrom(tx)
 	return &tokenAction{t.GetCoinsAccount(), t.GetStateDB(), hash, fromaddr, toaddr,
 		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer))}
 }
@@ -167,6 +167,11 @@ func (action *tokenAction) preCreate(token *pty.TokenPreCreate) (*types.Receipt,
 			return nil, types.ErrNotSupport
 		}
 	}
+	if !types.IsDappFork(action.height, pty.TokenX, pty.ForkTokenVestingX) {
+		if len(token.Allocations) != 0 {
+			return nil, types.ErrNotSupport
+		}
+	}
 
 	if !validSymbolWithHeight([]byte(token.GetSymbol()), action.height) {
 		tokenlog.Error("token precreate ", "symbol need be upper", token.GetSymbol())
@@ -206,6 +211,12 @@ func (action *tokenAction) preCreate(token *pty.TokenPreCreate) (*types.Receipt,
 		kv = append(kv, receipt.KV...)
 	}
 
+	allocKV, err := action.saveAllocations(token)
+	if err != nil {
+		return nil, err
+	}
+	kv = append(kv, allocKV...)
+
 	tokendb := newTokenDB(token, action.fromaddr, action.height)
 	var statuskey []byte
 	var key []byte
@@ -277,7 +288,7 @@ func (action *tokenAction) finishCreate(tokenFinish *pty.TokenFinishCreate) (*ty
 		return nil, err
 	}
 	tokenlog.Debug("finishCreate", "token.Owner", token.Owner, "token.GetTotal()", token.GetTotal())
-	receiptForToken, err := tokenAccount.GenesisInit(token.Owner, token.GetTotal())
+	receiptForToken, err := action.lockAllocations(tokenAccount, token)
 	if err != nil {
 		return nil, err
 	}
@@ -615,13 +626,245 @@ func (action *tokenAction) burn(burn *pty.TokenBurn) (*types.Receipt, error) {
 		return nil, err
 	}
 	tokenlog.Debug("burn", "token.Owner", burn.Symbol, "token.GetTotal()", burn.Amount)
+	release, err := releaseVesting(action.db, action.height, burn.GetSymbol(), action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
 	receipt, err := tokenAccount.Burn(action.fromaddr, burn.Amount)
 	if err != nil {
 		return nil, err
 	}
+	receipt = mergeReceipt(release, receipt)
 
 	logs = append(logs, receipt.Logs...)
 	kvs = append(kvs, receipt.KV...)
 
 	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
 }
+
+func (t *tokenDB) pause(paused bool) ([]*types.KeyValue, []*types.ReceiptLog) {
+	prev := t.token.Paused
+	t.token.Paused = paused
+
+	kvs := append(t.getKVSet(calcTokenKey(t.token.Symbol)), t.getKVSet(calcTokenAddrNewKeyS(t.token.Symbol, t.token.Owner))...)
+	log := &pty.ReceiptTokenPause{Symbol: t.token.Symbol, Owner: t.token.Owner, Prev: prev, Current: paused}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenPause, Log: types.Encode(log)}}
+	return kvs, logs
+}
+
+func isTokenPaused(db dbm.KV, symbol string) bool {
+	value, err := db.Get(calcTokenKey(symbol))
+	if err != nil {
+		return false
+	}
+	var t pty.Token
+	if err = types.Decode(value, &t); err != nil {
+		panic(err) //数据错误了，已经被修改了
+	}
+	return t.Paused
+}
+
+func isTokenAddrFrozen(db dbm.KV, symbol, addr string) bool {
+	value, err := db.Get(calcTokenFrozenAddrKey(symbol, addr))
+	if err != nil {
+		return false
+	}
+	var frozen pty.TokenAddrFrozen
+	if err = types.Decode(value, &frozen); err != nil {
+		panic(err) //数据错误了，已经被修改了
+	}
+	return frozen.Frozen
+}
+
+// token 暂停时所有地址都不能转账, 地址被冻结时不能转出也不能转入
+func checkTokenTransfer(db dbm.KV, height int64, symbol string, addrs ...string) error {
+	if !types.IsDappFork(height, pty.TokenX, pty.ForkTokenFreezeX) {
+		return nil
+	}
+	if isTokenPaused(db, symbol) {
+		return pty.ErrTokenPaused
+	}
+	for _, addr := range addrs {
+		if isTokenAddrFrozen(db, symbol, addr) {
+			tokenlog.Error("checkTokenTransfer", "symbol", symbol, "frozen addr", addr)
+			return pty.ErrTokenAddrFrozen
+		}
+	}
+	return nil
+}
+
+// 暂停和冻结由 token 的 owner 或者 manage 合约配置的管理员发起
+func (action *tokenAction) loadFreezeToken(symbol string) (*tokenDB, error) {
+	if !types.IsDappFork(action.height, pty.TokenX, pty.ForkTokenFreezeX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := loadTokenDB(action.db, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Owner == action.fromaddr {
+		return tokendb, nil
+	}
+	isAdmin, err := validOperator(action.fromaddr, adminKey, action.db)
+	if err != nil || !isAdmin {
+		tokenlog.Error("token freeze operator", "symbol", symbol, "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, pty.ErrTokenFreezeOperator
+	}
+	return tokendb, nil
+}
+
+func (action *tokenAction) pause(pause *pty.TokenPause) (*types.Receipt, error) {
+	if pause == nil {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := action.loadFreezeToken(pause.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	kvs, logs := tokendb.pause(pause.Paused)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) freezeAddr(freeze *pty.TokenFreezeAddr) (*types.Receipt, error) {
+	if freeze == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(freeze.GetAddr()); err != nil {
+		return nil, err
+	}
+	tokendb, err := action.loadFreezeToken(freeze.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	prev := isTokenAddrFrozen(action.db, freeze.Symbol, freeze.Addr)
+	value := &pty.TokenAddrFrozen{Symbol: freeze.Symbol, Addr: freeze.Addr, Frozen: freeze.Frozen}
+	kvs := []*types.KeyValue{{Key: calcTokenFrozenAddrKey(freeze.Symbol, freeze.Addr), Value: types.Encode(value)}}
+	log := &pty.ReceiptTokenFreezeAddr{Symbol: freeze.Symbol, Owner: tokendb.token.Owner, Addr: freeze.Addr, Prev: prev, Current: freeze.Frozen}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenFreezeAddr, Log: types.Encode(log)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// owner 变更后 token 同时保存到新 owner 的 key 下
+func (t *tokenDB) getOwnerKVSet() []*types.KeyValue {
+	return append(t.getKVSet(calcTokenKey(t.token.Symbol)), t.getKVSet(calcTokenAddrNewKeyS(t.token.Symbol, t.token.Owner))...)
+}
+
+func (t *tokenDB) transferOwnership(addr, newOwner string) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	if t.token.Owner != addr || newOwner == addr {
+		return nil, nil, types.ErrNotAllow
+	}
+	prevToken := t.token
+	t.token.PendingOwner = newOwner
+
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenTransferOwnership, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: &prevToken, Current: &t.token})}}
+	return t.getOwnerKVSet(), logs, nil
+}
+
+func (t *tokenDB) acceptOwnership(addr string) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	if t.token.PendingOwner == "" || t.token.PendingOwner != addr {
+		return nil, nil, types.ErrNotAllow
+	}
+	prevToken := t.token
+	t.token.Owner = addr
+	t.token.PendingOwner = ""
+
+	//原owner名下的记录同步更新, 避免残留过期的token信息
+	kvs := append(t.getOwnerKVSet(), t.getKVSet(calcTokenAddrNewKeyS(t.token.Symbol, prevToken.Owner))...)
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenAcceptOwnership, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: &prevToken, Current: &t.token})}}
+	return kvs, logs, nil
+}
+
+func (t *tokenDB) updateMetadata(addr string, update *pty.TokenUpdateMetadata) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	if t.token.Owner != addr {
+		return nil, nil, types.ErrNotAllow
+	}
+	prevToken := t.token
+	if update.Name != "" {
+		t.token.Name = update.Name
+	}
+	if update.Introduction != "" {
+		t.token.Introduction = update.Introduction
+	}
+
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenUpdateMetadata, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: &prevToken, Current: &t.token})}}
+	return t.getOwnerKVSet(), logs, nil
+}
+
+func (action *tokenAction) loadOwnershipToken(symbol string) (*tokenDB, error) {
+	if !types.IsDappFork(action.height, pty.TokenX, pty.ForkTokenOwnershipX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := loadTokenDB(action.db, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Status != pty.TokenStatusCreated {
+		return nil, pty.ErrTokenNotExist
+	}
+	return tokendb, nil
+}
+
+func (action *tokenAction) transferOwnership(transfer *pty.TokenTransferOwnership) (*types.Receipt, error) {
+	if transfer == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if transfer.GetNewOwner() != "" {
+		if err := address.CheckAddress(transfer.GetNewOwner()); err != nil {
+			return nil, err
+		}
+	}
+	tokendb, err := action.loadOwnershipToken(transfer.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	kvs, logs, err := tokendb.transferOwnership(action.fromaddr, transfer.GetNewOwner())
+	if err != nil {
+		tokenlog.Error("token transferOwnership", "symbol", transfer.GetSymbol(), "error", err, "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) acceptOwnership(accept *pty.TokenAcceptOwnership) (*types.Receipt, error) {
+	if accept == nil {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := action.loadOwnershipToken(accept.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	kvs, logs, err := tokendb.acceptOwnership(action.fromaddr)
+	if err != nil {
+		tokenlog.Error("token acceptOwnership", "symbol", accept.GetSymbol(), "error", err, "from", action.fromaddr, "pending", tokendb.token.PendingOwner)
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) updateMetadata(update *pty.TokenUpdateMetadata) (*types.Receipt, error) {
+	if update == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if len(update.GetName()) > pty.TokenNameLenLimit {
+		return nil, pty.ErrTokenNameLen
+	} else if len(update.GetIntroduction()) > pty.TokenIntroLenLimit {
+		return nil, pty.ErrTokenIntroLen
+	}
+	tokendb, err := action.loadOwnershipToken(update.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	kvs, logs, err := tokendb.updateMetadata(action.fromaddr, update)
+	if err != nil {
+		tokenlog.Error("token updateMetadata", "symbol", update.GetSymbol(), "error", err, "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
@@ -36,6 +36,21 @@ func tokenTxKvs(tx *types.Transaction, symbol string, height, index int64, isDel
 	return kv, nil
 }
 
+// transferFrom 由 spender 签名, 资产从 owner 转出, 按 owner 和 to 记录
+func tokenTransferFromTxKvs(tx *types.Transaction, transfer *tp.TokenTransferFrom, height, index int64, isDel bool) []*types.KeyValue {
+	var kv []*types.KeyValue
+	keys := tokenTxkeys(transfer.Symbol, transfer.From, transfer.To, height, index)
+
+	var txInfo []byte
+	if !isDel {
+		txInfo = makeReplyTxInfo(tx, height, index, transfer.Symbol)
+	}
+	for _, k := range keys {
+		kv = append(kv, &types.KeyValue{Key: k, Value: txInfo})
+	}
+	return kv
+}
+
 func tokenTxkeys(symbol, from, to string, height, index int64) (result [][]byte) {
 	key := calcTokenTxKey(symbol, height, index)
 	result = append(result, key)
@@ -16,7 +16,7 @@ import (
 func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction, action *tokenty.TokenAction, index int) (*types.Receipt, error) {
 	if (action.Ty == tokenty.ActionTransfer) && action.GetTransfer() != nil {
 		transfer := action.GetTransfer()
-		from := tx.From()
+		from := t.txFrom(tx)
 		//to 是 execs 合约地址
 		if drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) {
 			return accountDB.TransferToExec(from, tx.GetRealToAddr(), transfer.Amount)
@@ -27,7 +27,7 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 		if !types.IsFork(t.GetHeight(), "ForkWithdraw") {
 			withdraw.ExecName = ""
 		}
-		from := tx.From()
+		from := t.txFrom(tx)
 		//to 是 execs 合约地址
 		if drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) || isExecAddrMatch(withdraw.ExecName, tx.GetRealToAddr()) {
 			return accountDB.TransferWithdraw(from, tx.GetRealToAddr(), withdraw.Amount)
@@ -47,7 +47,7 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 			return nil, types.ErrActionNotSupport
 		}
 		transfer := action.GetTransferToExec()
-		from := tx.From()
+		from := t.txFrom(tx)
 		//to 是 execs 合约地址
 		if !isExecAddrMatch(transfer.ExecName, tx.GetRealToAddr()) {
 			return nil, types.ErrToAddrNotSameToExecAddr
@@ -84,7 +84,7 @@ func (t *token) ExecLocalTransWithdraw(tx *types.Transaction, receipt *types.Rec
 		kv, err = updateAddrReciver(t.GetLocalDB(), transfer.Cointoken, tx.GetRealToAddr(), transfer.Amount, true)
 	} else if action.Ty == tokenty.ActionWithdraw && action.GetWithdraw() != nil {
 		withdraw := action.GetWithdraw()
-		from := tx.From()
+		from := t.txFrom(tx)
 		kv, err = updateAddrReciver(t.GetLocalDB(), withdraw.Cointoken, from, withdraw.Amount, true)
 	} else if action.Ty == tokenty.ActionGenesis && action.GetGenesis() != nil {
 		gen := action.GetGenesis()
@@ -119,7 +119,7 @@ func (t *token) ExecDelLocalLocalTransWithdraw(tx *types.Transaction, receipt *t
 		kv, err = updateAddrReciver(t.GetLocalDB(), transfer.Cointoken, tx.GetRealToAddr(), transfer.Amount, false)
 	} else if action.Ty == tokenty.ActionWithdraw && action.GetWithdraw() != nil {
 		withdraw := action.GetWithdraw()
-		from := tx.From()
+		from := t.txFrom(tx)
 		kv, err = updateAddrReciver(t.GetLocalDB(), withdraw.Cointoken, from, withdraw.Amount, false)
 	} else if action.Ty == tokenty.TokenActionTransferToExec && action.GetTransferToExec() != nil {
 		transfer := action.GetTransferToExec()
@@ -0,0 +1,232 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+// 检查分配表, 返回分配总额
+func checkAllocations(allocations []*pty.TokenAllocation, total int64) (int64, error) {
+	if len(allocations) > pty.TokenAllocationLimit {
+		return 0, pty.ErrTokenAllocation
+	}
+	var locked int64
+	addrs := make(map[string]bool)
+	for _, alloc := range allocations {
+		if err := address.CheckAddress(alloc.Addr); err != nil {
+			return 0, err
+		}
+		if addrs[alloc.Addr] {
+			return 0, pty.ErrTokenAllocation
+		}
+		addrs[alloc.Addr] = true
+		if alloc.Amount <= 0 || alloc.Amount > total || alloc.CliffHeight < 0 || alloc.Period < 0 || alloc.Periods < 0 {
+			return 0, pty.ErrTokenAllocation
+		}
+		locked += alloc.Amount
+		if locked > total {
+			return 0, pty.ErrTokenAllocation
+		}
+	}
+	return locked, nil
+}
+
+// 到 height 为止已经释放的额度
+func vestedAmount(alloc *pty.TokenAllocation, height int64) int64 {
+	if height < alloc.CliffHeight {
+		return 0
+	}
+	if alloc.Period == 0 || alloc.Periods <= 1 {
+		return alloc.Amount
+	}
+	n := (height-alloc.CliffHeight)/alloc.Period + 1
+	if n >= int64(alloc.Periods) {
+		return alloc.Amount
+	}
+	return alloc.Amount / int64(alloc.Periods) * n
+}
+
+func loadTokenVestings(db dbm.KV, key []byte) (*pty.TokenVestings, error) {
+	value, err := db.Get(key)
+	if err != nil || len(value) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var vestings pty.TokenVestings
+	if err = types.Decode(value, &vestings); err != nil {
+		return nil, err
+	}
+	return &vestings, nil
+}
+
+func saveTokenVestings(db dbm.KV, key []byte, vestings *pty.TokenVestings) []*types.KeyValue {
+	kv := &types.KeyValue{Key: key, Value: types.Encode(vestings)}
+	db.Set(kv.Key, kv.Value)
+	return []*types.KeyValue{kv}
+}
+
+// 预创建时保存分配表, 创建完成时才锁定. 没有分配时也保存, 覆盖之前撤销的预创建留下的分配表
+func (action *tokenAction) saveAllocations(token *pty.TokenPreCreate) ([]*types.KeyValue, error) {
+	if !types.IsDappFork(action.height, pty.TokenX, pty.ForkTokenVestingX) {
+		return nil, nil
+	}
+	if _, err := checkAllocations(token.Allocations, token.Total); err != nil {
+		return nil, err
+	}
+	vestings := &pty.TokenVestings{Symbol: token.Symbol}
+	for _, alloc := range token.Allocations {
+		vestings.Vestings = append(vestings.Vestings, &pty.TokenVesting{Allocation: alloc})
+	}
+	return saveTokenVestings(action.db, calcTokenVestingPreKey(token.Symbol, token.Owner), vestings), nil
+}
+
+// 创建完成时把分配额度冻结在受益地址上, 剩余部分给 owner
+func (action *tokenAction) lockAllocations(tokenAccount *account.DB, token *pty.Token) (*types.Receipt, error) {
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	var vestings *pty.TokenVestings
+	var locked int64
+	if types.IsDappFork(action.height, pty.TokenX, pty.ForkTokenVestingX) {
+		vestings, _ = loadTokenVestings(action.db, calcTokenVestingPreKey(token.Symbol, token.Owner))
+	}
+	if vestings != nil && len(vestings.Vestings) == 0 {
+		vestings = nil
+	}
+	if vestings != nil {
+		for _, vesting := range vestings.Vestings {
+			locked += vesting.Allocation.Amount
+		}
+	}
+	if locked < token.Total {
+		genesis, err := tokenAccount.GenesisInit(token.Owner, token.Total-locked)
+		if err != nil {
+			return nil, err
+		}
+		receipt.KV = append(receipt.KV, genesis.KV...)
+		receipt.Logs = append(receipt.Logs, genesis.Logs...)
+	}
+	if vestings == nil {
+		return receipt, nil
+	}
+
+	for _, vesting := range vestings.Vestings {
+		acc := tokenAccount.LoadAccount(vesting.Allocation.Addr)
+		prev := *acc
+		acc.Frozen += vesting.Allocation.Amount
+		tokenAccount.SaveAccount(acc)
+		receipt.KV = append(receipt.KV, tokenAccount.GetKVSet(acc)...)
+		log := &types.ReceiptAccountTransfer{Prev: &prev, Current: acc}
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: types.TyLogGenesis, Log: types.Encode(log)})
+	}
+	receipt.KV = append(receipt.KV, saveTokenVestings(action.db, calcTokenVestingKey(token.Symbol), vestings)...)
+	return receipt, nil
+}
+
+// 查找 addr 的分配记录, 没有时返回 nil
+func findVesting(db dbm.KV, height int64, symbol, addr string) (*pty.TokenVestings, *pty.TokenVesting) {
+	if !types.IsDappFork(height, pty.TokenX, pty.ForkTokenVestingX) {
+		return nil, nil
+	}
+	vestings, err := loadTokenVestings(db, calcTokenVestingKey(symbol))
+	if err != nil {
+		return nil, nil
+	}
+	for _, v := range vestings.Vestings {
+		if v.Allocation.Addr == addr {
+			return vestings, v
+		}
+	}
+	return nil, nil
+}
+
+// 已经到期但还没有解冻的额度
+func unreleasedVesting(db dbm.KV, height int64, symbol, addr string) int64 {
+	_, vesting := findVesting(db, height, symbol, addr)
+	if vesting == nil {
+		return 0
+	}
+	vested := vestedAmount(vesting.Allocation, height)
+	if vested <= vesting.Released {
+		return 0
+	}
+	return vested - vesting.Released
+}
+
+// releaseVesting 把 addr 到当前高度已经到期的额度解冻,
+// 受益地址转出, 取回, 转入合约和销毁 token 时自动调用, 没有可释放的额度时返回 nil
+func releaseVesting(db dbm.KV, height int64, symbol, addr string) (*types.Receipt, error) {
+	vestings, vesting := findVesting(db, height, symbol, addr)
+	if vesting == nil {
+		return nil, nil
+	}
+	vested := vestedAmount(vesting.Allocation, height)
+	if vested <= vesting.Released {
+		return nil, nil
+	}
+
+	tokenAccount, err := account.NewAccountDB(pty.TokenX, symbol, db)
+	if err != nil {
+		return nil, err
+	}
+	acc := tokenAccount.LoadAccount(addr)
+	amount := vested - vesting.Released
+	if acc.Frozen < amount {
+		tokenlog.Error("releaseVesting", "symbol", symbol, "addr", addr, "frozen", acc.Frozen, "amount", amount)
+		return nil, types.ErrNoBalance
+	}
+	prevAcc := *acc
+	acc.Frozen -= amount
+	acc.Balance += amount
+	tokenAccount.SaveAccount(acc)
+
+	log := &pty.ReceiptTokenVesting{Symbol: symbol, Addr: addr, Prev: vesting.Released, Current: vested}
+	vesting.Released = vested
+	kv := tokenAccount.GetKVSet(acc)
+	kv = append(kv, saveTokenVestings(db, calcTokenVestingKey(symbol), vestings)...)
+	logs := []*types.ReceiptLog{
+		{Ty: types.TyLogTransfer, Log: types.Encode(&types.ReceiptAccountTransfer{Prev: &prevAcc, Current: acc})},
+		{Ty: pty.TyLogTokenVestingRelease, Log: types.Encode(log)},
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// 释放的收据放在转账收据之前
+func mergeReceipt(release, receipt *types.Receipt) *types.Receipt {
+	if release == nil || receipt == nil {
+		return receipt
+	}
+	receipt.KV = append(release.KV, receipt.KV...)
+	receipt.Logs = append(release.Logs, receipt.Logs...)
+	return receipt
+}
+
+func (t *token) getTokenVesting(req *pty.ReqTokenVesting) (types.Message, error) {
+	vestings, err := loadTokenVestings(t.GetStateDB(), calcTokenVestingKey(req.Symbol))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pty.ReplyTokenVesting{Symbol: req.Symbol}
+	for _, vesting := range vestings.Vestings {
+		alloc := vesting.Allocation
+		if req.Addr != "" && req.Addr != alloc.Addr {
+			continue
+		}
+		vested := vestedAmount(alloc, t.GetHeight())
+		reply.Balances = append(reply.Balances, &pty.TokenVestingBalance{
+			Addr:     alloc.Addr,
+			Amount:   alloc.Amount,
+			Locked:   alloc.Amount - vested,
+			Released: vesting.Released,
+			Vested:   vested,
+		})
+	}
+	if len(reply.Balances) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return reply, nil
+}
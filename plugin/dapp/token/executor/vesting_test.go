@@ -0,0 +1,100 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenVesting(t *testing.T) {
+	tokenTotal := int64(10000 * 1e8)
+	amount := int64(100 * 1e8)
+
+	e := newTokenTestEnv(t, pty.ForkTokenVestingX)
+	defer e.close()
+
+	vesting := func(addr string) *pty.TokenVestingBalance {
+		out, err := e.exec.Query("GetTokenVesting", types.Encode(&pty.ReqTokenVesting{Symbol: Symbol, Addr: addr}))
+		assert.Nil(t, err)
+		return out.(*pty.ReplyTokenVesting).Balances[0]
+	}
+
+	unit := int64(1000 * 1e8)
+	base := e.env.blockHeight
+	precreate := &pty.TokenPreCreate{
+		Name:   Symbol,
+		Symbol: Symbol,
+		Total:  tokenTotal,
+		Owner:  string(Nodes[0]),
+		Allocations: []*pty.TokenAllocation{
+			{Addr: string(Nodes[1]), Amount: 4 * unit, CliffHeight: base + 20, Period: 10, Periods: 4},
+			{Addr: string(Nodes[2]), Amount: unit},
+		},
+	}
+	// 分配总额不能超过发行总量
+	precreate.Allocations[0].Amount = tokenTotal
+	_, _, _, err := e.run("TokenPreCreate", precreate, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenAllocation, err)
+	precreate.Allocations[0].Amount = 4 * unit
+	_, _, _, err = e.run("TokenPreCreate", precreate, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, _, err = e.run("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+
+	accDB, _ := account.NewAccountDB(pty.TokenX, Symbol, e.stateDB)
+	assert.Equal(t, tokenTotal-5*unit, accDB.LoadAccount(string(Nodes[0])).Balance)
+	assert.Equal(t, 4*unit, accDB.LoadAccount(string(Nodes[1])).Frozen)
+	assert.Equal(t, &pty.TokenVestingBalance{Addr: string(Nodes[1]), Amount: 4 * unit, Locked: 4 * unit}, vesting(string(Nodes[1])))
+
+	// 没到 cliff 高度不能转出
+	assert.Equal(t, types.ErrNoBalance, e.transfer(string(Nodes[3]), amount, PrivKeyB))
+	// 没有锁定期的分配在第一次转出时全部释放
+	assert.Nil(t, e.transfer(string(Nodes[3]), amount, PrivKeyC))
+	assert.Equal(t, unit-amount, accDB.LoadAccount(string(Nodes[2])).Balance)
+
+	// 第二期释放后可以转出
+	e.setHeight(base + 35)
+	// 到期但还没有转出时仍然冻结, 没有解冻
+	assert.Equal(t, &pty.TokenVestingBalance{Addr: string(Nodes[1]), Amount: 4 * unit, Locked: 2 * unit, Vested: 2 * unit}, vesting(string(Nodes[1])))
+	assert.Equal(t, 4*unit, accDB.LoadAccount(string(Nodes[1])).Frozen)
+	assert.Nil(t, e.transfer(string(Nodes[3]), amount, PrivKeyB))
+	assert.Equal(t, 2*unit, vesting(string(Nodes[1])).Released)
+	assert.Equal(t, 2*unit-amount, accDB.LoadAccount(string(Nodes[1])).Balance)
+	assert.Equal(t, 2*unit, accDB.LoadAccount(string(Nodes[1])).Frozen)
+
+	// 第三期到期后, 余额查询把到期的额度算作可用余额
+	execAddr := address.ExecAddress("trade")
+	_, _, _, err = e.run("TransferToExec", &types.AssetsTransferToExec{Cointoken: Symbol, Amount: amount, To: execAddr, ExecName: "trade"}, PrivKeyB)
+	assert.Nil(t, err)
+	e.setHeight(base + 45)
+	assets := func() *types.Account {
+		out, err := e.exec.Query("GetAccountTokenAssets", types.Encode(&pty.ReqAccountTokenAssets{Address: string(Nodes[1]), Execer: pty.TokenX}))
+		assert.Nil(t, err)
+		return out.(*pty.ReplyAccountTokenAssets).TokenAssets[0].Account
+	}
+	assert.Equal(t, 3*unit-2*amount, assets().Balance)
+	assert.Equal(t, unit, assets().Frozen)
+	// 取回时同样释放到期的额度
+	_, _, _, err = e.run("Withdraw", &types.AssetsWithdraw{Cointoken: Symbol, Amount: amount, To: execAddr, ExecName: "trade"}, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, 3*unit, vesting(string(Nodes[1])).Released)
+	assert.Equal(t, 3*unit-amount, accDB.LoadAccount(string(Nodes[1])).Balance)
+	assert.Equal(t, unit, accDB.LoadAccount(string(Nodes[1])).Frozen)
+
+	e.setHeight(base + 100)
+	assert.Equal(t, int64(0), vesting(string(Nodes[1])).Locked)
+	assert.Equal(t, 4*unit, vesting(string(Nodes[1])).Vested)
+	assert.Equal(t, 3*unit, vesting(string(Nodes[1])).Released)
+	assert.Nil(t, e.transfer(string(Nodes[3]), amount, PrivKeyB))
+	assert.Equal(t, 4*unit, vesting(string(Nodes[1])).Released)
+	assert.Equal(t, 4*unit-2*amount, accDB.LoadAccount(string(Nodes[1])).Balance)
+	assert.Equal(t, int64(0), accDB.LoadAccount(string(Nodes[1])).Frozen)
+}
@@ -142,3 +142,107 @@ func (c *Jrpc) CreateRawTokenBurnTx(param *tokenty.TokenBurn, result *interface{
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawTokenPauseTx 创建未签名的暂停/恢复 Token 转账交易
+func (c *Jrpc) CreateRawTokenPauseTx(param *tokenty.TokenPause, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenPause", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenFreezeAddrTx 创建未签名的冻结/解冻地址交易
+func (c *Jrpc) CreateRawTokenFreezeAddrTx(param *tokenty.TokenFreezeAddr, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Addr == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenFreezeAddr", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenTransferOwnershipTx 创建未签名的转移 Token owner 交易
+func (c *Jrpc) CreateRawTokenTransferOwnershipTx(param *tokenty.TokenTransferOwnership, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenTransferOwnership", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenAcceptOwnershipTx 创建未签名的接受 Token owner 交易
+func (c *Jrpc) CreateRawTokenAcceptOwnershipTx(param *tokenty.TokenAcceptOwnership, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenAcceptOwnership", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenUpdateMetadataTx 创建未签名的修改 Token 信息交易
+func (c *Jrpc) CreateRawTokenUpdateMetadataTx(param *tokenty.TokenUpdateMetadata, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenUpdateMetadata", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenApproveTx 创建未签名的设置授权额度交易
+func (c *Jrpc) CreateRawTokenApproveTx(param *tokenty.TokenApprove, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Spender == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenApprove", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenIncreaseAllowanceTx 创建未签名的增加授权额度交易
+func (c *Jrpc) CreateRawTokenIncreaseAllowanceTx(param *tokenty.TokenIncreaseAllowance, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Spender == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenIncreaseAllowance", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenTransferFromTx 创建未签名的按授权额度转出 Token交易
+func (c *Jrpc) CreateRawTokenTransferFromTx(param *tokenty.TokenTransferFrom, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.From == "" || param.To == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenTransferFrom", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
@@ -23,6 +23,22 @@ const (
 	TokenActionMint = 12
 	// TokenActionBurn for token burn
 	TokenActionBurn = 13
+	// TokenActionPause for token pause
+	TokenActionPause = 14
+	// TokenActionFreezeAddr for token freeze addr
+	TokenActionFreezeAddr = 15
+	// TokenActionTransferOwnership for token transfer ownership
+	TokenActionTransferOwnership = 16
+	// TokenActionAcceptOwnership for token accept ownership
+	TokenActionAcceptOwnership = 17
+	// TokenActionUpdateMetadata for token update metadata
+	TokenActionUpdateMetadata = 18
+	// TokenActionApprove for token approve
+	TokenActionApprove = 19
+	// TokenActionIncreaseAllowance for token increase allowance
+	TokenActionIncreaseAllowance = 20
+	// TokenActionTransferFrom for token transfer from
+	TokenActionTransferFrom = 21
 )
 
 // token status
@@ -49,6 +65,14 @@ var (
 	ForkTokenSymbolWithNumberX = "ForkTokenSymbolWithNumber"
 	// ForkTokenCheckX  fork check impl bug
 	ForkTokenCheckX = "ForkTokenCheck"
+	// ForkTokenFreezeX fork const, 支持暂停 token 和冻结地址
+	ForkTokenFreezeX = "ForkTokenFreeze"
+	// ForkTokenOwnershipX fork const, 支持转移 owner 和修改 token 信息
+	ForkTokenOwnershipX = "ForkTokenOwnership"
+	// ForkTokenAllowanceX fork const, 支持授权第三方转出 token
+	ForkTokenAllowanceX = "ForkTokenAllowance"
+	// ForkTokenVestingX fork const, 支持创建时锁定分配额度并按高度释放
+	ForkTokenVestingX = "ForkTokenVesting"
 )
 
 const (
@@ -82,6 +106,20 @@ const (
 	TyLogTokenMint = 323
 	// TyLogTokenBurn log for token burn
 	TyLogTokenBurn = 324
+	// TyLogTokenPause log for token pause
+	TyLogTokenPause = 325
+	// TyLogTokenFreezeAddr log for token freeze addr
+	TyLogTokenFreezeAddr = 326
+	// TyLogTokenTransferOwnership log for token transfer ownership
+	TyLogTokenTransferOwnership = 327
+	// TyLogTokenAcceptOwnership log for token accept ownership
+	TyLogTokenAcceptOwnership = 328
+	// TyLogTokenUpdateMetadata log for token update metadata
+	TyLogTokenUpdateMetadata = 329
+	// TyLogTokenAllowance log for token allowance change
+	TyLogTokenAllowance = 330
+	// TyLogTokenVestingRelease log for token vesting release
+	TyLogTokenVestingRelease = 331
 )
 
 const (
@@ -91,6 +129,8 @@ const (
 	TokenSymbolLenLimit = 16
 	// TokenIntroLenLimit token introduction length limit
 	TokenIntroLenLimit = 1024
+	// TokenAllocationLimit token allocation count limit
+	TokenAllocationLimit = 64
 )
 
 const (
@@ -35,4 +35,14 @@ var (
 	ErrTokenBlacklist = errors.New("ErrTokenBlacklist")
 	// ErrTokenNotExist error token symbol not exist
 	ErrTokenNotExist = errors.New("ErrTokenSymbolNotExist")
+	// ErrTokenPaused error token transfer paused
+	ErrTokenPaused = errors.New("ErrTokenPaused")
+	// ErrTokenAddrFrozen error token addr frozen
+	ErrTokenAddrFrozen = errors.New("ErrTokenAddrFrozen")
+	// ErrTokenFreezeOperator error token pause/freeze operator not owner or admin
+	ErrTokenFreezeOperator = errors.New("ErrTokenFreezeOperator")
+	// ErrTokenAllowance error token allowance not enough
+	ErrTokenAllowance = errors.New("ErrTokenAllowanceNotEnough")
+	// ErrTokenAllocation error token allocation invalid
+	ErrTokenAllocation = errors.New("ErrTokenAllocation")
 )
@@ -4,17 +4,14 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
-
-	proto "github.com/golang/protobuf/proto"
-
-	math "math"
-
 	types "github.com/33cn/chain33/types"
-
-	context "golang.org/x/net/context"
-
+	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -26,7 +23,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // action
 type TokenAction struct {
@@ -40,6 +37,14 @@ type TokenAction struct {
 	//	*TokenAction_TransferToExec
 	//	*TokenAction_TokenMint
 	//	*TokenAction_TokenBurn
+	//	*TokenAction_TokenPause
+	//	*TokenAction_TokenFreezeAddr
+	//	*TokenAction_TokenTransferOwnership
+	//	*TokenAction_TokenAcceptOwnership
+	//	*TokenAction_TokenUpdateMetadata
+	//	*TokenAction_TokenApprove
+	//	*TokenAction_TokenIncreaseAllowance
+	//	*TokenAction_TokenTransferFrom
 	Value                isTokenAction_Value `protobuf_oneof:"value"`
 	Ty                   int32               `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -51,16 +56,17 @@ func (m *TokenAction) Reset()         { *m = TokenAction{} }
 func (m *TokenAction) String() string { return proto.CompactTextString(m) }
 func (*TokenAction) ProtoMessage()    {}
 func (*TokenAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{0}
+	return fileDescriptor_3aff0bcd502840ab, []int{0}
 }
+
 func (m *TokenAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenAction.Unmarshal(m, b)
 }
 func (m *TokenAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenAction.Marshal(b, m, deterministic)
 }
-func (dst *TokenAction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenAction.Merge(dst, src)
+func (m *TokenAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAction.Merge(m, src)
 }
 func (m *TokenAction) XXX_Size() int {
 	return xxx_messageInfo_TokenAction.Size(m)
@@ -111,6 +117,38 @@ type TokenAction_TokenBurn struct {
 	TokenBurn *TokenBurn `protobuf:"bytes,10,opt,name=tokenBurn,proto3,oneof"`
 }
 
+type TokenAction_TokenPause struct {
+	TokenPause *TokenPause `protobuf:"bytes,11,opt,name=tokenPause,proto3,oneof"`
+}
+
+type TokenAction_TokenFreezeAddr struct {
+	TokenFreezeAddr *TokenFreezeAddr `protobuf:"bytes,12,opt,name=tokenFreezeAddr,proto3,oneof"`
+}
+
+type TokenAction_TokenTransferOwnership struct {
+	TokenTransferOwnership *TokenTransferOwnership `protobuf:"bytes,13,opt,name=tokenTransferOwnership,proto3,oneof"`
+}
+
+type TokenAction_TokenAcceptOwnership struct {
+	TokenAcceptOwnership *TokenAcceptOwnership `protobuf:"bytes,14,opt,name=tokenAcceptOwnership,proto3,oneof"`
+}
+
+type TokenAction_TokenUpdateMetadata struct {
+	TokenUpdateMetadata *TokenUpdateMetadata `protobuf:"bytes,15,opt,name=tokenUpdateMetadata,proto3,oneof"`
+}
+
+type TokenAction_TokenApprove struct {
+	TokenApprove *TokenApprove `protobuf:"bytes,16,opt,name=tokenApprove,proto3,oneof"`
+}
+
+type TokenAction_TokenIncreaseAllowance struct {
+	TokenIncreaseAllowance *TokenIncreaseAllowance `protobuf:"bytes,17,opt,name=tokenIncreaseAllowance,proto3,oneof"`
+}
+
+type TokenAction_TokenTransferFrom struct {
+	TokenTransferFrom *TokenTransferFrom `protobuf:"bytes,18,opt,name=tokenTransferFrom,proto3,oneof"`
+}
+
 func (*TokenAction_TokenPreCreate) isTokenAction_Value() {}
 
 func (*TokenAction_TokenFinishCreate) isTokenAction_Value() {}
@@ -129,6 +167,22 @@ func (*TokenAction_TokenMint) isTokenAction_Value() {}
 
 func (*TokenAction_TokenBurn) isTokenAction_Value() {}
 
+func (*TokenAction_TokenPause) isTokenAction_Value() {}
+
+func (*TokenAction_TokenFreezeAddr) isTokenAction_Value() {}
+
+func (*TokenAction_TokenTransferOwnership) isTokenAction_Value() {}
+
+func (*TokenAction_TokenAcceptOwnership) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUpdateMetadata) isTokenAction_Value() {}
+
+func (*TokenAction_TokenApprove) isTokenAction_Value() {}
+
+func (*TokenAction_TokenIncreaseAllowance) isTokenAction_Value() {}
+
+func (*TokenAction_TokenTransferFrom) isTokenAction_Value() {}
+
 func (m *TokenAction) GetValue() isTokenAction_Value {
 	if m != nil {
 		return m.Value
@@ -199,6 +253,62 @@ func (m *TokenAction) GetTokenBurn() *TokenBurn {
 	return nil
 }
 
+func (m *TokenAction) GetTokenPause() *TokenPause {
+	if x, ok := m.GetValue().(*TokenAction_TokenPause); ok {
+		return x.TokenPause
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenFreezeAddr() *TokenFreezeAddr {
+	if x, ok := m.GetValue().(*TokenAction_TokenFreezeAddr); ok {
+		return x.TokenFreezeAddr
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenTransferOwnership() *TokenTransferOwnership {
+	if x, ok := m.GetValue().(*TokenAction_TokenTransferOwnership); ok {
+		return x.TokenTransferOwnership
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenAcceptOwnership() *TokenAcceptOwnership {
+	if x, ok := m.GetValue().(*TokenAction_TokenAcceptOwnership); ok {
+		return x.TokenAcceptOwnership
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenUpdateMetadata() *TokenUpdateMetadata {
+	if x, ok := m.GetValue().(*TokenAction_TokenUpdateMetadata); ok {
+		return x.TokenUpdateMetadata
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenApprove() *TokenApprove {
+	if x, ok := m.GetValue().(*TokenAction_TokenApprove); ok {
+		return x.TokenApprove
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenIncreaseAllowance() *TokenIncreaseAllowance {
+	if x, ok := m.GetValue().(*TokenAction_TokenIncreaseAllowance); ok {
+		return x.TokenIncreaseAllowance
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenTransferFrom() *TokenTransferFrom {
+	if x, ok := m.GetValue().(*TokenAction_TokenTransferFrom); ok {
+		return x.TokenTransferFrom
+	}
+	return nil
+}
+
 func (m *TokenAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -218,6 +328,14 @@ func (*TokenAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) e
 		(*TokenAction_TransferToExec)(nil),
 		(*TokenAction_TokenMint)(nil),
 		(*TokenAction_TokenBurn)(nil),
+		(*TokenAction_TokenPause)(nil),
+		(*TokenAction_TokenFreezeAddr)(nil),
+		(*TokenAction_TokenTransferOwnership)(nil),
+		(*TokenAction_TokenAcceptOwnership)(nil),
+		(*TokenAction_TokenUpdateMetadata)(nil),
+		(*TokenAction_TokenApprove)(nil),
+		(*TokenAction_TokenIncreaseAllowance)(nil),
+		(*TokenAction_TokenTransferFrom)(nil),
 	}
 }
 
@@ -270,6 +388,46 @@ func _TokenAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.TokenBurn); err != nil {
 			return err
 		}
+	case *TokenAction_TokenPause:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenPause); err != nil {
+			return err
+		}
+	case *TokenAction_TokenFreezeAddr:
+		b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenFreezeAddr); err != nil {
+			return err
+		}
+	case *TokenAction_TokenTransferOwnership:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenTransferOwnership); err != nil {
+			return err
+		}
+	case *TokenAction_TokenAcceptOwnership:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenAcceptOwnership); err != nil {
+			return err
+		}
+	case *TokenAction_TokenUpdateMetadata:
+		b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenUpdateMetadata); err != nil {
+			return err
+		}
+	case *TokenAction_TokenApprove:
+		b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenApprove); err != nil {
+			return err
+		}
+	case *TokenAction_TokenIncreaseAllowance:
+		b.EncodeVarint(17<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenIncreaseAllowance); err != nil {
+			return err
+		}
+	case *TokenAction_TokenTransferFrom:
+		b.EncodeVarint(18<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenTransferFrom); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("TokenAction.Value has unexpected type %T", x)
@@ -352,6 +510,70 @@ func _TokenAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.Value = &TokenAction_TokenBurn{msg}
 		return true, err
+	case 11: // value.tokenPause
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenPause)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenPause{msg}
+		return true, err
+	case 12: // value.tokenFreezeAddr
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenFreezeAddr)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenFreezeAddr{msg}
+		return true, err
+	case 13: // value.tokenTransferOwnership
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenTransferOwnership)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenTransferOwnership{msg}
+		return true, err
+	case 14: // value.tokenAcceptOwnership
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenAcceptOwnership)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenAcceptOwnership{msg}
+		return true, err
+	case 15: // value.tokenUpdateMetadata
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenUpdateMetadata)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenUpdateMetadata{msg}
+		return true, err
+	case 16: // value.tokenApprove
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenApprove)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenApprove{msg}
+		return true, err
+	case 17: // value.tokenIncreaseAllowance
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenIncreaseAllowance)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenIncreaseAllowance{msg}
+		return true, err
+	case 18: // value.tokenTransferFrom
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenTransferFrom)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenTransferFrom{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -406,6 +628,46 @@ func _TokenAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TokenAction_TokenPause:
+		s := proto.Size(x.TokenPause)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenFreezeAddr:
+		s := proto.Size(x.TokenFreezeAddr)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenTransferOwnership:
+		s := proto.Size(x.TokenTransferOwnership)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenAcceptOwnership:
+		s := proto.Size(x.TokenAcceptOwnership)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenUpdateMetadata:
+		s := proto.Size(x.TokenUpdateMetadata)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenApprove:
+		s := proto.Size(x.TokenApprove)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenIncreaseAllowance:
+		s := proto.Size(x.TokenIncreaseAllowance)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenTransferFrom:
+		s := proto.Size(x.TokenTransferFrom)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -415,32 +677,35 @@ func _TokenAction_OneofSizer(msg proto.Message) (n int) {
 
 // 创建token，支持最大精确度是8位小数,即存入数据库的实际总额需要放大1e8倍
 type TokenPreCreate struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	Total                int64    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Price                int64    `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Owner                string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
-	Category             int32    `protobuf:"varint,7,opt,name=category,proto3" json:"category,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol       string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Introduction string `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Total        int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Price        int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Owner        string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Category     int32  `protobuf:"varint,7,opt,name=category,proto3" json:"category,omitempty"`
+	// 创建完成时锁定, 按区块高度逐步释放给受益地址
+	Allocations          []*TokenAllocation `protobuf:"bytes,8,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *TokenPreCreate) Reset()         { *m = TokenPreCreate{} }
 func (m *TokenPreCreate) String() string { return proto.CompactTextString(m) }
 func (*TokenPreCreate) ProtoMessage()    {}
 func (*TokenPreCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{1}
+	return fileDescriptor_3aff0bcd502840ab, []int{1}
 }
+
 func (m *TokenPreCreate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenPreCreate.Unmarshal(m, b)
 }
 func (m *TokenPreCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenPreCreate.Marshal(b, m, deterministic)
 }
-func (dst *TokenPreCreate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenPreCreate.Merge(dst, src)
+func (m *TokenPreCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPreCreate.Merge(m, src)
 }
 func (m *TokenPreCreate) XXX_Size() int {
 	return xxx_messageInfo_TokenPreCreate.Size(m)
@@ -500,6 +765,85 @@ func (m *TokenPreCreate) GetCategory() int32 {
 	return 0
 }
 
+func (m *TokenPreCreate) GetAllocations() []*TokenAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// 从 cliffHeight 开始释放第一期, 之后每 period 个区块释放一期, 共 periods 期
+type TokenAllocation struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	CliffHeight          int64    `protobuf:"varint,3,opt,name=cliffHeight,proto3" json:"cliffHeight,omitempty"`
+	Period               int64    `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	Periods              int32    `protobuf:"varint,5,opt,name=periods,proto3" json:"periods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenAllocation) Reset()         { *m = TokenAllocation{} }
+func (m *TokenAllocation) String() string { return proto.CompactTextString(m) }
+func (*TokenAllocation) ProtoMessage()    {}
+func (*TokenAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{2}
+}
+
+func (m *TokenAllocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAllocation.Unmarshal(m, b)
+}
+func (m *TokenAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAllocation.Marshal(b, m, deterministic)
+}
+func (m *TokenAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAllocation.Merge(m, src)
+}
+func (m *TokenAllocation) XXX_Size() int {
+	return xxx_messageInfo_TokenAllocation.Size(m)
+}
+func (m *TokenAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAllocation proto.InternalMessageInfo
+
+func (m *TokenAllocation) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenAllocation) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TokenAllocation) GetCliffHeight() int64 {
+	if m != nil {
+		return m.CliffHeight
+	}
+	return 0
+}
+
+func (m *TokenAllocation) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *TokenAllocation) GetPeriods() int32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
 type TokenFinishCreate struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -512,16 +856,17 @@ func (m *TokenFinishCreate) Reset()         { *m = TokenFinishCreate{} }
 func (m *TokenFinishCreate) String() string { return proto.CompactTextString(m) }
 func (*TokenFinishCreate) ProtoMessage()    {}
 func (*TokenFinishCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{2}
+	return fileDescriptor_3aff0bcd502840ab, []int{3}
 }
+
 func (m *TokenFinishCreate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenFinishCreate.Unmarshal(m, b)
 }
 func (m *TokenFinishCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenFinishCreate.Marshal(b, m, deterministic)
 }
-func (dst *TokenFinishCreate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenFinishCreate.Merge(dst, src)
+func (m *TokenFinishCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFinishCreate.Merge(m, src)
 }
 func (m *TokenFinishCreate) XXX_Size() int {
 	return xxx_messageInfo_TokenFinishCreate.Size(m)
@@ -558,16 +903,17 @@ func (m *TokenRevokeCreate) Reset()         { *m = TokenRevokeCreate{} }
 func (m *TokenRevokeCreate) String() string { return proto.CompactTextString(m) }
 func (*TokenRevokeCreate) ProtoMessage()    {}
 func (*TokenRevokeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{3}
+	return fileDescriptor_3aff0bcd502840ab, []int{4}
 }
+
 func (m *TokenRevokeCreate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenRevokeCreate.Unmarshal(m, b)
 }
 func (m *TokenRevokeCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenRevokeCreate.Marshal(b, m, deterministic)
 }
-func (dst *TokenRevokeCreate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenRevokeCreate.Merge(dst, src)
+func (m *TokenRevokeCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRevokeCreate.Merge(m, src)
 }
 func (m *TokenRevokeCreate) XXX_Size() int {
 	return xxx_messageInfo_TokenRevokeCreate.Size(m)
@@ -604,16 +950,17 @@ func (m *TokenMint) Reset()         { *m = TokenMint{} }
 func (m *TokenMint) String() string { return proto.CompactTextString(m) }
 func (*TokenMint) ProtoMessage()    {}
 func (*TokenMint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{4}
+	return fileDescriptor_3aff0bcd502840ab, []int{5}
 }
+
 func (m *TokenMint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenMint.Unmarshal(m, b)
 }
 func (m *TokenMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenMint.Marshal(b, m, deterministic)
 }
-func (dst *TokenMint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenMint.Merge(dst, src)
+func (m *TokenMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMint.Merge(m, src)
 }
 func (m *TokenMint) XXX_Size() int {
 	return xxx_messageInfo_TokenMint.Size(m)
@@ -650,16 +997,17 @@ func (m *TokenBurn) Reset()         { *m = TokenBurn{} }
 func (m *TokenBurn) String() string { return proto.CompactTextString(m) }
 func (*TokenBurn) ProtoMessage()    {}
 func (*TokenBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{5}
+	return fileDescriptor_3aff0bcd502840ab, []int{6}
 }
+
 func (m *TokenBurn) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenBurn.Unmarshal(m, b)
 }
 func (m *TokenBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenBurn.Marshal(b, m, deterministic)
 }
-func (dst *TokenBurn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenBurn.Merge(dst, src)
+func (m *TokenBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBurn.Merge(m, src)
 }
 func (m *TokenBurn) XXX_Size() int {
 	return xxx_messageInfo_TokenBurn.Size(m)
@@ -684,105 +1032,764 @@ func (m *TokenBurn) GetAmount() int64 {
 	return 0
 }
 
-// state db
-type Token struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	Total                int64    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Price                int64    `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Owner                string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
-	Creator              string   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
-	Status               int32    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	Category             int32    `protobuf:"varint,9,opt,name=category,proto3" json:"category,omitempty"`
+// 暂停/恢复 token 的所有转账
+type TokenPause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Paused               bool     `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Token) Reset()         { *m = Token{} }
-func (m *Token) String() string { return proto.CompactTextString(m) }
-func (*Token) ProtoMessage()    {}
-func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{6}
+func (m *TokenPause) Reset()         { *m = TokenPause{} }
+func (m *TokenPause) String() string { return proto.CompactTextString(m) }
+func (*TokenPause) ProtoMessage()    {}
+func (*TokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{7}
 }
-func (m *Token) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Token.Unmarshal(m, b)
+
+func (m *TokenPause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenPause.Unmarshal(m, b)
 }
-func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+func (m *TokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenPause.Marshal(b, m, deterministic)
 }
-func (dst *Token) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Token.Merge(dst, src)
+func (m *TokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPause.Merge(m, src)
 }
-func (m *Token) XXX_Size() int {
-	return xxx_messageInfo_Token.Size(m)
+func (m *TokenPause) XXX_Size() int {
+	return xxx_messageInfo_TokenPause.Size(m)
 }
-func (m *Token) XXX_DiscardUnknown() {
-	xxx_messageInfo_Token.DiscardUnknown(m)
+func (m *TokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPause.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Token proto.InternalMessageInfo
+var xxx_messageInfo_TokenPause proto.InternalMessageInfo
 
-func (m *Token) GetName() string {
+func (m *TokenPause) GetSymbol() string {
 	if m != nil {
-		return m.Name
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *Token) GetSymbol() string {
+func (m *TokenPause) GetPaused() bool {
 	if m != nil {
-		return m.Symbol
+		return m.Paused
 	}
-	return ""
+	return false
 }
 
-func (m *Token) GetIntroduction() string {
+// 冻结/解冻某个地址的 token 转账
+type TokenFreezeAddr struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen               bool     `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenFreezeAddr) Reset()         { *m = TokenFreezeAddr{} }
+func (m *TokenFreezeAddr) String() string { return proto.CompactTextString(m) }
+func (*TokenFreezeAddr) ProtoMessage()    {}
+func (*TokenFreezeAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{8}
+}
+
+func (m *TokenFreezeAddr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenFreezeAddr.Unmarshal(m, b)
+}
+func (m *TokenFreezeAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenFreezeAddr.Marshal(b, m, deterministic)
+}
+func (m *TokenFreezeAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFreezeAddr.Merge(m, src)
+}
+func (m *TokenFreezeAddr) XXX_Size() int {
+	return xxx_messageInfo_TokenFreezeAddr.Size(m)
+}
+func (m *TokenFreezeAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFreezeAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFreezeAddr proto.InternalMessageInfo
+
+func (m *TokenFreezeAddr) GetSymbol() string {
 	if m != nil {
-		return m.Introduction
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenFreezeAddr) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenFreezeAddr) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+// owner 发起转移, 新的 owner 接受后生效, newOwner 为空表示取消转移
+type TokenTransferOwnership struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	NewOwner             string   `protobuf:"bytes,2,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenTransferOwnership) Reset()         { *m = TokenTransferOwnership{} }
+func (m *TokenTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferOwnership) ProtoMessage()    {}
+func (*TokenTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{9}
+}
+
+func (m *TokenTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenTransferOwnership.Unmarshal(m, b)
+}
+func (m *TokenTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenTransferOwnership.Marshal(b, m, deterministic)
+}
+func (m *TokenTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferOwnership.Merge(m, src)
+}
+func (m *TokenTransferOwnership) XXX_Size() int {
+	return xxx_messageInfo_TokenTransferOwnership.Size(m)
+}
+func (m *TokenTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferOwnership proto.InternalMessageInfo
+
+func (m *TokenTransferOwnership) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenTransferOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type TokenAcceptOwnership struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenAcceptOwnership) Reset()         { *m = TokenAcceptOwnership{} }
+func (m *TokenAcceptOwnership) String() string { return proto.CompactTextString(m) }
+func (*TokenAcceptOwnership) ProtoMessage()    {}
+func (*TokenAcceptOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{10}
+}
+
+func (m *TokenAcceptOwnership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAcceptOwnership.Unmarshal(m, b)
+}
+func (m *TokenAcceptOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAcceptOwnership.Marshal(b, m, deterministic)
+}
+func (m *TokenAcceptOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAcceptOwnership.Merge(m, src)
+}
+func (m *TokenAcceptOwnership) XXX_Size() int {
+	return xxx_messageInfo_TokenAcceptOwnership.Size(m)
+}
+func (m *TokenAcceptOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAcceptOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAcceptOwnership proto.InternalMessageInfo
+
+func (m *TokenAcceptOwnership) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// 为空的项保持不变
+type TokenUpdateMetadata struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenUpdateMetadata) Reset()         { *m = TokenUpdateMetadata{} }
+func (m *TokenUpdateMetadata) String() string { return proto.CompactTextString(m) }
+func (*TokenUpdateMetadata) ProtoMessage()    {}
+func (*TokenUpdateMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{11}
+}
+
+func (m *TokenUpdateMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenUpdateMetadata.Unmarshal(m, b)
+}
+func (m *TokenUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenUpdateMetadata.Marshal(b, m, deterministic)
+}
+func (m *TokenUpdateMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpdateMetadata.Merge(m, src)
+}
+func (m *TokenUpdateMetadata) XXX_Size() int {
+	return xxx_messageInfo_TokenUpdateMetadata.Size(m)
+}
+func (m *TokenUpdateMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpdateMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpdateMetadata proto.InternalMessageInfo
+
+func (m *TokenUpdateMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenUpdateMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenUpdateMetadata) GetIntroduction() string {
+	if m != nil {
+		return m.Introduction
+	}
+	return ""
+}
+
+// 设置 spender 可以从 from 地址转出的额度
+type TokenApprove struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Spender              string   `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenApprove) Reset()         { *m = TokenApprove{} }
+func (m *TokenApprove) String() string { return proto.CompactTextString(m) }
+func (*TokenApprove) ProtoMessage()    {}
+func (*TokenApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{12}
+}
+
+func (m *TokenApprove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenApprove.Unmarshal(m, b)
+}
+func (m *TokenApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenApprove.Marshal(b, m, deterministic)
+}
+func (m *TokenApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenApprove.Merge(m, src)
+}
+func (m *TokenApprove) XXX_Size() int {
+	return xxx_messageInfo_TokenApprove.Size(m)
+}
+func (m *TokenApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenApprove proto.InternalMessageInfo
+
+func (m *TokenApprove) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenApprove) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *TokenApprove) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type TokenIncreaseAllowance struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Spender              string   `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenIncreaseAllowance) Reset()         { *m = TokenIncreaseAllowance{} }
+func (m *TokenIncreaseAllowance) String() string { return proto.CompactTextString(m) }
+func (*TokenIncreaseAllowance) ProtoMessage()    {}
+func (*TokenIncreaseAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{13}
+}
+
+func (m *TokenIncreaseAllowance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenIncreaseAllowance.Unmarshal(m, b)
+}
+func (m *TokenIncreaseAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenIncreaseAllowance.Marshal(b, m, deterministic)
+}
+func (m *TokenIncreaseAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenIncreaseAllowance.Merge(m, src)
+}
+func (m *TokenIncreaseAllowance) XXX_Size() int {
+	return xxx_messageInfo_TokenIncreaseAllowance.Size(m)
+}
+func (m *TokenIncreaseAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenIncreaseAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenIncreaseAllowance proto.InternalMessageInfo
+
+func (m *TokenIncreaseAllowance) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenIncreaseAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *TokenIncreaseAllowance) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// spender 从 from 地址转出 token, 扣减授权额度
+type TokenTransferFrom struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note                 string   `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenTransferFrom) Reset()         { *m = TokenTransferFrom{} }
+func (m *TokenTransferFrom) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferFrom) ProtoMessage()    {}
+func (*TokenTransferFrom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{14}
+}
+
+func (m *TokenTransferFrom) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenTransferFrom.Unmarshal(m, b)
+}
+func (m *TokenTransferFrom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenTransferFrom.Marshal(b, m, deterministic)
+}
+func (m *TokenTransferFrom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferFrom.Merge(m, src)
+}
+func (m *TokenTransferFrom) XXX_Size() int {
+	return xxx_messageInfo_TokenTransferFrom.Size(m)
+}
+func (m *TokenTransferFrom) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferFrom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferFrom proto.InternalMessageInfo
+
+func (m *TokenTransferFrom) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenTransferFrom) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TokenTransferFrom) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TokenTransferFrom) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TokenTransferFrom) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// state db
+type Token struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Total                int64    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Price                int64    `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Owner                string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Creator              string   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status               int32    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Category             int32    `protobuf:"varint,9,opt,name=category,proto3" json:"category,omitempty"`
+	Paused               bool     `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+	PendingOwner         string   `protobuf:"bytes,11,opt,name=pendingOwner,proto3" json:"pendingOwner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{15}
+}
+
+func (m *Token) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Token.Unmarshal(m, b)
+}
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+}
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
+}
+func (m *Token) XXX_Size() int {
+	return xxx_messageInfo_Token.Size(m)
+}
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Token proto.InternalMessageInfo
+
+func (m *Token) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Token) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Token) GetIntroduction() string {
+	if m != nil {
+		return m.Introduction
 	}
 	return ""
 }
 
 func (m *Token) GetTotal() int64 {
 	if m != nil {
-		return m.Total
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Token) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Token) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Token) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Token) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Token) GetCategory() int32 {
+	if m != nil {
+		return m.Category
+	}
+	return 0
+}
+
+func (m *Token) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Token) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+type TokenAddrFrozen struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen               bool     `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenAddrFrozen) Reset()         { *m = TokenAddrFrozen{} }
+func (m *TokenAddrFrozen) String() string { return proto.CompactTextString(m) }
+func (*TokenAddrFrozen) ProtoMessage()    {}
+func (*TokenAddrFrozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{16}
+}
+
+func (m *TokenAddrFrozen) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAddrFrozen.Unmarshal(m, b)
+}
+func (m *TokenAddrFrozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAddrFrozen.Marshal(b, m, deterministic)
+}
+func (m *TokenAddrFrozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAddrFrozen.Merge(m, src)
+}
+func (m *TokenAddrFrozen) XXX_Size() int {
+	return xxx_messageInfo_TokenAddrFrozen.Size(m)
+}
+func (m *TokenAddrFrozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAddrFrozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAddrFrozen proto.InternalMessageInfo
+
+func (m *TokenAddrFrozen) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenAddrFrozen) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenAddrFrozen) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type TokenVesting struct {
+	Allocation           *TokenAllocation `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	Released             int64            `protobuf:"varint,2,opt,name=released,proto3" json:"released,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TokenVesting) Reset()         { *m = TokenVesting{} }
+func (m *TokenVesting) String() string { return proto.CompactTextString(m) }
+func (*TokenVesting) ProtoMessage()    {}
+func (*TokenVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{17}
+}
+
+func (m *TokenVesting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenVesting.Unmarshal(m, b)
+}
+func (m *TokenVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenVesting.Marshal(b, m, deterministic)
+}
+func (m *TokenVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenVesting.Merge(m, src)
+}
+func (m *TokenVesting) XXX_Size() int {
+	return xxx_messageInfo_TokenVesting.Size(m)
+}
+func (m *TokenVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenVesting proto.InternalMessageInfo
+
+func (m *TokenVesting) GetAllocation() *TokenAllocation {
+	if m != nil {
+		return m.Allocation
+	}
+	return nil
+}
+
+func (m *TokenVesting) GetReleased() int64 {
+	if m != nil {
+		return m.Released
 	}
 	return 0
 }
 
-func (m *Token) GetPrice() int64 {
+type TokenVestings struct {
+	Symbol               string          `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Vestings             []*TokenVesting `protobuf:"bytes,2,rep,name=vestings,proto3" json:"vestings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TokenVestings) Reset()         { *m = TokenVestings{} }
+func (m *TokenVestings) String() string { return proto.CompactTextString(m) }
+func (*TokenVestings) ProtoMessage()    {}
+func (*TokenVestings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{18}
+}
+
+func (m *TokenVestings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenVestings.Unmarshal(m, b)
+}
+func (m *TokenVestings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenVestings.Marshal(b, m, deterministic)
+}
+func (m *TokenVestings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenVestings.Merge(m, src)
+}
+func (m *TokenVestings) XXX_Size() int {
+	return xxx_messageInfo_TokenVestings.Size(m)
+}
+func (m *TokenVestings) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenVestings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenVestings proto.InternalMessageInfo
+
+func (m *TokenVestings) GetSymbol() string {
 	if m != nil {
-		return m.Price
+		return m.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (m *Token) GetOwner() string {
+func (m *TokenVestings) GetVestings() []*TokenVesting {
 	if m != nil {
-		return m.Owner
+		return m.Vestings
+	}
+	return nil
+}
+
+type TokenAllowance struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenAllowance) Reset()         { *m = TokenAllowance{} }
+func (m *TokenAllowance) String() string { return proto.CompactTextString(m) }
+func (*TokenAllowance) ProtoMessage()    {}
+func (*TokenAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{19}
+}
+
+func (m *TokenAllowance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAllowance.Unmarshal(m, b)
+}
+func (m *TokenAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAllowance.Marshal(b, m, deterministic)
+}
+func (m *TokenAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAllowance.Merge(m, src)
+}
+func (m *TokenAllowance) XXX_Size() int {
+	return xxx_messageInfo_TokenAllowance.Size(m)
+}
+func (m *TokenAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAllowance proto.InternalMessageInfo
+
+func (m *TokenAllowance) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *Token) GetCreator() string {
+func (m *TokenAllowance) GetOwner() string {
 	if m != nil {
-		return m.Creator
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *Token) GetStatus() int32 {
+func (m *TokenAllowance) GetSpender() string {
 	if m != nil {
-		return m.Status
+		return m.Spender
 	}
-	return 0
+	return ""
 }
 
-func (m *Token) GetCategory() int32 {
+func (m *TokenAllowance) GetAmount() int64 {
 	if m != nil {
-		return m.Category
+		return m.Amount
 	}
 	return 0
 }
@@ -801,16 +1808,17 @@ func (m *ReceiptToken) Reset()         { *m = ReceiptToken{} }
 func (m *ReceiptToken) String() string { return proto.CompactTextString(m) }
 func (*ReceiptToken) ProtoMessage()    {}
 func (*ReceiptToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{7}
+	return fileDescriptor_3aff0bcd502840ab, []int{20}
 }
+
 func (m *ReceiptToken) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptToken.Unmarshal(m, b)
 }
 func (m *ReceiptToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptToken.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptToken.Merge(dst, src)
+func (m *ReceiptToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptToken.Merge(m, src)
 }
 func (m *ReceiptToken) XXX_Size() int {
 	return xxx_messageInfo_ReceiptToken.Size(m)
@@ -854,16 +1862,17 @@ func (m *ReceiptTokenAmount) Reset()         { *m = ReceiptTokenAmount{} }
 func (m *ReceiptTokenAmount) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTokenAmount) ProtoMessage()    {}
 func (*ReceiptTokenAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{8}
+	return fileDescriptor_3aff0bcd502840ab, []int{21}
 }
+
 func (m *ReceiptTokenAmount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptTokenAmount.Unmarshal(m, b)
 }
 func (m *ReceiptTokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptTokenAmount.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptTokenAmount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTokenAmount.Merge(dst, src)
+func (m *ReceiptTokenAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenAmount.Merge(m, src)
 }
 func (m *ReceiptTokenAmount) XXX_Size() int {
 	return xxx_messageInfo_ReceiptTokenAmount.Size(m)
@@ -888,6 +1897,274 @@ func (m *ReceiptTokenAmount) GetCurrent() *Token {
 	return nil
 }
 
+type ReceiptTokenPause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Prev                 bool     `protobuf:"varint,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              bool     `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTokenPause) Reset()         { *m = ReceiptTokenPause{} }
+func (m *ReceiptTokenPause) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenPause) ProtoMessage()    {}
+func (*ReceiptTokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{22}
+}
+
+func (m *ReceiptTokenPause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenPause.Unmarshal(m, b)
+}
+func (m *ReceiptTokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenPause.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenPause.Merge(m, src)
+}
+func (m *ReceiptTokenPause) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenPause.Size(m)
+}
+func (m *ReceiptTokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenPause proto.InternalMessageInfo
+
+func (m *ReceiptTokenPause) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReceiptTokenPause) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReceiptTokenPause) GetPrev() bool {
+	if m != nil {
+		return m.Prev
+	}
+	return false
+}
+
+func (m *ReceiptTokenPause) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ReceiptTokenFreezeAddr struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Prev                 bool     `protobuf:"varint,4,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              bool     `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTokenFreezeAddr) Reset()         { *m = ReceiptTokenFreezeAddr{} }
+func (m *ReceiptTokenFreezeAddr) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenFreezeAddr) ProtoMessage()    {}
+func (*ReceiptTokenFreezeAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{23}
+}
+
+func (m *ReceiptTokenFreezeAddr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenFreezeAddr.Unmarshal(m, b)
+}
+func (m *ReceiptTokenFreezeAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenFreezeAddr.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenFreezeAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenFreezeAddr.Merge(m, src)
+}
+func (m *ReceiptTokenFreezeAddr) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenFreezeAddr.Size(m)
+}
+func (m *ReceiptTokenFreezeAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenFreezeAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenFreezeAddr proto.InternalMessageInfo
+
+func (m *ReceiptTokenFreezeAddr) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReceiptTokenFreezeAddr) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReceiptTokenFreezeAddr) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReceiptTokenFreezeAddr) GetPrev() bool {
+	if m != nil {
+		return m.Prev
+	}
+	return false
+}
+
+func (m *ReceiptTokenFreezeAddr) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ReceiptTokenAllowance struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	Prev                 int64    `protobuf:"varint,4,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              int64    `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTokenAllowance) Reset()         { *m = ReceiptTokenAllowance{} }
+func (m *ReceiptTokenAllowance) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenAllowance) ProtoMessage()    {}
+func (*ReceiptTokenAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{24}
+}
+
+func (m *ReceiptTokenAllowance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenAllowance.Unmarshal(m, b)
+}
+func (m *ReceiptTokenAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenAllowance.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenAllowance.Merge(m, src)
+}
+func (m *ReceiptTokenAllowance) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenAllowance.Size(m)
+}
+func (m *ReceiptTokenAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenAllowance proto.InternalMessageInfo
+
+func (m *ReceiptTokenAllowance) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReceiptTokenAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReceiptTokenAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *ReceiptTokenAllowance) GetPrev() int64 {
+	if m != nil {
+		return m.Prev
+	}
+	return 0
+}
+
+func (m *ReceiptTokenAllowance) GetCurrent() int64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+type ReceiptTokenVesting struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Prev                 int64    `protobuf:"varint,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              int64    `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTokenVesting) Reset()         { *m = ReceiptTokenVesting{} }
+func (m *ReceiptTokenVesting) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenVesting) ProtoMessage()    {}
+func (*ReceiptTokenVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{25}
+}
+
+func (m *ReceiptTokenVesting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenVesting.Unmarshal(m, b)
+}
+func (m *ReceiptTokenVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenVesting.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenVesting.Merge(m, src)
+}
+func (m *ReceiptTokenVesting) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenVesting.Size(m)
+}
+func (m *ReceiptTokenVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenVesting proto.InternalMessageInfo
+
+func (m *ReceiptTokenVesting) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReceiptTokenVesting) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReceiptTokenVesting) GetPrev() int64 {
+	if m != nil {
+		return m.Prev
+	}
+	return 0
+}
+
+func (m *ReceiptTokenVesting) GetCurrent() int64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
 // local
 type LocalToken struct {
 	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -908,6 +2185,9 @@ type LocalToken struct {
 	RevokedHeight        int64    `protobuf:"varint,15,opt,name=revokedHeight,proto3" json:"revokedHeight,omitempty"`
 	RevokedTime          int64    `protobuf:"varint,16,opt,name=revokedTime,proto3" json:"revokedTime,omitempty"`
 	Category             int32    `protobuf:"varint,17,opt,name=category,proto3" json:"category,omitempty"`
+	Paused               bool     `protobuf:"varint,18,opt,name=paused,proto3" json:"paused,omitempty"`
+	FrozenAddrCount      int64    `protobuf:"varint,19,opt,name=frozenAddrCount,proto3" json:"frozenAddrCount,omitempty"`
+	PendingOwner         string   `protobuf:"bytes,20,opt,name=pendingOwner,proto3" json:"pendingOwner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -917,16 +2197,17 @@ func (m *LocalToken) Reset()         { *m = LocalToken{} }
 func (m *LocalToken) String() string { return proto.CompactTextString(m) }
 func (*LocalToken) ProtoMessage()    {}
 func (*LocalToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{9}
+	return fileDescriptor_3aff0bcd502840ab, []int{26}
 }
+
 func (m *LocalToken) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocalToken.Unmarshal(m, b)
 }
 func (m *LocalToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_LocalToken.Marshal(b, m, deterministic)
 }
-func (dst *LocalToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LocalToken.Merge(dst, src)
+func (m *LocalToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalToken.Merge(m, src)
 }
 func (m *LocalToken) XXX_Size() int {
 	return xxx_messageInfo_LocalToken.Size(m)
@@ -1056,11 +2337,33 @@ func (m *LocalToken) GetCategory() int32 {
 	return 0
 }
 
+func (m *LocalToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *LocalToken) GetFrozenAddrCount() int64 {
+	if m != nil {
+		return m.FrozenAddrCount
+	}
+	return 0
+}
+
+func (m *LocalToken) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
 type LocalLogs struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	TxIndex              string   `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
 	ActionType           int32    `protobuf:"varint,3,opt,name=actionType,proto3" json:"actionType,omitempty"`
 	TxHash               string   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Owner                string   `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1070,16 +2373,17 @@ func (m *LocalLogs) Reset()         { *m = LocalLogs{} }
 func (m *LocalLogs) String() string { return proto.CompactTextString(m) }
 func (*LocalLogs) ProtoMessage()    {}
 func (*LocalLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{10}
+	return fileDescriptor_3aff0bcd502840ab, []int{27}
 }
+
 func (m *LocalLogs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocalLogs.Unmarshal(m, b)
 }
 func (m *LocalLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_LocalLogs.Marshal(b, m, deterministic)
 }
-func (dst *LocalLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LocalLogs.Merge(dst, src)
+func (m *LocalLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalLogs.Merge(m, src)
 }
 func (m *LocalLogs) XXX_Size() int {
 	return xxx_messageInfo_LocalLogs.Size(m)
@@ -1118,6 +2422,13 @@ func (m *LocalLogs) GetTxHash() string {
 	return ""
 }
 
+func (m *LocalLogs) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 // query
 type ReqTokens struct {
 	QueryAll             bool     `protobuf:"varint,1,opt,name=queryAll,proto3" json:"queryAll,omitempty"`
@@ -1133,16 +2444,17 @@ func (m *ReqTokens) Reset()         { *m = ReqTokens{} }
 func (m *ReqTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqTokens) ProtoMessage()    {}
 func (*ReqTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{11}
+	return fileDescriptor_3aff0bcd502840ab, []int{28}
 }
+
 func (m *ReqTokens) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokens.Unmarshal(m, b)
 }
 func (m *ReqTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqTokens.Marshal(b, m, deterministic)
 }
-func (dst *ReqTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokens.Merge(dst, src)
+func (m *ReqTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokens.Merge(m, src)
 }
 func (m *ReqTokens) XXX_Size() int {
 	return xxx_messageInfo_ReqTokens.Size(m)
@@ -1192,16 +2504,17 @@ func (m *ReplyTokens) Reset()         { *m = ReplyTokens{} }
 func (m *ReplyTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokens) ProtoMessage()    {}
 func (*ReplyTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{12}
+	return fileDescriptor_3aff0bcd502840ab, []int{29}
 }
+
 func (m *ReplyTokens) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyTokens.Unmarshal(m, b)
 }
 func (m *ReplyTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyTokens.Marshal(b, m, deterministic)
 }
-func (dst *ReplyTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTokens.Merge(dst, src)
+func (m *ReplyTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokens.Merge(m, src)
 }
 func (m *ReplyTokens) XXX_Size() int {
 	return xxx_messageInfo_ReplyTokens.Size(m)
@@ -1231,16 +2544,17 @@ func (m *TokenRecv) Reset()         { *m = TokenRecv{} }
 func (m *TokenRecv) String() string { return proto.CompactTextString(m) }
 func (*TokenRecv) ProtoMessage()    {}
 func (*TokenRecv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{13}
+	return fileDescriptor_3aff0bcd502840ab, []int{30}
 }
+
 func (m *TokenRecv) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenRecv.Unmarshal(m, b)
 }
 func (m *TokenRecv) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenRecv.Marshal(b, m, deterministic)
 }
-func (dst *TokenRecv) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenRecv.Merge(dst, src)
+func (m *TokenRecv) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRecv.Merge(m, src)
 }
 func (m *TokenRecv) XXX_Size() int {
 	return xxx_messageInfo_TokenRecv.Size(m)
@@ -1276,16 +2590,17 @@ func (m *ReplyAddrRecvForTokens) Reset()         { *m = ReplyAddrRecvForTokens{}
 func (m *ReplyAddrRecvForTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyAddrRecvForTokens) ProtoMessage()    {}
 func (*ReplyAddrRecvForTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{14}
+	return fileDescriptor_3aff0bcd502840ab, []int{31}
 }
+
 func (m *ReplyAddrRecvForTokens) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyAddrRecvForTokens.Unmarshal(m, b)
 }
 func (m *ReplyAddrRecvForTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyAddrRecvForTokens.Marshal(b, m, deterministic)
 }
-func (dst *ReplyAddrRecvForTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyAddrRecvForTokens.Merge(dst, src)
+func (m *ReplyAddrRecvForTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyAddrRecvForTokens.Merge(m, src)
 }
 func (m *ReplyAddrRecvForTokens) XXX_Size() int {
 	return xxx_messageInfo_ReplyAddrRecvForTokens.Size(m)
@@ -1316,16 +2631,17 @@ func (m *ReqTokenBalance) Reset()         { *m = ReqTokenBalance{} }
 func (m *ReqTokenBalance) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBalance) ProtoMessage()    {}
 func (*ReqTokenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{15}
+	return fileDescriptor_3aff0bcd502840ab, []int{32}
 }
+
 func (m *ReqTokenBalance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokenBalance.Unmarshal(m, b)
 }
 func (m *ReqTokenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqTokenBalance.Marshal(b, m, deterministic)
 }
-func (dst *ReqTokenBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokenBalance.Merge(dst, src)
+func (m *ReqTokenBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenBalance.Merge(m, src)
 }
 func (m *ReqTokenBalance) XXX_Size() int {
 	return xxx_messageInfo_ReqTokenBalance.Size(m)
@@ -1369,16 +2685,17 @@ func (m *ReqAccountTokenAssets) Reset()         { *m = ReqAccountTokenAssets{} }
 func (m *ReqAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccountTokenAssets) ProtoMessage()    {}
 func (*ReqAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{16}
+	return fileDescriptor_3aff0bcd502840ab, []int{33}
 }
+
 func (m *ReqAccountTokenAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqAccountTokenAssets.Unmarshal(m, b)
 }
 func (m *ReqAccountTokenAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqAccountTokenAssets.Marshal(b, m, deterministic)
 }
-func (dst *ReqAccountTokenAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqAccountTokenAssets.Merge(dst, src)
+func (m *ReqAccountTokenAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAccountTokenAssets.Merge(m, src)
 }
 func (m *ReqAccountTokenAssets) XXX_Size() int {
 	return xxx_messageInfo_ReqAccountTokenAssets.Size(m)
@@ -1415,16 +2732,17 @@ func (m *TokenAsset) Reset()         { *m = TokenAsset{} }
 func (m *TokenAsset) String() string { return proto.CompactTextString(m) }
 func (*TokenAsset) ProtoMessage()    {}
 func (*TokenAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{17}
+	return fileDescriptor_3aff0bcd502840ab, []int{34}
 }
+
 func (m *TokenAsset) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenAsset.Unmarshal(m, b)
 }
 func (m *TokenAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenAsset.Marshal(b, m, deterministic)
 }
-func (dst *TokenAsset) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenAsset.Merge(dst, src)
+func (m *TokenAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAsset.Merge(m, src)
 }
 func (m *TokenAsset) XXX_Size() int {
 	return xxx_messageInfo_TokenAsset.Size(m)
@@ -1460,16 +2778,17 @@ func (m *ReplyAccountTokenAssets) Reset()         { *m = ReplyAccountTokenAssets
 func (m *ReplyAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccountTokenAssets) ProtoMessage()    {}
 func (*ReplyAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{18}
+	return fileDescriptor_3aff0bcd502840ab, []int{35}
 }
+
 func (m *ReplyAccountTokenAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyAccountTokenAssets.Unmarshal(m, b)
 }
 func (m *ReplyAccountTokenAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyAccountTokenAssets.Marshal(b, m, deterministic)
 }
-func (dst *ReplyAccountTokenAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyAccountTokenAssets.Merge(dst, src)
+func (m *ReplyAccountTokenAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyAccountTokenAssets.Merge(m, src)
 }
 func (m *ReplyAccountTokenAssets) XXX_Size() int {
 	return xxx_messageInfo_ReplyAccountTokenAssets.Size(m)
@@ -1503,16 +2822,17 @@ func (m *ReqAddrTokens) Reset()         { *m = ReqAddrTokens{} }
 func (m *ReqAddrTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrTokens) ProtoMessage()    {}
 func (*ReqAddrTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{19}
+	return fileDescriptor_3aff0bcd502840ab, []int{36}
 }
+
 func (m *ReqAddrTokens) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqAddrTokens.Unmarshal(m, b)
 }
 func (m *ReqAddrTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqAddrTokens.Marshal(b, m, deterministic)
 }
-func (dst *ReqAddrTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqAddrTokens.Merge(dst, src)
+func (m *ReqAddrTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAddrTokens.Merge(m, src)
 }
 func (m *ReqAddrTokens) XXX_Size() int {
 	return xxx_messageInfo_ReqAddrTokens.Size(m)
@@ -1567,7 +2887,7 @@ func (m *ReqAddrTokens) GetFromKey() string {
 
 type ReqTokenTx struct {
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// 表示取所有/from/to/其他的hash列表
+	//表示取所有/from/to/其他的hash列表
 	Flag                 int32    `protobuf:"varint,2,opt,name=flag,proto3" json:"flag,omitempty"`
 	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
@@ -1583,16 +2903,17 @@ func (m *ReqTokenTx) Reset()         { *m = ReqTokenTx{} }
 func (m *ReqTokenTx) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenTx) ProtoMessage()    {}
 func (*ReqTokenTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{20}
+	return fileDescriptor_3aff0bcd502840ab, []int{37}
 }
+
 func (m *ReqTokenTx) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokenTx.Unmarshal(m, b)
 }
 func (m *ReqTokenTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqTokenTx.Marshal(b, m, deterministic)
 }
-func (dst *ReqTokenTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokenTx.Merge(dst, src)
+func (m *ReqTokenTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenTx.Merge(m, src)
 }
 func (m *ReqTokenTx) XXX_Size() int {
 	return xxx_messageInfo_ReqTokenTx.Size(m)
@@ -1663,16 +2984,17 @@ func (m *ReplyTokenLogs) Reset()         { *m = ReplyTokenLogs{} }
 func (m *ReplyTokenLogs) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokenLogs) ProtoMessage()    {}
 func (*ReplyTokenLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_token_1597bc5f193dd821, []int{21}
+	return fileDescriptor_3aff0bcd502840ab, []int{38}
 }
+
 func (m *ReplyTokenLogs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyTokenLogs.Unmarshal(m, b)
 }
 func (m *ReplyTokenLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyTokenLogs.Marshal(b, m, deterministic)
 }
-func (dst *ReplyTokenLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTokenLogs.Merge(dst, src)
+func (m *ReplyTokenLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenLogs.Merge(m, src)
 }
 func (m *ReplyTokenLogs) XXX_Size() int {
 	return xxx_messageInfo_ReplyTokenLogs.Size(m)
@@ -1690,16 +3012,468 @@ func (m *ReplyTokenLogs) GetLogs() []*LocalLogs {
 	return nil
 }
 
+type ReqTokenFreezeStatus struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenFreezeStatus) Reset()         { *m = ReqTokenFreezeStatus{} }
+func (m *ReqTokenFreezeStatus) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenFreezeStatus) ProtoMessage()    {}
+func (*ReqTokenFreezeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{39}
+}
+
+func (m *ReqTokenFreezeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenFreezeStatus.Unmarshal(m, b)
+}
+func (m *ReqTokenFreezeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenFreezeStatus.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenFreezeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenFreezeStatus.Merge(m, src)
+}
+func (m *ReqTokenFreezeStatus) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenFreezeStatus.Size(m)
+}
+func (m *ReqTokenFreezeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenFreezeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenFreezeStatus proto.InternalMessageInfo
+
+func (m *ReqTokenFreezeStatus) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenFreezeStatus) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReplyTokenFreezeStatus struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Paused               bool     `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen               bool     `protobuf:"varint,4,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyTokenFreezeStatus) Reset()         { *m = ReplyTokenFreezeStatus{} }
+func (m *ReplyTokenFreezeStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenFreezeStatus) ProtoMessage()    {}
+func (*ReplyTokenFreezeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{40}
+}
+
+func (m *ReplyTokenFreezeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenFreezeStatus.Unmarshal(m, b)
+}
+func (m *ReplyTokenFreezeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenFreezeStatus.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenFreezeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenFreezeStatus.Merge(m, src)
+}
+func (m *ReplyTokenFreezeStatus) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenFreezeStatus.Size(m)
+}
+func (m *ReplyTokenFreezeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenFreezeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenFreezeStatus proto.InternalMessageInfo
+
+func (m *ReplyTokenFreezeStatus) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReplyTokenFreezeStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ReplyTokenFreezeStatus) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReplyTokenFreezeStatus) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type ReqTokenFrozenAddrs struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	FromAddr             string   `protobuf:"bytes,2,opt,name=fromAddr,proto3" json:"fromAddr,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenFrozenAddrs) Reset()         { *m = ReqTokenFrozenAddrs{} }
+func (m *ReqTokenFrozenAddrs) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenFrozenAddrs) ProtoMessage()    {}
+func (*ReqTokenFrozenAddrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{41}
+}
+
+func (m *ReqTokenFrozenAddrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenFrozenAddrs.Unmarshal(m, b)
+}
+func (m *ReqTokenFrozenAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenFrozenAddrs.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenFrozenAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenFrozenAddrs.Merge(m, src)
+}
+func (m *ReqTokenFrozenAddrs) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenFrozenAddrs.Size(m)
+}
+func (m *ReqTokenFrozenAddrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenFrozenAddrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenFrozenAddrs proto.InternalMessageInfo
+
+func (m *ReqTokenFrozenAddrs) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenFrozenAddrs) GetFromAddr() string {
+	if m != nil {
+		return m.FromAddr
+	}
+	return ""
+}
+
+func (m *ReqTokenFrozenAddrs) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqTokenFrozenAddrs) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyTokenFrozenAddrs struct {
+	Addrs                []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyTokenFrozenAddrs) Reset()         { *m = ReplyTokenFrozenAddrs{} }
+func (m *ReplyTokenFrozenAddrs) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenFrozenAddrs) ProtoMessage()    {}
+func (*ReplyTokenFrozenAddrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{42}
+}
+
+func (m *ReplyTokenFrozenAddrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenFrozenAddrs.Unmarshal(m, b)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenFrozenAddrs.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenFrozenAddrs.Merge(m, src)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenFrozenAddrs.Size(m)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenFrozenAddrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenFrozenAddrs proto.InternalMessageInfo
+
+func (m *ReplyTokenFrozenAddrs) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+type ReqTokenAllowance struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenAllowance) Reset()         { *m = ReqTokenAllowance{} }
+func (m *ReqTokenAllowance) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenAllowance) ProtoMessage()    {}
+func (*ReqTokenAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{43}
+}
+
+func (m *ReqTokenAllowance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenAllowance.Unmarshal(m, b)
+}
+func (m *ReqTokenAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenAllowance.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenAllowance.Merge(m, src)
+}
+func (m *ReqTokenAllowance) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenAllowance.Size(m)
+}
+func (m *ReqTokenAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenAllowance proto.InternalMessageInfo
+
+func (m *ReqTokenAllowance) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqTokenAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+type ReqTokenVesting struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenVesting) Reset()         { *m = ReqTokenVesting{} }
+func (m *ReqTokenVesting) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenVesting) ProtoMessage()    {}
+func (*ReqTokenVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{44}
+}
+
+func (m *ReqTokenVesting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenVesting.Unmarshal(m, b)
+}
+func (m *ReqTokenVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenVesting.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenVesting.Merge(m, src)
+}
+func (m *ReqTokenVesting) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenVesting.Size(m)
+}
+func (m *ReqTokenVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenVesting proto.InternalMessageInfo
+
+func (m *ReqTokenVesting) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenVesting) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type TokenVestingBalance struct {
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Amount int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 未到期的额度
+	Locked int64 `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	// 已经解冻到可用余额的额度, 受益地址转出时才解冻
+	Released int64 `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	// 到当前高度已经到期的额度, 包括还没有解冻的部分
+	Vested               int64    `protobuf:"varint,5,opt,name=vested,proto3" json:"vested,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenVestingBalance) Reset()         { *m = TokenVestingBalance{} }
+func (m *TokenVestingBalance) String() string { return proto.CompactTextString(m) }
+func (*TokenVestingBalance) ProtoMessage()    {}
+func (*TokenVestingBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{45}
+}
+
+func (m *TokenVestingBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenVestingBalance.Unmarshal(m, b)
+}
+func (m *TokenVestingBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenVestingBalance.Marshal(b, m, deterministic)
+}
+func (m *TokenVestingBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenVestingBalance.Merge(m, src)
+}
+func (m *TokenVestingBalance) XXX_Size() int {
+	return xxx_messageInfo_TokenVestingBalance.Size(m)
+}
+func (m *TokenVestingBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenVestingBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenVestingBalance proto.InternalMessageInfo
+
+func (m *TokenVestingBalance) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenVestingBalance) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TokenVestingBalance) GetLocked() int64 {
+	if m != nil {
+		return m.Locked
+	}
+	return 0
+}
+
+func (m *TokenVestingBalance) GetReleased() int64 {
+	if m != nil {
+		return m.Released
+	}
+	return 0
+}
+
+func (m *TokenVestingBalance) GetVested() int64 {
+	if m != nil {
+		return m.Vested
+	}
+	return 0
+}
+
+type ReplyTokenVesting struct {
+	Symbol               string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Balances             []*TokenVestingBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ReplyTokenVesting) Reset()         { *m = ReplyTokenVesting{} }
+func (m *ReplyTokenVesting) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenVesting) ProtoMessage()    {}
+func (*ReplyTokenVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{46}
+}
+
+func (m *ReplyTokenVesting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenVesting.Unmarshal(m, b)
+}
+func (m *ReplyTokenVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenVesting.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenVesting.Merge(m, src)
+}
+func (m *ReplyTokenVesting) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenVesting.Size(m)
+}
+func (m *ReplyTokenVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenVesting proto.InternalMessageInfo
+
+func (m *ReplyTokenVesting) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReplyTokenVesting) GetBalances() []*TokenVestingBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenAction)(nil), "types.TokenAction")
 	proto.RegisterType((*TokenPreCreate)(nil), "types.TokenPreCreate")
+	proto.RegisterType((*TokenAllocation)(nil), "types.TokenAllocation")
 	proto.RegisterType((*TokenFinishCreate)(nil), "types.TokenFinishCreate")
 	proto.RegisterType((*TokenRevokeCreate)(nil), "types.TokenRevokeCreate")
 	proto.RegisterType((*TokenMint)(nil), "types.TokenMint")
 	proto.RegisterType((*TokenBurn)(nil), "types.TokenBurn")
+	proto.RegisterType((*TokenPause)(nil), "types.TokenPause")
+	proto.RegisterType((*TokenFreezeAddr)(nil), "types.TokenFreezeAddr")
+	proto.RegisterType((*TokenTransferOwnership)(nil), "types.TokenTransferOwnership")
+	proto.RegisterType((*TokenAcceptOwnership)(nil), "types.TokenAcceptOwnership")
+	proto.RegisterType((*TokenUpdateMetadata)(nil), "types.TokenUpdateMetadata")
+	proto.RegisterType((*TokenApprove)(nil), "types.TokenApprove")
+	proto.RegisterType((*TokenIncreaseAllowance)(nil), "types.TokenIncreaseAllowance")
+	proto.RegisterType((*TokenTransferFrom)(nil), "types.TokenTransferFrom")
 	proto.RegisterType((*Token)(nil), "types.Token")
+	proto.RegisterType((*TokenAddrFrozen)(nil), "types.TokenAddrFrozen")
+	proto.RegisterType((*TokenVesting)(nil), "types.TokenVesting")
+	proto.RegisterType((*TokenVestings)(nil), "types.TokenVestings")
+	proto.RegisterType((*TokenAllowance)(nil), "types.TokenAllowance")
 	proto.RegisterType((*ReceiptToken)(nil), "types.ReceiptToken")
 	proto.RegisterType((*ReceiptTokenAmount)(nil), "types.ReceiptTokenAmount")
+	proto.RegisterType((*ReceiptTokenPause)(nil), "types.ReceiptTokenPause")
+	proto.RegisterType((*ReceiptTokenFreezeAddr)(nil), "types.ReceiptTokenFreezeAddr")
+	proto.RegisterType((*ReceiptTokenAllowance)(nil), "types.ReceiptTokenAllowance")
+	proto.RegisterType((*ReceiptTokenVesting)(nil), "types.ReceiptTokenVesting")
 	proto.RegisterType((*LocalToken)(nil), "types.LocalToken")
 	proto.RegisterType((*LocalLogs)(nil), "types.LocalLogs")
 	proto.RegisterType((*ReqTokens)(nil), "types.ReqTokens")
@@ -1713,6 +3487,138 @@ func init() {
 	proto.RegisterType((*ReqAddrTokens)(nil), "types.ReqAddrTokens")
 	proto.RegisterType((*ReqTokenTx)(nil), "types.ReqTokenTx")
 	proto.RegisterType((*ReplyTokenLogs)(nil), "types.ReplyTokenLogs")
+	proto.RegisterType((*ReqTokenFreezeStatus)(nil), "types.ReqTokenFreezeStatus")
+	proto.RegisterType((*ReplyTokenFreezeStatus)(nil), "types.ReplyTokenFreezeStatus")
+	proto.RegisterType((*ReqTokenFrozenAddrs)(nil), "types.ReqTokenFrozenAddrs")
+	proto.RegisterType((*ReplyTokenFrozenAddrs)(nil), "types.ReplyTokenFrozenAddrs")
+	proto.RegisterType((*ReqTokenAllowance)(nil), "types.ReqTokenAllowance")
+	proto.RegisterType((*ReqTokenVesting)(nil), "types.ReqTokenVesting")
+	proto.RegisterType((*TokenVestingBalance)(nil), "types.TokenVestingBalance")
+	proto.RegisterType((*ReplyTokenVesting)(nil), "types.ReplyTokenVesting")
+}
+
+func init() { proto.RegisterFile("token.proto", fileDescriptor_3aff0bcd502840ab) }
+
+var fileDescriptor_3aff0bcd502840ab = []byte{
+	// 1886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0xb7, 0xdd, 0x76, 0xec, 0x7e, 0xce, 0xc7, 0xb8, 0x92, 0x09, 0xad, 0xb0, 0xac, 0xa2, 0xd6,
+	0x0a, 0x0d, 0x12, 0x84, 0xd1, 0x8e, 0x18, 0x2d, 0x62, 0x11, 0x72, 0x56, 0x33, 0xeb, 0x81, 0xd9,
+	0x19, 0xa8, 0xf5, 0xb2, 0x2b, 0x21, 0x21, 0x75, 0xda, 0x95, 0xa4, 0x35, 0x9d, 0xee, 0x9e, 0xee,
+	0x8a, 0x13, 0x2f, 0xe2, 0x8e, 0x84, 0x90, 0xe0, 0xc0, 0x99, 0x03, 0x77, 0xfe, 0x3e, 0x8e, 0xa8,
+	0x5e, 0x7d, 0x74, 0x95, 0xdd, 0x36, 0x93, 0x51, 0x0e, 0x88, 0x9b, 0xdf, 0xab, 0xf7, 0x5e, 0xbd,
+	0xcf, 0x5f, 0x55, 0xb5, 0x61, 0xc8, 0xf3, 0x37, 0x2c, 0x3b, 0x29, 0xca, 0x9c, 0xe7, 0xa4, 0xc7,
+	0x17, 0x05, 0xab, 0x8e, 0x46, 0xbc, 0x8c, 0xb2, 0x2a, 0x8a, 0x79, 0x92, 0xab, 0x95, 0xa3, 0x9d,
+	0x28, 0x8e, 0xf3, 0xeb, 0x8c, 0x4b, 0x32, 0xfc, 0xbb, 0x0f, 0xc3, 0xa9, 0x50, 0x1c, 0xa3, 0x10,
+	0xf9, 0x05, 0xec, 0xa2, 0x9d, 0x5f, 0x97, 0xec, 0xb3, 0x92, 0x45, 0x9c, 0x05, 0xed, 0xe3, 0xf6,
+	0xa3, 0xe1, 0xc7, 0x0f, 0x4f, 0xd0, 0xe2, 0xc9, 0xd4, 0x59, 0x9c, 0xb4, 0xe8, 0x92, 0x38, 0x99,
+	0xc0, 0x08, 0x39, 0xcf, 0x93, 0x2c, 0xa9, 0x2e, 0x95, 0x8d, 0x0e, 0xda, 0x08, 0x6c, 0x1b, 0xf6,
+	0xfa, 0xa4, 0x45, 0x57, 0x95, 0x8c, 0x25, 0xca, 0xe6, 0xf9, 0x1b, 0xed, 0x8d, 0xb7, 0x6a, 0xc9,
+	0x5e, 0x37, 0x96, 0x6c, 0x26, 0x79, 0x02, 0x03, 0x4c, 0xc4, 0x39, 0x2b, 0x83, 0xae, 0x13, 0xce,
+	0xb8, 0xaa, 0x18, 0xaf, 0xa6, 0x6a, 0x71, 0xd2, 0xa2, 0x46, 0x50, 0x28, 0xdd, 0x24, 0xfc, 0x72,
+	0x56, 0x46, 0x37, 0x41, 0xaf, 0x41, 0xe9, 0x6b, 0xb5, 0x28, 0x94, 0xb4, 0x20, 0x79, 0x0c, 0xfd,
+	0x0b, 0x96, 0xb1, 0x2a, 0xa9, 0x82, 0x2d, 0xd4, 0x39, 0x70, 0x74, 0x3e, 0x97, 0x6b, 0x93, 0x16,
+	0xd5, 0x62, 0xe4, 0x19, 0xec, 0xea, 0x2d, 0xa7, 0xf9, 0xb3, 0x5b, 0x16, 0x07, 0x03, 0x54, 0xfc,
+	0x6e, 0xa3, 0x87, 0x52, 0x04, 0xd3, 0xee, 0x70, 0xc8, 0x63, 0xf0, 0x31, 0xee, 0x2f, 0x92, 0x8c,
+	0x07, 0x3e, 0x5a, 0x78, 0x60, 0x27, 0x49, 0xf0, 0x27, 0x2d, 0x5a, 0x0b, 0x19, 0x8d, 0xd3, 0xeb,
+	0x32, 0x0b, 0x60, 0x55, 0x43, 0xf0, 0x8d, 0x86, 0x20, 0xc8, 0x13, 0x00, 0x59, 0xec, 0xe8, 0xba,
+	0x62, 0xc1, 0x10, 0x55, 0x46, 0x4e, 0x5f, 0x88, 0x85, 0x49, 0x8b, 0x5a, 0x62, 0xe4, 0x14, 0xf6,
+	0x64, 0x69, 0x4b, 0xc6, 0xbe, 0x65, 0xe3, 0xd9, 0xac, 0x0c, 0xb6, 0x51, 0xf3, 0xd0, 0xe9, 0x06,
+	0xb3, 0x3a, 0x69, 0xd1, 0x65, 0x05, 0xf2, 0x35, 0x1c, 0x22, 0x4b, 0x67, 0xe1, 0xf5, 0x4d, 0xc6,
+	0xca, 0xea, 0x32, 0x29, 0x82, 0x1d, 0x34, 0xf5, 0x3d, 0xdb, 0xd4, 0x8a, 0xd0, 0xa4, 0x45, 0xd7,
+	0xa8, 0x93, 0xdf, 0xc0, 0x01, 0x97, 0xcd, 0x1f, 0xb3, 0x82, 0xd7, 0x66, 0x77, 0x9d, 0x12, 0x4c,
+	0x1b, 0x44, 0x26, 0x2d, 0xda, 0xa8, 0x4a, 0x5e, 0xc1, 0x3e, 0xf2, 0xbf, 0x2a, 0x66, 0x11, 0x67,
+	0x5f, 0x30, 0x1e, 0xcd, 0x22, 0x1e, 0x05, 0x7b, 0x68, 0xf1, 0xc8, 0xb6, 0xe8, 0x4a, 0x4c, 0x5a,
+	0xb4, 0x49, 0x91, 0xfc, 0x14, 0xb6, 0xe5, 0x3e, 0x45, 0x51, 0xe6, 0x73, 0x16, 0x3c, 0x40, 0x43,
+	0xfb, 0x8e, 0x6b, 0x72, 0x69, 0xd2, 0xa2, 0x8e, 0xa8, 0x49, 0xdb, 0x8b, 0x2c, 0x2e, 0x59, 0x54,
+	0xb1, 0x71, 0x9a, 0xe6, 0x37, 0x51, 0x16, 0xb3, 0x60, 0xb4, 0x9a, 0xb6, 0x15, 0x21, 0x93, 0xb6,
+	0x95, 0x15, 0x33, 0x99, 0x3a, 0xa1, 0xcf, 0xcb, 0xfc, 0x2a, 0x20, 0xab, 0x93, 0x69, 0xaf, 0x9b,
+	0xc9, 0xb4, 0x99, 0x64, 0x17, 0x3a, 0xd3, 0x45, 0xd0, 0x3f, 0x6e, 0x3f, 0xea, 0xd1, 0xce, 0x74,
+	0x71, 0xda, 0x87, 0xde, 0x3c, 0x4a, 0xaf, 0x59, 0xf8, 0xef, 0x36, 0xec, 0xba, 0x58, 0x43, 0x08,
+	0x74, 0xb3, 0xe8, 0x4a, 0x02, 0x92, 0x4f, 0xf1, 0x37, 0x39, 0x84, 0xad, 0x6a, 0x71, 0x75, 0x96,
+	0xa7, 0x08, 0x31, 0x3e, 0x55, 0x14, 0x09, 0x61, 0x3b, 0xc9, 0x78, 0x99, 0xcf, 0xae, 0x11, 0xd6,
+	0x10, 0x36, 0x7c, 0xea, 0xf0, 0xc8, 0x01, 0xf4, 0x78, 0xce, 0xa3, 0x14, 0x21, 0xc1, 0xa3, 0x92,
+	0x10, 0xdc, 0xa2, 0x4c, 0x62, 0x86, 0x33, 0xef, 0x51, 0x49, 0x08, 0x6e, 0x2e, 0x4a, 0x8c, 0x53,
+	0xed, 0x53, 0x49, 0x90, 0x23, 0x18, 0xc4, 0x11, 0x67, 0x17, 0x79, 0xa9, 0x63, 0x30, 0x34, 0xf9,
+	0x04, 0x86, 0x51, 0x9a, 0xe6, 0x71, 0x24, 0xf6, 0xaa, 0x82, 0xc1, 0xb1, 0xb7, 0xdc, 0xf3, 0x63,
+	0xb3, 0x4c, 0x6d, 0xd1, 0xf0, 0x6f, 0x6d, 0xd8, 0x5b, 0x12, 0x10, 0xb1, 0x47, 0x62, 0x74, 0x54,
+	0xec, 0xe2, 0xb7, 0x88, 0x3d, 0xba, 0x12, 0x50, 0x8e, 0xb1, 0x7b, 0x54, 0x51, 0xe4, 0x18, 0x86,
+	0x71, 0x9a, 0x9c, 0x9f, 0x4f, 0x58, 0x72, 0x71, 0xc9, 0x31, 0x74, 0x8f, 0xda, 0x2c, 0xa1, 0x59,
+	0xb0, 0x32, 0xc9, 0x67, 0x2a, 0x74, 0x45, 0x91, 0x00, 0xfa, 0xf2, 0x57, 0x85, 0xd1, 0xf7, 0xa8,
+	0x26, 0xc3, 0x31, 0x8c, 0x56, 0x50, 0xdb, 0x4a, 0x7e, 0xdb, 0x49, 0xbe, 0x49, 0x56, 0xc7, 0x4a,
+	0x96, 0x31, 0xe1, 0x20, 0xf3, 0xdd, 0x4c, 0xfc, 0x0c, 0x7c, 0x03, 0x66, 0x6b, 0x55, 0xd7, 0xa4,
+	0xc5, 0x28, 0x23, 0x94, 0xdd, 0x55, 0xf9, 0x53, 0x80, 0x1a, 0xe1, 0x36, 0x69, 0x17, 0x42, 0x60,
+	0x86, 0xda, 0x03, 0xaa, 0xa8, 0xf0, 0x2b, 0x55, 0x50, 0x0b, 0xd2, 0xd6, 0x99, 0xd0, 0x85, 0xee,
+	0xb8, 0x85, 0x3e, 0x2f, 0xf3, 0x6f, 0x99, 0x6c, 0xe3, 0x01, 0x55, 0x54, 0xf8, 0x12, 0x0e, 0x9b,
+	0x11, 0x6f, 0xad, 0xf5, 0x23, 0x18, 0x64, 0xec, 0xe6, 0xb5, 0x95, 0x59, 0x43, 0x87, 0x27, 0x70,
+	0xd0, 0x04, 0x74, 0xeb, 0x6c, 0x85, 0x0c, 0xf6, 0x1b, 0x60, 0x6c, 0x53, 0x60, 0x38, 0xbd, 0x1d,
+	0x6b, 0x7a, 0xdf, 0x61, 0x4a, 0xc3, 0x6f, 0x60, 0xdb, 0x06, 0xb9, 0xb5, 0xf6, 0x03, 0xe8, 0x57,
+	0x05, 0xcb, 0x66, 0x26, 0x32, 0x4d, 0x5a, 0x35, 0xf5, 0x9c, 0x9a, 0x9e, 0xa9, 0xf4, 0xad, 0xe2,
+	0xdb, 0xfd, 0xed, 0xf1, 0x07, 0x18, 0x39, 0x25, 0x42, 0xd0, 0xdb, 0x90, 0xa2, 0x73, 0x81, 0xa4,
+	0x2a, 0x45, 0xe7, 0x0a, 0x20, 0x79, 0xae, 0x12, 0xd3, 0xe1, 0xb9, 0xb5, 0x51, 0xd7, 0x19, 0x7a,
+	0x91, 0xde, 0x9c, 0x4b, 0xd4, 0x12, 0xe9, 0xcd, 0x39, 0x0b, 0xff, 0xd9, 0x81, 0x1e, 0xee, 0xfe,
+	0x3f, 0x08, 0x9d, 0x01, 0xf4, 0x45, 0xd6, 0x79, 0x5e, 0x22, 0x72, 0xfa, 0x54, 0x93, 0xe8, 0x17,
+	0x8f, 0xf8, 0x75, 0x85, 0x17, 0xa1, 0x1e, 0x55, 0x94, 0x03, 0xb6, 0xfe, 0x12, 0xd8, 0xd6, 0x83,
+	0x07, 0xf6, 0xe0, 0x89, 0x58, 0x44, 0x81, 0x92, 0xec, 0x42, 0xf6, 0xfc, 0x50, 0xc6, 0x62, 0xf3,
+	0xcc, 0x70, 0x8a, 0xb1, 0x7c, 0x8e, 0x83, 0x75, 0x2f, 0xc3, 0x79, 0xa6, 0xfa, 0xf6, 0xb7, 0xac,
+	0xe2, 0x49, 0x76, 0x41, 0x9e, 0x02, 0xd4, 0x20, 0xaf, 0x2e, 0xd5, 0xeb, 0x8e, 0x03, 0x4b, 0x52,
+	0x84, 0x5d, 0xb2, 0x94, 0x45, 0x1a, 0x55, 0x3c, 0x6a, 0xe8, 0xf0, 0x1b, 0xd8, 0xb1, 0xf7, 0xa8,
+	0xd6, 0x3a, 0xfe, 0x63, 0x18, 0xcc, 0x95, 0x4c, 0xd0, 0x39, 0xf6, 0x96, 0x2f, 0x10, 0x4a, 0x9f,
+	0x1a, 0xa1, 0xb0, 0x80, 0x5d, 0xe3, 0xd4, 0xe6, 0x99, 0x68, 0x44, 0x6a, 0x7b, 0x52, 0xbc, 0x75,
+	0x93, 0xe2, 0x34, 0x70, 0x38, 0x85, 0x6d, 0xca, 0x62, 0x96, 0x14, 0x5c, 0xb6, 0xec, 0xdd, 0xf6,
+	0xab, 0x9b, 0xc6, 0xb3, 0x9b, 0x26, 0xfc, 0x3d, 0x10, 0xdb, 0xea, 0x58, 0x9f, 0x90, 0xdd, 0xa2,
+	0x64, 0x73, 0x55, 0x85, 0x6d, 0xe7, 0x31, 0x81, 0x2b, 0xe4, 0xfb, 0xd0, 0x8f, 0xaf, 0xcb, 0x92,
+	0xa9, 0x83, 0x60, 0x59, 0x48, 0x2f, 0x86, 0x39, 0x8c, 0x6c, 0xfb, 0x9b, 0x8f, 0x87, 0x66, 0xd7,
+	0x89, 0x72, 0x46, 0xb6, 0x8f, 0xdc, 0x3e, 0xa8, 0xb7, 0xef, 0x22, 0xdb, 0x6c, 0xf8, 0xa7, 0x36,
+	0x1c, 0xda, 0x3b, 0xbe, 0xc3, 0x91, 0xb2, 0x76, 0x5b, 0xec, 0x65, 0xcf, 0xea, 0x65, 0xed, 0x4a,
+	0xb7, 0xd9, 0x95, 0x9e, 0xeb, 0xca, 0x5f, 0xda, 0xf0, 0xd0, 0x49, 0xee, 0xbd, 0xf7, 0x8a, 0xed,
+	0x8f, 0xd7, 0xec, 0x8f, 0x67, 0xd7, 0x62, 0xdf, 0x76, 0x47, 0x0f, 0xde, 0x5d, 0x86, 0xd9, 0xae,
+	0x85, 0xd7, 0x5c, 0x0b, 0x6b, 0xc3, 0xbf, 0xf6, 0x00, 0x5e, 0xe6, 0x71, 0x94, 0xfe, 0xff, 0x80,
+	0xec, 0x47, 0xb0, 0x83, 0x22, 0x6c, 0xa6, 0x6e, 0x8f, 0x3e, 0xee, 0xe2, 0x32, 0xf1, 0x86, 0x29,
+	0x19, 0xd3, 0xe4, 0x8a, 0x05, 0xa0, 0x6e, 0x98, 0x35, 0x8b, 0x3c, 0x86, 0xfd, 0xa2, 0x64, 0x45,
+	0x64, 0x3e, 0x14, 0x48, 0x6b, 0x43, 0x94, 0x6c, 0x5a, 0x22, 0x3f, 0x84, 0x91, 0xc3, 0x46, 0xcb,
+	0xdb, 0x28, 0xbf, 0xba, 0x40, 0x3e, 0x00, 0xbf, 0x28, 0x59, 0x9c, 0x54, 0x22, 0x79, 0x3b, 0x18,
+	0x42, 0xcd, 0x20, 0x27, 0x40, 0x30, 0x59, 0xe6, 0xd5, 0x9c, 0x5c, 0xb1, 0x0a, 0x1f, 0x75, 0x1e,
+	0x6d, 0x58, 0x11, 0x51, 0x97, 0x78, 0x2b, 0xd5, 0x51, 0xef, 0xc9, 0xa8, 0x1d, 0xa6, 0x88, 0x5a,
+	0x31, 0xd0, 0xb7, 0x07, 0x32, 0x6a, 0x8b, 0xe5, 0x1c, 0x51, 0xa3, 0xb5, 0x47, 0x14, 0x71, 0x8e,
+	0xa8, 0x47, 0xb0, 0x27, 0x4f, 0x0c, 0x31, 0xc3, 0x9f, 0x21, 0x30, 0xee, 0xa3, 0xe5, 0x65, 0xf6,
+	0xca, 0x61, 0x76, 0xd0, 0x70, 0x98, 0xfd, 0xb9, 0x0d, 0x3e, 0xb6, 0xe4, 0xcb, 0x7c, 0xc3, 0x71,
+	0x10, 0x40, 0x9f, 0xdf, 0xbe, 0xc8, 0x66, 0xec, 0x56, 0xdf, 0x63, 0x14, 0x49, 0x3e, 0x04, 0x90,
+	0x5f, 0x8b, 0xa6, 0x8b, 0x82, 0x29, 0x2c, 0xb5, 0x38, 0xc2, 0x22, 0xbf, 0x9d, 0x44, 0xd5, 0x25,
+	0x36, 0xa5, 0x4f, 0x15, 0x55, 0xf7, 0x5f, 0xcf, 0xbe, 0xaf, 0xdf, 0x80, 0x4f, 0xd9, 0x5b, 0x9c,
+	0x0e, 0x3c, 0xbf, 0xdf, 0x5e, 0xb3, 0x72, 0x31, 0x4e, 0xa5, 0x3b, 0x03, 0x6a, 0x68, 0xab, 0x1d,
+	0x3b, 0x4e, 0x3b, 0x8a, 0xed, 0x50, 0x3b, 0xf0, 0x8e, 0x3d, 0xdc, 0x4e, 0xda, 0xfa, 0x10, 0x40,
+	0x86, 0xf2, 0x3a, 0x4b, 0x17, 0x0a, 0xae, 0x2c, 0x4e, 0xf8, 0x09, 0x0c, 0x29, 0x2b, 0xd2, 0x85,
+	0xda, 0xfa, 0x07, 0xc6, 0x4c, 0xfb, 0xd8, 0xb3, 0x3e, 0x5a, 0xd4, 0xc3, 0xab, 0x2d, 0x87, 0x3f,
+	0x51, 0xaf, 0x04, 0xca, 0xe2, 0xb9, 0x9c, 0xc0, 0x37, 0x2c, 0x53, 0xe9, 0xeb, 0x71, 0x3d, 0xe7,
+	0x25, 0x8b, 0xe7, 0xea, 0x34, 0xc6, 0xdf, 0xe1, 0x2f, 0x05, 0x2a, 0x17, 0xe9, 0x42, 0x54, 0x4b,
+	0xa8, 0x3e, 0xcf, 0x4b, 0xb5, 0xf7, 0x63, 0xf5, 0xd1, 0x44, 0x70, 0xf5, 0xfe, 0x0f, 0xdc, 0xcf,
+	0x57, 0xf1, 0x9c, 0x5a, 0x32, 0x61, 0x02, 0x7b, 0x3a, 0x6b, 0xa7, 0x51, 0x8a, 0x80, 0xfa, 0x01,
+	0xf8, 0x02, 0x9f, 0x58, 0x55, 0x31, 0x69, 0xc3, 0xa7, 0x35, 0x43, 0x34, 0x26, 0xaa, 0x7f, 0x69,
+	0x23, 0x8d, 0xcd, 0x12, 0x79, 0x64, 0xb7, 0x2c, 0x36, 0x08, 0xab, 0xa8, 0xf0, 0x85, 0x40, 0xf0,
+	0xb7, 0x63, 0xf9, 0x45, 0x50, 0x82, 0x38, 0x7e, 0x6e, 0x12, 0x1d, 0xa2, 0xec, 0xab, 0xd8, 0x35,
+	0x69, 0x99, 0xea, 0x38, 0xa6, 0x5e, 0x01, 0xd4, 0x06, 0xd6, 0x76, 0xde, 0x23, 0xe8, 0xab, 0xef,
+	0x8f, 0xea, 0x5c, 0xdd, 0xd5, 0x9f, 0xb9, 0x24, 0x97, 0xea, 0xe5, 0xf0, 0x15, 0x7c, 0x47, 0x66,
+	0x74, 0xd5, 0xb9, 0x27, 0x2a, 0x5e, 0x49, 0x2e, 0xd5, 0xb4, 0x16, 0xa4, 0xb6, 0x54, 0xf8, 0x8f,
+	0x36, 0xec, 0x88, 0x58, 0x67, 0x33, 0x5d, 0x99, 0x35, 0x6f, 0xea, 0xc6, 0x46, 0x34, 0x9d, 0x20,
+	0xfb, 0x50, 0x12, 0xa2, 0x2c, 0xb3, 0xa4, 0x64, 0x12, 0xc2, 0xbb, 0x12, 0x85, 0x0c, 0x43, 0xe8,
+	0xc8, 0x48, 0xe5, 0x5b, 0x5a, 0x12, 0x22, 0xb3, 0xe2, 0x62, 0xff, 0x2b, 0xb6, 0x50, 0x58, 0xad,
+	0xc9, 0xf0, 0x5f, 0x6d, 0x00, 0x5d, 0xf8, 0xe9, 0xed, 0xc6, 0x57, 0x42, 0x1a, 0x5d, 0x28, 0x07,
+	0xf1, 0x77, 0xbd, 0x95, 0x67, 0x6f, 0xb5, 0xd9, 0xbd, 0x43, 0xd8, 0xba, 0x94, 0x68, 0x27, 0x4f,
+	0x12, 0x45, 0x09, 0x5b, 0x09, 0x42, 0xc3, 0x16, 0xb2, 0x25, 0x61, 0x92, 0xd5, 0xaf, 0x93, 0x15,
+	0x3e, 0x85, 0xdd, 0x7a, 0xca, 0x10, 0x70, 0x3e, 0x82, 0x6e, 0x9a, 0x5f, 0x2c, 0xb7, 0xb9, 0x01,
+	0x24, 0x8a, 0xab, 0xe1, 0x29, 0x1c, 0xe8, 0x38, 0xe5, 0xf5, 0xe5, 0x4b, 0x33, 0xed, 0xef, 0x7a,
+	0x52, 0x87, 0x5c, 0x0d, 0xdc, 0xbb, 0x5b, 0x59, 0xf3, 0x38, 0x6f, 0xbc, 0x08, 0xd5, 0x97, 0xfa,
+	0xae, 0x73, 0xa9, 0xff, 0x23, 0xec, 0xd7, 0x9e, 0x6b, 0x74, 0xae, 0x36, 0x3d, 0xb7, 0x45, 0x71,
+	0xc7, 0xb5, 0xf3, 0x86, 0x7e, 0x9f, 0x92, 0x85, 0x3f, 0x82, 0x87, 0x76, 0xd0, 0xb5, 0x03, 0x07,
+	0xd0, 0x13, 0x7e, 0x6b, 0x6c, 0x90, 0x44, 0xf8, 0x3b, 0x18, 0x69, 0x6f, 0xef, 0xfd, 0x6e, 0x16,
+	0xfe, 0xbc, 0x46, 0xa9, 0xf7, 0xb8, 0x69, 0x89, 0xcb, 0xe3, 0xbe, 0xad, 0xac, 0x91, 0xee, 0x2e,
+	0x1f, 0xba, 0x0e, 0x61, 0x2b, 0xcd, 0xe3, 0x37, 0x6c, 0xa6, 0x1f, 0xdd, 0x92, 0x72, 0x9e, 0x4c,
+	0x5d, 0xf7, 0xc9, 0x24, 0x74, 0xc4, 0x23, 0x87, 0xcd, 0x74, 0xd7, 0x4b, 0x2a, 0x8c, 0x61, 0x54,
+	0xa7, 0xf6, 0xbf, 0x05, 0xf4, 0x14, 0x06, 0x67, 0xd2, 0x5f, 0xfd, 0x9c, 0x3a, 0x6a, 0x78, 0x4e,
+	0xa9, 0x90, 0xa8, 0x91, 0xfd, 0xf8, 0x99, 0x42, 0x11, 0xf2, 0x29, 0xec, 0x7d, 0xce, 0xb8, 0x03,
+	0xf1, 0xfa, 0x2d, 0xb8, 0x04, 0xfd, 0x47, 0x7b, 0x2e, 0x40, 0x56, 0x61, 0xeb, 0x6c, 0x0b, 0xff,
+	0xba, 0x79, 0xf2, 0x9f, 0x01, 0x00, 0xbc, 0xba, 0x75, 0x1e, 0xf2, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1728,7 +3634,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type TokenClient interface {
 	// token 对外提供服务的接口
-	// 区块链接口
+	//区块链接口
 	GetTokenBalance(ctx context.Context, in *ReqTokenBalance, opts ...grpc.CallOption) (*types.Accounts, error)
 }
 
@@ -1752,10 +3658,18 @@ func (c *tokenClient) GetTokenBalance(ctx context.Context, in *ReqTokenBalance,
 // TokenServer is the server API for Token service.
 type TokenServer interface {
 	// token 对外提供服务的接口
-	// 区块链接口
+	//区块链接口
 	GetTokenBalance(context.Context, *ReqTokenBalance) (*types.Accounts, error)
 }
 
+// UnimplementedTokenServer can be embedded to have forward compatible implementations.
+type UnimplementedTokenServer struct {
+}
+
+func (*UnimplementedTokenServer) GetTokenBalance(ctx context.Context, req *ReqTokenBalance) (*types.Accounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenBalance not implemented")
+}
+
 func RegisterTokenServer(s *grpc.Server, srv TokenServer) {
 	s.RegisterService(&_Token_serviceDesc, srv)
 }
@@ -1790,79 +3704,3 @@ var _Token_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "token.proto",
 }
-
-func init() { proto.RegisterFile("token.proto", fileDescriptor_token_1597bc5f193dd821) }
-
-var fileDescriptor_token_1597bc5f193dd821 = []byte{
-	// 1115 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xdd, 0x6a, 0xe3, 0xc6,
-	0x17, 0xb7, 0x2d, 0x7f, 0x44, 0xc7, 0x89, 0x13, 0xcf, 0x7f, 0x37, 0x7f, 0x91, 0x2e, 0x8b, 0x11,
-	0x4b, 0x49, 0xa1, 0x84, 0x90, 0xd0, 0x52, 0x68, 0xa1, 0x38, 0x25, 0xbb, 0xde, 0x76, 0xbb, 0x2d,
-	0x53, 0x43, 0xef, 0x0a, 0x5a, 0xe9, 0xc4, 0x11, 0xb1, 0x25, 0x65, 0x34, 0x76, 0xec, 0xa7, 0xe9,
-	0x7d, 0x2f, 0xfa, 0x08, 0xbd, 0xe8, 0xcb, 0xf4, 0x35, 0xca, 0x9c, 0x19, 0xc9, 0x23, 0x3b, 0x2e,
-	0xe4, 0xae, 0xf4, 0x4e, 0xe7, 0xeb, 0x37, 0x73, 0xce, 0xf9, 0x9d, 0xe3, 0x31, 0x74, 0x65, 0x7a,
-	0x87, 0xc9, 0x59, 0x26, 0x52, 0x99, 0xb2, 0x96, 0x5c, 0x65, 0x98, 0x9f, 0xf4, 0xa5, 0x08, 0x92,
-	0x3c, 0x08, 0x65, 0x9c, 0x1a, 0xcb, 0xc9, 0x41, 0x10, 0x86, 0xe9, 0x3c, 0x91, 0x5a, 0xf4, 0xff,
-	0x6c, 0x42, 0x77, 0xac, 0x02, 0x87, 0xe4, 0xc4, 0xbe, 0x86, 0x1e, 0xe1, 0xfc, 0x28, 0xf0, 0x1b,
-	0x81, 0x81, 0x44, 0xaf, 0x3e, 0xa8, 0x9f, 0x76, 0x2f, 0x9e, 0x9f, 0x11, 0xe2, 0xd9, 0xb8, 0x62,
-	0x1c, 0xd5, 0xf8, 0x86, 0x3b, 0x1b, 0x41, 0x9f, 0x34, 0xaf, 0xe3, 0x24, 0xce, 0x6f, 0x0d, 0x46,
-	0x83, 0x30, 0x3c, 0x1b, 0xc3, 0xb6, 0x8f, 0x6a, 0x7c, 0x3b, 0xa8, 0x44, 0xe2, 0xb8, 0x48, 0xef,
-	0x8a, 0xdb, 0x38, 0xdb, 0x48, 0xb6, 0xbd, 0x44, 0xb2, 0x95, 0xec, 0x12, 0xf6, 0xa8, 0x10, 0x37,
-	0x28, 0xbc, 0x66, 0x25, 0x9d, 0x61, 0x9e, 0xa3, 0xcc, 0xc7, 0xc6, 0x38, 0xaa, 0xf1, 0xd2, 0x51,
-	0x05, 0x3d, 0xc4, 0xf2, 0x36, 0x12, 0xc1, 0x83, 0xd7, 0x7a, 0x24, 0xe8, 0x67, 0x63, 0x54, 0x41,
-	0x85, 0x23, 0x3b, 0x87, 0xce, 0x04, 0x13, 0xcc, 0xe3, 0xdc, 0x6b, 0x53, 0xcc, 0xb3, 0x4a, 0xcc,
-	0x1b, 0x6d, 0x1b, 0xd5, 0x78, 0xe1, 0xc6, 0xae, 0xa1, 0x57, 0x1c, 0x39, 0x4e, 0xaf, 0x97, 0x18,
-	0x7a, 0x7b, 0x14, 0xf8, 0xd1, 0xa3, 0x37, 0xd4, 0x2e, 0x54, 0xf6, 0x8a, 0x86, 0x9d, 0x83, 0x4b,
-	0x79, 0x7f, 0x1f, 0x27, 0xd2, 0x73, 0x09, 0xe1, 0xc8, 0x2e, 0x92, 0xd2, 0x8f, 0x6a, 0x7c, 0xed,
-	0x54, 0x46, 0x5c, 0xcd, 0x45, 0xe2, 0xc1, 0x76, 0x84, 0xd2, 0x97, 0x11, 0x4a, 0x60, 0x3d, 0x68,
-	0x8c, 0x57, 0x5e, 0x67, 0x50, 0x3f, 0x6d, 0xf1, 0xc6, 0x78, 0x75, 0xd5, 0x81, 0xd6, 0x22, 0x98,
-	0xce, 0xd1, 0xff, 0xa3, 0x0e, 0xbd, 0x2a, 0x31, 0x18, 0x83, 0x66, 0x12, 0xcc, 0x34, 0x7b, 0x5c,
-	0x4e, 0xdf, 0xec, 0x18, 0xda, 0xf9, 0x6a, 0xf6, 0x21, 0x9d, 0x12, 0x1f, 0x5c, 0x6e, 0x24, 0xe6,
-	0xc3, 0x7e, 0x9c, 0x48, 0x91, 0x46, 0x73, 0xe2, 0x20, 0xf5, 0xd8, 0xe5, 0x15, 0x1d, 0x7b, 0x06,
-	0x2d, 0x99, 0xca, 0x60, 0x4a, 0xfd, 0x73, 0xb8, 0x16, 0x94, 0x36, 0x13, 0x71, 0x88, 0xd4, 0x20,
-	0x87, 0x6b, 0x41, 0x69, 0xd3, 0x87, 0x04, 0x05, 0xb5, 0xc0, 0xe5, 0x5a, 0x60, 0x27, 0xb0, 0x17,
-	0x06, 0x12, 0x27, 0xa9, 0x28, 0x72, 0x28, 0x65, 0x7f, 0x08, 0xfd, 0x2d, 0x52, 0x5a, 0xd7, 0xad,
-	0x57, 0xae, 0x5b, 0xc2, 0x37, 0x2c, 0xf8, 0x12, 0xa2, 0x42, 0xbc, 0xa7, 0x41, 0x7c, 0x09, 0x6e,
-	0xd9, 0xab, 0x9d, 0xa1, 0xc7, 0xd0, 0x0e, 0x66, 0x6a, 0x80, 0x29, 0xd6, 0xe1, 0x46, 0x2a, 0x83,
-	0xa9, 0x53, 0x4f, 0x0d, 0xfe, 0xab, 0x0e, 0x2d, 0x8a, 0xfe, 0x17, 0xf6, 0xcd, 0x83, 0x4e, 0xa8,
-	0xaa, 0x99, 0x0a, 0x6a, 0x9b, 0xcb, 0x0b, 0x91, 0xee, 0x25, 0x03, 0x39, 0xcf, 0x69, 0x64, 0x5a,
-	0xdc, 0x48, 0x95, 0x4e, 0xbb, 0x1b, 0x9d, 0x1e, 0xc3, 0x3e, 0xc7, 0x10, 0xe3, 0x4c, 0xea, 0x7c,
-	0x9f, 0xd4, 0x21, 0xeb, 0x44, 0xc7, 0x3e, 0xd1, 0xff, 0x05, 0x98, 0x8d, 0x3a, 0xa4, 0xaa, 0xb2,
-	0x01, 0x34, 0x33, 0x81, 0x0b, 0xb3, 0x41, 0xf7, 0x2b, 0x3b, 0x8b, 0x2c, 0xec, 0x63, 0xe8, 0x84,
-	0x73, 0x21, 0xd0, 0x34, 0x64, 0xd3, 0xa9, 0x30, 0xfa, 0xbf, 0x35, 0x01, 0xde, 0xa5, 0x61, 0x30,
-	0xfd, 0xef, 0x34, 0xe9, 0x15, 0x1c, 0x90, 0x0b, 0x46, 0x23, 0x8c, 0x27, 0xb7, 0x7a, 0x69, 0x39,
-	0xbc, 0xaa, 0x64, 0x03, 0xe8, 0x1a, 0xc5, 0x38, 0x9e, 0x21, 0xad, 0x29, 0x87, 0xdb, 0x2a, 0x76,
-	0x0e, 0xff, 0xcb, 0x04, 0x66, 0x41, 0xf9, 0x93, 0xa4, 0xd1, 0xba, 0xe4, 0xf9, 0x98, 0x89, 0x7d,
-	0x0a, 0xfd, 0x8a, 0x9a, 0x90, 0xf7, 0xc9, 0x7f, 0xdb, 0xc0, 0x5e, 0x80, 0x9b, 0x09, 0x0c, 0xe3,
-	0x5c, 0x15, 0xef, 0x80, 0x52, 0x58, 0x2b, 0xd8, 0x19, 0x30, 0x2a, 0x56, 0xb9, 0x9f, 0xe3, 0x19,
-	0xe6, 0x5e, 0x8f, 0xc0, 0x1e, 0xb1, 0xa8, 0xac, 0x05, 0x2d, 0x88, 0x22, 0xeb, 0x43, 0x9d, 0x75,
-	0x45, 0xa9, 0xb2, 0x36, 0x0a, 0xba, 0xdb, 0x91, 0xce, 0xda, 0x52, 0x55, 0x28, 0xde, 0xdf, 0xa0,
-	0xf8, 0x1c, 0x5c, 0xe2, 0xca, 0xbb, 0x74, 0x92, 0xef, 0xe4, 0xb7, 0x07, 0x1d, 0xb9, 0x7c, 0x9b,
-	0x44, 0xb8, 0x34, 0x7c, 0x29, 0x44, 0xf6, 0x12, 0x40, 0x3f, 0x18, 0xc6, 0xab, 0x0c, 0x0d, 0xcf,
-	0x2d, 0x8d, 0x42, 0x94, 0xcb, 0x51, 0x90, 0xdf, 0x12, 0x5b, 0x5c, 0x6e, 0x24, 0xff, 0x01, 0x5c,
-	0x8e, 0xf7, 0x44, 0x50, 0x1a, 0xc1, 0xfb, 0x39, 0x8a, 0xd5, 0x70, 0xaa, 0x0f, 0xde, 0xe3, 0xa5,
-	0x6c, 0x31, 0xa2, 0x51, 0x61, 0x84, 0x02, 0xa6, 0x68, 0xcf, 0x19, 0x38, 0x04, 0xac, 0xb1, 0x5e,
-	0x02, 0xe8, 0x4b, 0xff, 0x90, 0x4c, 0x57, 0x74, 0xe8, 0x1e, 0xb7, 0x34, 0xfe, 0x17, 0xd0, 0xe5,
-	0x98, 0x4d, 0x57, 0xe6, 0xe8, 0x4f, 0x4a, 0x98, 0xfa, 0xc0, 0x39, 0xed, 0x5e, 0xf4, 0xcd, 0x48,
-	0xad, 0xe7, 0xa7, 0x40, 0xf6, 0x3f, 0x33, 0x3b, 0x93, 0x63, 0xb8, 0xd0, 0x43, 0x70, 0x87, 0x89,
-	0x29, 0x94, 0x16, 0xd4, 0xa8, 0x09, 0x0c, 0x17, 0x66, 0x5f, 0xd2, 0xb7, 0xff, 0x2d, 0x1c, 0xd3,
-	0x81, 0xc3, 0x28, 0x12, 0x2a, 0xf4, 0x75, 0x2a, 0xcc, 0xd9, 0xe7, 0x00, 0xb2, 0x00, 0x2c, 0xce,
-	0x3f, 0xaa, 0xbe, 0x55, 0xc2, 0x05, 0xb7, 0x7c, 0xfc, 0x18, 0x0e, 0x8b, 0xaa, 0x5d, 0x05, 0xd3,
-	0x20, 0x09, 0x89, 0x71, 0x41, 0x14, 0x09, 0xcc, 0x73, 0xd4, 0x18, 0x2e, 0x5f, 0x2b, 0x14, 0x37,
-	0x28, 0xfc, 0x27, 0x7b, 0xd8, 0x6d, 0x95, 0xaa, 0x23, 0x2e, 0x31, 0x44, 0x61, 0x66, 0xdd, 0x48,
-	0xfe, 0x5b, 0x78, 0xce, 0xf1, 0x7e, 0xa8, 0x9f, 0x7f, 0x7a, 0x4f, 0xd1, 0xdb, 0x42, 0x71, 0xc1,
-	0xe0, 0x9b, 0xdc, 0x0b, 0xd1, 0x82, 0x6a, 0x54, 0xa0, 0xde, 0x03, 0xac, 0x01, 0x76, 0x72, 0xec,
-	0x14, 0x3a, 0xe6, 0xb1, 0x69, 0xb6, 0x5b, 0xaf, 0x78, 0xd3, 0x68, 0x2d, 0x2f, 0xcc, 0xfe, 0x7b,
-	0xf8, 0xbf, 0xae, 0xe8, 0xf6, 0xe5, 0x2e, 0x4d, 0xbe, 0x5a, 0xdc, 0xe8, 0xe9, 0xda, 0x91, 0xdb,
-	0x5e, 0xfe, 0xaf, 0x75, 0x38, 0x50, 0xb9, 0x46, 0x51, 0xd1, 0x19, 0x06, 0x4d, 0x95, 0x54, 0xb1,
-	0x32, 0xd5, 0xf7, 0x4e, 0x22, 0x96, 0x4c, 0xd0, 0x3c, 0x34, 0x4c, 0x78, 0x01, 0x6e, 0x14, 0x0b,
-	0xd4, 0x5b, 0xb4, 0xa9, 0x17, 0x41, 0xa9, 0x50, 0x31, 0x3a, 0xd3, 0x16, 0x59, 0xb4, 0xa0, 0x2a,
-	0x7b, 0x23, 0xd2, 0xd9, 0x77, 0xb8, 0x32, 0xeb, 0xb2, 0x10, 0xfd, 0xdf, 0xeb, 0x00, 0x45, 0xe3,
-	0xc7, 0xcb, 0x9d, 0x25, 0x64, 0xd0, 0xbc, 0x99, 0x06, 0x13, 0x73, 0x41, 0xfa, 0x5e, 0x1f, 0xe5,
-	0xd8, 0x47, 0xfd, 0xf3, 0xf5, 0x8e, 0xa1, 0x7d, 0xab, 0x17, 0x8e, 0x5e, 0xe6, 0x46, 0x52, 0x58,
-	0x31, 0x2d, 0x81, 0xb6, 0xde, 0xf1, 0x24, 0x94, 0xc5, 0xea, 0xac, 0x8b, 0xe5, 0x7f, 0x0e, 0xbd,
-	0xf5, 0x94, 0xd1, 0x6a, 0x79, 0x05, 0xcd, 0x69, 0x3a, 0xd9, 0xa4, 0x79, 0xb9, 0x7a, 0x38, 0x59,
-	0x2f, 0xae, 0x4d, 0x31, 0xd9, 0x57, 0x70, 0xf8, 0x06, 0x65, 0x85, 0xe9, 0xc7, 0x26, 0x66, 0x63,
-	0x02, 0x4e, 0x0e, 0xab, 0x3c, 0xc9, 0xfd, 0xda, 0x87, 0x36, 0xfd, 0x5d, 0xb9, 0xfc, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xe2, 0xae, 0x58, 0x34, 0xe6, 0x0c, 0x00, 0x00,
-}
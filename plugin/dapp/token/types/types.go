@@ -24,6 +24,10 @@ func init() {
 	types.RegisterDappFork(TokenX, ForkTokenPriceX, 560000)
 	types.RegisterDappFork(TokenX, ForkTokenSymbolWithNumberX, 1298600)
 	types.RegisterDappFork(TokenX, ForkTokenCheckX, 1600000)
+	types.RegisterDappFork(TokenX, ForkTokenFreezeX, 3800000)
+	types.RegisterDappFork(TokenX, ForkTokenOwnershipX, 3800000)
+	types.RegisterDappFork(TokenX, ForkTokenAllowanceX, 3800000)
+	types.RegisterDappFork(TokenX, ForkTokenVestingX, 3800000)
 }
 
 // TokenType 执行器基类结构体
@@ -51,35 +55,50 @@ func (t *TokenType) GetPayload() types.Message {
 // GetTypeMap 根据action的name获取type
 func (t *TokenType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Transfer":          ActionTransfer,
-		"Genesis":           ActionGenesis,
-		"Withdraw":          ActionWithdraw,
-		"TokenPreCreate":    TokenActionPreCreate,
-		"TokenFinishCreate": TokenActionFinishCreate,
-		"TokenRevokeCreate": TokenActionRevokeCreate,
-		"TransferToExec":    TokenActionTransferToExec,
-		"TokenMint":         TokenActionMint,
-		"TokenBurn":         TokenActionBurn,
+		"Transfer":               ActionTransfer,
+		"Genesis":                ActionGenesis,
+		"Withdraw":               ActionWithdraw,
+		"TokenPreCreate":         TokenActionPreCreate,
+		"TokenFinishCreate":      TokenActionFinishCreate,
+		"TokenRevokeCreate":      TokenActionRevokeCreate,
+		"TransferToExec":         TokenActionTransferToExec,
+		"TokenMint":              TokenActionMint,
+		"TokenBurn":              TokenActionBurn,
+		"TokenPause":             TokenActionPause,
+		"TokenFreezeAddr":        TokenActionFreezeAddr,
+		"TokenTransferOwnership": TokenActionTransferOwnership,
+		"TokenAcceptOwnership":   TokenActionAcceptOwnership,
+		"TokenUpdateMetadata":    TokenActionUpdateMetadata,
+		"TokenApprove":           TokenActionApprove,
+		"TokenIncreaseAllowance": TokenActionIncreaseAllowance,
+		"TokenTransferFrom":      TokenActionTransferFrom,
 	}
 }
 
 // GetLogMap 获取log的映射对应关系
 func (t *TokenType) GetLogMap() map[int64]*types.LogInfo {
 	return map[int64]*types.LogInfo{
-		TyLogTokenTransfer:        {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenTransfer"},
-		TyLogTokenDeposit:         {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenDeposit"},
-		TyLogTokenExecTransfer:    {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecTransfer"},
-		TyLogTokenExecWithdraw:    {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecWithdraw"},
-		TyLogTokenExecDeposit:     {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecDeposit"},
-		TyLogTokenExecFrozen:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecFrozen"},
-		TyLogTokenExecActive:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecActive"},
-		TyLogTokenGenesisTransfer: {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenGenesisTransfer"},
-		TyLogTokenGenesisDeposit:  {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenGenesisDeposit"},
-		TyLogPreCreateToken:       {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogPreCreateToken"},
-		TyLogFinishCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogFinishCreateToken"},
-		TyLogRevokeCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
-		TyLogTokenMint:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
-		TyLogTokenBurn:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogTokenTransfer:          {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenTransfer"},
+		TyLogTokenDeposit:           {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenDeposit"},
+		TyLogTokenExecTransfer:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecTransfer"},
+		TyLogTokenExecWithdraw:      {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecWithdraw"},
+		TyLogTokenExecDeposit:       {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecDeposit"},
+		TyLogTokenExecFrozen:        {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecFrozen"},
+		TyLogTokenExecActive:        {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenExecActive"},
+		TyLogTokenGenesisTransfer:   {Ty: reflect.TypeOf(types.ReceiptAccountTransfer{}), Name: "LogTokenGenesisTransfer"},
+		TyLogTokenGenesisDeposit:    {Ty: reflect.TypeOf(types.ReceiptExecAccountTransfer{}), Name: "LogTokenGenesisDeposit"},
+		TyLogPreCreateToken:         {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogPreCreateToken"},
+		TyLogFinishCreateToken:      {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogFinishCreateToken"},
+		TyLogRevokeCreateToken:      {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
+		TyLogTokenMint:              {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
+		TyLogTokenBurn:              {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogTokenPause:             {Ty: reflect.TypeOf(ReceiptTokenPause{}), Name: "LogTokenPause"},
+		TyLogTokenFreezeAddr:        {Ty: reflect.TypeOf(ReceiptTokenFreezeAddr{}), Name: "LogTokenFreezeAddr"},
+		TyLogTokenTransferOwnership: {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTokenTransferOwnership"},
+		TyLogTokenAcceptOwnership:   {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTokenAcceptOwnership"},
+		TyLogTokenUpdateMetadata:    {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTokenUpdateMetadata"},
+		TyLogTokenAllowance:         {Ty: reflect.TypeOf(ReceiptTokenAllowance{}), Name: "LogTokenAllowance"},
+		TyLogTokenVestingRelease:    {Ty: reflect.TypeOf(ReceiptTokenVesting{}), Name: "LogTokenVestingRelease"},
 	}
 }
 
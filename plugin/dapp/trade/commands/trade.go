@@ -33,6 +33,7 @@ func TradeCmd() *cobra.Command {
 		CreateRawBuyLimitTxCmd(),
 		CreateRawSellMarketTxCmd(),
 		CreateRawBuyRevokeTxCmd(),
+		CreateRawLimitOrderTxCmd(),
 
 		ShowOnesSellOrdersCmd(),
 		ShowOnesSellOrdersStatusCmd(),
@@ -43,6 +44,7 @@ func TradeCmd() *cobra.Command {
 		ShowTokenBuyOrdersStatusCmd(),
 
 		ShowOnesOrdersStatusCmd(),
+		ShowMarketDepthCmd(),
 	)
 
 	return cmd
@@ -99,7 +101,7 @@ func ShowOnesSellOrdersStatusCmd() *cobra.Command {
 }
 
 func addShowOnesSellOrdersStatusFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("status", "s", "", "sell order status (onsale, soldout or revoked)")
+	cmd.Flags().StringP("status", "s", "", "sell order status (onsale, soldout, revoked or expired)")
 	cmd.MarkFlagRequired("status")
 	cmd.Flags().StringP("address", "a", "", "seller address")
 	cmd.MarkFlagRequired("address")
@@ -145,7 +147,7 @@ func addShowTokenSellOrdersStatusFlags(cmd *cobra.Command) {
 	cmd.Flags().Int32P("count", "c", 10, "order count")
 	cmd.Flags().Int32P("direction", "d", 1, "direction must be 0 (previous-page) or 1(next-page)")
 	cmd.Flags().StringP("from", "f", "", "start from sell id (not required)")
-	cmd.Flags().StringP("status", "s", "", "sell order status (onsale, soldout or revoked)")
+	cmd.Flags().StringP("status", "s", "", "sell order status (onsale, soldout, revoked or expired)")
 	cmd.MarkFlagRequired("status")
 }
 
@@ -199,9 +201,12 @@ func parseSellOrders(arg interface{}) (interface{}, error) {
 			MinBoardlot:    o.MinBoardlot,
 			TotalBoardlot:  o.TotalBoardlot,
 			TradedBoardlot: o.TradedBoardlot,
+			ExpireHeight:   o.ExpireHeight,
+			ExpireTime:     o.ExpireTime,
 		}
 		order.AmountPerBoardlot = strconv.FormatFloat(float64(o.AmountPerBoardlot)/float64(types.Coin), 'f', 4, 64)
 		order.PricePerBoardlot = strconv.FormatFloat(float64(o.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64)
+		order.MakerFee, order.TakerFee = formatFee(o.MakerFee), formatFee(o.TakerFee)
 		result.SellOrders = append(result.SellOrders, order)
 	}
 	return result, nil
@@ -258,7 +263,7 @@ func ShowOnesBuyOrdersStatusCmd() *cobra.Command {
 func addShowOnesBuyTokenOrdersStatusFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("buyer", "b", "", "buyer address")
 	cmd.MarkFlagRequired("buyer")
-	cmd.Flags().StringP("status", "s", "", "buy order status (onbuy, boughtout or buyrevoked)")
+	cmd.Flags().StringP("status", "s", "", "buy order status (onbuy, boughtout, buyrevoked or expired)")
 	cmd.MarkFlagRequired("status")
 }
 
@@ -301,7 +306,7 @@ func addShowBuyTokenOrdersStatusFlags(cmd *cobra.Command) {
 	cmd.Flags().Int32P("count", "c", 10, "order count")
 	cmd.Flags().Int32P("direction", "d", 1, "direction must be 0 (previous-page) or 1(next-page)")
 	cmd.Flags().StringP("from", "f", "", "start from sell id (not required)")
-	cmd.Flags().StringP("status", "s", "", "buy order status (onbuy, boughtout or buyrevoked)")
+	cmd.Flags().StringP("status", "s", "", "buy order status (onbuy, boughtout, buyrevoked or expired)")
 	cmd.MarkFlagRequired("status")
 }
 
@@ -355,9 +360,12 @@ func parseBuyOrders(arg interface{}) (interface{}, error) {
 			MinBoardlot:    o.MinBoardlot,
 			TotalBoardlot:  o.TotalBoardlot,
 			TradedBoardlot: o.TradedBoardlot,
+			ExpireHeight:   o.ExpireHeight,
+			ExpireTime:     o.ExpireTime,
 		}
 		order.AmountPerBoardlot = strconv.FormatFloat(float64(o.AmountPerBoardlot)/float64(types.Coin), 'f', 4, 64)
 		order.PricePerBoardlot = strconv.FormatFloat(float64(o.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64)
+		order.MakerFee, order.TakerFee = formatFee(o.MakerFee), formatFee(o.TakerFee)
 		result.BuyOrders = append(result.BuyOrders, order)
 	}
 	return result, nil
@@ -380,7 +388,7 @@ func addShowOnesOrdersStatusFlags(cmd *cobra.Command) {
 	cmd.Flags().Int32P("count", "c", 10, "order count")
 	cmd.Flags().Int32P("direction", "d", 1, "direction must be 0 (previous-page) or 1(next-page)")
 	cmd.Flags().StringP("from", "f", "", "start from sell id (not required)")
-	cmd.Flags().Int32P("status", "s", 0, "order status (1: on, 2: done, 3: revoke)")
+	cmd.Flags().Int32P("status", "s", 0, "order status (1: on, 2: done, 3: revoke, 4: expired)")
 	cmd.MarkFlagRequired("status")
 }
 
@@ -391,7 +399,7 @@ func showOnesOrdersStatus(cmd *cobra.Command, args []string) {
 	dir, _ := cmd.Flags().GetInt32("direction")
 	from, _ := cmd.Flags().GetString("from")
 	status, _ := cmd.Flags().GetInt32("status")
-	if status < 1 || status > 3 {
+	if status < 1 || status > 4 {
 		fmt.Fprintln(os.Stderr, types.ErrInvalidParam)
 		return
 	}
@@ -429,9 +437,12 @@ func parseTradeOrders(arg interface{}) (interface{}, error) {
 			MinBoardlot:    o.MinBoardlot,
 			TotalBoardlot:  o.TotalBoardlot,
 			TradedBoardlot: o.TradedBoardlot,
+			ExpireHeight:   o.ExpireHeight,
+			ExpireTime:     o.ExpireTime,
 		}
 		order.AmountPerBoardlot = strconv.FormatFloat(float64(o.AmountPerBoardlot)/float64(types.Coin), 'f', 4, 64)
 		order.PricePerBoardlot = strconv.FormatFloat(float64(o.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64)
+		order.MakerFee, order.TakerFee = formatFee(o.MakerFee), formatFee(o.TakerFee)
 		result.Orders = append(result.Orders, order)
 	}
 	return result, nil
@@ -468,6 +479,8 @@ func addTokenSellFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
 	cmd.Flags().StringP("price_exec", "", "", "price exec")
 	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+	cmd.Flags().Int64P("expire_height", "", 0, "order expires at this height, 0: never")
+	cmd.Flags().Int64P("expire_time", "", 0, "order expires at this block time, 0: never")
 }
 
 func tokenSell(cmd *cobra.Command, args []string) {
@@ -483,6 +496,8 @@ func tokenSell(cmd *cobra.Command, args []string) {
 	if exec == "" {
 		exec = "token"
 	}
+	expireHeight, _ := cmd.Flags().GetInt64("expire_height")
+	expireTime, _ := cmd.Flags().GetInt64("expire_time")
 
 	priceInt64 := int64(price * 1e4)
 	feeInt64 := int64(fee * 1e4)
@@ -497,6 +512,8 @@ func tokenSell(cmd *cobra.Command, args []string) {
 		AssetExec:         exec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		ExpireHeight:      expireHeight,
+		ExpireTime:        expireTime,
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeSellTx", params, nil)
@@ -603,6 +620,8 @@ func addTokenBuyLimitFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
 	cmd.Flags().StringP("price_exec", "", "", "price exec")
 	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+	cmd.Flags().Int64P("expire_height", "", 0, "order expires at this height, 0: never")
+	cmd.Flags().Int64P("expire_time", "", 0, "order expires at this block time, 0: never")
 }
 
 func tokenBuyLimit(cmd *cobra.Command, args []string) {
@@ -618,6 +637,8 @@ func tokenBuyLimit(cmd *cobra.Command, args []string) {
 	if exec == "" {
 		exec = "token"
 	}
+	expireHeight, _ := cmd.Flags().GetInt64("expire_height")
+	expireTime, _ := cmd.Flags().GetInt64("expire_time")
 
 	priceInt64 := int64(price * 1e4)
 	feeInt64 := int64(fee * 1e4)
@@ -632,6 +653,8 @@ func tokenBuyLimit(cmd *cobra.Command, args []string) {
 		AssetExec:         exec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		ExpireHeight:      expireHeight,
+		ExpireTime:        expireTime,
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeBuyLimitTx", params, nil)
@@ -706,3 +729,143 @@ func buyRevoke(cmd *cobra.Command, args []string) {
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeRevokeBuyTx", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// CreateRawLimitOrderTxCmd : create raw limit order transaction
+func CreateRawLimitOrderTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit_order",
+		Short: "Create a limit order transaction, matched with the order book",
+		Run:   limitOrder,
+	}
+	addLimitOrderFlags(cmd)
+	return cmd
+}
+
+func addLimitOrderFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().Float64P("price", "p", 0, "price per boardlot")
+	cmd.MarkFlagRequired("price")
+
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee")
+
+	cmd.Flags().Float64P("total", "t", 0, "total tokens to trade")
+	cmd.MarkFlagRequired("total")
+
+	cmd.Flags().BoolP("sell", "", false, "sell order, default is buy order")
+	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
+	cmd.Flags().StringP("price_exec", "", "", "price exec")
+	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+	cmd.Flags().Int64P("expire_height", "", 0, "order expires at this height, 0: never")
+	cmd.Flags().Int64P("expire_time", "", 0, "order expires at this block time, 0: never")
+}
+
+func limitOrder(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	price, _ := cmd.Flags().GetFloat64("price")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	total, _ := cmd.Flags().GetFloat64("total")
+	isSell, _ := cmd.Flags().GetBool("sell")
+	priceExec, _ := cmd.Flags().GetString("price_exec")
+	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
+	exec, _ := cmd.Flags().GetString("asset_exec")
+	if exec == "" {
+		exec = "token"
+	}
+	expireHeight, _ := cmd.Flags().GetInt64("expire_height")
+	expireTime, _ := cmd.Flags().GetInt64("expire_time")
+
+	priceInt64 := int64(price * 1e4)
+	feeInt64 := int64(fee * 1e4)
+	totalInt64 := int64(total * 1e8 / 1e6)
+	params := &pty.TradeLimitOrderTx{
+		TokenSymbol:       symbol,
+		AmountPerBoardlot: 1e6,
+		PricePerBoardlot:  priceInt64 * 1e4,
+		TotalBoardlot:     totalInt64,
+		Fee:               feeInt64 * 1e4,
+		AssetExec:         exec,
+		PriceExec:         priceExec,
+		PriceSymbol:       priceSymbol,
+		IsSellOrder:       isSell,
+		ExpireHeight:      expireHeight,
+		ExpireTime:        expireTime,
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeLimitOrderTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// ShowMarketDepthCmd : show market depth of the order book
+func ShowMarketDepthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market_depth",
+		Short: "Show price levels of the order book",
+		Run:   showMarketDepth,
+	}
+	addShowMarketDepthFlags(cmd)
+	return cmd
+}
+
+func addShowMarketDepthFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+	cmd.Flags().Int32P("count", "c", 10, "price level count of each side")
+	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
+	cmd.Flags().StringP("price_exec", "", "", "price exec")
+	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+}
+
+func showMarketDepth(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	count, _ := cmd.Flags().GetInt32("count")
+	priceExec, _ := cmd.Flags().GetString("price_exec")
+	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
+	exec, _ := cmd.Flags().GetString("asset_exec")
+
+	var req pty.ReqMarketDepth
+	req.TokenSymbol = symbol
+	req.AssetExec = exec
+	req.PriceExec = priceExec
+	req.PriceSymbol = priceSymbol
+	req.AmountPerBoardlot = 1e6
+	req.Count = count
+	var params rpctypes.Query4Jrpc
+	params.Execer = "trade"
+	params.FuncName = "GetMarketDepth"
+	params.Payload = types.MustPBToJSON(&req)
+	var res pty.ReplyMarketDepth
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.SetResultCb(parseMarketDepth)
+	ctx.Run()
+}
+
+func parseMarketDepth(arg interface{}) (interface{}, error) {
+	res := arg.(*pty.ReplyMarketDepth)
+	var result replyMarketDepthResult
+	convert := func(levels []*pty.MarketDepthLevel) []*marketDepthLevelResult {
+		var out []*marketDepthLevelResult
+		for _, l := range levels {
+			out = append(out, &marketDepthLevelResult{
+				PricePerBoardlot: strconv.FormatFloat(float64(l.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64),
+				BoardlotCnt:      l.BoardlotCnt,
+				OrderCount:       l.OrderCount,
+			})
+		}
+		return out
+	}
+	result.Bids = convert(res.Bids)
+	result.Asks = convert(res.Asks)
+	return result, nil
+}
+
+// 手续费为0时不显示
+func formatFee(fee int64) string {
+	if fee == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(float64(fee)/float64(types.Coin), 'f', 8, 64)
+}
@@ -20,6 +20,10 @@ type tradeOrderResult struct {
 	Key               string `json:"key"`
 	BlockTime         int64  `json:"blockTime"`
 	IsSellOrder       bool   `json:"isSellOrder"`
+	ExpireHeight      int64  `json:"expireHeight,omitempty"`
+	ExpireTime        int64  `json:"expireTime,omitempty"`
+	MakerFee          string `json:"makerFee,omitempty"`
+	TakerFee          string `json:"takerFee,omitempty"`
 }
 
 type replySellOrdersResult struct {
@@ -33,3 +37,14 @@ type replyBuyOrdersResult struct {
 type replyTradeOrdersResult struct {
 	Orders []*tradeOrderResult `json:"orders"`
 }
+
+type marketDepthLevelResult struct {
+	PricePerBoardlot string `json:"pricePerBoardlot"`
+	BoardlotCnt      int64  `json:"boardlotCnt"`
+	OrderCount       int32  `json:"orderCount"`
+}
+
+type replyMarketDepthResult struct {
+	Bids []*marketDepthLevelResult `json:"bids"`
+	Asks []*marketDepthLevelResult `json:"asks"`
+}
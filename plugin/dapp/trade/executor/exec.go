@@ -38,3 +38,8 @@ func (t *trade) Exec_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.Transact
 	action := newTradeAction(t, tx)
 	return action.tradeRevokeBuyLimit(revoke)
 }
+
+func (t *trade) Exec_LimitOrder(order *pty.TradeForLimitOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTradeAction(t, tx)
+	return action.tradeLimitOrder(order)
+}
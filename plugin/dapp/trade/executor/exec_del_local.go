@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
@@ -34,10 +35,16 @@ func (t *trade) ExecDelLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.
 	return t.localDelLog(tx, receipt, index, 0)
 }
 
+func (t *trade) ExecDelLocal_LimitOrder(order *pty.TradeForLimitOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localDelLog(tx, receipt, index, 0)
+}
+
 func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, index int, tradedBoardlot int64) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
 	table := NewOrderTable(t.GetLocalDB())
 	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	// 限价单一笔交易会和多个订单成交, 每个订单成交的数量从成交日志中获得
+	fills := getMatchedBoardlot(receipt)
 
 	for i := 0; i < len(receipt.Logs); i++ {
 		item := receipt.Logs[i]
@@ -47,7 +54,11 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 			if err != nil {
 				panic(err) //数据错误了，已经被修改了
 			}
-			kv := t.deleteSell(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
+			traded := tradedBoardlot
+			if cnt, ok := fills[receipt.Base.SellID]; ok {
+				traded = cnt
+			}
+			kv := t.deleteSell(receipt.Base, item.Ty, tx, txIndex, table, traded)
 			set.KV = append(set.KV, kv...)
 		} else if item.Ty == pty.TyLogTradeSellRevoke {
 			var receipt pty.ReceiptTradeSellRevoke
@@ -79,7 +90,11 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 			if err != nil {
 				panic(err) //数据错误了，已经被修改了
 			}
-			kv := t.deleteBuyLimit(receipt.Base, item.Ty, tx, txIndex, table, tradedBoardlot)
+			traded := tradedBoardlot
+			if cnt, ok := fills[receipt.Base.BuyID]; ok {
+				traded = cnt
+			}
+			kv := t.deleteBuyLimit(receipt.Base, item.Ty, tx, txIndex, table, traded)
 			set.KV = append(set.KV, kv...)
 		} else if item.Ty == pty.TyLogTradeSellMarket {
 			var receipt pty.ReceiptSellMarket
@@ -97,9 +112,31 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 		return nil, err
 	}
 	set.KV = append(set.KV, newKvs...)
+	marketKvs, err := t.deleteMarketData(getTradeFills(receipt, common.ToHex(tx.Hash()), t.GetHeight(), t.GetBlockTime(), txIndex))
+	if err != nil {
+		tradelog.Error("trade deleteMarketData failed", "error", err)
+		return nil, err
+	}
+	set.KV = append(set.KV, marketKvs...)
 	for _, kv := range set.KV {
 		t.GetLocalDB().Set(kv.Key, kv.Value)
 	}
 
 	return &set, nil
 }
+
+func getMatchedBoardlot(receipt *types.ReceiptData) map[string]int64 {
+	fills := make(map[string]int64)
+	for _, item := range receipt.Logs {
+		if item.Ty != pty.TyLogTradeMatch {
+			continue
+		}
+		var match pty.ReceiptTradeMatch
+		err := types.Decode(item.Log, &match)
+		if err != nil {
+			panic(err) //数据错误了，已经被修改了
+		}
+		fills[match.MakerOrderID] += match.BoardlotCnt
+	}
+	return fills
+}
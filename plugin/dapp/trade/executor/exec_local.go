@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
@@ -34,6 +35,10 @@ func (t *trade) ExecLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.Tra
 	return t.localAddLog(tx, receipt, index)
 }
 
+func (t *trade) ExecLocal_LimitOrder(order *pty.TradeForLimitOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localAddLog(tx, receipt, index)
+}
+
 func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
 	table := NewOrderTable(t.GetLocalDB())
@@ -101,6 +106,8 @@ func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, i
 	}
 
 	set.KV = append(set.KV, newKvs...)
+	fills := getTradeFills(receipt, common.ToHex(tx.Hash()), t.GetHeight(), t.GetBlockTime(), txIndex)
+	set.KV = append(set.KV, t.saveMarketData(fills)...)
 	for _, kv := range set.KV {
 		t.GetLocalDB().Set(kv.Key, kv.Value)
 	}
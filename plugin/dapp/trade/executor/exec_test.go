@@ -515,3 +515,145 @@ func TestTradeSellFixAssetDB(t *testing.T) {
 	assert.Equal(t, 1, len(orders.Orders))
 	ldb.Close()
 }
+
+func TestTrade_Exec_Expire(t *testing.T) {
+	total := int64(100000)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+	accountB := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[1]),
+	}
+
+	env := execEnv{
+		1539918074,
+		types.GetDappFork("trade", pty.ForkTradeExpireX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, ldb, kvdb := util.CreateTestDB()
+	defer ldb.Close()
+
+	accB, _ := account.NewAccountDB(AssetExecToken, SymbolA, stateDB)
+	accB.SaveExecAccount(address.ExecAddress("trade"), &accountB)
+	accA, _ := account.NewAccountDB(AssetExecPara, Symbol, stateDB)
+	accA.SaveExecAccount(address.ExecAddress("trade"), &accountA)
+
+	driver := newTrade()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(kvdb)
+
+	exec := func(tx *types.Transaction, privKey string) (*types.Receipt, error) {
+		tx, _ = signTx(tx, privKey)
+		env.index++
+		receipt, err := driver.Exec(tx, env.index)
+		if err != nil {
+			return nil, err
+		}
+		set, err := driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			if kv.Value == nil {
+				ldb.Delete(kv.Key)
+				continue
+			}
+			kvdb.Set(kv.Key, kv.Value)
+		}
+		return receipt, nil
+	}
+
+	// 卖单在 10 个高度后过期, 买单在 100 秒后过期
+	sell := &pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 100,
+		MinBoardlot:       1,
+		PricePerBoardlot:  2,
+		TotalBoardlot:     10,
+		AssetExec:         AssetExecPara,
+		PriceExec:         AssetExecToken,
+		PriceSymbol:       SymbolA,
+		ExpireHeight:      env.blockHeight + 10,
+	}
+	tx, _ := pty.CreateRawTradeSellTx(sell)
+	receipt, err := exec(tx, PrivKeyA)
+	assert.Nil(t, err)
+	var sellOrder pty.SellOrder
+	assert.Nil(t, types.Decode(receipt.KV[1].Value, &sellOrder))
+	assert.Equal(t, env.blockHeight+10, sellOrder.ExpireHeight)
+	sellID := sellOrder.SellID[len("mavl-trade-sell-"):]
+
+	buyLimit := &pty.TradeBuyLimitTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 100,
+		MinBoardlot:       1,
+		PricePerBoardlot:  1,
+		TotalBoardlot:     10,
+		AssetExec:         AssetExecPara,
+		PriceExec:         AssetExecToken,
+		PriceSymbol:       SymbolA,
+		ExpireTime:        env.blockTime + 100,
+	}
+	tx, _ = pty.CreateRawTradeBuyLimitTx(buyLimit)
+	receipt, err = exec(tx, PrivKeyB)
+	assert.Nil(t, err)
+	var buyOrder pty.BuyLimitOrder
+	assert.Nil(t, types.Decode(receipt.KV[1].Value, &buyOrder))
+	buyID := buyOrder.BuyID[len("mavl-trade-buy-"):]
+
+	// 未过期时, 其他人不能撤单
+	tx, _ = pty.CreateRawTradeRevokeTx(&pty.TradeRevokeTx{SellID: sellID})
+	_, err = exec(tx, PrivKeyC)
+	assert.Equal(t, pty.ErrTSellOrderRevoke, err)
+
+	// 过期后不能成交
+	driver.SetEnv(env.blockHeight+10, env.blockTime+100, env.difficulty)
+	tx, _ = pty.CreateRawTradeBuyTx(&pty.TradeBuyTx{SellID: sellID, BoardlotCnt: 1})
+	_, err = exec(tx, PrivKeyB)
+	assert.Equal(t, pty.ErrTSellOrderExpired, err)
+	tx, _ = pty.CreateRawTradeSellMarketTx(&pty.TradeSellMarketTx{BuyID: buyID, BoardlotCnt: 1})
+	_, err = exec(tx, PrivKeyA)
+	assert.Equal(t, pty.ErrTBuyOrderExpired, err)
+
+	// 任何人都可以撤销过期的订单, 冻结的资产退回给挂单人
+	tx, _ = pty.CreateRawTradeRevokeTx(&pty.TradeRevokeTx{SellID: sellID})
+	receipt, err = exec(tx, PrivKeyC)
+	assert.Nil(t, err)
+	assert.Nil(t, types.Decode(receipt.KV[1].Value, &sellOrder))
+	assert.Equal(t, int32(pty.TradeOrderStatusExpired), sellOrder.Status)
+	acc := accA.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, total, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	tx, _ = pty.CreateRawTradeRevokeBuyTx(&pty.TradeRevokeBuyTx{BuyID: buyID})
+	_, err = exec(tx, PrivKeyD)
+	assert.Nil(t, err)
+	acc = accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	tx, _ = pty.CreateRawTradeRevokeTx(&pty.TradeRevokeTx{SellID: sellID})
+	_, err = exec(tx, PrivKeyA)
+	assert.Equal(t, pty.ErrTSellOrderExpired, err)
+
+	req := &pty.ReqAddrAssets{
+		Addr:      string(Nodes[0]),
+		Status:    pty.TradeOrderStatusExpired,
+		Direction: 1,
+		Count:     10,
+	}
+	resp, err := driver.Query("GetOnesOrderWithStatus", types.Encode(req))
+	assert.Nil(t, err)
+	orders, ok := resp.(*pty.ReplyTradeOrders)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(orders.Orders))
+	assert.Equal(t, int32(pty.TradeOrderStatusExpired), orders.Orders[0].Status)
+	assert.Equal(t, env.blockHeight+10, orders.Orders[0].ExpireHeight)
+}
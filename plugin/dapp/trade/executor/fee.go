@@ -0,0 +1,163 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strconv"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
+/*
+手续费
+
+ForkTradeFee 之后, 通过 manage 合约按定价资产配置挂单方(maker)和吃单方(taker)的手续费率,
+手续费以定价资产支付, 转到配置的收取地址. 没有配置收取地址时不收取手续费.
+
+卖方的手续费从卖得的定价资产中支付, 买方的手续费在成交金额之外另外支付.
+挂单方费率在挂单时确定并记录在订单中, 吃单方费率按成交时的配置.
+限价买单挂单时按挂单方费率冻结手续费, 成交时从冻结的手续费中扣除, 撤单时退回剩余部分.
+*/
+
+func getManageKey(key string, db dbm.KV) ([]byte, error) {
+	manageKey := types.ManageKey(key)
+	value, err := db.Get([]byte(manageKey))
+	if err != nil {
+		return getConfigKey(key, db)
+	}
+	return value, nil
+}
+
+func getConfigKey(key string, db dbm.KV) ([]byte, error) {
+	configKey := types.ConfigKey(key)
+	value, err := db.Get([]byte(configKey))
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// 取数组最后一位，作为最新配置项的值
+func getConfValue(db dbm.KV, key string) string {
+	value, err := getManageKey(key, db)
+	if err != nil || value == nil {
+		return ""
+	}
+	var item types.ConfigItem
+	err = types.Decode(value, &item)
+	if err != nil {
+		tradelog.Error("getConfValue", "decode db key", key, "err", err)
+		return ""
+	}
+	values := item.GetArr().GetValue()
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+func calcFeeRateKey(prefix, priceExec, priceSymbol string) string {
+	if priceExec == "" {
+		priceExec = defaultPriceExec
+		priceSymbol = types.GetCoinSymbol()
+	}
+	return prefix + priceExec + "." + priceSymbol
+}
+
+// 按万分之 rate 计算手续费, 先除后乘避免溢出
+func calcFee(amount, rate int64) int64 {
+	return amount/pty.FeeRateBase*rate + amount%pty.FeeRateBase*rate/pty.FeeRateBase
+}
+
+// 没有配置收取地址时不收取手续费
+func calcTradeFee(collector string, amount, rate int64) int64 {
+	if collector == "" {
+		return 0
+	}
+	return calcFee(amount, rate)
+}
+
+func (action *tradeAction) getFeeCollector() string {
+	if !types.IsDappFork(action.height, pty.TradeX, pty.ForkTradeFeeX) {
+		return ""
+	}
+	collector := getConfValue(action.db, pty.ManageKeyFeeCollector)
+	if collector == "" {
+		return ""
+	}
+	if err := address.CheckAddress(collector); err != nil {
+		tradelog.Error("getFeeCollector", "collector", collector, "err", err)
+		return ""
+	}
+	return collector
+}
+
+func (action *tradeAction) getFeeRate(collector, prefix, priceExec, priceSymbol string) int64 {
+	if collector == "" {
+		return 0
+	}
+	value := getConfValue(action.db, calcFeeRateKey(prefix, priceExec, priceSymbol))
+	if value == "" {
+		return 0
+	}
+	rate, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || rate < 0 || rate > pty.MaxFeeRate {
+		tradelog.Error("getFeeRate", "key", calcFeeRateKey(prefix, priceExec, priceSymbol), "value", value)
+		return 0
+	}
+	return rate
+}
+
+func (action *tradeAction) getMakerFeeRate(collector, priceExec, priceSymbol string) int64 {
+	return action.getFeeRate(collector, pty.ManageKeyMakerFee, priceExec, priceSymbol)
+}
+
+func (action *tradeAction) getTakerFeeRate(collector, priceExec, priceSymbol string) int64 {
+	return action.getFeeRate(collector, pty.ManageKeyTakerFee, priceExec, priceSymbol)
+}
+
+// 从 from 的可用余额中支付手续费
+func (action *tradeAction) payFee(acc *account.DB, from, collector string, fee int64) (*types.Receipt, error) {
+	if fee == 0 || collector == "" {
+		return &types.Receipt{}, nil
+	}
+	receipt, err := acc.ExecTransfer(from, collector, action.execaddr, fee)
+	if err != nil {
+		tradelog.Error("payFee", "addrFrom", from, "collector", collector, "fee", fee, "err", err)
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// 限价买单成交 cnt 手, 从冻结的手续费中支付挂单方手续费, 全部成交时支付剩余的冻结手续费
+// 没有配置收取地址时, 这部分冻结的手续费退回买方
+func (action *tradeAction) payBuyMakerFee(acc *account.DB, collector string, order *pty.BuyLimitOrder, cnt int64) (*types.Receipt, int64, error) {
+	if order.FeeFrozen == 0 {
+		return &types.Receipt{}, 0, nil
+	}
+	fee := calcFee(cnt*order.PricePerBoardlot, order.MakerFeeRate)
+	if fee > order.FeeFrozen || order.BoughtBoardlot+cnt == order.TotalBoardlot {
+		fee = order.FeeFrozen
+	}
+	order.FeeFrozen -= fee
+	if collector == "" {
+		receipt, err := acc.ExecActive(order.Address, action.execaddr, fee)
+		if err != nil {
+			tradelog.Error("payBuyMakerFee ExecActive", "addr", order.Address, "fee", fee, "err", err)
+			return nil, 0, err
+		}
+		return receipt, 0, nil
+	}
+	receipt, err := acc.ExecTransferFrozen(order.Address, collector, action.execaddr, fee)
+	if err != nil {
+		tradelog.Error("payBuyMakerFee", "addrFrom", order.Address, "collector", collector, "fee", fee, "err", err)
+		return nil, 0, err
+	}
+	return receipt, fee, nil
+}
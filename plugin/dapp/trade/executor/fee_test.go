@@ -0,0 +1,163 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func saveManageConfig(db dbm.KV, key string, values ...string) {
+	item := &types.ConfigItem{
+		Key: key,
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: values},
+		},
+	}
+	db.Set([]byte(types.ManageKey(key)), types.Encode(item))
+}
+
+func TestTrade_Exec_Fee(t *testing.T) {
+	amount := int64(1e8)
+	price := int64(1e8)
+	total := int64(1e12)
+	collector := string(Nodes[2])
+
+	env := execEnv{
+		1539918074,
+		types.GetDappFork("trade", pty.ForkTradeFeeX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	_, ldb, kvdb := util.CreateTestDB()
+	defer ldb.Close()
+	coins := account.NewCoinsAccount()
+	coins.SetDB(kvdb)
+	tokens, _ := account.NewAccountDB(AssetExecToken, Symbol, kvdb)
+	for _, node := range Nodes[:2] {
+		coins.SaveExecAccount(address.ExecAddress("trade"), &types.Account{Balance: total, Addr: string(node)})
+		tokens.SaveExecAccount(address.ExecAddress("trade"), &types.Account{Balance: total, Addr: string(node)})
+	}
+	kvdb.Set(calcTokenKey(Symbol), []byte("exist"))
+
+	// 挂单万分之十, 吃单万分之二十, 费率取配置的最后一个值
+	saveManageConfig(kvdb, pty.ManageKeyFeeCollector, collector)
+	saveManageConfig(kvdb, pty.ManageKeyMakerFee+"coins.bty", "5", "10")
+	saveManageConfig(kvdb, pty.ManageKeyTakerFee+"coins.bty", "20")
+
+	driver := newTrade()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+
+	exec := func(tx *types.Transaction, priv string) *types.Receipt {
+		env.index++
+		tx, _ = signTx(tx, priv)
+		receipt, err := driver.Exec(tx, env.index)
+		assert.Nil(t, err)
+		if err != nil {
+			return nil
+		}
+		set, err := driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			if kv.Value == nil {
+				ldb.Delete(kv.Key)
+				continue
+			}
+			kvdb.Set(kv.Key, kv.Value)
+		}
+		return receipt
+	}
+	coinsOf := func(addr string) *types.Account {
+		return coins.LoadExecAccount(addr, address.ExecAddress("trade"))
+	}
+
+	// A 挂卖单, 记录挂单费率
+	tx, _ := pty.CreateRawTradeSellTx(&pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: amount,
+		MinBoardlot:       1,
+		PricePerBoardlot:  2 * price,
+		TotalBoardlot:     10,
+		AssetExec:         AssetExecToken,
+	})
+	receipt := exec(tx, PrivKeyA)
+	if receipt == nil {
+		return
+	}
+	sellTxIndex := dapp.HeightIndexStr(env.blockHeight, int64(env.index))
+	var sellOrder pty.SellOrder
+	assert.Nil(t, types.Decode(receipt.KV[1].Value, &sellOrder))
+	assert.Equal(t, int64(10), sellOrder.MakerFeeRate)
+
+	// B 吃单 5 手, 成交金额 10, 买方另付吃单手续费, 卖方从所得中扣除挂单手续费
+	tx, _ = pty.CreateRawTradeBuyTx(&pty.TradeBuyTx{SellID: sellOrder.SellID[len(sellIDPrefix):], BoardlotCnt: 5})
+	receipt = exec(tx, PrivKeyB)
+	if receipt == nil {
+		return
+	}
+	volume := 10 * price
+	takerFee, makerFee := volume*20/10000, volume*10/10000
+	assert.Equal(t, total-volume-takerFee, coinsOf(string(Nodes[1])).Balance)
+	assert.Equal(t, total+volume-makerFee, coinsOf(string(Nodes[0])).Balance)
+	assert.Equal(t, takerFee+makerFee, coinsOf(collector).Balance)
+	for _, l := range receipt.Logs {
+		if l.Ty == pty.TyLogTradeBuyMarket {
+			var r pty.ReceiptTradeBuyMarket
+			assert.Nil(t, types.Decode(l.Log, &r))
+			assert.Equal(t, takerFee, r.Base.TakerFee)
+		}
+	}
+	resp, err := driver.Query("GetOneOrder", types.Encode(&pty.ReqAddrAssets{FromKey: sellTxIndex}))
+	assert.Nil(t, err)
+	assert.Equal(t, makerFee, resp.(*pty.ReplyTradeOrder).MakerFee)
+
+	// B 挂买单 4 手, 挂单手续费一起冻结
+	tx, _ = pty.CreateRawTradeBuyLimitTx(&pty.TradeBuyLimitTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: amount,
+		MinBoardlot:       1,
+		PricePerBoardlot:  price,
+		TotalBoardlot:     4,
+		AssetExec:         AssetExecToken,
+	})
+	receipt = exec(tx, PrivKeyB)
+	if receipt == nil {
+		return
+	}
+	var buyOrder pty.BuyLimitOrder
+	assert.Nil(t, types.Decode(receipt.KV[1].Value, &buyOrder))
+	frozenFee := 4 * price * 10 / 10000
+	assert.Equal(t, frozenFee, buyOrder.FeeFrozen)
+	assert.Equal(t, 4*price+frozenFee, coinsOf(string(Nodes[1])).Frozen)
+
+	// A 卖 1 手给买单, 买方的挂单手续费从冻结中扣除
+	tx, _ = pty.CreateRawTradeSellMarketTx(&pty.TradeSellMarketTx{BuyID: buyOrder.BuyID[len(buyIDPrefix):], BoardlotCnt: 1})
+	if exec(tx, PrivKeyA) == nil {
+		return
+	}
+	assert.Equal(t, 3*price+frozenFee*3/4, coinsOf(string(Nodes[1])).Frozen)
+	assert.Equal(t, takerFee+makerFee+price*20/10000+price*10/10000, coinsOf(collector).Balance)
+
+	// 撤单退回剩余的冻结资金和手续费
+	tx, _ = pty.CreateRawTradeRevokeBuyTx(&pty.TradeRevokeBuyTx{BuyID: buyOrder.BuyID[len(buyIDPrefix):]})
+	if exec(tx, PrivKeyB) == nil {
+		return
+	}
+	acc := coinsOf(string(Nodes[1]))
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, total-volume-takerFee-price-price*10/10000, acc.Balance)
+}
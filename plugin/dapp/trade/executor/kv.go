@@ -6,6 +6,7 @@ package executor
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/33cn/chain33/types"
@@ -25,6 +26,10 @@ const (
 	buyIDPrefix    = "mavl-trade-buy-"
 	// Addr-Status-Type-Height-Key
 	orderASTHK = "LODB-trade-order-asthk:"
+	// 成交记录, pair-txIndex-seq
+	fillPrefix = "LODB-trade-fill-"
+	// K线, pair-interval-startTime
+	candlePrefix = "LODB-trade-candle-"
 )
 
 // sell order 4 key, 4prefix
@@ -137,6 +142,62 @@ func calcOnesOrderKey(addr string, status int32, ty int32, height int64, key str
 	return []byte(fmt.Sprintf(orderASTHK+"%s:%d:%010d:%d:%s", addr, status, height, ty, key))
 }
 
+// 订单簿中的市场: 资产, 定价资产, 每手数量都相同的订单才能撮合
+func calcOrderBookMarket(assetExec, assetSymbol, priceExec, priceSymbol string, amountPerBoardlot int64) string {
+	if assetExec == "" {
+		assetExec = defaultAssetExec
+	}
+	if priceExec == "" {
+		priceExec = defaultPriceExec
+		priceSymbol = types.GetCoinSymbol()
+	}
+	return fmt.Sprintf("%s.%s-%s.%s-%d", assetExec, assetSymbol, priceExec, priceSymbol, amountPerBoardlot)
+}
+
+// 订单簿索引中的价格, 买单价格取反使两侧都按索引升序就是价格优先
+func calcOrderBookPrice(isSell bool, price int64) int64 {
+	if isSell {
+		return price
+	}
+	return math.MaxInt64 - price
+}
+
+// 订单簿一侧未完成订单的索引前缀
+func calcOrderBookPrefix(market string, isSell bool) []byte {
+	if isSell {
+		return []byte(fmt.Sprintf("%s_1_0_", market))
+	}
+	return []byte(fmt.Sprintf("%s_0_0_", market))
+}
+
+// 行情的交易对, assetExec:tokenSymbol/priceExec:priceSymbol
+func calcTradePair(assetExec, assetSymbol, priceExec, priceSymbol string) string {
+	if assetExec == "" {
+		assetExec = defaultAssetExec
+	}
+	if priceExec == "" {
+		priceExec = defaultPriceExec
+		priceSymbol = types.GetCoinSymbol()
+	}
+	return fmt.Sprintf("%s:%s/%s:%s", assetExec, assetSymbol, priceExec, priceSymbol)
+}
+
+func calcFillPrefix(pair string) []byte {
+	return []byte(fmt.Sprintf(fillPrefix+"%s-", pair))
+}
+
+func calcFillKey(pair string, fillKey string) []byte {
+	return []byte(fmt.Sprintf(fillPrefix+"%s-%s", pair, fillKey))
+}
+
+func calcCandlePrefix(pair string, interval string) []byte {
+	return []byte(fmt.Sprintf(candlePrefix+"%s-%s-", pair, interval))
+}
+
+func calcCandleKey(pair string, interval string, startTime int64) []byte {
+	return []byte(fmt.Sprintf(candlePrefix+"%s-%s-%020d", pair, interval, startTime))
+}
+
 // 特定状态下的买单
 //func calcTokenBuyOrderPrefixStatus(status int32) []byte {
 //	return []byte(fmt.Sprintf(buyOrderSHTAS+"%d", status))
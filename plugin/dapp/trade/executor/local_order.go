@@ -58,6 +58,7 @@ var opt_order_table = &table.Option{
 		"owner_asset_isFinished",
 		"owner_isFinished",
 		// "owner_statusPrefix", // 状态可以定制组合 , 成交历史需求
+		"market_isSell_isFinished_price", // 订单簿, 限价单撮合, 最优买卖价和市场深度
 	},
 }
 
@@ -118,6 +119,8 @@ func (r *OrderRow) Get(key string) ([]byte, error) {
 		return []byte(fmt.Sprintf("%s_%s_%d", r.Owner, r.asset(), r.isFinished())), nil
 	case "owner_isFinished":
 		return []byte(fmt.Sprintf("%s_%d", r.Owner, r.isFinished())), nil
+	case "market_isSell_isFinished_price":
+		return []byte(fmt.Sprintf("%s_%d_%d_%019d", r.market(), r.isSell(), r.isFinished(), calcOrderBookPrice(r.IsSellOrder, r.PricePerBoardlot))), nil
 	default:
 		return nil, types.ErrNotFound
 	}
@@ -127,6 +130,10 @@ func (r *OrderRow) asset() string {
 	return r.LocalOrder.AssetExec + "." + r.LocalOrder.AssetSymbol
 }
 
+func (r *OrderRow) market() string {
+	return calcOrderBookMarket(r.AssetExec, r.AssetSymbol, r.PriceExec, r.PriceSymbol, r.AmountPerBoardlot)
+}
+
 func (r *OrderRow) isSell() int {
 	if r.IsSellOrder {
 		return 1
@@ -142,8 +149,8 @@ func (r *OrderRow) isFinished() int {
 }
 
 // status: 设计为可以同时查询几种的并集 , 存储为前缀， 需要提前设计需要合并的， 用前缀表示
-//    进行中，  撤销，  部分成交 ， 全部成交，  完成状态统一前缀. 数字和原来不一样
-//      01     10     11          12        19 -> 1*
+//    进行中，  撤销，  部分成交 ， 全部成交，  过期，  完成状态统一前缀. 数字和原来不一样
+//      01     10     11          12        13      19 -> 1*
 func (r *OrderRow) status() string {
 	if r.Status == pty.TradeOrderStatusOnBuy || r.Status == pty.TradeOrderStatusOnSale {
 		return "01" // 试图用1 可以匹配所有完成的
@@ -153,6 +160,8 @@ func (r *OrderRow) status() string {
 		return "10"
 	} else if r.Status == pty.TradeOrderStatusSellHalfRevoked || r.Status == pty.TradeOrderStatusBuyHalfRevoked {
 		return "11"
+	} else if r.Status == pty.TradeOrderStatusExpired {
+		return "13"
 	} else if r.Status == pty.TradeOrderStatusGroupComplete {
 		return "1" // 1* match complete
 	}
@@ -192,13 +201,25 @@ func (t *trade) genSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBase,
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       true,
 		AssetExec:         sellorder.AssetExec,
-		IsFinished:        false,
+		IsFinished:        isSellFinished(sellorder.Status, sellorder.SoldBoardlot, sellorder.TotalBoardlot),
 		PriceExec:         sellorder.PriceExec,
 		PriceSymbol:       sellorder.PriceSymbol,
+		ExpireHeight:      sellorder.ExpireHeight,
+		ExpireTime:        sellorder.ExpireTime,
+		MakerFee:          sell.MakerFee,
+		TakerFee:          sell.TakerFee,
 	}
 	return order
 }
 
+// 众筹卖单一直保持 NotStart 状态, 卖完才算完成
+func isSellFinished(status int32, sold, total int64) bool {
+	if status == pty.TradeOrderStatusNotStart {
+		return sold >= total
+	}
+	return status != pty.TradeOrderStatusOnSale
+}
+
 func (t *trade) updateSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBase,
 	sellorder *pty.SellOrder, txIndex string, ldb *table.Table) *pty.LocalOrder {
 
@@ -220,7 +241,9 @@ func (t *trade) updateSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBase
 	order.Status = status
 	order.TxHash = append(order.TxHash, common.ToHex(tx.Hash()))
 	order.TradedBoardlot = sellorder.SoldBoardlot
-	order.IsFinished = (status != pty.TradeOrderStatusOnSale)
+	order.IsFinished = isSellFinished(status, order.TradedBoardlot, order.TotalBoardlot)
+	order.MakerFee += sell.MakerFee
+	order.TakerFee += sell.TakerFee
 
 	tradelog.Debug("Table", "sell-update", order)
 
@@ -244,9 +267,14 @@ func (t *trade) rollBackSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBa
 	// 撤销订单回滚, 只需要修改状态
 	// 其他的操作需要还修改数量
 	order.Status = pty.TradeOrderStatusOnSale
+	if sell.Starttime != pty.InvalidStartTime {
+		order.Status = pty.TradeOrderStatusNotStart
+	}
 	order.TxHash = order.TxHash[:len(order.TxHash)-1]
 	order.TradedBoardlot = order.TradedBoardlot - tradedBoardlot
-	order.IsFinished = (order.Status != pty.TradeOrderStatusOnSale)
+	order.IsFinished = isSellFinished(order.Status, order.TradedBoardlot, order.TotalBoardlot)
+	order.MakerFee -= sell.MakerFee
+	order.TakerFee -= sell.TakerFee
 
 	ldb.Replace(order)
 
@@ -295,6 +323,7 @@ func (t *trade) genSellMarket(tx *types.Transaction, sell *pty.ReceiptSellBase,
 		IsFinished:  true,
 		PriceExec:   sell.PriceExec,
 		PriceSymbol: sell.PriceSymbol,
+		TakerFee:    sell.TakerFee,
 	}
 	return order
 }
@@ -311,7 +340,7 @@ func (t *trade) genBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase, txIn
 		TotalBoardlot:     buy.TotalBoardlot,
 		TradedBoardlot:    buy.BoughtBoardlot,
 		BuyID:             buy.BuyID,
-		Status:            pty.TradeOrderStatusOnBuy,
+		Status:            pty.SellOrderStatus2Int[buy.Status],
 		SellID:            "",
 		TxHash:            []string{common.ToHex(tx.Hash())},
 		Height:            buy.Height,
@@ -319,9 +348,13 @@ func (t *trade) genBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase, txIn
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       false,
 		AssetExec:         buy.AssetExec,
-		IsFinished:        false,
+		IsFinished:        buy.Status != pty.SellOrderStatus[pty.TradeOrderStatusOnBuy],
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		ExpireHeight:      buy.ExpireHeight,
+		ExpireTime:        buy.ExpireTime,
+		MakerFee:          buy.MakerFee,
+		TakerFee:          buy.TakerFee,
 	}
 	return order
 }
@@ -346,6 +379,8 @@ func (t *trade) updateBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase,
 	order.TxHash = append(order.TxHash, common.ToHex(tx.Hash()))
 	order.TradedBoardlot = buyorder.BoughtBoardlot
 	order.IsFinished = (status != pty.TradeOrderStatusOnBuy)
+	order.MakerFee += buy.MakerFee
+	order.TakerFee += buy.TakerFee
 
 	ldb.Replace(order)
 
@@ -368,6 +403,8 @@ func (t *trade) rollbackBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase,
 	order.TxHash = order.TxHash[:len(order.TxHash)-1]
 	order.TradedBoardlot = order.TradedBoardlot - traded
 	order.IsFinished = false
+	order.MakerFee -= buy.MakerFee
+	order.TakerFee -= buy.TakerFee
 
 	ldb.Replace(order)
 
@@ -397,6 +434,7 @@ func (t *trade) genBuyMarket(tx *types.Transaction, buy *pty.ReceiptBuyBase, txI
 		IsFinished:        true,
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		TakerFee:          buy.TakerFee,
 	}
 	return order
 }
@@ -455,7 +493,7 @@ func list(db dbm.KVDB, indexName string, data *pty.LocalOrder, count, direction
 状态 1, TradeOrderStatusOnSale, 在售
 状态 2： TradeOrderStatusSoldOut，售完
 状态 3： TradeOrderStatusRevoked， 卖单被撤回
-状态 4： TradeOrderStatusExpired， 订单超时(目前不支持订单超时)
+状态 4： TradeOrderStatusExpired， 订单超时, 过期后被撤单
 状态 5： TradeOrderStatusOnBuy， 求购
 状态 6： TradeOrderStatusBoughtOut， 购买完成
 状态 7： TradeOrderStatusBuyRevoked， 买单被撤回
@@ -19,7 +19,7 @@ func genSaveSellKv(sellorder *pty.SellOrder) []*types.KeyValue {
 	status := sellorder.Status
 	var kv []*types.KeyValue
 	kv = saveSellOrderKeyValue(kv, sellorder, status)
-	if pty.TradeOrderStatusSoldOut == status || pty.TradeOrderStatusRevoked == status {
+	if pty.TradeOrderStatusSoldOut == status || pty.TradeOrderStatusRevoked == status || pty.TradeOrderStatusExpired == status {
 		tradelog.Debug("trade saveSell ", "remove old status onsale to soldout or revoked with sellid", sellorder.SellID)
 		kv = deleteSellOrderKeyValue(kv, sellorder, pty.TradeOrderStatusOnSale)
 	}
@@ -112,7 +112,7 @@ func genSaveBuyLimitKv(buyOrder *pty.BuyLimitOrder) []*types.KeyValue {
 	status := buyOrder.Status
 	var kv []*types.KeyValue
 	kv = saveBuyLimitOrderKeyValue(kv, buyOrder, status)
-	if pty.TradeOrderStatusBoughtOut == status || pty.TradeOrderStatusBuyRevoked == status {
+	if pty.TradeOrderStatusBoughtOut == status || pty.TradeOrderStatusBuyRevoked == status || pty.TradeOrderStatusExpired == status {
 		tradelog.Debug("trade saveBuyLimit ", "remove old status with Buyid", buyOrder.BuyID)
 		kv = deleteBuyLimitKeyValue(kv, buyOrder, pty.TradeOrderStatusOnBuy)
 	}
@@ -167,7 +167,7 @@ func genDeleteSellKv(sellorder *pty.SellOrder) []*types.KeyValue {
 	status := sellorder.Status
 	var kv []*types.KeyValue
 	kv = deleteSellOrderKeyValue(kv, sellorder, status)
-	if pty.TradeOrderStatusSoldOut == status || pty.TradeOrderStatusRevoked == status {
+	if pty.TradeOrderStatusSoldOut == status || pty.TradeOrderStatusRevoked == status || pty.TradeOrderStatusExpired == status {
 		tradelog.Debug("trade saveSell ", "remove old status onsale to soldout or revoked with sellID", sellorder.SellID)
 		kv = saveSellOrderKeyValue(kv, sellorder, pty.TradeOrderStatusOnSale)
 	}
@@ -190,7 +190,7 @@ func genDeleteBuyLimitKv(buyOrder *pty.BuyLimitOrder) []*types.KeyValue {
 	status := buyOrder.Status
 	var kv []*types.KeyValue
 	kv = deleteBuyLimitKeyValue(kv, buyOrder, status)
-	if pty.TradeOrderStatusBoughtOut == status || pty.TradeOrderStatusBuyRevoked == status {
+	if pty.TradeOrderStatusBoughtOut == status || pty.TradeOrderStatusBuyRevoked == status || pty.TradeOrderStatusExpired == status {
 		tradelog.Debug("trade saveSell ", "remove old status onsale to soldout or revoked with sellid", buyOrder.BuyID)
 		kv = saveBuyLimitOrderKeyValue(kv, buyOrder, pty.TradeOrderStatusOnBuy)
 	}
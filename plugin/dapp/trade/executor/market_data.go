@@ -0,0 +1,238 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
+// 行情数据: 每个交易对的成交历史, 以及 1m/1h/1d 的K线
+// K线只记录有成交的时间段, 回滚时用剩下的成交记录重新计算受影响的K线
+
+var candleIntervals = map[string]int64{
+	"1m": 60,
+	"1h": 3600,
+	"1d": 86400,
+}
+
+// 按固定顺序生成K线的kv
+var candleIntervalNames = []string{"1m", "1h", "1d"}
+
+const marketDataPage = 100
+
+func newTradeFill(assetExec, tokenSymbol, priceExec, priceSymbol string, amountPerBoardlot, pricePerBoardlot, cnt int64) *pty.TradeFill {
+	return &pty.TradeFill{
+		AssetExec:   assetExec,
+		TokenSymbol: tokenSymbol,
+		PriceExec:   priceExec,
+		PriceSymbol: priceSymbol,
+		Price:       calcPriceOfToken(pricePerBoardlot, amountPerBoardlot),
+		Amount:      amountPerBoardlot * cnt,
+		Volume:      pricePerBoardlot * cnt,
+	}
+}
+
+// 从收据中获得这笔交易的所有成交
+func getTradeFills(receipt *types.ReceiptData, txHash string, height, blockTime int64, txIndex string) []*pty.TradeFill {
+	var fills []*pty.TradeFill
+	for _, item := range receipt.Logs {
+		var fill *pty.TradeFill
+		switch item.Ty {
+		case pty.TyLogTradeBuyMarket:
+			var r pty.ReceiptTradeBuyMarket
+			if err := types.Decode(item.Log, &r); err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			b := r.Base
+			fill = newTradeFill(b.AssetExec, b.TokenSymbol, b.PriceExec, b.PriceSymbol,
+				b.Amount, b.Price, b.BoughtBoardlot)
+			fill.MakerOrderID = b.SellID
+			fill.Taker = b.Owner
+		case pty.TyLogTradeSellMarket:
+			var r pty.ReceiptSellMarket
+			if err := types.Decode(item.Log, &r); err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			b := r.Base
+			fill = newTradeFill(b.AssetExec, b.TokenSymbol, b.PriceExec, b.PriceSymbol,
+				b.Amount, b.Price, b.SoldBoardlot)
+			fill.MakerOrderID = b.BuyID
+			fill.Taker = b.Owner
+			fill.TakerIsSell = true
+		case pty.TyLogTradeMatch:
+			var m pty.ReceiptTradeMatch
+			if err := types.Decode(item.Log, &m); err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			fill = newTradeFill(m.AssetExec, m.TokenSymbol, m.PriceExec, m.PriceSymbol,
+				m.AmountPerBoardlot, m.PricePerBoardlot, m.BoardlotCnt)
+			fill.MakerOrderID = m.MakerOrderID
+			fill.TakerOrderID = m.TakerOrderID
+			fill.Taker = m.Taker
+			fill.TakerIsSell = m.TakerIsSell
+		default:
+			continue
+		}
+		fill.TxHash = txHash
+		fill.Height = height
+		fill.BlockTime = blockTime
+		fill.Key = fmt.Sprintf("%s-%03d", txIndex, len(fills))
+		fills = append(fills, fill)
+	}
+	return fills
+}
+
+func fillPair(fill *pty.TradeFill) string {
+	return calcTradePair(fill.AssetExec, fill.TokenSymbol, fill.PriceExec, fill.PriceSymbol)
+}
+
+func candleStart(blockTime int64, interval string) int64 {
+	seconds := candleIntervals[interval]
+	return blockTime - blockTime%seconds
+}
+
+func updateCandle(candle *pty.Candle, fill *pty.TradeFill) {
+	if candle.Count == 0 {
+		candle.Open = fill.Price
+		candle.High = fill.Price
+		candle.Low = fill.Price
+	}
+	if fill.Price > candle.High {
+		candle.High = fill.Price
+	}
+	if fill.Price < candle.Low {
+		candle.Low = fill.Price
+	}
+	candle.Close = fill.Price
+	candle.Amount += fill.Amount
+	candle.Volume += fill.Volume
+	candle.Count++
+}
+
+func getCandle(db dbm.KVDB, key []byte) (*pty.Candle, error) {
+	value, err := db.Get(key)
+	if err == types.ErrNotFound || (err == nil && value == nil) {
+		return nil, types.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var candle pty.Candle
+	err = types.Decode(value, &candle)
+	if err != nil {
+		return nil, err
+	}
+	return &candle, nil
+}
+
+// 保存成交记录, 并把成交计入K线
+func (t *trade) saveMarketData(fills []*pty.TradeFill) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	candles := make(map[string]*pty.Candle)
+	var keys []string
+	for _, fill := range fills {
+		pair := fillPair(fill)
+		kvs = append(kvs, &types.KeyValue{Key: calcFillKey(pair, fill.Key), Value: types.Encode(fill)})
+		for _, interval := range candleIntervalNames {
+			start := candleStart(fill.BlockTime, interval)
+			key := string(calcCandleKey(pair, interval, start))
+			candle, ok := candles[key]
+			if !ok {
+				var err error
+				candle, err = getCandle(t.GetLocalDB(), []byte(key))
+				if err != nil {
+					candle = &pty.Candle{StartTime: start}
+				}
+				candles[key] = candle
+				keys = append(keys, key)
+			}
+			updateCandle(candle, fill)
+		}
+	}
+	for _, key := range keys {
+		kvs = append(kvs, &types.KeyValue{Key: []byte(key), Value: types.Encode(candles[key])})
+	}
+	return kvs
+}
+
+// 删除成交记录, 用剩下的成交记录重新计算受影响的K线
+func (t *trade) deleteMarketData(fills []*pty.TradeFill) ([]*types.KeyValue, error) {
+	var kvs []*types.KeyValue
+	deleted := make(map[string]bool)
+	for _, fill := range fills {
+		key := calcFillKey(fillPair(fill), fill.Key)
+		deleted[string(key)] = true
+		kvs = append(kvs, &types.KeyValue{Key: key, Value: nil})
+	}
+
+	done := make(map[string]bool)
+	for _, fill := range fills {
+		pair := fillPair(fill)
+		for _, interval := range candleIntervalNames {
+			start := candleStart(fill.BlockTime, interval)
+			key := calcCandleKey(pair, interval, start)
+			if done[string(key)] {
+				continue
+			}
+			done[string(key)] = true
+
+			candle, err := t.rebuildCandle(pair, start, start+candleIntervals[interval], deleted)
+			if err != nil {
+				return nil, err
+			}
+			if candle == nil {
+				kvs = append(kvs, &types.KeyValue{Key: key, Value: nil})
+				continue
+			}
+			kvs = append(kvs, &types.KeyValue{Key: key, Value: types.Encode(candle)})
+		}
+	}
+	return kvs, nil
+}
+
+// 从新到旧遍历成交记录, 找出 [start, end) 内未被删除的成交, 没有成交返回 nil
+func (t *trade) rebuildCandle(pair string, start, end int64, deleted map[string]bool) (*pty.Candle, error) {
+	prefix := calcFillPrefix(pair)
+	var fills []*pty.TradeFill
+	var fromKey []byte
+	for {
+		values, err := t.GetLocalDB().List(prefix, fromKey, marketDataPage, 0)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		stop := len(values) < marketDataPage
+		for _, value := range values {
+			var fill pty.TradeFill
+			err = types.Decode(value, &fill)
+			if err != nil {
+				return nil, err
+			}
+			fromKey = calcFillKey(pair, fill.Key)
+			if fill.BlockTime < start {
+				stop = true
+				break
+			}
+			if fill.BlockTime >= end || deleted[string(fromKey)] {
+				continue
+			}
+			fills = append(fills, &fill)
+		}
+		if stop {
+			break
+		}
+	}
+	if len(fills) == 0 {
+		return nil, nil
+	}
+	candle := &pty.Candle{StartTime: start}
+	for i := len(fills) - 1; i >= 0; i-- {
+		updateCandle(candle, fills[i])
+	}
+	return candle, nil
+}
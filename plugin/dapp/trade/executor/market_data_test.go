@@ -0,0 +1,210 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrade_MarketData(t *testing.T) {
+	amount := int64(1e8)
+	price := int64(1e8)
+	total := int64(1e12)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+	accountB := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[1]),
+	}
+
+	env := execEnv{
+		1539918000,
+		types.GetDappFork("trade", pty.ForkTradeBookX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	_, ldb, kvdb := util.CreateTestDB()
+	defer ldb.Close()
+	accB := account.NewCoinsAccount()
+	accB.SetDB(kvdb)
+	accB.SaveExecAccount(address.ExecAddress("trade"), &accountB)
+
+	accA, _ := account.NewAccountDB(AssetExecToken, Symbol, kvdb)
+	accA.SaveExecAccount(address.ExecAddress("trade"), &accountA)
+
+	driver := newTrade()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+
+	apply := func(set *types.LocalDBSet) {
+		for _, kv := range set.KV {
+			if kv.Value == nil {
+				ldb.Delete(kv.Key)
+				continue
+			}
+			kvdb.Set(kv.Key, kv.Value)
+		}
+	}
+	type executed struct {
+		tx      *types.Transaction
+		receipt *types.ReceiptData
+		index   int
+	}
+	exec := func(tx *types.Transaction, blockTime int64) *executed {
+		env.index++
+		driver.SetEnv(env.blockHeight, blockTime, env.difficulty)
+		receipt, err := driver.Exec(tx, env.index)
+		assert.Nil(t, err)
+		if err != nil {
+			return nil
+		}
+		data := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+		set, err := driver.ExecLocal(tx, data, env.index)
+		assert.Nil(t, err)
+		apply(set)
+		return &executed{tx: tx, receipt: data, index: env.index}
+	}
+	rollback := func(e *executed, blockTime int64) {
+		driver.SetEnv(env.blockHeight, blockTime, env.difficulty)
+		set, err := driver.ExecDelLocal(e.tx, e.receipt, e.index)
+		assert.Nil(t, err)
+		apply(set)
+	}
+
+	// A 挂两个卖单, 价格 2 和 3
+	var sellIDs []string
+	for _, p := range []int64{2, 3} {
+		sell := &pty.TradeSellTx{
+			TokenSymbol:       Symbol,
+			AmountPerBoardlot: amount,
+			MinBoardlot:       1,
+			PricePerBoardlot:  p * price,
+			TotalBoardlot:     10,
+			AssetExec:         AssetExecToken,
+			PriceExec:         "coins",
+			PriceSymbol:       "bty",
+		}
+		tx, _ := pty.CreateRawTradeSellTx(sell)
+		tx, _ = signTx(tx, PrivKeyA)
+		e := exec(tx, env.blockTime)
+		if e == nil {
+			return
+		}
+		for _, l := range e.receipt.Logs {
+			if l.Ty == pty.TyLogTradeSellLimit {
+				var r pty.ReceiptTradeSellLimit
+				assert.Nil(t, types.Decode(l.Log, &r))
+				sellIDs = append(sellIDs, r.Base.SellID)
+			}
+		}
+	}
+	assert.Equal(t, 2, len(sellIDs))
+
+	// B 限价买 4 手, 和价格 2 的卖单撮合
+	order := &pty.TradeLimitOrderTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: amount,
+		PricePerBoardlot:  2 * price,
+		TotalBoardlot:     4,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+	}
+	tx, _ := pty.CreateRawTradeLimitOrderTx(order)
+	tx, _ = signTx(tx, PrivKeyB)
+	limit := exec(tx, env.blockTime+10)
+	if limit == nil {
+		return
+	}
+
+	// 同一分钟内, B 直接买价格 3 的卖单 3 手
+	buy := &pty.TradeBuyTx{
+		SellID:      sellIDs[1][len("mavl-trade-sell-"):],
+		BoardlotCnt: 3,
+	}
+	tx, _ = pty.CreateRawTradeBuyTx(buy)
+	tx, _ = signTx(tx, PrivKeyB)
+	market := exec(tx, env.blockTime+20)
+	if market == nil {
+		return
+	}
+
+	reqHistory := &pty.ReqTradeHistory{
+		AssetExec:   AssetExecToken,
+		TokenSymbol: Symbol,
+		PriceExec:   "coins",
+		PriceSymbol: "bty",
+	}
+	resp, err := driver.Query("GetTradeHistory", types.Encode(reqHistory))
+	assert.Nil(t, err)
+	history := resp.(*pty.ReplyTradeHistory)
+	assert.Equal(t, 2, len(history.Fills))
+	// 默认从新到旧
+	assert.Equal(t, 3*price, history.Fills[0].Price)
+	assert.Equal(t, 3*amount, history.Fills[0].Amount)
+	assert.Equal(t, 9*price, history.Fills[0].Volume)
+	assert.False(t, history.Fills[0].TakerIsSell)
+	assert.Equal(t, 2*price, history.Fills[1].Price)
+	assert.Equal(t, 4*amount, history.Fills[1].Amount)
+
+	reqCandles := &pty.ReqCandles{
+		AssetExec:   AssetExecToken,
+		TokenSymbol: Symbol,
+		PriceExec:   "coins",
+		PriceSymbol: "bty",
+		Interval:    "1m",
+	}
+	queryCandles := func() []*pty.Candle {
+		resp, err := driver.Query("GetCandles", types.Encode(reqCandles))
+		assert.Nil(t, err)
+		return resp.(*pty.ReplyCandles).Candles
+	}
+	candles := queryCandles()
+	assert.Equal(t, 1, len(candles))
+	assert.Equal(t, env.blockTime, candles[0].StartTime)
+	assert.Equal(t, 2*price, candles[0].Open)
+	assert.Equal(t, 3*price, candles[0].High)
+	assert.Equal(t, 2*price, candles[0].Low)
+	assert.Equal(t, 3*price, candles[0].Close)
+	assert.Equal(t, 7*amount, candles[0].Amount)
+	assert.Equal(t, 17*price, candles[0].Volume)
+	assert.Equal(t, int64(2), candles[0].Count)
+
+	reqCandles.Interval = "5m"
+	_, err = driver.Query("GetCandles", types.Encode(reqCandles))
+	assert.Equal(t, types.ErrInvalidParam, err)
+	reqCandles.Interval = "1m"
+
+	// 回滚市价买单, K线回到只有一笔成交的状态
+	rollback(market, env.blockTime+20)
+	candles = queryCandles()
+	assert.Equal(t, 1, len(candles))
+	assert.Equal(t, 2*price, candles[0].Open)
+	assert.Equal(t, 2*price, candles[0].High)
+	assert.Equal(t, 2*price, candles[0].Close)
+	assert.Equal(t, 4*amount, candles[0].Amount)
+	assert.Equal(t, int64(1), candles[0].Count)
+
+	// 回滚限价单, 没有成交, K线被删除
+	rollback(limit, env.blockTime+10)
+	assert.Equal(t, 0, len(queryCandles()))
+	resp, err = driver.Query("GetTradeHistory", types.Encode(reqHistory))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(resp.(*pty.ReplyTradeHistory).Fills))
+}
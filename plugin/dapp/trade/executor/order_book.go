@@ -0,0 +1,346 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
+/*
+订单簿
+
+订单簿是 localdb 中订单表的索引 market_isSell_isFinished_price, 在 ExecLocal/ExecDelLocal 中随订单表一起维护.
+订单簿按 资产/定价资产/每手数量 划分市场, 每个市场分买卖两侧, 每个价位下的订单按挂单交易的 txIndex 排序.
+卖单按价格从低到高, 买单按价格从高到低, 所以按索引顺序读出的第一个订单就是最优价格上最早的挂单.
+还没有开始的众筹卖单也在订单簿中, 开始以后才能成交. 订单全部成交或撤销后从订单簿中移除.
+
+ForkTradeBook 之后 trade 的 ExecLocal 和 Exec 同时执行, Exec 中可以读到同一区块中之前交易的挂单.
+限价单 LimitOrder 按价格优先, 时间优先的顺序和对手方的订单成交, 成交价为对手方订单的价格,
+一笔交易中可以和多个订单成交, 未成交部分作为普通的卖单/买单挂在订单簿上.
+为限制单笔交易的执行开销, 一笔限价单最多处理 MaxLimitOrderMatch 个对手方订单, 超出的部分同样挂在订单簿上.
+过期的挂单不会成交, 但在撤单之前仍然留在订单簿中.
+*/
+
+// 从订单簿中按价格优先, 时间优先的顺序读出一侧的未完成订单
+func listOrderBook(db dbm.KVDB, market string, isSell bool, count int32) ([]*pty.LocalOrder, error) {
+	query := NewOrderTable(db).GetQuery(db)
+	rows, err := query.ListIndex("market_isSell_isFinished_price", calcOrderBookPrefix(market, isSell), nil, count, dbm.ListASC)
+	if err == types.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var orders []*pty.LocalOrder
+	for _, row := range rows {
+		o, ok := row.Data.(*pty.LocalOrder)
+		if !ok {
+			return nil, types.ErrTypeAsset
+		}
+		orders = append(orders, o)
+	}
+	return orders, nil
+}
+
+// 卖单是否可以成交, 众筹卖单开始以后才能成交
+func (action *tradeAction) isSellOrderOpen(order *pty.SellOrder) bool {
+	if order.Status == pty.TradeOrderStatusNotStart {
+		return order.Starttime <= action.blocktime && order.SoldBoardlot < order.TotalBoardlot
+	}
+	return order.Status == pty.TradeOrderStatusOnSale
+}
+
+func (action *tradeAction) getMatchLog(order *pty.TradeForLimitOrder, price, cnt int64,
+	makerID, maker, takerID string) *types.ReceiptLog {
+	receipt := &pty.ReceiptTradeMatch{
+		TokenSymbol:       order.TokenSymbol,
+		AssetExec:         order.AssetExec,
+		PriceExec:         order.PriceExec,
+		PriceSymbol:       order.PriceSymbol,
+		AmountPerBoardlot: order.AmountPerBoardlot,
+		PricePerBoardlot:  price,
+		BoardlotCnt:       cnt,
+		MakerOrderID:      makerID,
+		Maker:             maker,
+		TakerOrderID:      takerID,
+		Taker:             action.fromaddr,
+		TakerIsSell:       order.IsSellOrder,
+		TxHash:            action.txhash,
+		Height:            action.height,
+	}
+	return &types.ReceiptLog{Ty: pty.TyLogTradeMatch, Log: types.Encode(receipt)}
+}
+
+func (action *tradeAction) tradeLimitOrder(order *pty.TradeForLimitOrder) (*types.Receipt, error) {
+	if !types.IsDappFork(action.height, pty.TradeX, pty.ForkTradeBookX) {
+		return nil, pty.ErrTOrderBookNotSupport
+	}
+	if order.TotalBoardlot <= 0 || order.PricePerBoardlot <= 0 || order.AmountPerBoardlot <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if !checkAsset(action.height, order.AssetExec, order.TokenSymbol) {
+		return nil, types.ErrInvalidParam
+	}
+	if !checkPrice(action.height, order.PriceExec, order.PriceSymbol) {
+		return nil, types.ErrInvalidParam
+	}
+	if !notSameAsset(action.height, order.AssetExec, order.TokenSymbol, order.PriceExec, order.PriceSymbol) {
+		return nil, pty.ErrAssetAndPriceSame
+	}
+	expireHeight, expireTime, err := action.checkExpire(order.ExpireHeight, order.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
+	order.ExpireHeight, order.ExpireTime = expireHeight, expireTime
+
+	accDB, err := createAccountDB(action.height, action.db, order.AssetExec, order.TokenSymbol)
+	if err != nil {
+		return nil, err
+	}
+	priceAcc, err := createPriceDB(action.height, action.db, order.PriceExec, order.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+	if order.IsSellOrder {
+		return action.limitSell(order, accDB, priceAcc)
+	}
+	return action.limitBuy(order, accDB, priceAcc)
+}
+
+// 限价买: 从最低的卖价开始, 和价格不高于买价的卖单成交
+func (action *tradeAction) limitBuy(order *pty.TradeForLimitOrder, accDB, priceAcc *account.DB) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	market := calcOrderBookMarket(order.AssetExec, order.TokenSymbol, order.PriceExec, order.PriceSymbol, order.AmountPerBoardlot)
+	buyID := calcTokenBuyID(action.txhash)
+	collector := action.getFeeCollector()
+	takerFeeRate := action.getTakerFeeRate(collector, order.PriceExec, order.PriceSymbol)
+	var takerFeeTotal int64
+
+	book, err := listOrderBook(action.localdb, market, true, pty.MaxLimitOrderMatch)
+	if err != nil {
+		return nil, err
+	}
+	left := order.TotalBoardlot
+	for _, o := range book {
+		if left == 0 || o.PricePerBoardlot > order.PricePerBoardlot {
+			break
+		}
+		sellOrder, err := getSellOrderFromID([]byte(o.SellID), action.db)
+		if err != nil {
+			return nil, err
+		}
+		// 还没有开始或者已经过期的挂单不成交, 也不和自己的订单成交
+		if !action.isSellOrderOpen(sellOrder) || action.isExpired(sellOrder.ExpireHeight, sellOrder.ExpireTime) ||
+			sellOrder.Address == action.fromaddr {
+			continue
+		}
+		rest := sellOrder.TotalBoardlot - sellOrder.SoldBoardlot
+		cnt := left
+		if cnt > rest {
+			cnt = rest
+		}
+		if cnt < sellOrder.MinBoardlot && cnt < rest {
+			continue
+		}
+
+		receiptFromAcc, err := priceAcc.ExecTransfer(action.fromaddr, sellOrder.Address, action.execaddr, cnt*sellOrder.PricePerBoardlot)
+		if err != nil {
+			tradelog.Error("limitBuy ExecTransfer price", "addrFrom", action.fromaddr, "addrTo", sellOrder.Address,
+				"amount", cnt*sellOrder.PricePerBoardlot, "err", err)
+			return nil, err
+		}
+		receiptFromExecAcc, err := accDB.ExecTransferFrozen(sellOrder.Address, action.fromaddr, action.execaddr, cnt*sellOrder.AmountPerBoardlot)
+		if err != nil {
+			tradelog.Error("limitBuy ExecTransferFrozen asset", "addrFrom", sellOrder.Address, "addrTo", action.fromaddr,
+				"amount", cnt*sellOrder.AmountPerBoardlot, "err", err)
+			return nil, err
+		}
+		takerFee := calcTradeFee(collector, cnt*sellOrder.PricePerBoardlot, takerFeeRate)
+		receiptTakerFee, err := action.payFee(priceAcc, action.fromaddr, collector, takerFee)
+		if err != nil {
+			return nil, err
+		}
+		makerFee := calcTradeFee(collector, cnt*sellOrder.PricePerBoardlot, sellOrder.MakerFeeRate)
+		receiptMakerFee, err := action.payFee(priceAcc, sellOrder.Address, collector, makerFee)
+		if err != nil {
+			return nil, err
+		}
+		takerFeeTotal += takerFee
+		sellOrder.SoldBoardlot += cnt
+		if sellOrder.SoldBoardlot == sellOrder.TotalBoardlot {
+			sellOrder.Status = pty.TradeOrderStatusSoldOut
+		}
+		sellTokendb := newSellDB(*sellOrder)
+		sellTokendb.makerFee = makerFee
+		kv = append(kv, receiptFromAcc.KV...)
+		kv = append(kv, receiptFromExecAcc.KV...)
+		kv = append(kv, receiptTakerFee.KV...)
+		kv = append(kv, receiptMakerFee.KV...)
+		kv = append(kv, sellTokendb.save(action.db)...)
+		logs = append(logs, receiptFromAcc.Logs...)
+		logs = append(logs, receiptFromExecAcc.Logs...)
+		logs = append(logs, receiptTakerFee.Logs...)
+		logs = append(logs, receiptMakerFee.Logs...)
+		logs = append(logs, sellTokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
+		logs = append(logs, action.getMatchLog(order, sellOrder.PricePerBoardlot, cnt, sellOrder.SellID, sellOrder.Address, buyID))
+		left -= cnt
+	}
+
+	buyOrder := pty.BuyLimitOrder{
+		TokenSymbol:       order.TokenSymbol,
+		Address:           action.fromaddr,
+		AmountPerBoardlot: order.AmountPerBoardlot,
+		MinBoardlot:       1,
+		PricePerBoardlot:  order.PricePerBoardlot,
+		TotalBoardlot:     order.TotalBoardlot,
+		BoughtBoardlot:    order.TotalBoardlot - left,
+		BuyID:             buyID,
+		Status:            pty.TradeOrderStatusOnBuy,
+		Height:            action.height,
+		AssetExec:         order.AssetExec,
+		PriceExec:         order.PriceExec,
+		PriceSymbol:       order.PriceSymbol,
+		ExpireHeight:      order.ExpireHeight,
+		ExpireTime:        order.ExpireTime,
+	}
+	if left == 0 {
+		buyOrder.Status = pty.TradeOrderStatusBoughtOut
+	} else {
+		// 未成交部分挂单, 按挂单方费率冻结手续费
+		buyOrder.MakerFeeRate = action.getMakerFeeRate(collector, order.PriceExec, order.PriceSymbol)
+		buyOrder.FeeFrozen = calcTradeFee(collector, left*order.PricePerBoardlot, buyOrder.MakerFeeRate)
+		frozen := left*order.PricePerBoardlot + buyOrder.FeeFrozen
+		receipt, err := priceAcc.ExecFrozen(action.fromaddr, action.execaddr, frozen)
+		if err != nil {
+			tradelog.Error("limitBuy ExecFrozen", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", frozen)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+	tokendb := newBuyDB(buyOrder)
+	tokendb.takerFee = takerFeeTotal
+	kv = append(kv, tokendb.save(action.db)...)
+	logs = append(logs, tokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// 限价卖: 从最高的买价开始, 和价格不低于卖价的买单成交
+func (action *tradeAction) limitSell(order *pty.TradeForLimitOrder, accDB, priceAcc *account.DB) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	market := calcOrderBookMarket(order.AssetExec, order.TokenSymbol, order.PriceExec, order.PriceSymbol, order.AmountPerBoardlot)
+	sellID := calcTokenSellID(action.txhash)
+	collector := action.getFeeCollector()
+	takerFeeRate := action.getTakerFeeRate(collector, order.PriceExec, order.PriceSymbol)
+	var takerFeeTotal int64
+
+	book, err := listOrderBook(action.localdb, market, false, pty.MaxLimitOrderMatch)
+	if err != nil {
+		return nil, err
+	}
+	left := order.TotalBoardlot
+	for _, o := range book {
+		if left == 0 || o.PricePerBoardlot < order.PricePerBoardlot {
+			break
+		}
+		buyOrder, err := getBuyOrderFromID([]byte(o.BuyID), action.db)
+		if err != nil {
+			return nil, err
+		}
+		if buyOrder.Status != pty.TradeOrderStatusOnBuy || action.isExpired(buyOrder.ExpireHeight, buyOrder.ExpireTime) ||
+			buyOrder.Address == action.fromaddr {
+			continue
+		}
+		rest := buyOrder.TotalBoardlot - buyOrder.BoughtBoardlot
+		cnt := left
+		if cnt > rest {
+			cnt = rest
+		}
+		if cnt < buyOrder.MinBoardlot && cnt < rest {
+			continue
+		}
+
+		receiptFromExecAcc, err := accDB.ExecTransfer(action.fromaddr, buyOrder.Address, action.execaddr, cnt*buyOrder.AmountPerBoardlot)
+		if err != nil {
+			tradelog.Error("limitSell ExecTransfer asset", "addrFrom", action.fromaddr, "addrTo", buyOrder.Address,
+				"amount", cnt*buyOrder.AmountPerBoardlot, "err", err)
+			return nil, err
+		}
+		receiptFromAcc, err := priceAcc.ExecTransferFrozen(buyOrder.Address, action.fromaddr, action.execaddr, cnt*buyOrder.PricePerBoardlot)
+		if err != nil {
+			tradelog.Error("limitSell ExecTransferFrozen price", "addrFrom", buyOrder.Address, "addrTo", action.fromaddr,
+				"amount", cnt*buyOrder.PricePerBoardlot, "err", err)
+			return nil, err
+		}
+		takerFee := calcTradeFee(collector, cnt*buyOrder.PricePerBoardlot, takerFeeRate)
+		receiptTakerFee, err := action.payFee(priceAcc, action.fromaddr, collector, takerFee)
+		if err != nil {
+			return nil, err
+		}
+		receiptMakerFee, makerFee, err := action.payBuyMakerFee(priceAcc, collector, buyOrder, cnt)
+		if err != nil {
+			return nil, err
+		}
+		takerFeeTotal += takerFee
+		buyOrder.BoughtBoardlot += cnt
+		if buyOrder.BoughtBoardlot == buyOrder.TotalBoardlot {
+			buyOrder.Status = pty.TradeOrderStatusBoughtOut
+		}
+		buyTokendb := newBuyDB(*buyOrder)
+		buyTokendb.makerFee = makerFee
+		kv = append(kv, receiptFromAcc.KV...)
+		kv = append(kv, receiptFromExecAcc.KV...)
+		kv = append(kv, receiptTakerFee.KV...)
+		kv = append(kv, receiptMakerFee.KV...)
+		kv = append(kv, buyTokendb.save(action.db)...)
+		logs = append(logs, receiptFromAcc.Logs...)
+		logs = append(logs, receiptFromExecAcc.Logs...)
+		logs = append(logs, receiptTakerFee.Logs...)
+		logs = append(logs, receiptMakerFee.Logs...)
+		logs = append(logs, buyTokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
+		logs = append(logs, action.getMatchLog(order, buyOrder.PricePerBoardlot, cnt, buyOrder.BuyID, buyOrder.Address, sellID))
+		left -= cnt
+	}
+
+	sellOrder := pty.SellOrder{
+		TokenSymbol:       order.TokenSymbol,
+		Address:           action.fromaddr,
+		AmountPerBoardlot: order.AmountPerBoardlot,
+		MinBoardlot:       1,
+		PricePerBoardlot:  order.PricePerBoardlot,
+		TotalBoardlot:     order.TotalBoardlot,
+		SoldBoardlot:      order.TotalBoardlot - left,
+		SellID:            sellID,
+		Status:            pty.TradeOrderStatusOnSale,
+		Height:            action.height,
+		AssetExec:         order.AssetExec,
+		PriceExec:         order.PriceExec,
+		PriceSymbol:       order.PriceSymbol,
+		ExpireHeight:      order.ExpireHeight,
+		ExpireTime:        order.ExpireTime,
+	}
+	if left == 0 {
+		sellOrder.Status = pty.TradeOrderStatusSoldOut
+	} else {
+		sellOrder.MakerFeeRate = action.getMakerFeeRate(collector, order.PriceExec, order.PriceSymbol)
+		receipt, err := accDB.ExecFrozen(action.fromaddr, action.execaddr, left*order.AmountPerBoardlot)
+		if err != nil {
+			tradelog.Error("limitSell ExecFrozen", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", left*order.AmountPerBoardlot)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+	tokendb := newSellDB(sellOrder)
+	tokendb.takerFee = takerFeeTotal
+	kv = append(kv, tokendb.save(action.db)...)
+	logs = append(logs, tokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -0,0 +1,324 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type limitOrderEnv struct {
+	env    execEnv
+	ldb    dbm.DB
+	kvdb   dbm.KVDB
+	driver drivers.Driver
+	// B 在 trade 合约中的 coins 账户, A 在 trade 合约中的 token 账户
+	coins *account.DB
+	token *account.DB
+}
+
+func newLimitOrderEnv(total int64) *limitOrderEnv {
+	e := &limitOrderEnv{
+		env: execEnv{
+			1539918074,
+			types.GetDappFork("trade", pty.ForkTradeBookX),
+			2,
+			1539918074,
+			"hash",
+		},
+	}
+	_, e.ldb, e.kvdb = util.CreateTestDB()
+	e.coins = account.NewCoinsAccount()
+	e.coins.SetDB(e.kvdb)
+	e.coins.SaveExecAccount(address.ExecAddress("trade"), &types.Account{Balance: total, Addr: string(Nodes[1])})
+	e.token, _ = account.NewAccountDB(AssetExecToken, Symbol, e.kvdb)
+	e.token.SaveExecAccount(address.ExecAddress("trade"), &types.Account{Balance: total, Addr: string(Nodes[0])})
+
+	e.driver = newTrade()
+	e.driver.SetEnv(e.env.blockHeight, e.env.blockTime, e.env.difficulty)
+	e.driver.SetStateDB(e.kvdb)
+	e.driver.SetLocalDB(e.kvdb)
+	return e
+}
+
+// 和 ExecLocalSameTime 一样, 每笔交易执行之后立即执行 ExecLocal
+func (e *limitOrderEnv) exec(t *testing.T, tx *types.Transaction, priv string) *types.Receipt {
+	tx, err := signTx(tx, priv)
+	assert.Nil(t, err)
+	e.env.index++
+	receipt, err := e.driver.Exec(tx, e.env.index)
+	assert.Nil(t, err)
+	if err != nil {
+		return nil
+	}
+	set, err := e.driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, e.env.index)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			e.ldb.Delete(kv.Key)
+			continue
+		}
+		e.kvdb.Set(kv.Key, kv.Value)
+	}
+	return receipt
+}
+
+func (e *limitOrderEnv) depth(t *testing.T, amount int64) *pty.ReplyMarketDepth {
+	req := &pty.ReqMarketDepth{
+		AssetExec:         AssetExecToken,
+		TokenSymbol:       Symbol,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		AmountPerBoardlot: amount,
+		Count:             10,
+	}
+	resp, err := e.driver.Query("GetMarketDepth", types.Encode(req))
+	assert.Nil(t, err)
+	depth, ok := resp.(*pty.ReplyMarketDepth)
+	assert.True(t, ok)
+	return depth
+}
+
+func getMatchLogs(t *testing.T, receipt *types.Receipt) []*pty.ReceiptTradeMatch {
+	var fills []*pty.ReceiptTradeMatch
+	for _, l := range receipt.Logs {
+		if l.Ty != pty.TyLogTradeMatch {
+			continue
+		}
+		var m pty.ReceiptTradeMatch
+		assert.Nil(t, types.Decode(l.Log, &m))
+		fills = append(fills, &m)
+	}
+	return fills
+}
+
+func TestTrade_Exec_LimitOrder(t *testing.T) {
+	amount := int64(1e8)
+	price := int64(1e8)
+	total := int64(1e12)
+	e := newLimitOrderEnv(total)
+	defer e.ldb.Close()
+
+	// A 挂两个卖单, 价格 2 和 3
+	for _, p := range []int64{3, 2} {
+		sell := &pty.TradeSellTx{
+			TokenSymbol:       Symbol,
+			AmountPerBoardlot: amount,
+			MinBoardlot:       1,
+			PricePerBoardlot:  p * price,
+			TotalBoardlot:     10,
+			AssetExec:         AssetExecToken,
+			PriceExec:         "coins",
+			PriceSymbol:       "bty",
+		}
+		tx, _ := pty.CreateRawTradeSellTx(sell)
+		if e.exec(t, tx, PrivKeyA) == nil {
+			return
+		}
+	}
+
+	// B 限价买 15 手, 价格 3: 先吃掉价格 2 的 10 手, 再吃价格 3 的 5 手
+	order := &pty.TradeLimitOrderTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: amount,
+		PricePerBoardlot:  3 * price,
+		TotalBoardlot:     15,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+	}
+	tx, _ := pty.CreateRawTradeLimitOrderTx(order)
+	receipt := e.exec(t, tx, PrivKeyB)
+	if receipt == nil {
+		return
+	}
+
+	fills := getMatchLogs(t, receipt)
+	assert.Equal(t, 2, len(fills))
+	assert.Equal(t, 2*price, fills[0].PricePerBoardlot)
+	assert.Equal(t, int64(10), fills[0].BoardlotCnt)
+	assert.Equal(t, 3*price, fills[1].PricePerBoardlot)
+	assert.Equal(t, int64(5), fills[1].BoardlotCnt)
+
+	coinsB := e.coins.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total-(10*2+5*3)*price, coinsB.Balance)
+	assert.Equal(t, int64(0), coinsB.Frozen)
+	tokenB := e.token.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, 15*amount, tokenB.Balance)
+	tokenA := e.token.LoadExecAccount(string(Nodes[0]), address.ExecAddress("trade"))
+	assert.Equal(t, 5*amount, tokenA.Frozen)
+
+	// B 再挂两个价格 1 的买单, 无法成交, 挂到买盘
+	order.PricePerBoardlot = price
+	order.TotalBoardlot = 5
+	for i := 0; i < 2; i++ {
+		tx, _ = pty.CreateRawTradeLimitOrderTx(order)
+		if e.exec(t, tx, PrivKeyB) == nil {
+			return
+		}
+	}
+	coinsB = e.coins.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, 10*price, coinsB.Frozen)
+
+	depth := e.depth(t, amount)
+	assert.Equal(t, 1, len(depth.Asks))
+	assert.Equal(t, 3*price, depth.Asks[0].PricePerBoardlot)
+	assert.Equal(t, int64(5), depth.Asks[0].BoardlotCnt)
+	assert.Equal(t, 1, len(depth.Bids))
+	assert.Equal(t, price, depth.Bids[0].PricePerBoardlot)
+	assert.Equal(t, int64(10), depth.Bids[0].BoardlotCnt)
+	assert.Equal(t, int32(2), depth.Bids[0].OrderCount)
+
+	// A 限价卖 7 手, 价格 1: 先和 B 最早的买单成交 5 手, 再和第二个买单成交 2 手
+	order.IsSellOrder = true
+	order.TotalBoardlot = 7
+	tx, _ = pty.CreateRawTradeLimitOrderTx(order)
+	receipt = e.exec(t, tx, PrivKeyA)
+	if receipt == nil {
+		return
+	}
+	fills = getMatchLogs(t, receipt)
+	assert.Equal(t, 2, len(fills))
+	assert.Equal(t, int64(5), fills[0].BoardlotCnt)
+	assert.Equal(t, int64(2), fills[1].BoardlotCnt)
+	assert.Equal(t, fills[0].TakerOrderID, fills[1].TakerOrderID)
+	assert.NotEqual(t, fills[0].MakerOrderID, fills[1].MakerOrderID)
+	depth = e.depth(t, amount)
+	assert.Equal(t, int64(3), depth.Bids[0].BoardlotCnt)
+	assert.Equal(t, int32(1), depth.Bids[0].OrderCount)
+}
+
+func TestTrade_Exec_LimitOrderMatchCap(t *testing.T) {
+	amount := int64(1e8)
+	price := int64(1e8)
+	e := newLimitOrderEnv(int64(1e12))
+	defer e.ldb.Close()
+
+	// A 挂出比上限多 5 个的卖单, 每个 1 手
+	extra := int64(5)
+	cnt := int64(pty.MaxLimitOrderMatch) + extra
+	for i := int64(0); i < cnt; i++ {
+		sell := &pty.TradeSellTx{
+			TokenSymbol:       Symbol,
+			AmountPerBoardlot: amount,
+			MinBoardlot:       1,
+			PricePerBoardlot:  price,
+			TotalBoardlot:     1,
+			AssetExec:         AssetExecToken,
+			PriceExec:         "coins",
+			PriceSymbol:       "bty",
+		}
+		tx, _ := pty.CreateRawTradeSellTx(sell)
+		if e.exec(t, tx, PrivKeyA) == nil {
+			return
+		}
+	}
+
+	// B 限价买全部卖单, 只和前 MaxLimitOrderMatch 个成交, 剩余部分挂到买盘
+	order := &pty.TradeLimitOrderTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: amount,
+		PricePerBoardlot:  price,
+		TotalBoardlot:     cnt,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+	}
+	tx, _ := pty.CreateRawTradeLimitOrderTx(order)
+	receipt := e.exec(t, tx, PrivKeyB)
+	if receipt == nil {
+		return
+	}
+	assert.Equal(t, pty.MaxLimitOrderMatch, len(getMatchLogs(t, receipt)))
+
+	tokenB := e.token.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(pty.MaxLimitOrderMatch)*amount, tokenB.Balance)
+	coinsB := e.coins.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, extra*price, coinsB.Frozen)
+
+	depth := e.depth(t, amount)
+	assert.Equal(t, 1, len(depth.Asks))
+	assert.Equal(t, extra, depth.Asks[0].BoardlotCnt)
+	assert.Equal(t, 1, len(depth.Bids))
+	assert.Equal(t, extra, depth.Bids[0].BoardlotCnt)
+}
+
+func TestTrade_Exec_LimitOrderCrowdfund(t *testing.T) {
+	amount := int64(1e8)
+	price := int64(1e8)
+	e := newLimitOrderEnv(int64(1e12))
+	defer e.ldb.Close()
+
+	// A 挂一个还没有开始的众筹卖单
+	sell := &pty.Trade{
+		Ty: pty.TradeSellLimit,
+		Value: &pty.Trade_SellLimit{SellLimit: &pty.TradeForSell{
+			TokenSymbol:       Symbol,
+			AmountPerBoardlot: amount,
+			MinBoardlot:       1,
+			PricePerBoardlot:  price,
+			TotalBoardlot:     10,
+			Starttime:         e.env.blockTime + 100,
+			Crowdfund:         true,
+			AssetExec:         AssetExecToken,
+			PriceExec:         "coins",
+			PriceSymbol:       "bty",
+		}},
+	}
+	tx, _ := types.CreateFormatTx(types.ExecName(pty.TradeX), types.Encode(sell))
+	if e.exec(t, tx, PrivKeyA) == nil {
+		return
+	}
+	depth := e.depth(t, amount)
+	assert.Equal(t, 1, len(depth.Asks))
+	assert.Equal(t, int64(10), depth.Asks[0].BoardlotCnt)
+
+	// 开始之前不成交
+	order := &pty.TradeLimitOrderTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: amount,
+		PricePerBoardlot:  price,
+		TotalBoardlot:     6,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		ExpireTime:        e.env.blockTime + 50,
+	}
+	tx, _ = pty.CreateRawTradeLimitOrderTx(order)
+	receipt := e.exec(t, tx, PrivKeyB)
+	if receipt == nil {
+		return
+	}
+	assert.Equal(t, 0, len(getMatchLogs(t, receipt)))
+
+	// 开始以后可以成交, 之前过期的买单不再成交
+	e.env.blockTime += 100
+	e.driver.SetEnv(e.env.blockHeight, e.env.blockTime, e.env.difficulty)
+	order.ExpireTime = 0
+	for _, cnt := range []int64{6, 4} {
+		order.TotalBoardlot = cnt
+		tx, _ = pty.CreateRawTradeLimitOrderTx(order)
+		receipt = e.exec(t, tx, PrivKeyB)
+		if receipt == nil {
+			return
+		}
+		fills := getMatchLogs(t, receipt)
+		assert.Equal(t, 1, len(fills))
+		assert.Equal(t, cnt, fills[0].BoardlotCnt)
+	}
+	// 卖完以后从订单簿中移除
+	depth = e.depth(t, amount)
+	assert.Equal(t, 0, len(depth.Asks))
+	tokenB := e.token.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, 10*amount, tokenB.Balance)
+}
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
 )
@@ -30,6 +31,21 @@ func (t *trade) Query_GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error)
 	return t.GetOneOrder(req)
 }
 
+// 市场深度, 按价位汇总未完成的订单, 第一个价位即为最优买价/卖价
+func (t *trade) Query_GetMarketDepth(req *pty.ReqMarketDepth) (types.Message, error) {
+	return t.GetMarketDepth(req)
+}
+
+// 交易对的成交历史, 默认从新到旧
+func (t *trade) Query_GetTradeHistory(req *pty.ReqTradeHistory) (types.Message, error) {
+	return t.GetTradeHistory(req)
+}
+
+// 交易对的K线, 结果按时间从旧到新
+func (t *trade) Query_GetCandles(req *pty.ReqCandles) (types.Message, error) {
+	return t.GetCandles(req)
+}
+
 // query reply utils
 
 const (
@@ -37,6 +53,7 @@ const (
 	orderStatusOn
 	orderStatusDone
 	orderStatusRevoke
+	orderStatusExpired
 )
 
 const (
@@ -59,6 +76,8 @@ func fromStatus(status int32) (st, ty int32) {
 		return orderStatusDone, orderTypeBuy
 	case pty.TradeOrderStatusBuyRevoked:
 		return orderStatusRevoke, orderTypeBuy
+	case pty.TradeOrderStatusExpired:
+		return orderStatusExpired, orderTypeSell
 	}
 	return orderStatusInvalid, orderTypeInvalid
 }
@@ -81,7 +100,13 @@ func (t *trade) GetOnesOrderWithStatus(req *pty.ReqAddrAssets) (types.Message, e
 	if len(req.FromKey) > 0 {
 		order.TxIndex = req.FromKey
 	}
-	rows, err := list(t.GetLocalDB(), "owner_isFinished", &order, req.Count, req.Direction)
+	indexName := "owner_isFinished"
+	// 过期的买单和卖单状态相同, 按状态单独查询
+	if orderStatus == orderStatusExpired {
+		indexName = "owner_status"
+		order.Status = pty.TradeOrderStatusExpired
+	}
+	rows, err := list(t.GetLocalDB(), indexName, &order, req.Count, req.Direction)
 	if err != nil {
 		tradelog.Error("GetOnesOrderWithStatus", "err", err)
 		return nil, err
@@ -126,6 +151,10 @@ func fmtReply(order *pty.LocalOrder) *pty.ReplyTradeOrder {
 		AssetExec:         order.AssetExec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		ExpireHeight:      order.ExpireHeight,
+		ExpireTime:        order.ExpireTime,
+		MakerFee:          order.MakerFee,
+		TakerFee:          order.TakerFee,
 	}
 }
 
@@ -147,3 +176,159 @@ func (t *trade) GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error) {
 
 	return reply, nil
 }
+
+// GetMarketDepth list price levels of both sides from the order table
+func (t *trade) GetMarketDepth(req *pty.ReqMarketDepth) (types.Message, error) {
+	if req.Count <= 0 || req.AmountPerBoardlot <= 0 || req.TokenSymbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	market := calcOrderBookMarket(req.AssetExec, req.TokenSymbol, req.PriceExec, req.PriceSymbol, req.AmountPerBoardlot)
+	var reply pty.ReplyMarketDepth
+	var err error
+	reply.Bids, err = listMarketDepth(t.GetLocalDB(), market, false, req.Count)
+	if err != nil {
+		return nil, err
+	}
+	reply.Asks, err = listMarketDepth(t.GetLocalDB(), market, true, req.Count)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+const marketDepthPage = 100
+
+// 卖单价格从低到高, 买单价格从高到低
+func listMarketDepth(db dbm.KVDB, market string, isSell bool, count int32) ([]*pty.MarketDepthLevel, error) {
+	query := NewOrderTable(db).GetQuery(db)
+	prefix := calcOrderBookPrefix(market, isSell)
+
+	var levels []*pty.MarketDepthLevel
+	var primary []byte
+	for {
+		rows, err := query.ListIndex("market_isSell_isFinished_price", prefix, primary, marketDepthPage, dbm.ListASC)
+		if err == types.ErrNotFound {
+			return levels, nil
+		}
+		if err != nil {
+			tradelog.Error("listMarketDepth", "market", market, "err", err)
+			return nil, err
+		}
+		for _, row := range rows {
+			o, ok := row.Data.(*pty.LocalOrder)
+			if !ok {
+				return nil, types.ErrTypeAsset
+			}
+			last := len(levels) - 1
+			if last >= 0 && levels[last].PricePerBoardlot == o.PricePerBoardlot {
+				levels[last].BoardlotCnt += o.TotalBoardlot - o.TradedBoardlot
+				levels[last].OrderCount++
+				continue
+			}
+			if len(levels) == int(count) {
+				return levels, nil
+			}
+			levels = append(levels, &pty.MarketDepthLevel{
+				PricePerBoardlot: o.PricePerBoardlot,
+				BoardlotCnt:      o.TotalBoardlot - o.TradedBoardlot,
+				OrderCount:       1,
+			})
+		}
+		if len(rows) < marketDepthPage {
+			return levels, nil
+		}
+		primary = []byte(rows[len(rows)-1].Data.(*pty.LocalOrder).TxIndex)
+	}
+}
+
+const (
+	defaultHistoryCount = 20
+	maxHistoryCount     = 100
+	defaultCandleCount  = 100
+	maxCandleCount      = 1000
+)
+
+// GetTradeHistory list fills of a pair by block order
+func (t *trade) GetTradeHistory(req *pty.ReqTradeHistory) (types.Message, error) {
+	if req.TokenSymbol == "" || req.Count < 0 || req.Count > maxHistoryCount {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count == 0 {
+		count = defaultHistoryCount
+	}
+	pair := calcTradePair(req.AssetExec, req.TokenSymbol, req.PriceExec, req.PriceSymbol)
+	var fromKey []byte
+	if req.FromKey != "" {
+		fromKey = calcFillKey(pair, req.FromKey)
+	}
+	values, err := t.GetLocalDB().List(calcFillPrefix(pair), fromKey, count, req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	var reply pty.ReplyTradeHistory
+	for _, value := range values {
+		var fill pty.TradeFill
+		err = types.Decode(value, &fill)
+		if err != nil {
+			return nil, err
+		}
+		reply.Fills = append(reply.Fills, &fill)
+	}
+	return &reply, nil
+}
+
+// GetCandles list candles of a pair in [startTime, endTime], latest count candles at most
+func (t *trade) GetCandles(req *pty.ReqCandles) (types.Message, error) {
+	if req.TokenSymbol == "" || req.Count < 0 || req.Count > maxCandleCount {
+		return nil, types.ErrInvalidParam
+	}
+	if _, ok := candleIntervals[req.Interval]; !ok {
+		return nil, types.ErrInvalidParam
+	}
+	count := int(req.Count)
+	if count == 0 {
+		count = defaultCandleCount
+	}
+	pair := calcTradePair(req.AssetExec, req.TokenSymbol, req.PriceExec, req.PriceSymbol)
+	prefix := calcCandlePrefix(pair, req.Interval)
+
+	// 从新到旧遍历, 结果再反转
+	var candles []*pty.Candle
+	var fromKey []byte
+	for len(candles) < count {
+		values, err := t.GetLocalDB().List(prefix, fromKey, marketDataPage, 0)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		stop := len(values) < marketDataPage
+		for _, value := range values {
+			var candle pty.Candle
+			err = types.Decode(value, &candle)
+			if err != nil {
+				return nil, err
+			}
+			fromKey = calcCandleKey(pair, req.Interval, candle.StartTime)
+			if req.StartTime > 0 && candle.StartTime < candleStart(req.StartTime, req.Interval) {
+				stop = true
+				break
+			}
+			if req.EndTime > 0 && candle.StartTime > req.EndTime {
+				continue
+			}
+			candles = append(candles, &candle)
+			if len(candles) == count {
+				break
+			}
+		}
+		if stop {
+			break
+		}
+	}
+
+	var reply pty.ReplyCandles
+	for i := len(candles) - 1; i >= 0; i-- {
+		reply.Candles = append(reply.Candles, candles[i])
+	}
+	return &reply, nil
+}
@@ -388,6 +388,8 @@ func buyBase2Order(base *pty.ReceiptBuyBase, txHash string, blockTime int64) *pt
 		BlockTime:         blockTime,
 		IsSellOrder:       false,
 		AssetExec:         base.AssetExec,
+		ExpireHeight:      base.ExpireHeight,
+		ExpireTime:        base.ExpireTime,
 	}
 	tradelog.Debug("txResult2sellOrderReply", "show reply", reply)
 	return reply
@@ -426,6 +428,8 @@ func sellBase2Order(base *pty.ReceiptSellBase, txHash string, blockTime int64) *
 		BlockTime:         blockTime,
 		IsSellOrder:       true,
 		AssetExec:         base.AssetExec,
+		ExpireHeight:      base.ExpireHeight,
+		ExpireTime:        base.ExpireTime,
 	}
 	tradelog.Debug("txResult2sellOrderReply", "show reply", reply)
 	return reply
@@ -17,6 +17,8 @@ trade执行器支持trade的创建和交易，
 */
 
 import (
+	"encoding/hex"
+
 	log "github.com/33cn/chain33/common/log/log15"
 
 	"github.com/33cn/chain33/common/db/table"
@@ -62,6 +64,14 @@ func (t *trade) GetDriverName() string {
 	return driverName
 }
 
+// ExecutorOrder 限价单撮合时要从localdb读取订单簿
+func (t *trade) ExecutorOrder() int64 {
+	if types.IsDappFork(t.GetHeight(), pty.TradeX, pty.ForkTradeBookX) {
+		return drivers.ExecLocalSameTime
+	}
+	return t.DriverBase.ExecutorOrder()
+}
+
 func (t *trade) getSellOrderFromDb(sellID []byte) *pty.SellOrder {
 	value, err := t.GetStateDB().Get(sellID)
 	if err != nil {
@@ -72,10 +82,16 @@ func (t *trade) getSellOrderFromDb(sellID []byte) *pty.SellOrder {
 	return &sellorder
 }
 
+// 订单是否由这个交易创建, 限价单创建时可能已经部分成交
+func isOrderCreatedByTx(orderID string, tx *types.Transaction) bool {
+	hash := hex.EncodeToString(tx.Hash())
+	return orderID == calcTokenSellID(hash) || orderID == calcTokenBuyID(hash)
+}
+
 func (t *trade) saveSell(base *pty.ReceiptSellBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table) []*types.KeyValue {
 	sellorder := t.getSellOrderFromDb([]byte(base.SellID))
 
-	if ty == pty.TyLogTradeSellLimit && sellorder.SoldBoardlot == 0 {
+	if ty == pty.TyLogTradeSellLimit && isOrderCreatedByTx(base.SellID, tx) {
 		newOrder := t.genSellLimit(tx, base, sellorder, txIndex)
 		tradelog.Info("Table", "sell-add", newOrder)
 		ldb.Add(newOrder)
@@ -87,7 +103,7 @@ func (t *trade) saveSell(base *pty.ReceiptSellBase, ty int32, tx *types.Transact
 
 func (t *trade) deleteSell(base *pty.ReceiptSellBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table, tradedBoardlot int64) []*types.KeyValue {
 	sellorder := t.getSellOrderFromDb([]byte(base.SellID))
-	if ty == pty.TyLogTradeSellLimit && sellorder.SoldBoardlot == 0 {
+	if ty == pty.TyLogTradeSellLimit && isOrderCreatedByTx(base.SellID, tx) {
 		ldb.Del([]byte(txIndex))
 	} else {
 		t.rollBackSellLimit(tx, base, sellorder, txIndex, ldb, tradedBoardlot)
@@ -125,7 +141,7 @@ func (t *trade) getBuyOrderFromDb(buyID []byte) *pty.BuyLimitOrder {
 func (t *trade) saveBuyLimit(buy *pty.ReceiptBuyBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table) []*types.KeyValue {
 	buyOrder := t.getBuyOrderFromDb([]byte(buy.BuyID))
 	tradelog.Debug("Table", "buy-add", buyOrder)
-	if buyOrder.Status == pty.TradeOrderStatusOnBuy && buy.BoughtBoardlot == 0 {
+	if ty == pty.TyLogTradeBuyLimit && isOrderCreatedByTx(buy.BuyID, tx) {
 		order := t.genBuyLimit(tx, buy, txIndex)
 		tradelog.Info("Table", "buy-add", order)
 		ldb.Add(order)
@@ -138,7 +154,7 @@ func (t *trade) saveBuyLimit(buy *pty.ReceiptBuyBase, ty int32, tx *types.Transa
 
 func (t *trade) deleteBuyLimit(buy *pty.ReceiptBuyBase, ty int32, tx *types.Transaction, txIndex string, ldb *table.Table, traded int64) []*types.KeyValue {
 	buyOrder := t.getBuyOrderFromDb([]byte(buy.BuyID))
-	if ty == pty.TyLogTradeBuyLimit && buy.BoughtBoardlot == 0 {
+	if ty == pty.TyLogTradeBuyLimit && isOrderCreatedByTx(buy.BuyID, tx) {
 		ldb.Del([]byte(txIndex))
 	} else {
 		t.rollbackBuyLimit(tx, buy, buyOrder, txIndex, ldb, traded)
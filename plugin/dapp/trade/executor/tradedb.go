@@ -21,10 +21,13 @@ import (
 
 type sellDB struct {
 	pty.SellOrder
+	// 本次交易中支付的手续费, 只记录在日志中
+	makerFee int64
+	takerFee int64
 }
 
 func newSellDB(sellOrder pty.SellOrder) (selldb *sellDB) {
-	selldb = &sellDB{sellOrder}
+	selldb = &sellDB{SellOrder: sellOrder}
 	if pty.InvalidStartTime != selldb.Starttime {
 		selldb.Status = pty.TradeOrderStatusNotStart
 	}
@@ -49,6 +52,8 @@ func (selldb *sellDB) getSellLogs(tradeType int32, txhash string) *types.Receipt
 		AmountPerBoardlot: strconv.FormatFloat(float64(selldb.AmountPerBoardlot)/float64(types.TokenPrecision), 'f', 8, 64),
 		MinBoardlot:       selldb.MinBoardlot,
 		PricePerBoardlot:  strconv.FormatFloat(float64(selldb.PricePerBoardlot)/float64(types.Coin), 'f', 8, 64),
+		Amount:            selldb.AmountPerBoardlot,
+		Price:             selldb.PricePerBoardlot,
 		TotalBoardlot:     selldb.TotalBoardlot,
 		SoldBoardlot:      selldb.SoldBoardlot,
 		Starttime:         selldb.Starttime,
@@ -62,6 +67,10 @@ func (selldb *sellDB) getSellLogs(tradeType int32, txhash string) *types.Receipt
 		AssetExec:         selldb.AssetExec,
 		PriceExec:         selldb.GetPriceExec(),
 		PriceSymbol:       selldb.GetPriceSymbol(),
+		ExpireHeight:      selldb.ExpireHeight,
+		ExpireTime:        selldb.ExpireTime,
+		MakerFee:          selldb.makerFee,
+		TakerFee:          selldb.takerFee,
 	}
 	if pty.TyLogTradeSellLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeSellLimit{Base: base}
@@ -75,7 +84,7 @@ func (selldb *sellDB) getSellLogs(tradeType int32, txhash string) *types.Receipt
 	return log
 }
 
-func (selldb *sellDB) getBuyLogs(buyerAddr string, boardlotcnt int64, txhash string) *types.ReceiptLog {
+func (selldb *sellDB) getBuyLogs(buyerAddr string, boardlotcnt int64, txhash string, fee int64) *types.ReceiptLog {
 	log := &types.ReceiptLog{}
 	log.Ty = pty.TyLogTradeBuyMarket
 	base := &pty.ReceiptBuyBase{
@@ -84,6 +93,8 @@ func (selldb *sellDB) getBuyLogs(buyerAddr string, boardlotcnt int64, txhash str
 		AmountPerBoardlot: strconv.FormatFloat(float64(selldb.AmountPerBoardlot)/float64(types.TokenPrecision), 'f', 8, 64),
 		MinBoardlot:       selldb.MinBoardlot,
 		PricePerBoardlot:  strconv.FormatFloat(float64(selldb.PricePerBoardlot)/float64(types.Coin), 'f', 8, 64),
+		Amount:            selldb.AmountPerBoardlot,
+		Price:             selldb.PricePerBoardlot,
 		TotalBoardlot:     boardlotcnt,
 		BoughtBoardlot:    boardlotcnt,
 		BuyID:             "",
@@ -94,6 +105,7 @@ func (selldb *sellDB) getBuyLogs(buyerAddr string, boardlotcnt int64, txhash str
 		AssetExec:         selldb.AssetExec,
 		PriceExec:         selldb.PriceExec,
 		PriceSymbol:       selldb.PriceSymbol,
+		TakerFee:          fee,
 	}
 
 	receipt := &pty.ReceiptTradeBuyMarket{Base: base}
@@ -146,10 +158,13 @@ func (selldb *sellDB) getKVSet() (kvset []*types.KeyValue) {
 
 type buyDB struct {
 	pty.BuyLimitOrder
+	// 本次交易中支付的手续费, 只记录在日志中
+	makerFee int64
+	takerFee int64
 }
 
 func newBuyDB(sellOrder pty.BuyLimitOrder) (buydb *buyDB) {
-	buydb = &buyDB{sellOrder}
+	buydb = &buyDB{BuyLimitOrder: sellOrder}
 	return
 }
 
@@ -178,6 +193,8 @@ func (buydb *buyDB) getBuyLogs(tradeType int32, txhash string) *types.ReceiptLog
 		AmountPerBoardlot: strconv.FormatFloat(float64(buydb.AmountPerBoardlot)/float64(types.TokenPrecision), 'f', 8, 64),
 		MinBoardlot:       buydb.MinBoardlot,
 		PricePerBoardlot:  strconv.FormatFloat(float64(buydb.PricePerBoardlot)/float64(types.Coin), 'f', 8, 64),
+		Amount:            buydb.AmountPerBoardlot,
+		Price:             buydb.PricePerBoardlot,
 		TotalBoardlot:     buydb.TotalBoardlot,
 		BoughtBoardlot:    buydb.BoughtBoardlot,
 		BuyID:             buydb.BuyID,
@@ -188,6 +205,10 @@ func (buydb *buyDB) getBuyLogs(tradeType int32, txhash string) *types.ReceiptLog
 		AssetExec:         buydb.AssetExec,
 		PriceExec:         buydb.PriceExec,
 		PriceSymbol:       buydb.PriceSymbol,
+		ExpireHeight:      buydb.ExpireHeight,
+		ExpireTime:        buydb.ExpireTime,
+		MakerFee:          buydb.makerFee,
+		TakerFee:          buydb.takerFee,
 	}
 	if pty.TyLogTradeBuyLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeBuyLimit{Base: base}
@@ -216,7 +237,7 @@ func getBuyOrderFromID(buyID []byte, db dbm.KV) (*pty.BuyLimitOrder, error) {
 	return &buy, nil
 }
 
-func (buydb *buyDB) getSellLogs(sellerAddr string, sellID string, boardlotCnt int64, txhash string) *types.ReceiptLog {
+func (buydb *buyDB) getSellLogs(sellerAddr string, sellID string, boardlotCnt int64, txhash string, fee int64) *types.ReceiptLog {
 	log := &types.ReceiptLog{}
 	log.Ty = pty.TyLogTradeSellMarket
 	base := &pty.ReceiptSellBase{
@@ -225,6 +246,8 @@ func (buydb *buyDB) getSellLogs(sellerAddr string, sellID string, boardlotCnt in
 		AmountPerBoardlot: strconv.FormatFloat(float64(buydb.AmountPerBoardlot)/float64(types.TokenPrecision), 'f', 8, 64),
 		MinBoardlot:       buydb.MinBoardlot,
 		PricePerBoardlot:  strconv.FormatFloat(float64(buydb.PricePerBoardlot)/float64(types.Coin), 'f', 8, 64),
+		Amount:            buydb.AmountPerBoardlot,
+		Price:             buydb.PricePerBoardlot,
 		TotalBoardlot:     boardlotCnt,
 		SoldBoardlot:      boardlotCnt,
 		Starttime:         0,
@@ -238,6 +261,7 @@ func (buydb *buyDB) getSellLogs(sellerAddr string, sellID string, boardlotCnt in
 		AssetExec:         buydb.AssetExec,
 		PriceExec:         buydb.PriceExec,
 		PriceSymbol:       buydb.PriceSymbol,
+		TakerFee:          fee,
 	}
 	receiptSellMarket := &pty.ReceiptSellMarket{Base: base}
 	log.Log = types.Encode(receiptSellMarket)
@@ -247,6 +271,7 @@ func (buydb *buyDB) getSellLogs(sellerAddr string, sellID string, boardlotCnt in
 
 type tradeAction struct {
 	db        dbm.KV
+	localdb   dbm.KVDB
 	txhash    string
 	fromaddr  string
 	blocktime int64
@@ -257,10 +282,26 @@ type tradeAction struct {
 func newTradeAction(t *trade, tx *types.Transaction) *tradeAction {
 	hash := hex.EncodeToString(tx.Hash())
 	fromaddr := tx.From()
-	return &tradeAction{t.GetStateDB(), hash, fromaddr,
+	return &tradeAction{t.GetStateDB(), t.GetLocalDB(), hash, fromaddr,
 		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer))}
 }
 
+// 检查挂单的过期高度/时间, 分叉前忽略这两个参数
+func (action *tradeAction) checkExpire(expireHeight, expireTime int64) (int64, int64, error) {
+	if !types.IsDappFork(action.height, pty.TradeX, pty.ForkTradeExpireX) {
+		return 0, 0, nil
+	}
+	if expireHeight < 0 || expireTime < 0 || action.isExpired(expireHeight, expireTime) {
+		return 0, 0, types.ErrInvalidParam
+	}
+	return expireHeight, expireTime, nil
+}
+
+// 到达过期高度或过期时间的订单不能再成交, 任何人都可以撤单
+func (action *tradeAction) isExpired(expireHeight, expireTime int64) bool {
+	return (expireHeight > 0 && action.height >= expireHeight) || (expireTime > 0 && action.blocktime >= expireTime)
+}
+
 func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, error) {
 	if sell.TotalBoardlot < 0 || sell.PricePerBoardlot < 0 || sell.MinBoardlot < 0 || sell.AmountPerBoardlot < 0 {
 		return nil, types.ErrInvalidParam
@@ -274,6 +315,12 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 	if !notSameAsset(action.height, sell.AssetExec, sell.TokenSymbol, sell.PriceExec, sell.PriceExec) {
 		return nil, pty.ErrAssetAndPriceSame
 	}
+	expireHeight, expireTime, err := action.checkExpire(sell.ExpireHeight, sell.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := action.getFeeCollector()
 
 	accDB, err := createAccountDB(action.height, action.db, sell.AssetExec, sell.TokenSymbol)
 	if err != nil {
@@ -306,6 +353,9 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 		AssetExec:         sell.AssetExec,
 		PriceExec:         sell.GetPriceExec(),
 		PriceSymbol:       sell.GetPriceSymbol(),
+		ExpireHeight:      expireHeight,
+		ExpireTime:        expireTime,
+		MakerFeeRate:      action.getMakerFeeRate(collector, sell.GetPriceExec(), sell.GetPriceSymbol()),
 	}
 
 	tokendb := newSellDB(sellOrder)
@@ -341,7 +391,7 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		return nil, pty.ErrTSellOrderNotEnough
 	} else if sellOrder.Status == pty.TradeOrderStatusRevoked {
 		return nil, pty.ErrTSellOrderRevoked
-	} else if sellOrder.Status == pty.TradeOrderStatusExpired {
+	} else if sellOrder.Status == pty.TradeOrderStatusExpired || action.isExpired(sellOrder.ExpireHeight, sellOrder.ExpireTime) {
 		return nil, pty.ErrTSellOrderExpired
 	} else if sellOrder.Status == pty.TradeOrderStatusOnSale && buyOrder.BoardlotCnt < sellOrder.MinBoardlot {
 		return nil, pty.ErrTCntLessThanMinBoardlot
@@ -354,7 +404,11 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		return nil, err
 	}
 	//首先购买费用的划转
-	receiptFromAcc, err := priceAcc.ExecTransfer(action.fromaddr, sellOrder.Address, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
+	volume := buyOrder.BoardlotCnt * sellOrder.PricePerBoardlot
+	collector := action.getFeeCollector()
+	takerFee := calcTradeFee(collector, volume, action.getTakerFeeRate(collector, sellOrder.PriceExec, sellOrder.PriceSymbol))
+	makerFee := calcTradeFee(collector, volume, sellOrder.MakerFeeRate)
+	receiptFromAcc, err := priceAcc.ExecTransfer(action.fromaddr, sellOrder.Address, action.execaddr, volume)
 	if err != nil {
 		tradelog.Error("account.Transfer ", "addrFrom", action.fromaddr, "addrTo", sellOrder.Address,
 			"amount", buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
@@ -376,6 +430,15 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		priceAcc.ExecTransfer(sellOrder.Address, action.fromaddr, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
 		return nil, err
 	}
+	// 买方吃单, 另外支付手续费; 卖方挂单, 从卖得的金额中支付手续费
+	receiptTakerFee, err := action.payFee(priceAcc, action.fromaddr, collector, takerFee)
+	if err != nil {
+		return nil, err
+	}
+	receiptMakerFee, err := action.payFee(priceAcc, sellOrder.Address, collector, makerFee)
+	if err != nil {
+		return nil, err
+	}
 
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
@@ -387,14 +450,19 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		sellOrder.Status = pty.TradeOrderStatusSoldOut
 	}
 	sellTokendb := newSellDB(*sellOrder)
+	sellTokendb.makerFee = makerFee
 	sellOrderKV := sellTokendb.save(action.db)
 
 	logs = append(logs, receiptFromAcc.Logs...)
 	logs = append(logs, receiptFromExecAcc.Logs...)
+	logs = append(logs, receiptTakerFee.Logs...)
+	logs = append(logs, receiptMakerFee.Logs...)
 	logs = append(logs, sellTokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
-	logs = append(logs, sellTokendb.getBuyLogs(action.fromaddr, buyOrder.BoardlotCnt, action.txhash))
+	logs = append(logs, sellTokendb.getBuyLogs(action.fromaddr, buyOrder.BoardlotCnt, action.txhash, takerFee))
 	kv = append(kv, receiptFromAcc.KV...)
 	kv = append(kv, receiptFromExecAcc.KV...)
+	kv = append(kv, receiptTakerFee.KV...)
+	kv = append(kv, receiptMakerFee.KV...)
 	kv = append(kv, sellOrderKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
@@ -420,7 +488,8 @@ func (action *tradeAction) tradeRevokeSell(revoke *pty.TradeForRevokeSell) (*typ
 		return nil, pty.ErrTSellOrderExpired
 	}
 
-	if action.fromaddr != sellOrder.Address {
+	expired := action.isExpired(sellOrder.ExpireHeight, sellOrder.ExpireTime)
+	if action.fromaddr != sellOrder.Address && !expired {
 		return nil, pty.ErrTSellOrderRevoke
 	}
 	//然后实现购买token的转移,因为这部分token在之前的卖单生成时已经进行冻结
@@ -438,6 +507,9 @@ func (action *tradeAction) tradeRevokeSell(revoke *pty.TradeForRevokeSell) (*typ
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 	sellOrder.Status = pty.TradeOrderStatusRevoked
+	if expired {
+		sellOrder.Status = pty.TradeOrderStatusExpired
+	}
 	tokendb := newSellDB(*sellOrder)
 	sellOrderKV := tokendb.save(action.db)
 
@@ -486,14 +558,21 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 	if !notSameAsset(action.height, buy.AssetExec, buy.TokenSymbol, buy.PriceExec, buy.PriceExec) {
 		return nil, pty.ErrAssetAndPriceSame
 	}
+	expireHeight, expireTime, err := action.checkExpire(buy.ExpireHeight, buy.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
 
 	priceAcc, err := createPriceDB(action.height, action.db, buy.PriceExec, buy.PriceSymbol)
 	if err != nil {
 		return nil, err
 	}
-	// check enough bty
+	// check enough bty, 挂单方手续费一起冻结
 	amount := buy.PricePerBoardlot * buy.TotalBoardlot
-	receipt, err := priceAcc.ExecFrozen(action.fromaddr, action.execaddr, amount)
+	collector := action.getFeeCollector()
+	makerFeeRate := action.getMakerFeeRate(collector, buy.PriceExec, buy.PriceSymbol)
+	feeFrozen := calcTradeFee(collector, amount, makerFeeRate)
+	receipt, err := priceAcc.ExecFrozen(action.fromaddr, action.execaddr, amount+feeFrozen)
 	if err != nil {
 		tradelog.Error("trade tradeBuyLimit ", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", amount)
 		return nil, err
@@ -515,6 +594,10 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 		AssetExec:         buy.AssetExec,
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		ExpireHeight:      expireHeight,
+		ExpireTime:        expireTime,
+		MakerFeeRate:      makerFeeRate,
+		FeeFrozen:         feeFrozen,
 	}
 
 	tokendb := newBuyDB(buyOrder)
@@ -547,6 +630,8 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 		return nil, pty.ErrTBuyOrderSoldout
 	} else if buyOrder.Status == pty.TradeOrderStatusRevoked {
 		return nil, pty.ErrTBuyOrderRevoked
+	} else if buyOrder.Status == pty.TradeOrderStatusExpired || action.isExpired(buyOrder.ExpireHeight, buyOrder.ExpireTime) {
+		return nil, pty.ErrTBuyOrderExpired
 	} else if buyOrder.Status == pty.TradeOrderStatusOnBuy && buyOrder.TotalBoardlot-buyOrder.BoughtBoardlot < sellOrder.BoardlotCnt {
 		return nil, pty.ErrTBuyOrderNotEnough
 	} else if buyOrder.Status == pty.TradeOrderStatusOnBuy && sellOrder.BoardlotCnt < buyOrder.MinBoardlot {
@@ -585,6 +670,18 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 		return nil, err
 	}
 
+	// 卖方吃单, 从卖得的金额中支付手续费; 买方挂单, 从冻结的手续费中支付
+	collector := action.getFeeCollector()
+	takerFee := calcTradeFee(collector, amount, action.getTakerFeeRate(collector, buyOrder.PriceExec, buyOrder.PriceSymbol))
+	receiptTakerFee, err := action.payFee(priceAcc, action.fromaddr, collector, takerFee)
+	if err != nil {
+		return nil, err
+	}
+	receiptMakerFee, makerFee, err := action.payBuyMakerFee(priceAcc, collector, buyOrder, sellOrder.BoardlotCnt)
+	if err != nil {
+		return nil, err
+	}
+
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
@@ -595,14 +692,19 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 		buyOrder.Status = pty.TradeOrderStatusBoughtOut
 	}
 	buyTokendb := newBuyDB(*buyOrder)
+	buyTokendb.makerFee = makerFee
 	sellOrderKV := buyTokendb.save(action.db)
 
 	logs = append(logs, receiptFromAcc.Logs...)
 	logs = append(logs, receiptFromExecAcc.Logs...)
+	logs = append(logs, receiptTakerFee.Logs...)
+	logs = append(logs, receiptMakerFee.Logs...)
 	logs = append(logs, buyTokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
-	logs = append(logs, buyTokendb.getSellLogs(action.fromaddr, action.txhash, sellOrder.BoardlotCnt, action.txhash))
+	logs = append(logs, buyTokendb.getSellLogs(action.fromaddr, action.txhash, sellOrder.BoardlotCnt, action.txhash, takerFee))
 	kv = append(kv, receiptFromAcc.KV...)
 	kv = append(kv, receiptFromExecAcc.KV...)
+	kv = append(kv, receiptTakerFee.KV...)
+	kv = append(kv, receiptMakerFee.KV...)
 	kv = append(kv, sellOrderKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
@@ -624,9 +726,12 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 		return nil, pty.ErrTBuyOrderSoldout
 	} else if buyOrder.Status == pty.TradeOrderStatusBuyRevoked {
 		return nil, pty.ErrTBuyOrderRevoked
+	} else if buyOrder.Status == pty.TradeOrderStatusExpired {
+		return nil, pty.ErrTBuyOrderExpired
 	}
 
-	if action.fromaddr != buyOrder.Address {
+	expired := action.isExpired(buyOrder.ExpireHeight, buyOrder.ExpireTime)
+	if action.fromaddr != buyOrder.Address && !expired {
 		return nil, pty.ErrTBuyOrderRevoke
 	}
 
@@ -635,7 +740,8 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 		return nil, err
 	}
 	//然后实现购买token的转移,因为这部分token在之前的卖单生成时已经进行冻结
-	tradeRest := (buyOrder.TotalBoardlot - buyOrder.BoughtBoardlot) * buyOrder.PricePerBoardlot
+	//未成交部分冻结的手续费一起退回
+	tradeRest := (buyOrder.TotalBoardlot-buyOrder.BoughtBoardlot)*buyOrder.PricePerBoardlot + buyOrder.FeeFrozen
 	//tradelog.Info("tradeRevokeBuyLimit", "total-b", buyOrder.TotalBoardlot, "price", buyOrder.PricePerBoardlot, "amount", tradeRest)
 	receiptFromExecAcc, err := priceAcc.ExecActive(buyOrder.Address, action.execaddr, tradeRest)
 	if err != nil {
@@ -646,6 +752,10 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 	buyOrder.Status = pty.TradeOrderStatusBuyRevoked
+	buyOrder.FeeFrozen = 0
+	if expired {
+		buyOrder.Status = pty.TradeOrderStatusExpired
+	}
 	tokendb := newBuyDB(*buyOrder)
 	sellOrderKV := tokendb.save(action.db)
 
@@ -30,6 +30,8 @@ func (jrpc *Jrpc) CreateRawTradeSellTx(in *ptypes.TradeSellTx, result *interface
 		AssetExec:         in.AssetExec,
 		PriceExec:         in.PriceExec,
 		PriceSymbol:       in.PriceSymbol,
+		ExpireHeight:      in.ExpireHeight,
+		ExpireTime:        in.ExpireTime,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeSellTx(context.Background(), param)
@@ -89,6 +91,8 @@ func (jrpc *Jrpc) CreateRawTradeBuyLimitTx(in *ptypes.TradeBuyLimitTx, result *i
 		AssetExec:         in.AssetExec,
 		PriceExec:         in.PriceExec,
 		PriceSymbol:       in.PriceSymbol,
+		ExpireHeight:      in.ExpireHeight,
+		ExpireTime:        in.ExpireTime,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeBuyLimitTx(context.Background(), param)
@@ -133,3 +137,55 @@ func (jrpc *Jrpc) CreateRawTradeRevokeBuyTx(in *ptypes.TradeRevokeBuyTx, result
 	*result = hex.EncodeToString(reply.Data)
 	return nil
 }
+
+//CreateRawTradeLimitOrderTx : 限价单, 与对手盘自动撮合, 未成交部分挂单
+func (jrpc *Jrpc) CreateRawTradeLimitOrderTx(in *ptypes.TradeLimitOrderTx, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	param := &ptypes.TradeForLimitOrder{
+		TokenSymbol:       in.TokenSymbol,
+		AmountPerBoardlot: in.AmountPerBoardlot,
+		PricePerBoardlot:  in.PricePerBoardlot,
+		TotalBoardlot:     in.TotalBoardlot,
+		AssetExec:         in.AssetExec,
+		PriceExec:         in.PriceExec,
+		PriceSymbol:       in.PriceSymbol,
+		IsSellOrder:       in.IsSellOrder,
+		ExpireHeight:      in.ExpireHeight,
+		ExpireTime:        in.ExpireTime,
+	}
+
+	reply, err := jrpc.cli.CreateRawTradeLimitOrderTx(context.Background(), param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
+
+//GetTradeHistory : 交易对的成交历史
+func (jrpc *Jrpc) GetTradeHistory(in *ptypes.ReqTradeHistory, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	reply, err := jrpc.cli.Query(types.ExecName(ptypes.TradeX), "GetTradeHistory", in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//GetCandles : 交易对的K线
+func (jrpc *Jrpc) GetCandles(in *ptypes.ReqCandles, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	reply, err := jrpc.cli.Query(types.ExecName(ptypes.TradeX), "GetCandles", in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
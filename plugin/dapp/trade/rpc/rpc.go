@@ -112,3 +112,20 @@ func (cc *channelClient) CreateRawTradeRevokeBuyTx(ctx context.Context, in *ptyp
 	data := types.Encode(tx)
 	return &types.UnsignTx{Data: data}, nil
 }
+
+//CreateRawTradeLimitOrderTx :
+func (cc *channelClient) CreateRawTradeLimitOrderTx(ctx context.Context, in *ptypes.TradeForLimitOrder) (*types.UnsignTx, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	order := &ptypes.Trade{
+		Ty:    ptypes.TradeLimitOrder,
+		Value: &ptypes.Trade_LimitOrder{LimitOrder: in},
+	}
+	tx, err := types.CreateFormatTx(types.ExecName(ptypes.TradeX), types.Encode(order))
+	if err != nil {
+		return nil, err
+	}
+	data := types.Encode(tx)
+	return &types.UnsignTx{Data: data}, nil
+}
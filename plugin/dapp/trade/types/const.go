@@ -12,6 +12,7 @@ const (
 	TradeSellMarket
 	TradeBuyLimit
 	TradeRevokeBuy
+	TradeLimitOrder
 )
 
 // log
@@ -23,6 +24,7 @@ const (
 	TyLogTradeSellMarket = 330
 	TyLogTradeBuyLimit   = 331
 	TyLogTradeBuyRevoke  = 332
+	TyLogTradeMatch      = 333
 )
 
 // 0->not start, 1->on sale, 2->sold out, 3->revoke, 4->expired
@@ -69,6 +71,7 @@ var MapSellOrderStatusStr2Int = map[string]int32{
 	"onsale":  TradeOrderStatusOnSale,
 	"soldout": TradeOrderStatusSoldOut,
 	"revoked": TradeOrderStatusRevoked,
+	"expired": TradeOrderStatusExpired,
 }
 
 //MapBuyOrderStatusStr2Int :
@@ -76,6 +79,7 @@ var MapBuyOrderStatusStr2Int = map[string]int32{
 	"onbuy":      TradeOrderStatusOnBuy,
 	"boughtout":  TradeOrderStatusBoughtOut,
 	"buyrevoked": TradeOrderStatusBuyRevoked,
+	"expired":    TradeOrderStatusExpired,
 }
 
 const (
@@ -83,6 +87,26 @@ const (
 	InvalidStartTime = 0
 )
 
+// 手续费通过 manage 合约配置, 配置项取最后一个值
+// 费率按定价资产分别配置, key 后接 priceExec.priceSymbol, 如 trade-maker-fee-coins.bty
+const (
+	// ManageKeyFeeCollector 收取手续费的地址
+	ManageKeyFeeCollector = "trade-fee-collector"
+	// ManageKeyMakerFee 挂单方手续费率
+	ManageKeyMakerFee = "trade-maker-fee-"
+	// ManageKeyTakerFee 吃单方手续费率
+	ManageKeyTakerFee = "trade-taker-fee-"
+	// FeeRateBase 手续费率单位为万分之一
+	FeeRateBase = 10000
+	// MaxFeeRate 手续费率上限, 超过上限的配置视为无效
+	MaxFeeRate = 1000
+)
+
+const (
+	// MaxLimitOrderMatch 一笔限价单最多处理的对手方订单数(包括成交, 跳过和清理掉的订单), 未成交部分挂在订单簿上
+	MaxLimitOrderMatch = 20
+)
+
 const (
 	// ForkTradeAssetX support more kinds of asset
 	ForkTradeAssetX = "ForkTradeAsset"
@@ -94,4 +118,10 @@ const (
 	ForkTradeFixAssetDBX = "ForkTradeFixAssetDB"
 	// ForkTradePriceX all asset can be price
 	ForkTradePriceX = "ForkTradePrice"
+	// ForkTradeBookX support order book and limit order matching
+	ForkTradeBookX = "ForkTradeBook"
+	// ForkTradeExpireX support order expire at height or blocktime
+	ForkTradeExpireX = "ForkTradeExpire"
+	// ForkTradeFeeX support maker/taker fee
+	ForkTradeFeeX = "ForkTradeFee"
 )
@@ -33,10 +33,14 @@ var (
 	ErrTBuyOrderSoldout = errors.New("ErrTradeBuyOrderSoldout")
 	//ErrTBuyOrderRevoked :
 	ErrTBuyOrderRevoked = errors.New("ErrTradeBuyOrderRevoked")
+	//ErrTBuyOrderExpired :
+	ErrTBuyOrderExpired = errors.New("ErrTradeBuyOrderExpired")
 	//ErrTBuyOrderRevoke :
 	ErrTBuyOrderRevoke = errors.New("ErrTradeBuyOrderRevokeNotAllowed")
 	//ErrTCntLessThanMinBoardlot :
 	ErrTCntLessThanMinBoardlot = errors.New("ErrTradeCountLessThanMinBoardlot")
 	// ErrAssetAndPriceSame :
 	ErrAssetAndPriceSame = errors.New("ErrAssetAndPriceSame")
+	// ErrTOrderBookNotSupport :
+	ErrTOrderBookNotSupport = errors.New("ErrTradeOrderBookNotSupport")
 )
@@ -24,6 +24,7 @@ var (
 		"BuyLimit":   TradeBuyLimit,
 		"SellMarket": TradeSellMarket,
 		"RevokeBuy":  TradeRevokeBuy,
+		"LimitOrder": TradeLimitOrder,
 	}
 
 	logInfo = map[int64]*types.LogInfo{
@@ -33,6 +34,7 @@ var (
 		TyLogTradeSellMarket: {Ty: reflect.TypeOf(ReceiptSellMarket{}), Name: "LogTradeSellMarket"},
 		TyLogTradeBuyLimit:   {Ty: reflect.TypeOf(ReceiptTradeBuyLimit{}), Name: "LogTradeBuyLimit"},
 		TyLogTradeBuyRevoke:  {Ty: reflect.TypeOf(ReceiptTradeBuyRevoke{}), Name: "LogTradeBuyRevoke"},
+		TyLogTradeMatch:      {Ty: reflect.TypeOf(ReceiptTradeMatch{}), Name: "LogTradeMatch"},
 	}
 )
 
@@ -57,6 +59,9 @@ func init() {
 	types.RegisterDappFork(TradeX, ForkTradeIDX, 1450000)
 	types.RegisterDappFork(TradeX, ForkTradeFixAssetDBX, 2500000)
 	types.RegisterDappFork(TradeX, ForkTradePriceX, 3150000)
+	types.RegisterDappFork(TradeX, ForkTradeBookX, 3800000)
+	types.RegisterDappFork(TradeX, ForkTradeExpireX, 3800000)
+	types.RegisterDappFork(TradeX, ForkTradeFeeX, 3800000)
 }
 
 type tradeType struct {
@@ -92,6 +97,8 @@ func (t *tradeType) ActionName(tx *types.Transaction) string {
 		return "sellmarkettoken"
 	} else if action.Ty == TradeRevokeBuy && action.GetRevokeBuy() != nil {
 		return "revokebuytoken"
+	} else if action.Ty == TradeLimitOrder && action.GetLimitOrder() != nil {
+		return "limitordertoken"
 	}
 	return "unknown"
 }
@@ -164,6 +171,14 @@ func (t *tradeType) CreateTx(action string, message json.RawMessage) (*types.Tra
 			return nil, types.ErrInvalidParam
 		}
 		return CreateRawTradeRevokeBuyTx(&param)
+	} else if action == "TradeLimitOrder" {
+		var param TradeLimitOrderTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawTradeLimitOrderTx(&param)
 	}
 
 	return nil, types.ErrNotSupport
@@ -186,6 +201,8 @@ func CreateRawTradeSellTx(parm *TradeSellTx) (*types.Transaction, error) {
 		AssetExec:         parm.AssetExec,
 		PriceExec:         parm.PriceExec,
 		PriceSymbol:       parm.PriceSymbol,
+		ExpireHeight:      parm.ExpireHeight,
+		ExpireTime:        parm.ExpireTime,
 	}
 	sell := &Trade{
 		Ty:    TradeSellLimit,
@@ -235,6 +252,8 @@ func CreateRawTradeBuyLimitTx(parm *TradeBuyLimitTx) (*types.Transaction, error)
 		AssetExec:         parm.AssetExec,
 		PriceExec:         parm.PriceExec,
 		PriceSymbol:       parm.PriceSymbol,
+		ExpireHeight:      parm.ExpireHeight,
+		ExpireTime:        parm.ExpireTime,
 	}
 	buyLimit := &Trade{
 		Ty:    TradeBuyLimit,
@@ -269,3 +288,27 @@ func CreateRawTradeRevokeBuyTx(parm *TradeRevokeBuyTx) (*types.Transaction, erro
 	}
 	return types.CreateFormatTx(types.ExecName(TradeX), types.Encode(buy))
 }
+
+//CreateRawTradeLimitOrderTx : 创建自动撮合的限价单交易
+func CreateRawTradeLimitOrderTx(parm *TradeLimitOrderTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	v := &TradeForLimitOrder{
+		TokenSymbol:       parm.TokenSymbol,
+		AmountPerBoardlot: parm.AmountPerBoardlot,
+		PricePerBoardlot:  parm.PricePerBoardlot,
+		TotalBoardlot:     parm.TotalBoardlot,
+		AssetExec:         parm.AssetExec,
+		PriceExec:         parm.PriceExec,
+		PriceSymbol:       parm.PriceSymbol,
+		IsSellOrder:       parm.IsSellOrder,
+		ExpireHeight:      parm.ExpireHeight,
+		ExpireTime:        parm.ExpireTime,
+	}
+	limitOrder := &Trade{
+		Ty:    TradeLimitOrder,
+		Value: &Trade_LimitOrder{v},
+	}
+	return types.CreateFormatTx(types.ExecName(TradeX), types.Encode(limitOrder))
+}
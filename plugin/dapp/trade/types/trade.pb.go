@@ -4,17 +4,14 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
-
-	proto "github.com/golang/protobuf/proto"
-
-	math "math"
-
 	types "github.com/33cn/chain33/types"
-
-	context "golang.org/x/net/context"
-
+	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -26,10 +23,9 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // trade 交易部分
-//
 type Trade struct {
 	// Types that are valid to be assigned to Value:
 	//	*Trade_SellLimit
@@ -38,6 +34,7 @@ type Trade struct {
 	//	*Trade_BuyLimit
 	//	*Trade_SellMarket
 	//	*Trade_RevokeBuy
+	//	*Trade_LimitOrder
 	Value                isTrade_Value `protobuf_oneof:"value"`
 	Ty                   int32         `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -49,16 +46,17 @@ func (m *Trade) Reset()         { *m = Trade{} }
 func (m *Trade) String() string { return proto.CompactTextString(m) }
 func (*Trade) ProtoMessage()    {}
 func (*Trade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{0}
+	return fileDescriptor_ee944bd90e8a0312, []int{0}
 }
+
 func (m *Trade) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Trade.Unmarshal(m, b)
 }
 func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
 }
-func (dst *Trade) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Trade.Merge(dst, src)
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
 }
 func (m *Trade) XXX_Size() int {
 	return xxx_messageInfo_Trade.Size(m)
@@ -97,6 +95,10 @@ type Trade_RevokeBuy struct {
 	RevokeBuy *TradeForRevokeBuy `protobuf:"bytes,7,opt,name=revokeBuy,proto3,oneof"`
 }
 
+type Trade_LimitOrder struct {
+	LimitOrder *TradeForLimitOrder `protobuf:"bytes,8,opt,name=limitOrder,proto3,oneof"`
+}
+
 func (*Trade_SellLimit) isTrade_Value() {}
 
 func (*Trade_BuyMarket) isTrade_Value() {}
@@ -109,6 +111,8 @@ func (*Trade_SellMarket) isTrade_Value() {}
 
 func (*Trade_RevokeBuy) isTrade_Value() {}
 
+func (*Trade_LimitOrder) isTrade_Value() {}
+
 func (m *Trade) GetValue() isTrade_Value {
 	if m != nil {
 		return m.Value
@@ -158,6 +162,13 @@ func (m *Trade) GetRevokeBuy() *TradeForRevokeBuy {
 	return nil
 }
 
+func (m *Trade) GetLimitOrder() *TradeForLimitOrder {
+	if x, ok := m.GetValue().(*Trade_LimitOrder); ok {
+		return x.LimitOrder
+	}
+	return nil
+}
+
 func (m *Trade) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -174,6 +185,7 @@ func (*Trade) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Trade_BuyLimit)(nil),
 		(*Trade_SellMarket)(nil),
 		(*Trade_RevokeBuy)(nil),
+		(*Trade_LimitOrder)(nil),
 	}
 }
 
@@ -211,6 +223,11 @@ func _Trade_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.RevokeBuy); err != nil {
 			return err
 		}
+	case *Trade_LimitOrder:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LimitOrder); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Trade.Value has unexpected type %T", x)
@@ -269,6 +286,14 @@ func _Trade_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Value = &Trade_RevokeBuy{msg}
 		return true, err
+	case 8: // value.limitOrder
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TradeForLimitOrder)
+		err := b.DecodeMessage(msg)
+		m.Value = &Trade_LimitOrder{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -308,6 +333,11 @@ func _Trade_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Trade_LimitOrder:
+		s := proto.Size(x.LimitOrder)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -332,8 +362,11 @@ type TradeForSell struct {
 	// 资产来源
 	AssetExec string `protobuf:"bytes,9,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	// 定价资产
-	PriceExec            string   `protobuf:"bytes,10,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,11,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	PriceExec   string `protobuf:"bytes,10,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,11,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// 订单过期高度/时间, 为0表示不过期, 过期后不能成交, 任何人都可以撤单, 冻结资产退回卖家
+	ExpireHeight         int64    `protobuf:"varint,12,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,13,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -343,16 +376,17 @@ func (m *TradeForSell) Reset()         { *m = TradeForSell{} }
 func (m *TradeForSell) String() string { return proto.CompactTextString(m) }
 func (*TradeForSell) ProtoMessage()    {}
 func (*TradeForSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{1}
+	return fileDescriptor_ee944bd90e8a0312, []int{1}
 }
+
 func (m *TradeForSell) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TradeForSell.Unmarshal(m, b)
 }
 func (m *TradeForSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TradeForSell.Marshal(b, m, deterministic)
 }
-func (dst *TradeForSell) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TradeForSell.Merge(dst, src)
+func (m *TradeForSell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForSell.Merge(m, src)
 }
 func (m *TradeForSell) XXX_Size() int {
 	return xxx_messageInfo_TradeForSell.Size(m)
@@ -440,6 +474,20 @@ func (m *TradeForSell) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *TradeForSell) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *TradeForSell) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
 // 购买者发起交易用来购买token持有者之前挂单出售的token
 // 其中的hash为token出售者发起出售交易的hash
 type TradeForBuy struct {
@@ -454,16 +502,17 @@ func (m *TradeForBuy) Reset()         { *m = TradeForBuy{} }
 func (m *TradeForBuy) String() string { return proto.CompactTextString(m) }
 func (*TradeForBuy) ProtoMessage()    {}
 func (*TradeForBuy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{2}
+	return fileDescriptor_ee944bd90e8a0312, []int{2}
 }
+
 func (m *TradeForBuy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TradeForBuy.Unmarshal(m, b)
 }
 func (m *TradeForBuy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TradeForBuy.Marshal(b, m, deterministic)
 }
-func (dst *TradeForBuy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TradeForBuy.Merge(dst, src)
+func (m *TradeForBuy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForBuy.Merge(m, src)
 }
 func (m *TradeForBuy) XXX_Size() int {
 	return xxx_messageInfo_TradeForBuy.Size(m)
@@ -500,16 +549,17 @@ func (m *TradeForRevokeSell) Reset()         { *m = TradeForRevokeSell{} }
 func (m *TradeForRevokeSell) String() string { return proto.CompactTextString(m) }
 func (*TradeForRevokeSell) ProtoMessage()    {}
 func (*TradeForRevokeSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{3}
+	return fileDescriptor_ee944bd90e8a0312, []int{3}
 }
+
 func (m *TradeForRevokeSell) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TradeForRevokeSell.Unmarshal(m, b)
 }
 func (m *TradeForRevokeSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TradeForRevokeSell.Marshal(b, m, deterministic)
 }
-func (dst *TradeForRevokeSell) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TradeForRevokeSell.Merge(dst, src)
+func (m *TradeForRevokeSell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForRevokeSell.Merge(m, src)
 }
 func (m *TradeForRevokeSell) XXX_Size() int {
 	return xxx_messageInfo_TradeForRevokeSell.Size(m)
@@ -536,8 +586,11 @@ type TradeForBuyLimit struct {
 	TotalBoardlot     int64  `protobuf:"varint,5,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
 	AssetExec         string `protobuf:"bytes,6,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	// 定价资产
-	PriceExec            string   `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	PriceExec   string `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// 订单过期高度/时间, 为0表示不过期
+	ExpireHeight         int64    `protobuf:"varint,9,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,10,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -547,16 +600,17 @@ func (m *TradeForBuyLimit) Reset()         { *m = TradeForBuyLimit{} }
 func (m *TradeForBuyLimit) String() string { return proto.CompactTextString(m) }
 func (*TradeForBuyLimit) ProtoMessage()    {}
 func (*TradeForBuyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{4}
+	return fileDescriptor_ee944bd90e8a0312, []int{4}
 }
+
 func (m *TradeForBuyLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TradeForBuyLimit.Unmarshal(m, b)
 }
 func (m *TradeForBuyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TradeForBuyLimit.Marshal(b, m, deterministic)
 }
-func (dst *TradeForBuyLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TradeForBuyLimit.Merge(dst, src)
+func (m *TradeForBuyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForBuyLimit.Merge(m, src)
 }
 func (m *TradeForBuyLimit) XXX_Size() int {
 	return xxx_messageInfo_TradeForBuyLimit.Size(m)
@@ -623,6 +677,20 @@ func (m *TradeForBuyLimit) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *TradeForBuyLimit) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *TradeForBuyLimit) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
 // 现价卖单
 type TradeForSellMarket struct {
 	BuyID                string   `protobuf:"bytes,1,opt,name=buyID,proto3" json:"buyID,omitempty"`
@@ -636,16 +704,17 @@ func (m *TradeForSellMarket) Reset()         { *m = TradeForSellMarket{} }
 func (m *TradeForSellMarket) String() string { return proto.CompactTextString(m) }
 func (*TradeForSellMarket) ProtoMessage()    {}
 func (*TradeForSellMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{5}
+	return fileDescriptor_ee944bd90e8a0312, []int{5}
 }
+
 func (m *TradeForSellMarket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TradeForSellMarket.Unmarshal(m, b)
 }
 func (m *TradeForSellMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TradeForSellMarket.Marshal(b, m, deterministic)
 }
-func (dst *TradeForSellMarket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TradeForSellMarket.Merge(dst, src)
+func (m *TradeForSellMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForSellMarket.Merge(m, src)
 }
 func (m *TradeForSellMarket) XXX_Size() int {
 	return xxx_messageInfo_TradeForSellMarket.Size(m)
@@ -682,16 +751,17 @@ func (m *TradeForRevokeBuy) Reset()         { *m = TradeForRevokeBuy{} }
 func (m *TradeForRevokeBuy) String() string { return proto.CompactTextString(m) }
 func (*TradeForRevokeBuy) ProtoMessage()    {}
 func (*TradeForRevokeBuy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{6}
+	return fileDescriptor_ee944bd90e8a0312, []int{6}
 }
+
 func (m *TradeForRevokeBuy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TradeForRevokeBuy.Unmarshal(m, b)
 }
 func (m *TradeForRevokeBuy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TradeForRevokeBuy.Marshal(b, m, deterministic)
 }
-func (dst *TradeForRevokeBuy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TradeForRevokeBuy.Merge(dst, src)
+func (m *TradeForRevokeBuy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForRevokeBuy.Merge(m, src)
 }
 func (m *TradeForRevokeBuy) XXX_Size() int {
 	return xxx_messageInfo_TradeForRevokeBuy.Size(m)
@@ -709,28 +779,145 @@ func (m *TradeForRevokeBuy) GetBuyID() string {
 	return ""
 }
 
+// 连续撮合的限价单, 按价格优先、时间优先与对手方挂单自动成交, 未成交部分挂单
+type TradeForLimitOrder struct {
+	TokenSymbol       string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AmountPerBoardlot int64  `protobuf:"varint,2,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	PricePerBoardlot  int64  `protobuf:"varint,3,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TotalBoardlot     int64  `protobuf:"varint,4,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
+	AssetExec         string `protobuf:"bytes,5,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec         string `protobuf:"bytes,6,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol       string `protobuf:"bytes,7,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	IsSellOrder       bool   `protobuf:"varint,8,opt,name=isSellOrder,proto3" json:"isSellOrder,omitempty"`
+	// 未成交部分挂单的过期高度/时间, 为0表示不过期
+	ExpireHeight         int64    `protobuf:"varint,9,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,10,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeForLimitOrder) Reset()         { *m = TradeForLimitOrder{} }
+func (m *TradeForLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*TradeForLimitOrder) ProtoMessage()    {}
+func (*TradeForLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{7}
+}
+
+func (m *TradeForLimitOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeForLimitOrder.Unmarshal(m, b)
+}
+func (m *TradeForLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeForLimitOrder.Marshal(b, m, deterministic)
+}
+func (m *TradeForLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForLimitOrder.Merge(m, src)
+}
+func (m *TradeForLimitOrder) XXX_Size() int {
+	return xxx_messageInfo_TradeForLimitOrder.Size(m)
+}
+func (m *TradeForLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeForLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeForLimitOrder proto.InternalMessageInfo
+
+func (m *TradeForLimitOrder) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *TradeForLimitOrder) GetAmountPerBoardlot() int64 {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return 0
+}
+
+func (m *TradeForLimitOrder) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *TradeForLimitOrder) GetTotalBoardlot() int64 {
+	if m != nil {
+		return m.TotalBoardlot
+	}
+	return 0
+}
+
+func (m *TradeForLimitOrder) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *TradeForLimitOrder) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *TradeForLimitOrder) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *TradeForLimitOrder) GetIsSellOrder() bool {
+	if m != nil {
+		return m.IsSellOrder
+	}
+	return false
+}
+
+func (m *TradeForLimitOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *TradeForLimitOrder) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
 // 数据库部分
 type SellOrder struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
 	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// 每一手出售的token的数量
+	//每一手出售的token的数量
 	AmountPerBoardlot int64 `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
 	MinBoardlot       int64 `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	// 每一手token的价格
+	//每一手token的价格
 	PricePerBoardlot int64 `protobuf:"varint,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
 	TotalBoardlot    int64 `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
 	SoldBoardlot     int64 `protobuf:"varint,7,opt,name=soldBoardlot,proto3" json:"soldBoardlot,omitempty"`
-	// 此次出售的起始时间，如果非众筹则可以忽略此时间
+	//此次出售的起始时间，如果非众筹则可以忽略此时间
 	Starttime int64 `protobuf:"varint,8,opt,name=starttime,proto3" json:"starttime,omitempty"`
 	Stoptime  int64 `protobuf:"varint,9,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
 	Crowdfund bool  `protobuf:"varint,10,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
-	// 此处使用tx的hash来指定
-	SellID               string   `protobuf:"bytes,11,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	Status               int32    `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
-	Height               int64    `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
-	AssetExec            string   `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
-	PriceExec            string   `protobuf:"bytes,15,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,16,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	//此处使用tx的hash来指定
+	SellID       string `protobuf:"bytes,11,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	Status       int32  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	Height       int64  `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+	AssetExec    string `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec    string `protobuf:"bytes,15,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol  string `protobuf:"bytes,16,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight int64  `protobuf:"varint,17,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime   int64  `protobuf:"varint,18,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	// 挂单时的挂单方手续费率, 万分之
+	MakerFeeRate         int64    `protobuf:"varint,19,opt,name=makerFeeRate,proto3" json:"makerFeeRate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -740,16 +927,17 @@ func (m *SellOrder) Reset()         { *m = SellOrder{} }
 func (m *SellOrder) String() string { return proto.CompactTextString(m) }
 func (*SellOrder) ProtoMessage()    {}
 func (*SellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{7}
+	return fileDescriptor_ee944bd90e8a0312, []int{8}
 }
+
 func (m *SellOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SellOrder.Unmarshal(m, b)
 }
 func (m *SellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_SellOrder.Marshal(b, m, deterministic)
 }
-func (dst *SellOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SellOrder.Merge(dst, src)
+func (m *SellOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellOrder.Merge(m, src)
 }
 func (m *SellOrder) XXX_Size() int {
 	return xxx_messageInfo_SellOrder.Size(m)
@@ -872,21 +1060,48 @@ func (m *SellOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *SellOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *SellOrder) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *SellOrder) GetMakerFeeRate() int64 {
+	if m != nil {
+		return m.MakerFeeRate
+	}
+	return 0
+}
+
 // 限价买单数据库记录
 type BuyLimitOrder struct {
-	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	AmountPerBoardlot    int64    `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
-	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	PricePerBoardlot     int64    `protobuf:"varint,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
-	TotalBoardlot        int64    `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	BoughtBoardlot       int64    `protobuf:"varint,7,opt,name=boughtBoardlot,proto3" json:"boughtBoardlot,omitempty"`
-	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
-	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
-	Height               int64    `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
-	AssetExec            string   `protobuf:"bytes,11,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
-	PriceExec            string   `protobuf:"bytes,12,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,13,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenSymbol       string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Address           string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AmountPerBoardlot int64  `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	MinBoardlot       int64  `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
+	PricePerBoardlot  int64  `protobuf:"varint,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TotalBoardlot     int64  `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
+	BoughtBoardlot    int64  `protobuf:"varint,7,opt,name=boughtBoardlot,proto3" json:"boughtBoardlot,omitempty"`
+	BuyID             string `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Status            int32  `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	Height            int64  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	AssetExec         string `protobuf:"bytes,11,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec         string `protobuf:"bytes,12,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol       string `protobuf:"bytes,13,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight      int64  `protobuf:"varint,14,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime        int64  `protobuf:"varint,15,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	// 挂单时的挂单方手续费率, 万分之
+	MakerFeeRate int64 `protobuf:"varint,16,opt,name=makerFeeRate,proto3" json:"makerFeeRate,omitempty"`
+	// 未成交部分冻结的手续费
+	FeeFrozen            int64    `protobuf:"varint,17,opt,name=feeFrozen,proto3" json:"feeFrozen,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -896,16 +1111,17 @@ func (m *BuyLimitOrder) Reset()         { *m = BuyLimitOrder{} }
 func (m *BuyLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*BuyLimitOrder) ProtoMessage()    {}
 func (*BuyLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{8}
+	return fileDescriptor_ee944bd90e8a0312, []int{9}
 }
+
 func (m *BuyLimitOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BuyLimitOrder.Unmarshal(m, b)
 }
 func (m *BuyLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_BuyLimitOrder.Marshal(b, m, deterministic)
 }
-func (dst *BuyLimitOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuyLimitOrder.Merge(dst, src)
+func (m *BuyLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyLimitOrder.Merge(m, src)
 }
 func (m *BuyLimitOrder) XXX_Size() int {
 	return xxx_messageInfo_BuyLimitOrder.Size(m)
@@ -1007,23 +1223,59 @@ func (m *BuyLimitOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *BuyLimitOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *BuyLimitOrder) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *BuyLimitOrder) GetMakerFeeRate() int64 {
+	if m != nil {
+		return m.MakerFeeRate
+	}
+	return 0
+}
+
+func (m *BuyLimitOrder) GetFeeFrozen() int64 {
+	if m != nil {
+		return m.FeeFrozen
+	}
+	return 0
+}
+
 // 执行器日志部分
 type ReceiptBuyBase struct {
-	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	AmountPerBoardlot    string   `protobuf:"bytes,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
-	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	PricePerBoardlot     string   `protobuf:"bytes,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
-	TotalBoardlot        int64    `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	BoughtBoardlot       int64    `protobuf:"varint,7,opt,name=boughtBoardlot,proto3" json:"boughtBoardlot,omitempty"`
-	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
-	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	SellID               string   `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	TxHash               string   `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
-	AssetExec            string   `protobuf:"bytes,13,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
-	PriceExec            string   `protobuf:"bytes,14,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,15,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenSymbol       string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Owner             string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AmountPerBoardlot string `protobuf:"bytes,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	MinBoardlot       int64  `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
+	PricePerBoardlot  string `protobuf:"bytes,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TotalBoardlot     int64  `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
+	BoughtBoardlot    int64  `protobuf:"varint,7,opt,name=boughtBoardlot,proto3" json:"boughtBoardlot,omitempty"`
+	BuyID             string `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Status            string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	SellID            string `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	TxHash            string `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height            int64  `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	AssetExec         string `protobuf:"bytes,13,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec         string `protobuf:"bytes,14,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol       string `protobuf:"bytes,15,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight      int64  `protobuf:"varint,16,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime        int64  `protobuf:"varint,17,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	// 本次交易中作为挂单方/吃单方支付的手续费
+	MakerFee int64 `protobuf:"varint,18,opt,name=makerFee,proto3" json:"makerFee,omitempty"`
+	TakerFee int64 `protobuf:"varint,19,opt,name=takerFee,proto3" json:"takerFee,omitempty"`
+	// 每一手token的数量和价格, 未按精度格式化的原始值
+	Amount               int64    `protobuf:"varint,20,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                int64    `protobuf:"varint,21,opt,name=price,proto3" json:"price,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1033,16 +1285,17 @@ func (m *ReceiptBuyBase) Reset()         { *m = ReceiptBuyBase{} }
 func (m *ReceiptBuyBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptBuyBase) ProtoMessage()    {}
 func (*ReceiptBuyBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{9}
+	return fileDescriptor_ee944bd90e8a0312, []int{10}
 }
+
 func (m *ReceiptBuyBase) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptBuyBase.Unmarshal(m, b)
 }
 func (m *ReceiptBuyBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptBuyBase.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptBuyBase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptBuyBase.Merge(dst, src)
+func (m *ReceiptBuyBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptBuyBase.Merge(m, src)
 }
 func (m *ReceiptBuyBase) XXX_Size() int {
 	return xxx_messageInfo_ReceiptBuyBase.Size(m)
@@ -1158,30 +1411,80 @@ func (m *ReceiptBuyBase) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReceiptBuyBase) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetMakerFee() int64 {
+	if m != nil {
+		return m.MakerFee
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetTakerFee() int64 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
 type ReceiptSellBase struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
 	Owner       string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	// 每一手出售的token的数量
+	//每一手出售的token的数量
 	AmountPerBoardlot string `protobuf:"bytes,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
 	MinBoardlot       int64  `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	// 每一手token的价格
+	//每一手token的价格
 	PricePerBoardlot string `protobuf:"bytes,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
 	TotalBoardlot    int64  `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
 	SoldBoardlot     int64  `protobuf:"varint,7,opt,name=soldBoardlot,proto3" json:"soldBoardlot,omitempty"`
-	// 此次出售的起始时间，如果非众筹则可以忽略此时间
+	//此次出售的起始时间，如果非众筹则可以忽略此时间
 	Starttime int64 `protobuf:"varint,8,opt,name=starttime,proto3" json:"starttime,omitempty"`
 	Stoptime  int64 `protobuf:"varint,9,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
 	Crowdfund bool  `protobuf:"varint,10,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
-	// 此处使用tx的hash来指定
+	//此处使用tx的hash来指定
 	SellID string `protobuf:"bytes,11,opt,name=sellID,proto3" json:"sellID,omitempty"`
 	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
 	// buyid
-	BuyID                string   `protobuf:"bytes,13,opt,name=buyID,proto3" json:"buyID,omitempty"`
-	TxHash               string   `protobuf:"bytes,14,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	Height               int64    `protobuf:"varint,15,opt,name=height,proto3" json:"height,omitempty"`
-	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
-	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	BuyID        string `protobuf:"bytes,13,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	TxHash       string `protobuf:"bytes,14,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height       int64  `protobuf:"varint,15,opt,name=height,proto3" json:"height,omitempty"`
+	AssetExec    string `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec    string `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol  string `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight int64  `protobuf:"varint,19,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime   int64  `protobuf:"varint,20,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	// 本次交易中作为挂单方/吃单方支付的手续费
+	MakerFee int64 `protobuf:"varint,21,opt,name=makerFee,proto3" json:"makerFee,omitempty"`
+	TakerFee int64 `protobuf:"varint,22,opt,name=takerFee,proto3" json:"takerFee,omitempty"`
+	// 每一手token的数量和价格, 未按精度格式化的原始值
+	Amount               int64    `protobuf:"varint,23,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                int64    `protobuf:"varint,24,opt,name=price,proto3" json:"price,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1191,16 +1494,17 @@ func (m *ReceiptSellBase) Reset()         { *m = ReceiptSellBase{} }
 func (m *ReceiptSellBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellBase) ProtoMessage()    {}
 func (*ReceiptSellBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{10}
+	return fileDescriptor_ee944bd90e8a0312, []int{11}
 }
+
 func (m *ReceiptSellBase) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptSellBase.Unmarshal(m, b)
 }
 func (m *ReceiptSellBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptSellBase.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptSellBase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptSellBase.Merge(dst, src)
+func (m *ReceiptSellBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptSellBase.Merge(m, src)
 }
 func (m *ReceiptSellBase) XXX_Size() int {
 	return xxx_messageInfo_ReceiptSellBase.Size(m)
@@ -1337,27 +1641,70 @@ func (m *ReceiptSellBase) GetPriceSymbol() string {
 	return ""
 }
 
-type ReceiptTradeBuyMarket struct {
-	Base                 *ReceiptBuyBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ReceiptSellBase) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
 }
 
-func (m *ReceiptTradeBuyMarket) Reset()         { *m = ReceiptTradeBuyMarket{} }
-func (m *ReceiptTradeBuyMarket) String() string { return proto.CompactTextString(m) }
-func (*ReceiptTradeBuyMarket) ProtoMessage()    {}
-func (*ReceiptTradeBuyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{11}
-}
-func (m *ReceiptTradeBuyMarket) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReceiptTradeBuyMarket.Unmarshal(m, b)
+func (m *ReceiptSellBase) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
 }
-func (m *ReceiptTradeBuyMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReceiptTradeBuyMarket.Marshal(b, m, deterministic)
+
+func (m *ReceiptSellBase) GetMakerFee() int64 {
+	if m != nil {
+		return m.MakerFee
+	}
+	return 0
 }
-func (dst *ReceiptTradeBuyMarket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTradeBuyMarket.Merge(dst, src)
+
+func (m *ReceiptSellBase) GetTakerFee() int64 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
+func (m *ReceiptSellBase) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ReceiptSellBase) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type ReceiptTradeBuyMarket struct {
+	Base                 *ReceiptBuyBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptTradeBuyMarket) Reset()         { *m = ReceiptTradeBuyMarket{} }
+func (m *ReceiptTradeBuyMarket) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeBuyMarket) ProtoMessage()    {}
+func (*ReceiptTradeBuyMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{12}
+}
+
+func (m *ReceiptTradeBuyMarket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeBuyMarket.Unmarshal(m, b)
+}
+func (m *ReceiptTradeBuyMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeBuyMarket.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeBuyMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeBuyMarket.Merge(m, src)
 }
 func (m *ReceiptTradeBuyMarket) XXX_Size() int {
 	return xxx_messageInfo_ReceiptTradeBuyMarket.Size(m)
@@ -1386,16 +1733,17 @@ func (m *ReceiptTradeBuyLimit) Reset()         { *m = ReceiptTradeBuyLimit{} }
 func (m *ReceiptTradeBuyLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyLimit) ProtoMessage()    {}
 func (*ReceiptTradeBuyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{12}
+	return fileDescriptor_ee944bd90e8a0312, []int{13}
 }
+
 func (m *ReceiptTradeBuyLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptTradeBuyLimit.Unmarshal(m, b)
 }
 func (m *ReceiptTradeBuyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptTradeBuyLimit.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptTradeBuyLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTradeBuyLimit.Merge(dst, src)
+func (m *ReceiptTradeBuyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeBuyLimit.Merge(m, src)
 }
 func (m *ReceiptTradeBuyLimit) XXX_Size() int {
 	return xxx_messageInfo_ReceiptTradeBuyLimit.Size(m)
@@ -1424,16 +1772,17 @@ func (m *ReceiptTradeBuyRevoke) Reset()         { *m = ReceiptTradeBuyRevoke{} }
 func (m *ReceiptTradeBuyRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyRevoke) ProtoMessage()    {}
 func (*ReceiptTradeBuyRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{13}
+	return fileDescriptor_ee944bd90e8a0312, []int{14}
 }
+
 func (m *ReceiptTradeBuyRevoke) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptTradeBuyRevoke.Unmarshal(m, b)
 }
 func (m *ReceiptTradeBuyRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptTradeBuyRevoke.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptTradeBuyRevoke) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTradeBuyRevoke.Merge(dst, src)
+func (m *ReceiptTradeBuyRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeBuyRevoke.Merge(m, src)
 }
 func (m *ReceiptTradeBuyRevoke) XXX_Size() int {
 	return xxx_messageInfo_ReceiptTradeBuyRevoke.Size(m)
@@ -1462,16 +1811,17 @@ func (m *ReceiptTradeSellLimit) Reset()         { *m = ReceiptTradeSellLimit{} }
 func (m *ReceiptTradeSellLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellLimit) ProtoMessage()    {}
 func (*ReceiptTradeSellLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{14}
+	return fileDescriptor_ee944bd90e8a0312, []int{15}
 }
+
 func (m *ReceiptTradeSellLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptTradeSellLimit.Unmarshal(m, b)
 }
 func (m *ReceiptTradeSellLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptTradeSellLimit.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptTradeSellLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTradeSellLimit.Merge(dst, src)
+func (m *ReceiptTradeSellLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeSellLimit.Merge(m, src)
 }
 func (m *ReceiptTradeSellLimit) XXX_Size() int {
 	return xxx_messageInfo_ReceiptTradeSellLimit.Size(m)
@@ -1500,16 +1850,17 @@ func (m *ReceiptSellMarket) Reset()         { *m = ReceiptSellMarket{} }
 func (m *ReceiptSellMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellMarket) ProtoMessage()    {}
 func (*ReceiptSellMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{15}
+	return fileDescriptor_ee944bd90e8a0312, []int{16}
 }
+
 func (m *ReceiptSellMarket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptSellMarket.Unmarshal(m, b)
 }
 func (m *ReceiptSellMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptSellMarket.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptSellMarket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptSellMarket.Merge(dst, src)
+func (m *ReceiptSellMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptSellMarket.Merge(m, src)
 }
 func (m *ReceiptSellMarket) XXX_Size() int {
 	return xxx_messageInfo_ReceiptSellMarket.Size(m)
@@ -1538,16 +1889,17 @@ func (m *ReceiptTradeSellRevoke) Reset()         { *m = ReceiptTradeSellRevoke{}
 func (m *ReceiptTradeSellRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellRevoke) ProtoMessage()    {}
 func (*ReceiptTradeSellRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{16}
+	return fileDescriptor_ee944bd90e8a0312, []int{17}
 }
+
 func (m *ReceiptTradeSellRevoke) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptTradeSellRevoke.Unmarshal(m, b)
 }
 func (m *ReceiptTradeSellRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReceiptTradeSellRevoke.Marshal(b, m, deterministic)
 }
-func (dst *ReceiptTradeSellRevoke) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTradeSellRevoke.Merge(dst, src)
+func (m *ReceiptTradeSellRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeSellRevoke.Merge(m, src)
 }
 func (m *ReceiptTradeSellRevoke) XXX_Size() int {
 	return xxx_messageInfo_ReceiptTradeSellRevoke.Size(m)
@@ -1565,6 +1917,150 @@ func (m *ReceiptTradeSellRevoke) GetBase() *ReceiptSellBase {
 	return nil
 }
 
+// 撮合成交日志, 每一笔成交一条
+type ReceiptTradeMatch struct {
+	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AssetExec            string   `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,3,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,4,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	AmountPerBoardlot    int64    `protobuf:"varint,5,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	PricePerBoardlot     int64    `protobuf:"varint,6,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	BoardlotCnt          int64    `protobuf:"varint,7,opt,name=boardlotCnt,proto3" json:"boardlotCnt,omitempty"`
+	MakerOrderID         string   `protobuf:"bytes,8,opt,name=makerOrderID,proto3" json:"makerOrderID,omitempty"`
+	Maker                string   `protobuf:"bytes,9,opt,name=maker,proto3" json:"maker,omitempty"`
+	TakerOrderID         string   `protobuf:"bytes,10,opt,name=takerOrderID,proto3" json:"takerOrderID,omitempty"`
+	Taker                string   `protobuf:"bytes,11,opt,name=taker,proto3" json:"taker,omitempty"`
+	TakerIsSell          bool     `protobuf:"varint,12,opt,name=takerIsSell,proto3" json:"takerIsSell,omitempty"`
+	TxHash               string   `protobuf:"bytes,13,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,14,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTradeMatch) Reset()         { *m = ReceiptTradeMatch{} }
+func (m *ReceiptTradeMatch) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeMatch) ProtoMessage()    {}
+func (*ReceiptTradeMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{18}
+}
+
+func (m *ReceiptTradeMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeMatch.Unmarshal(m, b)
+}
+func (m *ReceiptTradeMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeMatch.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeMatch.Merge(m, src)
+}
+func (m *ReceiptTradeMatch) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeMatch.Size(m)
+}
+func (m *ReceiptTradeMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeMatch proto.InternalMessageInfo
+
+func (m *ReceiptTradeMatch) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetAmountPerBoardlot() int64 {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetBoardlotCnt() int64 {
+	if m != nil {
+		return m.BoardlotCnt
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetMakerOrderID() string {
+	if m != nil {
+		return m.MakerOrderID
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetTakerOrderID() string {
+	if m != nil {
+		return m.TakerOrderID
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetTaker() string {
+	if m != nil {
+		return m.Taker
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetTakerIsSell() bool {
+	if m != nil {
+		return m.TakerIsSell
+	}
+	return false
+}
+
+func (m *ReceiptTradeMatch) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ReqAddrAssets struct {
 	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1582,16 +2078,17 @@ func (m *ReqAddrAssets) Reset()         { *m = ReqAddrAssets{} }
 func (m *ReqAddrAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrAssets) ProtoMessage()    {}
 func (*ReqAddrAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{17}
+	return fileDescriptor_ee944bd90e8a0312, []int{19}
 }
+
 func (m *ReqAddrAssets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqAddrAssets.Unmarshal(m, b)
 }
 func (m *ReqAddrAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqAddrAssets.Marshal(b, m, deterministic)
 }
-func (dst *ReqAddrAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqAddrAssets.Merge(dst, src)
+func (m *ReqAddrAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAddrAssets.Merge(m, src)
 }
 func (m *ReqAddrAssets) XXX_Size() int {
 	return xxx_messageInfo_ReqAddrAssets.Size(m)
@@ -1645,7 +2142,9 @@ func (m *ReqAddrAssets) GetFromKey() string {
 }
 
 // 获取Token未完成卖单的交易列表
-// 	 fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
+//	fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
 // 传当前页最后一个；当要获得上一页时， 传当前页第一个。 	 count
 // :获取交易列表的个数。 	 direction :查找方式；0，上一页；1，下一页。
 // 越靠后的也单价越贵
@@ -1664,16 +2163,17 @@ func (m *ReqTokenSellOrder) Reset()         { *m = ReqTokenSellOrder{} }
 func (m *ReqTokenSellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenSellOrder) ProtoMessage()    {}
 func (*ReqTokenSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{18}
+	return fileDescriptor_ee944bd90e8a0312, []int{20}
 }
+
 func (m *ReqTokenSellOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokenSellOrder.Unmarshal(m, b)
 }
 func (m *ReqTokenSellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqTokenSellOrder.Marshal(b, m, deterministic)
 }
-func (dst *ReqTokenSellOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokenSellOrder.Merge(dst, src)
+func (m *ReqTokenSellOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenSellOrder.Merge(m, src)
 }
 func (m *ReqTokenSellOrder) XXX_Size() int {
 	return xxx_messageInfo_ReqTokenSellOrder.Size(m)
@@ -1734,16 +2234,17 @@ func (m *ReqTokenBuyOrder) Reset()         { *m = ReqTokenBuyOrder{} }
 func (m *ReqTokenBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBuyOrder) ProtoMessage()    {}
 func (*ReqTokenBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{19}
+	return fileDescriptor_ee944bd90e8a0312, []int{21}
 }
+
 func (m *ReqTokenBuyOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqTokenBuyOrder.Unmarshal(m, b)
 }
 func (m *ReqTokenBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqTokenBuyOrder.Marshal(b, m, deterministic)
 }
-func (dst *ReqTokenBuyOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokenBuyOrder.Merge(dst, src)
+func (m *ReqTokenBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenBuyOrder.Merge(m, src)
 }
 func (m *ReqTokenBuyOrder) XXX_Size() int {
 	return xxx_messageInfo_ReqTokenBuyOrder.Size(m)
@@ -1813,16 +2314,17 @@ func (m *ReplyBuyOrder) Reset()         { *m = ReplyBuyOrder{} }
 func (m *ReplyBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrder) ProtoMessage()    {}
 func (*ReplyBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{20}
+	return fileDescriptor_ee944bd90e8a0312, []int{22}
 }
+
 func (m *ReplyBuyOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyBuyOrder.Unmarshal(m, b)
 }
 func (m *ReplyBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyBuyOrder.Marshal(b, m, deterministic)
 }
-func (dst *ReplyBuyOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyBuyOrder.Merge(dst, src)
+func (m *ReplyBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyBuyOrder.Merge(m, src)
 }
 func (m *ReplyBuyOrder) XXX_Size() int {
 	return xxx_messageInfo_ReplyBuyOrder.Size(m)
@@ -1955,16 +2457,17 @@ func (m *ReplySellOrder) Reset()         { *m = ReplySellOrder{} }
 func (m *ReplySellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrder) ProtoMessage()    {}
 func (*ReplySellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{21}
+	return fileDescriptor_ee944bd90e8a0312, []int{23}
 }
+
 func (m *ReplySellOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplySellOrder.Unmarshal(m, b)
 }
 func (m *ReplySellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplySellOrder.Marshal(b, m, deterministic)
 }
-func (dst *ReplySellOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplySellOrder.Merge(dst, src)
+func (m *ReplySellOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySellOrder.Merge(m, src)
 }
 func (m *ReplySellOrder) XXX_Size() int {
 	return xxx_messageInfo_ReplySellOrder.Size(m)
@@ -2084,16 +2587,17 @@ func (m *ReplySellOrders) Reset()         { *m = ReplySellOrders{} }
 func (m *ReplySellOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrders) ProtoMessage()    {}
 func (*ReplySellOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{22}
+	return fileDescriptor_ee944bd90e8a0312, []int{24}
 }
+
 func (m *ReplySellOrders) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplySellOrders.Unmarshal(m, b)
 }
 func (m *ReplySellOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplySellOrders.Marshal(b, m, deterministic)
 }
-func (dst *ReplySellOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplySellOrders.Merge(dst, src)
+func (m *ReplySellOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySellOrders.Merge(m, src)
 }
 func (m *ReplySellOrders) XXX_Size() int {
 	return xxx_messageInfo_ReplySellOrders.Size(m)
@@ -2122,16 +2626,17 @@ func (m *ReplyBuyOrders) Reset()         { *m = ReplyBuyOrders{} }
 func (m *ReplyBuyOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrders) ProtoMessage()    {}
 func (*ReplyBuyOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{23}
+	return fileDescriptor_ee944bd90e8a0312, []int{25}
 }
+
 func (m *ReplyBuyOrders) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyBuyOrders.Unmarshal(m, b)
 }
 func (m *ReplyBuyOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyBuyOrders.Marshal(b, m, deterministic)
 }
-func (dst *ReplyBuyOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyBuyOrders.Merge(dst, src)
+func (m *ReplyBuyOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyBuyOrders.Merge(m, src)
 }
 func (m *ReplyBuyOrders) XXX_Size() int {
 	return xxx_messageInfo_ReplyBuyOrders.Size(m)
@@ -2150,24 +2655,29 @@ func (m *ReplyBuyOrders) GetBuyOrders() []*ReplyBuyOrder {
 }
 
 type ReplyTradeOrder struct {
-	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	AmountPerBoardlot    int64    `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
-	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	PricePerBoardlot     int64    `protobuf:"varint,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
-	TotalBoardlot        int64    `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	TradedBoardlot       int64    `protobuf:"varint,7,opt,name=tradedBoardlot,proto3" json:"tradedBoardlot,omitempty"`
-	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
-	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
-	SellID               string   `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	TxHash               string   `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
-	Key                  string   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
-	BlockTime            int64    `protobuf:"varint,14,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
-	IsSellOrder          bool     `protobuf:"varint,15,opt,name=isSellOrder,proto3" json:"isSellOrder,omitempty"`
-	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
-	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenSymbol       string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Owner             string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AmountPerBoardlot int64  `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	MinBoardlot       int64  `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
+	PricePerBoardlot  int64  `protobuf:"varint,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TotalBoardlot     int64  `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
+	TradedBoardlot    int64  `protobuf:"varint,7,opt,name=tradedBoardlot,proto3" json:"tradedBoardlot,omitempty"`
+	BuyID             string `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Status            int32  `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	SellID            string `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	TxHash            string `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height            int64  `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	Key               string `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
+	BlockTime         int64  `protobuf:"varint,14,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	IsSellOrder       bool   `protobuf:"varint,15,opt,name=isSellOrder,proto3" json:"isSellOrder,omitempty"`
+	AssetExec         string `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec         string `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol       string `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight      int64  `protobuf:"varint,19,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime        int64  `protobuf:"varint,20,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	// 累计支付的手续费
+	MakerFee             int64    `protobuf:"varint,21,opt,name=makerFee,proto3" json:"makerFee,omitempty"`
+	TakerFee             int64    `protobuf:"varint,22,opt,name=takerFee,proto3" json:"takerFee,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2177,16 +2687,17 @@ func (m *ReplyTradeOrder) Reset()         { *m = ReplyTradeOrder{} }
 func (m *ReplyTradeOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrder) ProtoMessage()    {}
 func (*ReplyTradeOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{24}
+	return fileDescriptor_ee944bd90e8a0312, []int{26}
 }
+
 func (m *ReplyTradeOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyTradeOrder.Unmarshal(m, b)
 }
 func (m *ReplyTradeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyTradeOrder.Marshal(b, m, deterministic)
 }
-func (dst *ReplyTradeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTradeOrder.Merge(dst, src)
+func (m *ReplyTradeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeOrder.Merge(m, src)
 }
 func (m *ReplyTradeOrder) XXX_Size() int {
 	return xxx_messageInfo_ReplyTradeOrder.Size(m)
@@ -2323,6 +2834,34 @@ func (m *ReplyTradeOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReplyTradeOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *ReplyTradeOrder) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *ReplyTradeOrder) GetMakerFee() int64 {
+	if m != nil {
+		return m.MakerFee
+	}
+	return 0
+}
+
+func (m *ReplyTradeOrder) GetTakerFee() int64 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
 type ReplyTradeOrders struct {
 	Orders               []*ReplyTradeOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -2334,16 +2873,17 @@ func (m *ReplyTradeOrders) Reset()         { *m = ReplyTradeOrders{} }
 func (m *ReplyTradeOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrders) ProtoMessage()    {}
 func (*ReplyTradeOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{25}
+	return fileDescriptor_ee944bd90e8a0312, []int{27}
 }
+
 func (m *ReplyTradeOrders) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplyTradeOrders.Unmarshal(m, b)
 }
 func (m *ReplyTradeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReplyTradeOrders.Marshal(b, m, deterministic)
 }
-func (dst *ReplyTradeOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTradeOrders.Merge(dst, src)
+func (m *ReplyTradeOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeOrders.Merge(m, src)
 }
 func (m *ReplyTradeOrders) XXX_Size() int {
 	return xxx_messageInfo_ReplyTradeOrders.Size(m)
@@ -2361,101 +2901,805 @@ func (m *ReplyTradeOrders) GetOrders() []*ReplyTradeOrder {
 	return nil
 }
 
-type ReqSellToken struct {
-	Sell                 *TradeForSell `protobuf:"bytes,1,opt,name=sell,proto3" json:"sell,omitempty"`
-	Owner                string        `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// 查询市场深度, 市场由 资产/定价资产/每手数量 确定
+type ReqMarketDepth struct {
+	AssetExec         string `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TokenSymbol       string `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	PriceExec         string `protobuf:"bytes,3,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol       string `protobuf:"bytes,4,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	AmountPerBoardlot int64  `protobuf:"varint,5,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	// 每一侧返回的价位个数
+	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqSellToken) Reset()         { *m = ReqSellToken{} }
-func (m *ReqSellToken) String() string { return proto.CompactTextString(m) }
-func (*ReqSellToken) ProtoMessage()    {}
-func (*ReqSellToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{26}
+func (m *ReqMarketDepth) Reset()         { *m = ReqMarketDepth{} }
+func (m *ReqMarketDepth) String() string { return proto.CompactTextString(m) }
+func (*ReqMarketDepth) ProtoMessage()    {}
+func (*ReqMarketDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{28}
 }
-func (m *ReqSellToken) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqSellToken.Unmarshal(m, b)
+
+func (m *ReqMarketDepth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMarketDepth.Unmarshal(m, b)
 }
-func (m *ReqSellToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqSellToken.Marshal(b, m, deterministic)
+func (m *ReqMarketDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMarketDepth.Marshal(b, m, deterministic)
 }
-func (dst *ReqSellToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqSellToken.Merge(dst, src)
+func (m *ReqMarketDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMarketDepth.Merge(m, src)
 }
-func (m *ReqSellToken) XXX_Size() int {
-	return xxx_messageInfo_ReqSellToken.Size(m)
+func (m *ReqMarketDepth) XXX_Size() int {
+	return xxx_messageInfo_ReqMarketDepth.Size(m)
 }
-func (m *ReqSellToken) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqSellToken.DiscardUnknown(m)
+func (m *ReqMarketDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMarketDepth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqSellToken proto.InternalMessageInfo
+var xxx_messageInfo_ReqMarketDepth proto.InternalMessageInfo
 
-func (m *ReqSellToken) GetSell() *TradeForSell {
+func (m *ReqMarketDepth) GetAssetExec() string {
 	if m != nil {
-		return m.Sell
+		return m.AssetExec
 	}
-	return nil
+	return ""
 }
 
-func (m *ReqSellToken) GetOwner() string {
+func (m *ReqMarketDepth) GetTokenSymbol() string {
 	if m != nil {
-		return m.Owner
+		return m.TokenSymbol
 	}
 	return ""
 }
 
-type ReqRevokeSell struct {
-	Revoke               *TradeForRevokeSell `protobuf:"bytes,1,opt,name=revoke,proto3" json:"revoke,omitempty"`
-	Owner                string              `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *ReqMarketDepth) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
 }
 
-func (m *ReqRevokeSell) Reset()         { *m = ReqRevokeSell{} }
-func (m *ReqRevokeSell) String() string { return proto.CompactTextString(m) }
-func (*ReqRevokeSell) ProtoMessage()    {}
-func (*ReqRevokeSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{27}
-}
-func (m *ReqRevokeSell) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqRevokeSell.Unmarshal(m, b)
-}
-func (m *ReqRevokeSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqRevokeSell.Marshal(b, m, deterministic)
-}
-func (dst *ReqRevokeSell) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqRevokeSell.Merge(dst, src)
-}
-func (m *ReqRevokeSell) XXX_Size() int {
-	return xxx_messageInfo_ReqRevokeSell.Size(m)
-}
-func (m *ReqRevokeSell) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqRevokeSell.DiscardUnknown(m)
+func (m *ReqMarketDepth) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
 }
 
-var xxx_messageInfo_ReqRevokeSell proto.InternalMessageInfo
-
-func (m *ReqRevokeSell) GetRevoke() *TradeForRevokeSell {
+func (m *ReqMarketDepth) GetAmountPerBoardlot() int64 {
 	if m != nil {
-		return m.Revoke
+		return m.AmountPerBoardlot
 	}
-	return nil
+	return 0
 }
 
-func (m *ReqRevokeSell) GetOwner() string {
+func (m *ReqMarketDepth) GetCount() int32 {
 	if m != nil {
-		return m.Owner
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-type ReqBuyToken struct {
-	Buy                  *TradeForBuy `protobuf:"bytes,1,opt,name=buy,proto3" json:"buy,omitempty"`
-	Buyer                string       `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+type MarketDepthLevel struct {
+	PricePerBoardlot     int64    `protobuf:"varint,1,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	BoardlotCnt          int64    `protobuf:"varint,2,opt,name=boardlotCnt,proto3" json:"boardlotCnt,omitempty"`
+	OrderCount           int32    `protobuf:"varint,3,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketDepthLevel) Reset()         { *m = MarketDepthLevel{} }
+func (m *MarketDepthLevel) String() string { return proto.CompactTextString(m) }
+func (*MarketDepthLevel) ProtoMessage()    {}
+func (*MarketDepthLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{29}
+}
+
+func (m *MarketDepthLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketDepthLevel.Unmarshal(m, b)
+}
+func (m *MarketDepthLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketDepthLevel.Marshal(b, m, deterministic)
+}
+func (m *MarketDepthLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDepthLevel.Merge(m, src)
+}
+func (m *MarketDepthLevel) XXX_Size() int {
+	return xxx_messageInfo_MarketDepthLevel.Size(m)
+}
+func (m *MarketDepthLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDepthLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketDepthLevel proto.InternalMessageInfo
+
+func (m *MarketDepthLevel) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *MarketDepthLevel) GetBoardlotCnt() int64 {
+	if m != nil {
+		return m.BoardlotCnt
+	}
+	return 0
+}
+
+func (m *MarketDepthLevel) GetOrderCount() int32 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+// bids 价格从高到低, asks 价格从低到高, 第一个即为最优买价/卖价
+type ReplyMarketDepth struct {
+	Bids                 []*MarketDepthLevel `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks                 []*MarketDepthLevel `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReplyMarketDepth) Reset()         { *m = ReplyMarketDepth{} }
+func (m *ReplyMarketDepth) String() string { return proto.CompactTextString(m) }
+func (*ReplyMarketDepth) ProtoMessage()    {}
+func (*ReplyMarketDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{30}
+}
+
+func (m *ReplyMarketDepth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMarketDepth.Unmarshal(m, b)
+}
+func (m *ReplyMarketDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMarketDepth.Marshal(b, m, deterministic)
+}
+func (m *ReplyMarketDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMarketDepth.Merge(m, src)
+}
+func (m *ReplyMarketDepth) XXX_Size() int {
+	return xxx_messageInfo_ReplyMarketDepth.Size(m)
+}
+func (m *ReplyMarketDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMarketDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMarketDepth proto.InternalMessageInfo
+
+func (m *ReplyMarketDepth) GetBids() []*MarketDepthLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *ReplyMarketDepth) GetAsks() []*MarketDepthLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+// 成交记录, 市场 = 资产/定价资产
+type TradeFill struct {
+	AssetExec   string `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TokenSymbol string `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	PriceExec   string `protobuf:"bytes,3,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,4,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// 每个完整token(1e8)的价格
+	Price int64 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	// 成交的token数量
+	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 成交的定价资产数量
+	Volume               int64    `protobuf:"varint,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	MakerOrderID         string   `protobuf:"bytes,8,opt,name=makerOrderID,proto3" json:"makerOrderID,omitempty"`
+	TakerOrderID         string   `protobuf:"bytes,9,opt,name=takerOrderID,proto3" json:"takerOrderID,omitempty"`
+	Taker                string   `protobuf:"bytes,10,opt,name=taker,proto3" json:"taker,omitempty"`
+	TakerIsSell          bool     `protobuf:"varint,11,opt,name=takerIsSell,proto3" json:"takerIsSell,omitempty"`
+	TxHash               string   `protobuf:"bytes,12,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64    `protobuf:"varint,14,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	Key                  string   `protobuf:"bytes,15,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeFill) Reset()         { *m = TradeFill{} }
+func (m *TradeFill) String() string { return proto.CompactTextString(m) }
+func (*TradeFill) ProtoMessage()    {}
+func (*TradeFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{31}
+}
+
+func (m *TradeFill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeFill.Unmarshal(m, b)
+}
+func (m *TradeFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeFill.Marshal(b, m, deterministic)
+}
+func (m *TradeFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeFill.Merge(m, src)
+}
+func (m *TradeFill) XXX_Size() int {
+	return xxx_messageInfo_TradeFill.Size(m)
+}
+func (m *TradeFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeFill proto.InternalMessageInfo
+
+func (m *TradeFill) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *TradeFill) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *TradeFill) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *TradeFill) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *TradeFill) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TradeFill) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TradeFill) GetVolume() int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *TradeFill) GetMakerOrderID() string {
+	if m != nil {
+		return m.MakerOrderID
+	}
+	return ""
+}
+
+func (m *TradeFill) GetTakerOrderID() string {
+	if m != nil {
+		return m.TakerOrderID
+	}
+	return ""
+}
+
+func (m *TradeFill) GetTaker() string {
+	if m != nil {
+		return m.Taker
+	}
+	return ""
+}
+
+func (m *TradeFill) GetTakerIsSell() bool {
+	if m != nil {
+		return m.TakerIsSell
+	}
+	return false
+}
+
+func (m *TradeFill) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TradeFill) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TradeFill) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *TradeFill) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// 查询成交历史
+// fromKey : 第一次传参为空, 之后传上一页最后一条的key
+// direction : 0, 从新到旧; 1, 从旧到新
+type ReqTradeHistory struct {
+	AssetExec            string   `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TokenSymbol          string   `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	PriceExec            string   `protobuf:"bytes,3,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,4,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	FromKey              string   `protobuf:"bytes,5,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,7,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTradeHistory) Reset()         { *m = ReqTradeHistory{} }
+func (m *ReqTradeHistory) String() string { return proto.CompactTextString(m) }
+func (*ReqTradeHistory) ProtoMessage()    {}
+func (*ReqTradeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{32}
+}
+
+func (m *ReqTradeHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTradeHistory.Unmarshal(m, b)
+}
+func (m *ReqTradeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTradeHistory.Marshal(b, m, deterministic)
+}
+func (m *ReqTradeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTradeHistory.Merge(m, src)
+}
+func (m *ReqTradeHistory) XXX_Size() int {
+	return xxx_messageInfo_ReqTradeHistory.Size(m)
+}
+func (m *ReqTradeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTradeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTradeHistory proto.InternalMessageInfo
+
+func (m *ReqTradeHistory) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReqTradeHistory) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReqTradeHistory) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReqTradeHistory) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *ReqTradeHistory) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
+}
+
+func (m *ReqTradeHistory) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqTradeHistory) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyTradeHistory struct {
+	Fills                []*TradeFill `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReplyTradeHistory) Reset()         { *m = ReplyTradeHistory{} }
+func (m *ReplyTradeHistory) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeHistory) ProtoMessage()    {}
+func (*ReplyTradeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{33}
+}
+
+func (m *ReplyTradeHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeHistory.Unmarshal(m, b)
+}
+func (m *ReplyTradeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeHistory.Marshal(b, m, deterministic)
+}
+func (m *ReplyTradeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeHistory.Merge(m, src)
+}
+func (m *ReplyTradeHistory) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeHistory.Size(m)
+}
+func (m *ReplyTradeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTradeHistory proto.InternalMessageInfo
+
+func (m *ReplyTradeHistory) GetFills() []*TradeFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+// K线, 价格为每个完整token(1e8)的价格
+type Candle struct {
+	StartTime int64 `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Open      int64 `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	High      int64 `protobuf:"varint,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low       int64 `protobuf:"varint,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close     int64 `protobuf:"varint,5,opt,name=close,proto3" json:"close,omitempty"`
+	// 成交的token数量
+	Amount int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 成交的定价资产数量
+	Volume               int64    `protobuf:"varint,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	Count                int64    `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{34}
+}
+
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Candle.Unmarshal(m, b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return xxx_messageInfo_Candle.Size(m)
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Candle) GetOpen() int64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *Candle) GetHigh() int64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *Candle) GetLow() int64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *Candle) GetClose() int64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *Candle) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Candle) GetVolume() int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *Candle) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// 查询K线, interval 支持 1m, 1h, 1d
+// startTime/endTime 为0表示不限制, 结果按时间从旧到新排列
+type ReqCandles struct {
+	AssetExec            string   `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TokenSymbol          string   `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	PriceExec            string   `protobuf:"bytes,3,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,4,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	Interval             string   `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartTime            int64    `protobuf:"varint,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Count                int32    `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCandles) Reset()         { *m = ReqCandles{} }
+func (m *ReqCandles) String() string { return proto.CompactTextString(m) }
+func (*ReqCandles) ProtoMessage()    {}
+func (*ReqCandles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{35}
+}
+
+func (m *ReqCandles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCandles.Unmarshal(m, b)
+}
+func (m *ReqCandles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCandles.Marshal(b, m, deterministic)
+}
+func (m *ReqCandles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCandles.Merge(m, src)
+}
+func (m *ReqCandles) XXX_Size() int {
+	return xxx_messageInfo_ReqCandles.Size(m)
+}
+func (m *ReqCandles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCandles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCandles proto.InternalMessageInfo
+
+func (m *ReqCandles) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReqCandles) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReqCandles) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReqCandles) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *ReqCandles) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *ReqCandles) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ReqCandles) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ReqCandles) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyCandles struct {
+	Candles              []*Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReplyCandles) Reset()         { *m = ReplyCandles{} }
+func (m *ReplyCandles) String() string { return proto.CompactTextString(m) }
+func (*ReplyCandles) ProtoMessage()    {}
+func (*ReplyCandles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{36}
+}
+
+func (m *ReplyCandles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyCandles.Unmarshal(m, b)
+}
+func (m *ReplyCandles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyCandles.Marshal(b, m, deterministic)
+}
+func (m *ReplyCandles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyCandles.Merge(m, src)
+}
+func (m *ReplyCandles) XXX_Size() int {
+	return xxx_messageInfo_ReplyCandles.Size(m)
+}
+func (m *ReplyCandles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyCandles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyCandles proto.InternalMessageInfo
+
+func (m *ReplyCandles) GetCandles() []*Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+type ReqSellToken struct {
+	Sell                 *TradeForSell `protobuf:"bytes,1,opt,name=sell,proto3" json:"sell,omitempty"`
+	Owner                string        `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReqSellToken) Reset()         { *m = ReqSellToken{} }
+func (m *ReqSellToken) String() string { return proto.CompactTextString(m) }
+func (*ReqSellToken) ProtoMessage()    {}
+func (*ReqSellToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{37}
+}
+
+func (m *ReqSellToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSellToken.Unmarshal(m, b)
+}
+func (m *ReqSellToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSellToken.Marshal(b, m, deterministic)
+}
+func (m *ReqSellToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSellToken.Merge(m, src)
+}
+func (m *ReqSellToken) XXX_Size() int {
+	return xxx_messageInfo_ReqSellToken.Size(m)
+}
+func (m *ReqSellToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSellToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSellToken proto.InternalMessageInfo
+
+func (m *ReqSellToken) GetSell() *TradeForSell {
+	if m != nil {
+		return m.Sell
+	}
+	return nil
+}
+
+func (m *ReqSellToken) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type ReqRevokeSell struct {
+	Revoke               *TradeForRevokeSell `protobuf:"bytes,1,opt,name=revoke,proto3" json:"revoke,omitempty"`
+	Owner                string              `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReqRevokeSell) Reset()         { *m = ReqRevokeSell{} }
+func (m *ReqRevokeSell) String() string { return proto.CompactTextString(m) }
+func (*ReqRevokeSell) ProtoMessage()    {}
+func (*ReqRevokeSell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{38}
+}
+
+func (m *ReqRevokeSell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRevokeSell.Unmarshal(m, b)
+}
+func (m *ReqRevokeSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRevokeSell.Marshal(b, m, deterministic)
+}
+func (m *ReqRevokeSell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRevokeSell.Merge(m, src)
+}
+func (m *ReqRevokeSell) XXX_Size() int {
+	return xxx_messageInfo_ReqRevokeSell.Size(m)
+}
+func (m *ReqRevokeSell) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRevokeSell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRevokeSell proto.InternalMessageInfo
+
+func (m *ReqRevokeSell) GetRevoke() *TradeForRevokeSell {
+	if m != nil {
+		return m.Revoke
+	}
+	return nil
+}
+
+func (m *ReqRevokeSell) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type ReqBuyToken struct {
+	Buy                  *TradeForBuy `protobuf:"bytes,1,opt,name=buy,proto3" json:"buy,omitempty"`
+	Buyer                string       `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -2465,16 +3709,17 @@ func (m *ReqBuyToken) Reset()         { *m = ReqBuyToken{} }
 func (m *ReqBuyToken) String() string { return proto.CompactTextString(m) }
 func (*ReqBuyToken) ProtoMessage()    {}
 func (*ReqBuyToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{28}
+	return fileDescriptor_ee944bd90e8a0312, []int{39}
 }
+
 func (m *ReqBuyToken) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReqBuyToken.Unmarshal(m, b)
 }
 func (m *ReqBuyToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ReqBuyToken.Marshal(b, m, deterministic)
 }
-func (dst *ReqBuyToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqBuyToken.Merge(dst, src)
+func (m *ReqBuyToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBuyToken.Merge(m, src)
 }
 func (m *ReqBuyToken) XXX_Size() int {
 	return xxx_messageInfo_ReqBuyToken.Size(m)
@@ -2520,6 +3765,10 @@ type LocalOrder struct {
 	IsFinished           bool     `protobuf:"varint,18,opt,name=isFinished,proto3" json:"isFinished,omitempty"`
 	PriceExec            string   `protobuf:"bytes,19,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,20,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,21,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,22,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	MakerFee             int64    `protobuf:"varint,23,opt,name=makerFee,proto3" json:"makerFee,omitempty"`
+	TakerFee             int64    `protobuf:"varint,24,opt,name=takerFee,proto3" json:"takerFee,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2529,16 +3778,17 @@ func (m *LocalOrder) Reset()         { *m = LocalOrder{} }
 func (m *LocalOrder) String() string { return proto.CompactTextString(m) }
 func (*LocalOrder) ProtoMessage()    {}
 func (*LocalOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_trade_05e36a4efd974a28, []int{29}
+	return fileDescriptor_ee944bd90e8a0312, []int{40}
 }
+
 func (m *LocalOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocalOrder.Unmarshal(m, b)
 }
 func (m *LocalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_LocalOrder.Marshal(b, m, deterministic)
 }
-func (dst *LocalOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LocalOrder.Merge(dst, src)
+func (m *LocalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalOrder.Merge(m, src)
 }
 func (m *LocalOrder) XXX_Size() int {
 	return xxx_messageInfo_LocalOrder.Size(m)
@@ -2689,6 +3939,34 @@ func (m *LocalOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *LocalOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *LocalOrder) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *LocalOrder) GetMakerFee() int64 {
+	if m != nil {
+		return m.MakerFee
+	}
+	return 0
+}
+
+func (m *LocalOrder) GetTakerFee() int64 {
+	if m != nil {
+		return m.TakerFee
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Trade)(nil), "types.Trade")
 	proto.RegisterType((*TradeForSell)(nil), "types.TradeForSell")
@@ -2697,6 +3975,7 @@ func init() {
 	proto.RegisterType((*TradeForBuyLimit)(nil), "types.TradeForBuyLimit")
 	proto.RegisterType((*TradeForSellMarket)(nil), "types.TradeForSellMarket")
 	proto.RegisterType((*TradeForRevokeBuy)(nil), "types.TradeForRevokeBuy")
+	proto.RegisterType((*TradeForLimitOrder)(nil), "types.TradeForLimitOrder")
 	proto.RegisterType((*SellOrder)(nil), "types.SellOrder")
 	proto.RegisterType((*BuyLimitOrder)(nil), "types.BuyLimitOrder")
 	proto.RegisterType((*ReceiptBuyBase)(nil), "types.ReceiptBuyBase")
@@ -2707,6 +3986,7 @@ func init() {
 	proto.RegisterType((*ReceiptTradeSellLimit)(nil), "types.ReceiptTradeSellLimit")
 	proto.RegisterType((*ReceiptSellMarket)(nil), "types.ReceiptSellMarket")
 	proto.RegisterType((*ReceiptTradeSellRevoke)(nil), "types.ReceiptTradeSellRevoke")
+	proto.RegisterType((*ReceiptTradeMatch)(nil), "types.ReceiptTradeMatch")
 	proto.RegisterType((*ReqAddrAssets)(nil), "types.ReqAddrAssets")
 	proto.RegisterType((*ReqTokenSellOrder)(nil), "types.ReqTokenSellOrder")
 	proto.RegisterType((*ReqTokenBuyOrder)(nil), "types.ReqTokenBuyOrder")
@@ -2716,12 +3996,155 @@ func init() {
 	proto.RegisterType((*ReplyBuyOrders)(nil), "types.ReplyBuyOrders")
 	proto.RegisterType((*ReplyTradeOrder)(nil), "types.ReplyTradeOrder")
 	proto.RegisterType((*ReplyTradeOrders)(nil), "types.ReplyTradeOrders")
+	proto.RegisterType((*ReqMarketDepth)(nil), "types.ReqMarketDepth")
+	proto.RegisterType((*MarketDepthLevel)(nil), "types.MarketDepthLevel")
+	proto.RegisterType((*ReplyMarketDepth)(nil), "types.ReplyMarketDepth")
+	proto.RegisterType((*TradeFill)(nil), "types.TradeFill")
+	proto.RegisterType((*ReqTradeHistory)(nil), "types.ReqTradeHistory")
+	proto.RegisterType((*ReplyTradeHistory)(nil), "types.ReplyTradeHistory")
+	proto.RegisterType((*Candle)(nil), "types.Candle")
+	proto.RegisterType((*ReqCandles)(nil), "types.ReqCandles")
+	proto.RegisterType((*ReplyCandles)(nil), "types.ReplyCandles")
 	proto.RegisterType((*ReqSellToken)(nil), "types.ReqSellToken")
 	proto.RegisterType((*ReqRevokeSell)(nil), "types.ReqRevokeSell")
 	proto.RegisterType((*ReqBuyToken)(nil), "types.ReqBuyToken")
 	proto.RegisterType((*LocalOrder)(nil), "types.LocalOrder")
 }
 
+func init() { proto.RegisterFile("trade.proto", fileDescriptor_ee944bd90e8a0312) }
+
+var fileDescriptor_ee944bd90e8a0312 = []byte{
+	// 2039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x8f, 0xe4, 0x48,
+	0x11, 0x1e, 0x97, 0xcb, 0xf5, 0x88, 0x7a, 0x67, 0x3f, 0xc6, 0xdb, 0x42, 0xa8, 0x65, 0xad, 0xf6,
+	0xc5, 0x6a, 0x24, 0x66, 0xb5, 0x02, 0x69, 0x11, 0x68, 0xaa, 0x87, 0xa6, 0x07, 0x7a, 0x04, 0xf2,
+	0x14, 0x12, 0x57, 0x57, 0x55, 0xce, 0x94, 0xd5, 0x6e, 0xbb, 0xda, 0xce, 0xea, 0x29, 0x73, 0xe2,
+	0x27, 0x70, 0x86, 0x03, 0xbf, 0x01, 0x09, 0xc4, 0x05, 0x89, 0x3f, 0xc0, 0x11, 0xce, 0x9c, 0xb8,
+	0x70, 0x45, 0x02, 0xc4, 0x09, 0xe5, 0xc3, 0x76, 0xfa, 0x5d, 0x25, 0x8d, 0x98, 0xde, 0x9e, 0xbd,
+	0x39, 0x22, 0x23, 0xc3, 0xe1, 0xfc, 0xbe, 0x0c, 0x47, 0xa4, 0x0d, 0x3d, 0xe2, 0x5b, 0x4b, 0xfc,
+	0x68, 0xed, 0x7b, 0xc4, 0x43, 0x1a, 0x09, 0xd7, 0x38, 0x38, 0x99, 0x10, 0xdf, 0x72, 0x03, 0x6b,
+	0x41, 0x6c, 0xcf, 0xe5, 0x23, 0xc6, 0x6f, 0x55, 0xd0, 0x66, 0xd4, 0x12, 0x7d, 0x06, 0xdd, 0x00,
+	0x3b, 0xce, 0xa5, 0x7d, 0x6d, 0x13, 0x5d, 0x39, 0x55, 0x3e, 0xea, 0x3d, 0x3e, 0x78, 0xc4, 0xe6,
+	0x3d, 0x62, 0x06, 0xe7, 0x9e, 0xff, 0x02, 0x3b, 0xce, 0xc5, 0x03, 0x33, 0xb1, 0x43, 0x8f, 0xa1,
+	0x3b, 0xdf, 0x84, 0xcf, 0x2d, 0xff, 0x0a, 0x13, 0xbd, 0xc1, 0x26, 0xa1, 0xcc, 0xa4, 0xe9, 0x26,
+	0xa4, 0x73, 0x62, 0x33, 0xf4, 0x05, 0x80, 0x8f, 0x6f, 0xbd, 0x2b, 0x4c, 0xdd, 0xe9, 0x2a, 0x9b,
+	0xf4, 0x5e, 0x66, 0x92, 0x19, 0x1b, 0x5c, 0x3c, 0x30, 0x25, 0x73, 0xf4, 0x39, 0x74, 0xe6, 0x9b,
+	0x90, 0x07, 0xa9, 0xb1, 0xa9, 0x0f, 0xf3, 0xf7, 0x63, 0xc3, 0x17, 0x0f, 0xcc, 0xd8, 0x94, 0xde,
+	0x93, 0x06, 0x2d, 0x02, 0x6d, 0x15, 0xde, 0xf3, 0x45, 0x6c, 0x40, 0xef, 0x99, 0x98, 0xa3, 0x6f,
+	0x43, 0x97, 0x47, 0x30, 0xdd, 0x84, 0x7a, 0x9b, 0xcd, 0xd5, 0x0b, 0xe3, 0x15, 0x8f, 0x1a, 0x1b,
+	0xd3, 0xdb, 0x3a, 0xf4, 0xfe, 0x3f, 0xf6, 0x97, 0xd8, 0xd7, 0x3b, 0x85, 0xb7, 0xbd, 0x8c, 0x0d,
+	0xe8, 0x6d, 0x13, 0x73, 0x34, 0x84, 0x06, 0x09, 0xf5, 0xe6, 0xa9, 0xf2, 0x91, 0x66, 0x36, 0x48,
+	0x38, 0x6d, 0x83, 0x76, 0x6b, 0x39, 0x1b, 0x6c, 0xfc, 0x59, 0x85, 0xbe, 0x1c, 0x34, 0x3a, 0x85,
+	0x1e, 0xf1, 0xae, 0xb0, 0xfb, 0x22, 0xbc, 0x9e, 0x7b, 0x0e, 0x03, 0xaf, 0x6b, 0xca, 0x2a, 0xf4,
+	0x29, 0x4c, 0xac, 0x6b, 0x6f, 0xe3, 0x92, 0x9f, 0x60, 0x7f, 0xea, 0x59, 0xfe, 0xd2, 0xf1, 0x38,
+	0x5e, 0xaa, 0x99, 0x1f, 0xa0, 0xfe, 0xae, 0x6d, 0x37, 0xb6, 0x53, 0x99, 0x9d, 0xac, 0x42, 0x9f,
+	0xc0, 0x78, 0xed, 0xdb, 0x0b, 0x2c, 0xbb, 0x6b, 0x32, 0xb3, 0x9c, 0x1e, 0xbd, 0x0f, 0x03, 0xe2,
+	0x11, 0xcb, 0x89, 0x0d, 0x35, 0x66, 0x98, 0x56, 0xa2, 0xaf, 0x41, 0x37, 0x20, 0x96, 0x4f, 0x88,
+	0x7d, 0x8d, 0x19, 0x40, 0xaa, 0x99, 0x28, 0xd0, 0x09, 0x74, 0x02, 0xe2, 0xad, 0xd9, 0x60, 0x9b,
+	0x0d, 0xc6, 0x32, 0x9d, 0xb9, 0xf0, 0xbd, 0xd7, 0xcb, 0x97, 0x1b, 0x77, 0xc9, 0xd6, 0xb8, 0x63,
+	0x26, 0x0a, 0x3a, 0x6a, 0x05, 0x01, 0x26, 0xdf, 0xdf, 0xe2, 0x85, 0xde, 0x65, 0x2b, 0x93, 0x28,
+	0xe8, 0x28, 0x8b, 0x97, 0x8d, 0x02, 0x1f, 0x8d, 0x15, 0x74, 0x1d, 0x98, 0x20, 0xd6, 0xb5, 0xc7,
+	0xd7, 0x55, 0x52, 0x21, 0x03, 0xfa, 0x78, 0xbb, 0xb6, 0x7d, 0x7c, 0x81, 0xed, 0x57, 0x2b, 0xa2,
+	0xf7, 0x59, 0x6c, 0x29, 0x1d, 0xfa, 0x3a, 0x00, 0x97, 0x67, 0x34, 0xfa, 0x01, 0xb3, 0x90, 0x34,
+	0xc6, 0x0f, 0xa0, 0x27, 0x71, 0x17, 0x1d, 0x43, 0x8b, 0x72, 0xef, 0xd9, 0x53, 0x81, 0xa3, 0x90,
+	0x68, 0x30, 0x73, 0xb1, 0x58, 0x67, 0x6e, 0x04, 0x9e, 0xac, 0x32, 0x3e, 0x05, 0x94, 0xdf, 0x3f,
+	0x65, 0xfe, 0x8c, 0xff, 0x34, 0x60, 0x9c, 0xdd, 0x33, 0xf7, 0x85, 0x49, 0x09, 0xe2, 0xad, 0x4a,
+	0xc4, 0xdb, 0x35, 0x88, 0x77, 0xea, 0x11, 0xef, 0xd6, 0x22, 0x0e, 0x39, 0xc4, 0x2f, 0x13, 0xa0,
+	0x92, 0xa4, 0x83, 0x0e, 0x41, 0x9b, 0x6f, 0xc2, 0x18, 0x27, 0x2e, 0xec, 0x00, 0xfb, 0xc7, 0x30,
+	0xc9, 0xa5, 0xa1, 0x62, 0x67, 0xc6, 0x7f, 0x1b, 0xc9, 0x9d, 0x93, 0xbc, 0xf3, 0xc6, 0x51, 0x2f,
+	0xc2, 0x54, 0xdd, 0x15, 0xd3, 0x66, 0x2d, 0xa6, 0x5a, 0x25, 0xa6, 0xad, 0x1a, 0x4c, 0xdb, 0x79,
+	0x4c, 0x4f, 0xa1, 0x67, 0x07, 0x14, 0x89, 0x24, 0x4f, 0x77, 0x4c, 0x59, 0xf5, 0x46, 0x50, 0xff,
+	0x67, 0x13, 0xba, 0x89, 0xc7, 0xfa, 0x35, 0xd7, 0xa1, 0x6d, 0x2d, 0x97, 0x3e, 0x0e, 0x02, 0xb6,
+	0xd2, 0x5d, 0x33, 0x12, 0x8b, 0xd1, 0x50, 0x77, 0xdc, 0x83, 0xcd, 0xdd, 0xf6, 0xa0, 0xb6, 0x2b,
+	0x5e, 0xad, 0x22, 0xbc, 0x0c, 0xe8, 0x07, 0x9e, 0xb3, 0x8c, 0x8d, 0x78, 0xce, 0x4e, 0xe9, 0xd2,
+	0x19, 0xbf, 0x53, 0x95, 0xf1, 0xbb, 0x55, 0x19, 0x1f, 0xb2, 0x19, 0x3f, 0x49, 0x78, 0xbd, 0x54,
+	0x02, 0xa5, 0x7a, 0x62, 0x91, 0x4d, 0xc0, 0xb2, 0xb4, 0x66, 0x0a, 0x89, 0xea, 0x57, 0x1c, 0x55,
+	0x9e, 0x9b, 0x85, 0x94, 0xe6, 0xdc, 0xb0, 0x92, 0x73, 0xa3, 0x1a, 0xce, 0x8d, 0xeb, 0xf3, 0xc8,
+	0xa4, 0x96, 0x51, 0x28, 0xcb, 0x28, 0xea, 0xe3, 0xda, 0xba, 0xc2, 0xfe, 0x39, 0xc6, 0xa6, 0x45,
+	0xb0, 0x7e, 0xc0, 0x7d, 0xc8, 0x3a, 0xe3, 0x4f, 0x4d, 0x18, 0x44, 0xe9, 0xfd, 0x5d, 0x60, 0xde,
+	0x07, 0x30, 0x9c, 0x7b, 0x9b, 0x57, 0x2b, 0x92, 0xe1, 0x5e, 0x46, 0x9b, 0x24, 0xc8, 0x8e, 0x9c,
+	0x6d, 0x13, 0x8e, 0x74, 0x4b, 0x38, 0x02, 0xe5, 0x1c, 0xe9, 0x55, 0x72, 0xa4, 0x5f, 0xc3, 0x91,
+	0x41, 0x3d, 0x47, 0x86, 0xb5, 0x1c, 0x19, 0xd5, 0x72, 0x64, 0x9c, 0xe7, 0x08, 0x8d, 0xf3, 0x25,
+	0xc6, 0xe7, 0xbe, 0xf7, 0x73, 0xec, 0x0a, 0x22, 0x26, 0x0a, 0xe3, 0x97, 0x1a, 0x0c, 0x4d, 0xbc,
+	0xc0, 0xf6, 0x9a, 0x4c, 0x37, 0xe1, 0xd4, 0x0a, 0xf0, 0x0e, 0x14, 0x3a, 0x04, 0xcd, 0x7b, 0xed,
+	0x62, 0x5f, 0x10, 0x88, 0x0b, 0xe5, 0xf4, 0xe9, 0xbe, 0x59, 0xfa, 0x74, 0xef, 0x04, 0x7d, 0xba,
+	0x32, 0x7d, 0x44, 0x4a, 0x82, 0x6c, 0x4a, 0x22, 0xdb, 0x0b, 0x2b, 0x58, 0x45, 0xa9, 0x8a, 0x4b,
+	0x12, 0xdd, 0xfa, 0xe5, 0x74, 0x1b, 0x54, 0xd2, 0x6d, 0x58, 0x43, 0xb7, 0x51, 0x3d, 0xdd, 0xc6,
+	0xb5, 0x74, 0x9b, 0xe4, 0xe8, 0x76, 0x02, 0x9d, 0x88, 0x5a, 0x22, 0x61, 0xc5, 0x32, 0x1d, 0x23,
+	0xd1, 0x18, 0x4f, 0x55, 0xb1, 0x4c, 0x9f, 0x98, 0x13, 0x40, 0x3f, 0xe4, 0x4f, 0xcc, 0x25, 0xba,
+	0xce, 0x2c, 0x44, 0xfd, 0x88, 0xa9, 0xb9, 0x60, 0xfc, 0x5d, 0x83, 0x91, 0xa0, 0x24, 0x7d, 0xa3,
+	0xde, 0x73, 0x4e, 0xde, 0xfd, 0x97, 0x69, 0xc2, 0xf4, 0x78, 0x5f, 0x0c, 0x32, 0xfb, 0x42, 0xf0,
+	0x7c, 0x58, 0xc2, 0xf3, 0x51, 0x39, 0xcf, 0xc7, 0x95, 0x3c, 0x9f, 0xd4, 0xf0, 0x1c, 0xd5, 0xf3,
+	0xfc, 0xa0, 0x96, 0xe7, 0x87, 0x95, 0x3c, 0x3f, 0xaa, 0xe0, 0xf9, 0x71, 0x29, 0xcf, 0x1f, 0x16,
+	0xf3, 0x5c, 0x97, 0x79, 0x3e, 0x85, 0x23, 0x41, 0x73, 0x56, 0xb5, 0x4f, 0xe3, 0x33, 0x94, 0x8f,
+	0xa1, 0x39, 0xb7, 0x02, 0x2c, 0xce, 0x69, 0x8e, 0xc4, 0x91, 0x42, 0x3a, 0x4b, 0x9b, 0xcc, 0xc4,
+	0x78, 0x02, 0x87, 0x19, 0x1f, 0xbc, 0xd5, 0xdb, 0xc3, 0x45, 0x3e, 0x0c, 0xde, 0x68, 0xec, 0xe3,
+	0xe3, 0x2c, 0xed, 0xe3, 0x45, 0x7c, 0x84, 0xf4, 0x49, 0xca, 0xc7, 0x71, 0xda, 0x47, 0xb4, 0xbb,
+	0x85, 0x93, 0xef, 0xc1, 0x44, 0x1a, 0x10, 0x6b, 0xb1, 0x8f, 0x83, 0xa7, 0x70, 0x9c, 0x8d, 0x42,
+	0x3c, 0xca, 0x3e, 0x5e, 0xfe, 0xaa, 0xc2, 0x44, 0x76, 0xf3, 0xdc, 0x22, 0x8b, 0xd5, 0x0e, 0x09,
+	0x28, 0x45, 0xeb, 0x46, 0x25, 0xad, 0xd5, 0x1a, 0x5a, 0x37, 0xf3, 0xb4, 0x2e, 0x4c, 0x64, 0xda,
+	0x3e, 0x3d, 0x5a, 0xab, 0xa4, 0xf2, 0xca, 0xf4, 0xa0, 0xed, 0x5c, 0x0f, 0x1a, 0x57, 0x19, 0xac,
+	0xc2, 0x8c, 0xdf, 0x8a, 0x29, 0x1d, 0xa5, 0x38, 0x93, 0xc5, 0xbb, 0x91, 0x0b, 0x74, 0x26, 0x91,
+	0x67, 0xf2, 0x17, 0x64, 0x9f, 0x64, 0x66, 0x32, 0x59, 0xe4, 0x20, 0x2e, 0xb0, 0xf5, 0xa6, 0x17,
+	0xcf, 0x58, 0x9f, 0xc6, 0xf2, 0x50, 0xc7, 0x94, 0x55, 0x52, 0xda, 0x19, 0x94, 0xa4, 0x9d, 0xa1,
+	0x9c, 0x76, 0x8c, 0xdf, 0x28, 0x30, 0x30, 0xf1, 0xcd, 0x93, 0xe5, 0xd2, 0x7f, 0x42, 0x61, 0x09,
+	0x10, 0x82, 0x26, 0x2d, 0x7d, 0x05, 0x98, 0xec, 0x5a, 0x4a, 0x7d, 0x8d, 0x54, 0x8d, 0x48, 0xa3,
+	0xa4, 0x60, 0xeb, 0xea, 0xa9, 0xca, 0xa2, 0xa4, 0x02, 0x45, 0x75, 0x69, 0xfb, 0x98, 0x9d, 0xb9,
+	0x8a, 0xc3, 0xbc, 0x44, 0x41, 0xe7, 0x2c, 0x58, 0x36, 0xd0, 0xd8, 0x08, 0x17, 0x68, 0xfd, 0xfd,
+	0xd2, 0xf7, 0xae, 0x7f, 0x84, 0x43, 0xd1, 0xcd, 0x46, 0xa2, 0xf1, 0x6b, 0x85, 0x32, 0xef, 0x66,
+	0xc6, 0x48, 0xb5, 0x5f, 0x2f, 0x19, 0x79, 0x6c, 0xa4, 0x3c, 0x26, 0x11, 0xa8, 0x72, 0x04, 0xd5,
+	0x51, 0x27, 0x2b, 0xa0, 0xc9, 0x2b, 0x60, 0xfc, 0x4a, 0x81, 0x71, 0x14, 0xdd, 0x74, 0x13, 0xde,
+	0xad, 0xe0, 0xfe, 0xa0, 0x52, 0x70, 0xd7, 0x4e, 0xb8, 0x47, 0x64, 0x7b, 0x56, 0x0c, 0xf7, 0xbf,
+	0x09, 0x7a, 0x23, 0x55, 0xec, 0x18, 0xd4, 0x2b, 0x1c, 0x8a, 0x3d, 0x49, 0x2f, 0xab, 0x5b, 0x6d,
+	0xe3, 0x77, 0x2a, 0x6d, 0x40, 0xd6, 0x4e, 0xb8, 0x0f, 0xe3, 0xbf, 0xac, 0xd0, 0xed, 0x52, 0xec,
+	0x7d, 0x39, 0x60, 0xbb, 0x80, 0x51, 0x1a, 0xb5, 0x00, 0x7d, 0xce, 0x3f, 0xc3, 0x70, 0x49, 0x57,
+	0x4e, 0xd5, 0x54, 0xd5, 0x20, 0xdb, 0x9a, 0x92, 0xa1, 0xf1, 0x14, 0x86, 0xa9, 0x9d, 0x1b, 0x88,
+	0xef, 0x4e, 0x29, 0x3f, 0x87, 0xb2, 0x9f, 0xc8, 0xd2, 0x4c, 0xcc, 0x8c, 0xdf, 0x6b, 0x22, 0x20,
+	0xf6, 0xce, 0x7e, 0x07, 0x52, 0x00, 0xfb, 0x02, 0x98, 0x65, 0x52, 0x46, 0x7b, 0x97, 0xb8, 0x34,
+	0x77, 0xbc, 0xc5, 0x15, 0xab, 0xb7, 0xf9, 0x6b, 0x39, 0x51, 0x64, 0x4f, 0x68, 0x47, 0xf9, 0x13,
+	0xda, 0x7b, 0xdb, 0x32, 0x18, 0x53, 0x18, 0x67, 0x68, 0x1b, 0xa0, 0x47, 0xd0, 0xf2, 0x64, 0xf2,
+	0x1f, 0xcb, 0xe4, 0x4f, 0x0c, 0x4d, 0x61, 0x65, 0xfc, 0x45, 0xa1, 0x5b, 0xe8, 0x86, 0x57, 0xcc,
+	0x4f, 0xf1, 0x9a, 0xac, 0xd2, 0x0b, 0xa6, 0x64, 0x17, 0x2c, 0xb3, 0x31, 0x1a, 0x85, 0xc5, 0xec,
+	0xff, 0xb1, 0x5c, 0x8d, 0x2b, 0x81, 0x96, 0x54, 0x09, 0x18, 0xbf, 0x50, 0x60, 0x2c, 0x3d, 0xd3,
+	0x25, 0xbe, 0xc5, 0x4e, 0xe1, 0x5e, 0x52, 0x76, 0xab, 0x6c, 0xf3, 0x5f, 0x57, 0x28, 0xaa, 0x6c,
+	0x0d, 0xcf, 0xa4, 0x3a, 0x44, 0xd2, 0x18, 0x8e, 0x40, 0x47, 0x5e, 0xda, 0x6f, 0x40, 0x73, 0x6e,
+	0x2f, 0x23, 0x6c, 0xa2, 0x0f, 0xd4, 0xd9, 0x40, 0x4d, 0x66, 0x44, 0x8d, 0xad, 0xe0, 0x8a, 0x16,
+	0x93, 0xd5, 0xc6, 0xd4, 0x88, 0x7e, 0xae, 0xef, 0xf2, 0x0f, 0x38, 0xb6, 0xe3, 0xbc, 0x75, 0x08,
+	0xe3, 0xa6, 0x55, 0x93, 0x9a, 0x56, 0xa9, 0xc5, 0x6d, 0xa5, 0x5a, 0xdc, 0x63, 0x68, 0xdd, 0x7a,
+	0xce, 0x26, 0xfe, 0x82, 0x2b, 0xa4, 0x9d, 0x7a, 0x87, 0x6c, 0x97, 0xd0, 0xad, 0xea, 0x12, 0xa0,
+	0xa2, 0x4b, 0xe8, 0x55, 0x75, 0x09, 0xfd, 0x92, 0xdc, 0x95, 0xfb, 0x2e, 0x50, 0x91, 0xa9, 0x44,
+	0x66, 0x1b, 0xc5, 0x99, 0xcd, 0xf8, 0x9b, 0x42, 0xdf, 0x3b, 0x37, 0x0c, 0xb6, 0x0b, 0x3b, 0x20,
+	0x9e, 0x1f, 0xbe, 0x75, 0xe4, 0xa4, 0x92, 0x5b, 0x2b, 0x29, 0xb9, 0x5b, 0xa5, 0x25, 0x77, 0x3b,
+	0x53, 0x72, 0x1b, 0x5f, 0xc0, 0x24, 0x49, 0x3c, 0xd1, 0x23, 0x7e, 0x00, 0xda, 0x4b, 0xdb, 0x71,
+	0xa2, 0x5d, 0x30, 0x4e, 0xfd, 0xf6, 0x60, 0x3b, 0x8e, 0xc9, 0x87, 0x8d, 0x3f, 0x2a, 0xd0, 0x3a,
+	0xb3, 0xdc, 0xa5, 0x83, 0xe3, 0x43, 0x2c, 0xb6, 0xb2, 0x8a, 0x74, 0x88, 0xc5, 0x56, 0x16, 0x41,
+	0xd3, 0x5b, 0x63, 0x57, 0x6c, 0x52, 0x76, 0x4d, 0x75, 0x2b, 0xfb, 0xd5, 0x4a, 0xbc, 0x7a, 0xd9,
+	0x35, 0x45, 0xc0, 0xf1, 0x5e, 0x8b, 0xb7, 0x2c, 0xbd, 0x64, 0xcf, 0xe4, 0x78, 0x41, 0xcc, 0x53,
+	0x26, 0xec, 0xcd, 0xd3, 0x78, 0x65, 0x3a, 0xc2, 0x0b, 0x15, 0x8c, 0x7f, 0x29, 0x00, 0x26, 0xbe,
+	0xe1, 0x4f, 0x10, 0xbc, 0x75, 0x60, 0x4f, 0xa0, 0x63, 0xbb, 0x04, 0xfb, 0xb7, 0x96, 0x23, 0x90,
+	0x8d, 0xe5, 0xf4, 0xf2, 0xb6, 0xb2, 0xcb, 0xab, 0x43, 0x1b, 0xbb, 0xcb, 0x59, 0xf2, 0x87, 0x45,
+	0x24, 0xa6, 0x1f, 0x3c, 0xce, 0xbd, 0xdf, 0x82, 0x3e, 0x03, 0x3d, 0x7a, 0xf2, 0x0f, 0xa1, 0xbd,
+	0xe0, 0x97, 0x02, 0xf1, 0x81, 0x40, 0x9c, 0x1b, 0x98, 0xd1, 0xa8, 0xf1, 0x9c, 0x4e, 0xbc, 0xa1,
+	0x5b, 0x8f, 0x35, 0x8a, 0xe8, 0x43, 0x68, 0xd2, 0x2a, 0xa2, 0xe2, 0x9f, 0x23, 0x93, 0x19, 0x14,
+	0x97, 0x62, 0xc6, 0xcf, 0x58, 0xcf, 0x2e, 0xfd, 0xf0, 0xf0, 0x4d, 0x68, 0xf1, 0x3f, 0x70, 0x74,
+	0xa5, 0xf0, 0x87, 0x9b, 0xc4, 0xd4, 0x14, 0x86, 0x25, 0x9e, 0x9f, 0x41, 0xcf, 0xc4, 0x37, 0xd3,
+	0x4d, 0xc8, 0xe3, 0x7c, 0x1f, 0xd4, 0xf9, 0x26, 0xd4, 0x95, 0xb2, 0xbf, 0x9c, 0x4c, 0x3a, 0x2c,
+	0xea, 0xa9, 0xc4, 0x15, 0x13, 0x8c, 0x7f, 0x68, 0x00, 0x97, 0xde, 0xc2, 0x4a, 0xda, 0x17, 0x46,
+	0x8a, 0x74, 0xd9, 0x29, 0xa9, 0xbe, 0x2a, 0x3b, 0xf7, 0x2e, 0x3b, 0xd5, 0x3b, 0x58, 0x76, 0xea,
+	0xd0, 0x26, 0xdb, 0x67, 0xee, 0x12, 0x6f, 0x45, 0xd1, 0x19, 0x89, 0xb4, 0xac, 0xb0, 0x83, 0x73,
+	0xdb, 0xb5, 0x83, 0x15, 0x5e, 0xb2, 0x8a, 0xb3, 0x63, 0x4a, 0x9a, 0x74, 0x1e, 0x38, 0xa8, 0xc9,
+	0x03, 0x87, 0xf5, 0x05, 0xeb, 0x51, 0x6d, 0xc1, 0x7a, 0x5c, 0x59, 0xb0, 0x3e, 0xac, 0x28, 0x58,
+	0xf5, 0x74, 0xc1, 0xfa, 0xf8, 0xdf, 0x2a, 0x68, 0x0c, 0x6e, 0xf4, 0x5d, 0x38, 0x3c, 0xf3, 0xb1,
+	0x45, 0xb0, 0x69, 0xbd, 0x8e, 0x0f, 0x5c, 0x67, 0x5b, 0x54, 0xb4, 0xc9, 0x4f, 0x46, 0x42, 0xf9,
+	0x53, 0x37, 0xb0, 0x5f, 0xb9, 0xb3, 0xad, 0xf1, 0x00, 0x7d, 0x07, 0x0e, 0xd2, 0xf3, 0xe9, 0x66,
+	0xdc, 0xa2, 0x82, 0xcd, 0x57, 0x34, 0xfb, 0x1c, 0x8e, 0xd3, 0xb3, 0xf9, 0xce, 0x9f, 0x6d, 0x51,
+	0x79, 0x4a, 0x28, 0xf6, 0xa3, 0xe7, 0xa2, 0x60, 0x67, 0xd7, 0xb3, 0x2d, 0x2a, 0xfb, 0xfb, 0xb0,
+	0xc8, 0xcf, 0x0f, 0xe1, 0x24, 0xbf, 0x1a, 0xbc, 0xd0, 0x2b, 0x88, 0x29, 0x19, 0x2c, 0xf2, 0x75,
+	0x01, 0xef, 0x15, 0x3d, 0x1b, 0x5f, 0x9f, 0xd2, 0xbf, 0x13, 0x77, 0x8a, 0x2a, 0xf9, 0x55, 0xa0,
+	0x20, 0xaa, 0x64, 0xb0, 0xc0, 0xd7, 0xbc, 0xc5, 0x7e, 0x2a, 0xfd, 0xec, 0x7f, 0x03, 0x00, 0x7c,
+	0x54, 0x8c, 0x5f, 0x7d, 0x2a, 0x00, 0x00,
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -2740,6 +4163,7 @@ type TradeClient interface {
 	CreateRawTradeBuyLimitTx(ctx context.Context, in *TradeForBuyLimit, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(ctx context.Context, in *TradeForSellMarket, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeForRevokeBuy, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	CreateRawTradeLimitOrderTx(ctx context.Context, in *TradeForLimitOrder, opts ...grpc.CallOption) (*types.UnsignTx, error)
 }
 
 type tradeClient struct {
@@ -2804,6 +4228,15 @@ func (c *tradeClient) CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeFo
 	return out, nil
 }
 
+func (c *tradeClient) CreateRawTradeLimitOrderTx(ctx context.Context, in *TradeForLimitOrder, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.trade/CreateRawTradeLimitOrderTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TradeServer is the server API for Trade service.
 type TradeServer interface {
 	CreateRawTradeSellTx(context.Context, *TradeForSell) (*types.UnsignTx, error)
@@ -2812,6 +4245,33 @@ type TradeServer interface {
 	CreateRawTradeBuyLimitTx(context.Context, *TradeForBuyLimit) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(context.Context, *TradeForSellMarket) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(context.Context, *TradeForRevokeBuy) (*types.UnsignTx, error)
+	CreateRawTradeLimitOrderTx(context.Context, *TradeForLimitOrder) (*types.UnsignTx, error)
+}
+
+// UnimplementedTradeServer can be embedded to have forward compatible implementations.
+type UnimplementedTradeServer struct {
+}
+
+func (*UnimplementedTradeServer) CreateRawTradeSellTx(ctx context.Context, req *TradeForSell) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeSellTx not implemented")
+}
+func (*UnimplementedTradeServer) CreateRawTradeBuyTx(ctx context.Context, req *TradeForBuy) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeBuyTx not implemented")
+}
+func (*UnimplementedTradeServer) CreateRawTradeRevokeTx(ctx context.Context, req *TradeForRevokeSell) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeRevokeTx not implemented")
+}
+func (*UnimplementedTradeServer) CreateRawTradeBuyLimitTx(ctx context.Context, req *TradeForBuyLimit) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeBuyLimitTx not implemented")
+}
+func (*UnimplementedTradeServer) CreateRawTradeSellMarketTx(ctx context.Context, req *TradeForSellMarket) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeSellMarketTx not implemented")
+}
+func (*UnimplementedTradeServer) CreateRawTradeRevokeBuyTx(ctx context.Context, req *TradeForRevokeBuy) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeRevokeBuyTx not implemented")
+}
+func (*UnimplementedTradeServer) CreateRawTradeLimitOrderTx(ctx context.Context, req *TradeForLimitOrder) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeLimitOrderTx not implemented")
 }
 
 func RegisterTradeServer(s *grpc.Server, srv TradeServer) {
@@ -2926,6 +4386,24 @@ func _Trade_CreateRawTradeRevokeBuyTx_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trade_CreateRawTradeLimitOrderTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeForLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServer).CreateRawTradeLimitOrderTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.trade/CreateRawTradeLimitOrderTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServer).CreateRawTradeLimitOrderTx(ctx, req.(*TradeForLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Trade_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.trade",
 	HandlerType: (*TradeServer)(nil),
@@ -2954,99 +4432,11 @@ var _Trade_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRawTradeRevokeBuyTx",
 			Handler:    _Trade_CreateRawTradeRevokeBuyTx_Handler,
 		},
+		{
+			MethodName: "CreateRawTradeLimitOrderTx",
+			Handler:    _Trade_CreateRawTradeLimitOrderTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "trade.proto",
 }
-
-func init() { proto.RegisterFile("trade.proto", fileDescriptor_trade_05e36a4efd974a28) }
-
-var fileDescriptor_trade_05e36a4efd974a28 = []byte{
-	// 1371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x8a, 0xdb, 0xc6,
-	0x17, 0x5f, 0x5b, 0x96, 0xd7, 0x3a, 0xfe, 0x58, 0xef, 0xc4, 0xd9, 0xbf, 0xb2, 0xfc, 0x29, 0x46,
-	0x84, 0x36, 0x09, 0x61, 0xa1, 0x09, 0x81, 0x42, 0x4b, 0x4b, 0x9c, 0x34, 0x75, 0xda, 0x84, 0x96,
-	0x59, 0x17, 0x7a, 0x2b, 0x5b, 0x93, 0xac, 0x58, 0x59, 0xf2, 0x4a, 0xa3, 0xc4, 0x7a, 0x83, 0x42,
-	0xdf, 0xa0, 0xbd, 0xe8, 0x03, 0xf4, 0x36, 0x50, 0x42, 0xdf, 0xa7, 0x97, 0xbd, 0xea, 0x03, 0x94,
-	0x19, 0x8d, 0xa5, 0xd1, 0x97, 0x3f, 0x20, 0x85, 0x4d, 0xd2, 0x3b, 0xcf, 0x99, 0x33, 0x67, 0x8e,
-	0xce, 0xef, 0xf7, 0x9b, 0x2f, 0x43, 0x9b, 0xfa, 0xa6, 0x45, 0x4e, 0x16, 0xbe, 0x47, 0x3d, 0xa4,
-	0xd2, 0x68, 0x41, 0x82, 0xe3, 0x43, 0xea, 0x9b, 0x6e, 0x60, 0xce, 0xa8, 0xed, 0xb9, 0x71, 0x8f,
-	0xf1, 0x77, 0x1d, 0xd4, 0x09, 0xf3, 0x44, 0x77, 0x41, 0x0b, 0x88, 0xe3, 0x3c, 0xb1, 0xe7, 0x36,
-	0xd5, 0x6b, 0xc3, 0xda, 0x8d, 0xf6, 0x9d, 0x2b, 0x27, 0x7c, 0xdc, 0x09, 0x77, 0x78, 0xe4, 0xf9,
-	0xa7, 0xc4, 0x71, 0xc6, 0x7b, 0x38, 0xf5, 0x43, 0x77, 0x40, 0x9b, 0x86, 0xd1, 0x53, 0xd3, 0x3f,
-	0x27, 0x54, 0xaf, 0xf3, 0x41, 0x28, 0x37, 0x68, 0x14, 0x46, 0x6c, 0x4c, 0xe2, 0x86, 0x3e, 0x05,
-	0xf0, 0xc9, 0x0b, 0xef, 0x9c, 0xb0, 0x70, 0xba, 0xc2, 0x07, 0x5d, 0xcb, 0x0d, 0xc2, 0x89, 0xc3,
-	0x78, 0x0f, 0x4b, 0xee, 0xe8, 0x1e, 0xb4, 0xa6, 0x61, 0x14, 0x27, 0xa9, 0xf2, 0xa1, 0xff, 0x2b,
-	0xce, 0xc7, 0xbb, 0xc7, 0x7b, 0x38, 0x71, 0x65, 0x73, 0xb2, 0xa4, 0x45, 0xa2, 0xcd, 0xd2, 0x39,
-	0x4f, 0x13, 0x07, 0x36, 0x67, 0xea, 0x8e, 0x3e, 0x01, 0x2d, 0xce, 0x60, 0x14, 0x46, 0xfa, 0x3e,
-	0x1f, 0xab, 0x97, 0xe6, 0x2b, 0x3e, 0x35, 0x71, 0x46, 0x3d, 0xa8, 0xd3, 0x48, 0x6f, 0x0c, 0x6b,
-	0x37, 0x54, 0x5c, 0xa7, 0xd1, 0x68, 0x1f, 0xd4, 0x17, 0xa6, 0x13, 0x12, 0xe3, 0x47, 0x05, 0x3a,
-	0xf2, 0xbc, 0x68, 0x08, 0x6d, 0xea, 0x9d, 0x13, 0xf7, 0x34, 0x9a, 0x4f, 0x3d, 0x87, 0xd7, 0x5f,
-	0xc3, 0xb2, 0x09, 0xdd, 0x86, 0x43, 0x73, 0xee, 0x85, 0x2e, 0xfd, 0x8e, 0xf8, 0x23, 0xcf, 0xf4,
-	0x2d, 0xc7, 0x8b, 0x4b, 0xae, 0xe0, 0x62, 0x07, 0x8b, 0x37, 0xb7, 0xdd, 0xc4, 0x4f, 0xe1, 0x7e,
-	0xb2, 0x09, 0xdd, 0x82, 0xfe, 0xc2, 0xb7, 0x67, 0x44, 0x0e, 0xd7, 0xe0, 0x6e, 0x05, 0x3b, 0xba,
-	0x0e, 0x5d, 0xea, 0x51, 0xd3, 0x49, 0x1c, 0x55, 0xee, 0x98, 0x35, 0xa2, 0xff, 0x83, 0x16, 0x50,
-	0xd3, 0xa7, 0xd4, 0x9e, 0x13, 0x5e, 0x63, 0x05, 0xa7, 0x06, 0x74, 0x0c, 0xad, 0x80, 0x7a, 0x0b,
-	0xde, 0xb9, 0xcf, 0x3b, 0x93, 0x36, 0x1b, 0x39, 0xf3, 0xbd, 0x97, 0xd6, 0xb3, 0xd0, 0xb5, 0xf4,
-	0xd6, 0xb0, 0x76, 0xa3, 0x85, 0x53, 0x03, 0xeb, 0x35, 0x83, 0x80, 0xd0, 0x2f, 0x97, 0x64, 0xa6,
-	0x6b, 0xbc, 0x32, 0xa9, 0x81, 0xf5, 0xf2, 0x7c, 0x79, 0x2f, 0xc4, 0xbd, 0x89, 0x81, 0xd5, 0x81,
-	0x37, 0x44, 0x5d, 0xdb, 0x71, 0x5d, 0x25, 0x93, 0xf1, 0x15, 0xb4, 0x25, 0xea, 0xa0, 0x23, 0x68,
-	0x32, 0xe8, 0x1f, 0x3f, 0x14, 0x18, 0x88, 0x16, 0x0b, 0x34, 0x15, 0x1f, 0xfa, 0xc0, 0x5d, 0x15,
-	0x5e, 0x36, 0x19, 0xb7, 0x01, 0x15, 0xe9, 0x5b, 0x15, 0xcf, 0x78, 0x55, 0x87, 0x7e, 0x9e, 0xb2,
-	0xef, 0x0a, 0x0b, 0x52, 0xb4, 0x9a, 0x6b, 0xd1, 0xda, 0xdf, 0x80, 0x56, 0xab, 0x88, 0xd6, 0x93,
-	0xb4, 0xc8, 0xa9, 0x5e, 0xd1, 0x00, 0xd4, 0x69, 0x18, 0x25, 0x35, 0x8e, 0x1b, 0x5b, 0x40, 0x76,
-	0x13, 0x0e, 0x0b, 0x0a, 0x2e, 0x0f, 0x66, 0xfc, 0xd4, 0x00, 0x8d, 0xcd, 0xf8, 0xad, 0x6f, 0x11,
-	0x7f, 0x0b, 0xa0, 0x74, 0xd8, 0x37, 0x2d, 0xcb, 0x27, 0x41, 0xc0, 0x27, 0xd6, 0xf0, 0xaa, 0x59,
-	0x0e, 0xa1, 0xb2, 0x25, 0x84, 0x8d, 0xed, 0x20, 0x54, 0xb7, 0x85, 0xb0, 0x59, 0x06, 0xa1, 0x01,
-	0x9d, 0xc0, 0x73, 0xac, 0xc4, 0x29, 0x96, 0x6b, 0xc6, 0x96, 0x15, 0x7b, 0x6b, 0x9d, 0xd8, 0xb5,
-	0x75, 0x62, 0x87, 0xbc, 0xd8, 0x53, 0xbd, 0xb4, 0x33, 0xfa, 0x63, 0x76, 0x6a, 0xd2, 0x30, 0xd0,
-	0x3b, 0x7c, 0x39, 0x15, 0x2d, 0x66, 0x3f, 0x23, 0xf6, 0xf3, 0x33, 0xaa, 0x77, 0xf9, 0x3c, 0xa2,
-	0x95, 0xa5, 0x61, 0x6f, 0x2d, 0x0d, 0x0f, 0x36, 0xd0, 0xb0, 0x5f, 0xa4, 0xe1, 0x6b, 0x05, 0xba,
-	0x2b, 0xd5, 0xbe, 0x0f, 0x8c, 0xf8, 0x10, 0x7a, 0x53, 0x2f, 0x7c, 0x7e, 0x46, 0x73, 0x9c, 0xc8,
-	0x59, 0x53, 0xed, 0xb4, 0x64, 0x21, 0xa6, 0xd8, 0x69, 0x15, 0xd8, 0x41, 0x35, 0x76, 0xed, 0xb5,
-	0xd8, 0x75, 0x36, 0x60, 0xd7, 0x2d, 0x62, 0xf7, 0xa7, 0x02, 0x3d, 0x4c, 0x66, 0xc4, 0x5e, 0xd0,
-	0x51, 0x18, 0x8d, 0xcc, 0x80, 0x6c, 0x01, 0xde, 0x00, 0x54, 0xef, 0xa5, 0x4b, 0x7c, 0x01, 0x5d,
-	0xdc, 0xa8, 0x06, 0x4e, 0x7b, 0xb3, 0xc0, 0x69, 0x97, 0x02, 0x38, 0x4d, 0x06, 0x4e, 0x88, 0x14,
-	0xf2, 0x22, 0xa5, 0xcb, 0xb1, 0x19, 0x9c, 0xad, 0xc4, 0x1b, 0xb7, 0x24, 0xa0, 0x3b, 0xd5, 0x40,
-	0x77, 0xd7, 0x02, 0xdd, 0xdb, 0x00, 0xf4, 0x41, 0x11, 0xe8, 0x3f, 0x1a, 0x70, 0x20, 0x80, 0x66,
-	0x2b, 0xf7, 0x3b, 0x8e, 0xf4, 0xe5, 0x5f, 0xb4, 0x53, 0xfe, 0x24, 0x6c, 0xeb, 0xe6, 0xd8, 0x26,
-	0xd8, 0xd3, 0xab, 0x60, 0xcf, 0x41, 0x35, 0x7b, 0xfa, 0x6b, 0xd9, 0x73, 0xb8, 0x81, 0x3d, 0xa8,
-	0xc8, 0x9e, 0x11, 0x5c, 0x15, 0xe4, 0xe1, 0x47, 0x84, 0x51, 0x72, 0x7f, 0xb9, 0x09, 0x8d, 0xa9,
-	0x19, 0x10, 0x71, 0x47, 0xba, 0x2a, 0x6e, 0x02, 0xd9, 0x15, 0x05, 0x73, 0x17, 0xe3, 0x3e, 0x0c,
-	0x72, 0x31, 0xe2, 0x73, 0xde, 0x0e, 0x21, 0x8a, 0x69, 0xc4, 0x27, 0x95, 0x5d, 0x62, 0x3c, 0xc8,
-	0xc6, 0x38, 0x4d, 0xae, 0x6f, 0xb7, 0x32, 0x31, 0x8e, 0xb2, 0x31, 0x56, 0x9a, 0x11, 0x41, 0xbe,
-	0x80, 0x43, 0xa9, 0x43, 0xd4, 0x62, 0x97, 0x00, 0x0f, 0xe1, 0x28, 0x9f, 0x85, 0xf8, 0x94, 0x5d,
-	0xa2, 0xfc, 0x5a, 0x83, 0x2e, 0x26, 0x17, 0xf7, 0x2d, 0xcb, 0xbf, 0xcf, 0xb0, 0x0e, 0x10, 0x82,
-	0x06, 0xdb, 0x48, 0x85, 0x96, 0xf9, 0x6f, 0x89, 0x78, 0xf5, 0xcc, 0x8e, 0x33, 0x00, 0x95, 0x6b,
-	0x5d, 0x57, 0x86, 0x0a, 0x23, 0x1e, 0x6f, 0x30, 0xaa, 0x58, 0xb6, 0x4f, 0xf8, 0xbd, 0x58, 0xdc,
-	0xd6, 0x52, 0x03, 0x1b, 0x33, 0x63, 0x02, 0xe7, 0xfa, 0x54, 0x71, 0xdc, 0x60, 0xbb, 0xf9, 0x33,
-	0xdf, 0x9b, 0x7f, 0x43, 0x22, 0x71, 0xc8, 0x5d, 0x35, 0x8d, 0x5f, 0x6a, 0xac, 0x52, 0x17, 0x13,
-	0xbe, 0xa6, 0xec, 0x76, 0x62, 0x5c, 0x45, 0xac, 0x67, 0x22, 0xa6, 0x19, 0x28, 0x72, 0x06, 0xeb,
-	0xb3, 0x4e, 0x2b, 0xa0, 0xca, 0x15, 0x30, 0x7e, 0xae, 0x41, 0x7f, 0x95, 0xdd, 0x28, 0x8c, 0x2e,
-	0x57, 0x72, 0xbf, 0x2b, 0x0c, 0xdc, 0x85, 0x13, 0xed, 0x90, 0xd9, 0x8e, 0xeb, 0xf5, 0xbb, 0x7f,
-	0xa4, 0x7a, 0x23, 0x3b, 0x73, 0x1f, 0x94, 0x73, 0x12, 0x89, 0xf5, 0x99, 0xfd, 0x5c, 0x7f, 0xa0,
-	0x36, 0x5e, 0xf1, 0x43, 0xd5, 0xc2, 0x89, 0x76, 0x61, 0xfc, 0xdb, 0x0a, 0xdd, 0x36, 0x5b, 0xed,
-	0xdb, 0x01, 0xdb, 0x98, 0x9d, 0x90, 0x64, 0xd4, 0x02, 0x74, 0x2f, 0x7e, 0x2a, 0x8b, 0x5b, 0x7a,
-	0x6d, 0xa8, 0x64, 0x76, 0x17, 0xd9, 0x17, 0x4b, 0x8e, 0xc6, 0x43, 0x81, 0xff, 0x4a, 0xb9, 0x81,
-	0x78, 0x1b, 0xcc, 0xc4, 0x19, 0xc8, 0x71, 0x56, 0x9e, 0x38, 0x75, 0x33, 0x5e, 0x37, 0x44, 0x42,
-	0x7c, 0x8b, 0x78, 0x0f, 0x96, 0x00, 0xfe, 0x4a, 0x9b, 0x67, 0x52, 0xce, 0x7a, 0x99, 0xb8, 0x34,
-	0x75, 0xbc, 0xd9, 0xf9, 0x84, 0x9d, 0x10, 0x7b, 0xf1, 0xf1, 0x31, 0x31, 0xb0, 0x0a, 0xda, 0x41,
-	0x42, 0x0e, 0x7e, 0x56, 0x6b, 0x61, 0xd9, 0xf4, 0xaf, 0x1f, 0xd8, 0xfa, 0x39, 0xea, 0x04, 0xe8,
-	0x04, 0x9a, 0x9e, 0x4c, 0xc0, 0x23, 0x99, 0x80, 0xa9, 0x23, 0x16, 0x5e, 0xc6, 0x53, 0xe8, 0x60,
-	0x72, 0xc1, 0x32, 0xe6, 0x1b, 0x24, 0xfa, 0x08, 0x1a, 0xac, 0x7a, 0x6b, 0xde, 0xc3, 0x31, 0x77,
-	0x28, 0xa7, 0xa0, 0xf1, 0x03, 0x3f, 0xab, 0x48, 0xaf, 0x81, 0x1f, 0x43, 0x33, 0x7e, 0x1d, 0x16,
-	0x11, 0xab, 0xdf, 0xbd, 0xb1, 0x70, 0xac, 0x88, 0xfc, 0x18, 0xda, 0x98, 0x5c, 0x8c, 0xc2, 0x28,
-	0xce, 0xf3, 0x3a, 0x28, 0xd3, 0x30, 0x12, 0x41, 0x4b, 0x5e, 0xe0, 0x31, 0xeb, 0x16, 0x3c, 0x4a,
-	0x43, 0xf1, 0x86, 0xf1, 0x57, 0x03, 0xe0, 0x89, 0x37, 0x33, 0xd3, 0x65, 0x9b, 0x63, 0x92, 0x95,
-	0x9b, 0x64, 0xfa, 0x4f, 0x6e, 0x3b, 0xcb, 0x4d, 0xb9, 0x84, 0x72, 0xd3, 0x61, 0x9f, 0x2e, 0x1f,
-	0xbb, 0x16, 0x59, 0x0a, 0xb1, 0xad, 0x9a, 0xe8, 0x03, 0x00, 0x3b, 0x78, 0x64, 0xbb, 0x76, 0x70,
-	0x46, 0x2c, 0xae, 0xb4, 0x16, 0x96, 0x2c, 0x59, 0xa1, 0x5e, 0xd9, 0x20, 0xd4, 0x41, 0x41, 0xa8,
-	0x77, 0x7e, 0x53, 0x40, 0xe5, 0x25, 0x47, 0x9f, 0xc3, 0xe0, 0x81, 0x4f, 0x4c, 0x4a, 0xb0, 0xf9,
-	0x32, 0xb9, 0x14, 0x4c, 0x96, 0xa8, 0x4c, 0x68, 0xc7, 0x07, 0xc2, 0xf8, 0xbd, 0x1b, 0xd8, 0xcf,
-	0xdd, 0xc9, 0xd2, 0xd8, 0x43, 0x9f, 0xc1, 0x95, 0xec, 0x78, 0x26, 0x88, 0x25, 0x2a, 0x11, 0x40,
-	0xd9, 0xe8, 0x47, 0x70, 0x94, 0x1d, 0x1d, 0xab, 0x6f, 0xb2, 0x44, 0xd5, 0xb2, 0x2c, 0x8f, 0xa3,
-	0x17, 0xb2, 0xe0, 0xf7, 0xab, 0xc9, 0x12, 0x55, 0xfd, 0x3b, 0x55, 0x16, 0xe7, 0x6b, 0x38, 0x2e,
-	0x56, 0x23, 0xbe, 0x68, 0x95, 0xe4, 0x94, 0x76, 0x96, 0xc5, 0x1a, 0xc3, 0xb5, 0xb2, 0x6f, 0x8b,
-	0xeb, 0x53, 0xf9, 0xef, 0x55, 0x49, 0xa4, 0x69, 0x93, 0xff, 0x51, 0x78, 0xf7, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe0, 0xb9, 0xad, 0xb0, 0x51, 0x1c, 0x00, 0x00,
-}
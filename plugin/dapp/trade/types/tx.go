@@ -15,6 +15,8 @@ type TradeSellTx struct {
 	AssetExec         string `json:"assetExec"`
 	PriceExec         string `json:"priceExec"`
 	PriceSymbol       string `json:"priceSymbol"`
+	ExpireHeight      int64  `json:"expireHeight"`
+	ExpireTime        int64  `json:"expireTime"`
 }
 
 //TradeBuyTx :info for buy order to speficied order
@@ -41,6 +43,8 @@ type TradeBuyLimitTx struct {
 	AssetExec         string `json:"assetExec"`
 	PriceExec         string `json:"priceExec"`
 	PriceSymbol       string `json:"priceSymbol"`
+	ExpireHeight      int64  `json:"expireHeight"`
+	ExpireTime        int64  `json:"expireTime"`
 }
 
 //TradeSellMarketTx :用于向指定买单出售token的信息
@@ -55,3 +59,18 @@ type TradeRevokeBuyTx struct {
 	BuyID string `json:"buyID,"`
 	Fee   int64  `json:"fee"`
 }
+
+//TradeLimitOrderTx :自动撮合的限价单, 未成交部分挂单
+type TradeLimitOrderTx struct {
+	TokenSymbol       string `json:"tokenSymbol"`
+	AmountPerBoardlot int64  `json:"amountPerBoardlot"`
+	PricePerBoardlot  int64  `json:"pricePerBoardlot"`
+	TotalBoardlot     int64  `json:"totalBoardlot"`
+	Fee               int64  `json:"fee"`
+	AssetExec         string `json:"assetExec"`
+	PriceExec         string `json:"priceExec"`
+	PriceSymbol       string `json:"priceSymbol"`
+	IsSellOrder       bool   `json:"isSellOrder"`
+	ExpireHeight      int64  `json:"expireHeight"`
+	ExpireTime        int64  `json:"expireTime"`
+}